@@ -1,5 +1,6 @@
 import React, { useState, useEffect } from 'react';
 import axios from 'axios';
+import studentLabel from './studentLabel';
 import './GradedSubmissionsView.css';
 
 const GradedSubmissionsView = ({ assignment, onBack, onViewSubmission }) => {
@@ -143,10 +144,10 @@ const GradedSubmissionsView = ({ assignment, onBack, onViewSubmission }) => {
                     <div className="submission-header">
                       <div className="student-info">
                         <div className="student-avatar">
-                          {sub.student_name.charAt(0).toUpperCase()}
+                          {studentLabel(sub).charAt(0).toUpperCase()}
                         </div>
                         <div className="student-details">
-                          <div className="student-name">{sub.student_name}</div>
+                          <div className="student-name">{studentLabel(sub)}</div>
                           <div className="student-id">{sub.student_id}</div>
                         </div>
                       </div>
@@ -172,10 +173,10 @@ const GradedSubmissionsView = ({ assignment, onBack, onViewSubmission }) => {
                     <div className="submission-header">
                       <div className="student-info">
                         <div className="student-avatar pending">
-                          {sub.student_name.charAt(0).toUpperCase()}
+                          {studentLabel(sub).charAt(0).toUpperCase()}
                         </div>
                         <div className="student-details">
-                          <div className="student-name">{sub.student_name}</div>
+                          <div className="student-name">{studentLabel(sub)}</div>
                           <div className="student-id">{sub.student_id}</div>
                         </div>
                       </div>
@@ -211,10 +212,10 @@ const GradedSubmissionsView = ({ assignment, onBack, onViewSubmission }) => {
                     <div className="submission-header">
                       <div className="student-info">
                         <div className="student-avatar">
-                          {sub.student_name.charAt(0).toUpperCase()}
+                          {studentLabel(sub).charAt(0).toUpperCase()}
                         </div>
                         <div className="student-details">
-                          <div className="student-name">{sub.student_name}</div>
+                          <div className="student-name">{studentLabel(sub)}</div>
                           <div className="student-id">{sub.student_id}</div>
                         </div>
                       </div>
@@ -255,10 +256,10 @@ const GradedSubmissionsView = ({ assignment, onBack, onViewSubmission }) => {
                     <div className="submission-header">
                       <div className="student-info">
                         <div className="student-avatar pending">
-                          {sub.student_name.charAt(0).toUpperCase()}
+                          {studentLabel(sub).charAt(0).toUpperCase()}
                         </div>
                         <div className="student-details">
-                          <div className="student-name">{sub.student_name}</div>
+                          <div className="student-name">{studentLabel(sub)}</div>
                           <div className="student-id">{sub.student_id}</div>
                         </div>
                       </div>
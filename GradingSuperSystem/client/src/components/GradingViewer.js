@@ -1,6 +1,7 @@
 import React, { useState, useEffect } from 'react';
 import axios from 'axios';
 import CommentThread from './CommentThread';
+import studentLabel from './studentLabel';
 import './GradingViewer.css';
 
 const GradingViewer = ({ assignment, onBack, userRole }) => {
@@ -9,11 +10,17 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
   const [loading, setLoading] = useState(true);
   const [pdfUrl, setPdfUrl] = useState(null);
   const [rubricScores, setRubricScores] = useState({});
+  const [criterionFeedback, setCriterionFeedback] = useState({});
+  const [feedback, setFeedback] = useState('');
   const [gradeId, setGradeId] = useState(null);
+  const [gradeVersion, setGradeVersion] = useState(0);
   const [saving, setSaving] = useState(false);
+  const [bankComments, setBankComments] = useState([]);
+  const [appliedComments, setAppliedComments] = useState([]);
 
   useEffect(() => {
     fetchSubmissions();
+    fetchBankComments();
   }, [assignment]);
 
   useEffect(() => {
@@ -40,6 +47,28 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
     }
   };
 
+  const fetchBankComments = async () => {
+    try {
+      const token = localStorage.getItem('token');
+      const response = await axios.get(
+        `http://localhost:5000/api/assignments/${assignment.id}/comment-bank`,
+        { headers: { Authorization: `Bearer ${token}` }}
+      );
+      setBankComments(response.data.comments || []);
+    } catch (error) {
+      console.error('Error fetching comment bank:', error);
+    }
+  };
+
+  const showGrade = (grade) => {
+    setRubricScores(grade.rubric_scores || {});
+    setCriterionFeedback(grade.criterion_feedback || {});
+    setFeedback(grade.feedback || '');
+    setAppliedComments(grade.applied_comments || []);
+    setGradeId(grade.id);
+    setGradeVersion(grade.version || 0);
+  };
+
   const loadExistingGrade = async (submission) => {
     try {
       const token = localStorage.getItem('token');
@@ -48,8 +77,7 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
         { headers: { Authorization: `Bearer ${token}` }}
       );
       if (response.data.grade) {
-        setRubricScores(response.data.grade.rubric_scores || {});
-        setGradeId(response.data.grade.id);
+        showGrade(response.data.grade);
       } else {
         // Initialize empty scores
         const initialScores = {};
@@ -57,7 +85,11 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
           initialScores[index] = 0;
         });
         setRubricScores(initialScores);
+        setCriterionFeedback({});
+        setFeedback('');
+        setAppliedComments([]);
         setGradeId(null);
+        setGradeVersion(0);
       }
     } catch (error) {
       // No grade exists yet
@@ -66,7 +98,11 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
         initialScores[index] = 0;
       });
       setRubricScores(initialScores);
+      setCriterionFeedback({});
+      setFeedback('');
+      setAppliedComments([]);
       setGradeId(null);
+      setGradeVersion(0);
     }
   };
 
@@ -97,6 +133,13 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
     });
   };
 
+  const handleFeedbackChange = (criterionIndex, value) => {
+    setCriterionFeedback({
+      ...criterionFeedback,
+      [criterionIndex]: value
+    });
+  };
+
   const calculateTotalScore = () => {
     return Object.values(rubricScores).reduce((sum, score) => sum + (parseFloat(score) || 0), 0);
   };
@@ -118,7 +161,10 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
           submission_id: currentSubmission.id,
           student_id: currentSubmission.student_id,
           rubric_scores: rubricScores,
-          total_score: calculateTotalScore()
+          total_score: calculateTotalScore(),
+          criterion_feedback: criterionFeedback,
+          feedback: feedback,
+          version: gradeVersion
         },
         { headers: { Authorization: `Bearer ${token}` }}
       );
@@ -126,6 +172,7 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
       // Update gradeId if this is a new grade
       if (response.data.grade) {
         setGradeId(response.data.grade.id);
+        setGradeVersion(response.data.grade.version);
       }
       
       alert('Grade submitted successfully!');
@@ -136,12 +183,51 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
       }
     } catch (error) {
       console.error('Error submitting grade:', error);
-      alert('Failed to submit grade: ' + (error.response?.data || error.message));
+      const fields = error.response?.data?.fields;
+      const current = error.response?.status === 409 && error.response.data?.current;
+      if (current) {
+        // Someone saved this grade since it was loaded; show their version
+        showGrade(current);
+        alert(`This grade was changed by ${current.grader_name || 'someone else'} while you were editing. Their scores have been loaded; review them and submit again.`);
+      } else if (fields) {
+        alert('Failed to submit grade:\n' + fields.map(f => `${f.field}: ${f.message}`).join('\n'));
+      } else {
+        alert('Failed to submit grade: ' + (error.response?.data || error.message));
+      }
     } finally {
       setSaving(false);
     }
   };
 
+  // Applying or removing a bank comment saves the grade on the server, so
+  // unsaved edits to the form are replaced by the saved grade
+  const handleBankComment = async (commentId, remove) => {
+    try {
+      const token = localStorage.getItem('token');
+      const currentSubmission = submissions[currentIndex];
+      const response = remove
+        ? await axios.delete(
+            `http://localhost:5000/api/submissions/${currentSubmission.id}/bank-comments/${commentId}`,
+            { headers: { Authorization: `Bearer ${token}`, 'If-Match': `"${gradeVersion}"` }}
+          )
+        : await axios.post(
+            `http://localhost:5000/api/submissions/${currentSubmission.id}/bank-comments`,
+            { comment_id: commentId, version: gradeVersion },
+            { headers: { Authorization: `Bearer ${token}` }}
+          );
+      showGrade(response.data.grade);
+    } catch (error) {
+      console.error('Error updating bank comment:', error);
+      const current = error.response?.status === 409 && error.response.data?.current;
+      if (current) {
+        showGrade(current);
+        alert('This grade was changed while you were editing. The latest version has been loaded; try again.');
+      } else {
+        alert('Failed to update bank comment: ' + (error.response?.data || error.message));
+      }
+    }
+  };
+
   const goToPrevious = () => {
     if (currentIndex > 0) {
       setCurrentIndex(currentIndex - 1);
@@ -198,7 +284,7 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
         <div className="submission-info">
           <h2>{assignment.name}</h2>
           <div className="student-info">
-            <span className="student-name">{currentSubmission.student_name}</span>
+            <span className="student-name">{studentLabel(currentSubmission)}</span>
             <span className="student-id">({currentSubmission.student_id})</span>
           </div>
         </div>
@@ -231,7 +317,7 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
           {pdfUrl ? (
             <iframe
               src={pdfUrl}
-              title={`Submission ${currentSubmission.student_name}`}
+              title={`Submission ${studentLabel(currentSubmission)}`}
               className="pdf-viewer"
             />
           ) : (
@@ -262,9 +348,51 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
                   className="score-input"
                   disabled={userRole === 'instructor'}
                 />
+                <textarea
+                  rows="2"
+                  placeholder="Feedback for the student (optional)"
+                  value={criterionFeedback[index] || ''}
+                  onChange={(e) => handleFeedbackChange(index, e.target.value)}
+                  className="feedback-input"
+                  disabled={userRole === 'instructor'}
+                />
+                {userRole !== 'instructor' && bankComments.some(c => c.criterion_key === String(index)) && (
+                  <div className="bank-comments">
+                    {appliedComments.filter(c => c.criterion_key === String(index)).map(applied => (
+                      <span key={applied.comment_id} className="applied-comment">
+                        {applied.text} (-{applied.deduction})
+                        <button onClick={() => handleBankComment(applied.comment_id, true)} title="Remove">×</button>
+                      </span>
+                    ))}
+                    <select
+                      value=""
+                      onChange={(e) => e.target.value && handleBankComment(parseInt(e.target.value, 10), false)}
+                      className="bank-select"
+                    >
+                      <option value="">Apply comment from bank...</option>
+                      {bankComments
+                        .filter(c => c.criterion_key === String(index) && !appliedComments.some(a => a.comment_id === c.id))
+                        .map(c => (
+                          <option key={c.id} value={c.id}>{c.text} (-{c.deduction})</option>
+                        ))}
+                    </select>
+                  </div>
+                )}
               </div>
             ))}
 
+            <div className="overall-feedback">
+              <label className="criterion-name">Overall Comment</label>
+              <textarea
+                rows="3"
+                placeholder="Overall feedback for the student (optional)"
+                value={feedback}
+                onChange={(e) => setFeedback(e.target.value)}
+                className="feedback-input"
+                disabled={userRole === 'instructor'}
+              />
+            </div>
+
             <div className="total-score">
               <span className="total-label">Total Score:</span>
               <span className="total-value">
@@ -300,7 +428,7 @@ const GradingViewer = ({ assignment, onBack, userRole }) => {
             >
               <div className="submission-icon">📄</div>
               <div className="submission-details">
-                <div className="submission-name">{sub.student_name}</div>
+                <div className="submission-name">{studentLabel(sub)}</div>
                 <div className="submission-id">{sub.student_id}</div>
               </div>
             </div>
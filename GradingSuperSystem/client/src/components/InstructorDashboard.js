@@ -491,7 +491,8 @@ const InstructorDashboard = ({ course, onBack }) => {
             const assignmentData = {
               course_id: course.id,
               name: formData.get('name'),
-              description: formData.get('description')
+              description: formData.get('description'),
+              blind_grading: formData.get('blind_grading') === 'on'
             };
             handleCreateAssignment(assignmentData);
           }}
@@ -518,6 +519,13 @@ const InstructorDashboard = ({ course, onBack }) => {
             />
           </div>
 
+          <div className="form-group">
+            <label htmlFor="blind_grading">
+              <input type="checkbox" id="blind_grading" name="blind_grading" />
+              {' '}Blind grading (hide student identities from TAs until grades are released)
+            </label>
+          </div>
+
           <RubricBuilder 
             onRubricChange={setRubricData}
           />
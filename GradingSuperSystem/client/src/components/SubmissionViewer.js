@@ -1,5 +1,6 @@
 import React, { useState, useEffect } from 'react';
 import axios from 'axios';
+import studentLabel from './studentLabel';
 import './SubmissionViewer.css';
 
 const SubmissionViewer = ({ assignment, onBack }) => {
@@ -107,7 +108,7 @@ const SubmissionViewer = ({ assignment, onBack }) => {
         <div className="submission-info">
           <h2>{assignment.name}</h2>
           <div className="student-info">
-            <span className="student-name">{currentSubmission.student_name}</span>
+            <span className="student-name">{studentLabel(currentSubmission)}</span>
             <span className="student-id">({currentSubmission.student_id})</span>
           </div>
         </div>
@@ -137,7 +138,7 @@ const SubmissionViewer = ({ assignment, onBack }) => {
         {pdfUrl ? (
           <iframe
             src={pdfUrl}
-            title={`Submission ${currentSubmission.student_name}`}
+            title={`Submission ${studentLabel(currentSubmission)}`}
             className="pdf-viewer"
           />
         ) : (
@@ -159,7 +160,7 @@ const SubmissionViewer = ({ assignment, onBack }) => {
             >
               <div className="submission-icon">📄</div>
               <div className="submission-details">
-                <div className="submission-name">{sub.student_name}</div>
+                <div className="submission-name">{studentLabel(sub)}</div>
                 <div className="submission-id">{sub.student_id}</div>
               </div>
             </div>
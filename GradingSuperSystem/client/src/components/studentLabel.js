@@ -0,0 +1,6 @@
+// Blind-graded submissions reach TAs without a student name; their
+// student_id is then an opaque submission token
+const studentLabel = (submission) =>
+  submission.student_name || `Submission ${submission.student_id}`;
+
+export default studentLabel;
@@ -11,14 +11,49 @@ export const useAuth = () => {
   return context;
 };
 
+// LTI launches arrive with a one-time sign-in code in the URL fragment; take
+// it and drop it from the address bar so it is never reused
+const takeLtiCode = () => {
+  const match = window.location.hash.match(/lti_code=([^&]+)/);
+  if (!match) {
+    return null;
+  }
+  window.history.replaceState(null, '', window.location.pathname + window.location.search);
+  return decodeURIComponent(match[1]);
+};
+
 export const AuthProvider = ({ children }) => {
   const [user, setUser] = useState(null);
-  const [token, setToken] = useState(localStorage.getItem('token'));
+  const [ltiCode] = useState(takeLtiCode);
+  const [token, setToken] = useState(() => (ltiCode ? null : localStorage.getItem('token')));
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState('');
 
   const API_BASE_URL = process.env.REACT_APP_API_URL || 'http://localhost:5000';
 
+  // Exchange an LTI launch's sign-in code for a session
+  useEffect(() => {
+    if (!ltiCode) {
+      return;
+    }
+    const exchangeLtiCode = async () => {
+      try {
+        const response = await axios.post(`${API_BASE_URL}/api/lti/session`, { code: ltiCode });
+        const { user: userData, token: userToken } = response.data;
+        setUser(userData);
+        setToken(userToken);
+        localStorage.setItem('token', userToken);
+      } catch (err) {
+        console.error('LTI sign-in failed:', err);
+        setError(err.response?.data || 'LTI sign-in failed. Please launch TAlytics from your course again.');
+        localStorage.removeItem('token');
+        setLoading(false);
+      }
+    };
+
+    exchangeLtiCode();
+  }, [ltiCode, API_BASE_URL]);
+
   // Set up axios defaults
   useEffect(() => {
     if (token) {
@@ -30,6 +65,10 @@ export const AuthProvider = ({ children }) => {
 
   // Check if user is logged in on app start
   useEffect(() => {
+    if (ltiCode && !token) {
+      // Wait for the LTI sign-in code to be exchanged
+      return;
+    }
     const checkAuth = async () => {
       if (token) {
         try {
@@ -47,7 +86,7 @@ export const AuthProvider = ({ children }) => {
     };
 
     checkAuth();
-  }, [token, API_BASE_URL]); // Removed logout dependency to prevent infinite loop
+  }, [token, ltiCode, API_BASE_URL]); // Removed logout dependency to prevent infinite loop
 
   const login = async (email, password) => {
     try {
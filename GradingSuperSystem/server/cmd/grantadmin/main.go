@@ -0,0 +1,62 @@
+// Command grantadmin makes an existing TAlytics account an administrator, who
+// can register LTI platforms. Administrators are only ever made by whoever
+// runs the server, so check the account it prints is the one you meant.
+//
+// Run it from the server directory:
+//
+//	go run ./cmd/grantadmin -email admin@example.edu
+//
+// -revoke takes administrator rights away again, and -db names a database
+// other than ./talytics.db.
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+)
+
+func main() {
+	email := flag.String("email", "", "email of the account")
+	revoke := flag.Bool("revoke", false, "take administrator rights away instead")
+	dbPath := flag.String("db", "./talytics.db", "path of the TAlytics database")
+	flag.Parse()
+
+	if strings.TrimSpace(*email) == "" {
+		log.Fatal("-email is required")
+	}
+
+	db, err := database.Open(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	var userID int64
+	var name, accountEmail, role string
+	var createdAt time.Time
+	err = db.DB.QueryRow(`
+		SELECT id, name, email, role, created_at FROM users WHERE email = ? COLLATE NOCASE
+	`, strings.TrimSpace(*email)).Scan(&userID, &name, &accountEmail, &role, &createdAt)
+	if err == sql.ErrNoRows {
+		log.Fatalf("No account has the email %s", *email)
+	}
+	if err != nil {
+		log.Fatalf("Failed to find account: %v", err)
+	}
+
+	if _, err := db.DB.Exec("UPDATE users SET is_admin = ? WHERE id = ?", !*revoke, userID); err != nil {
+		log.Fatalf("Failed to update account: %v", err)
+	}
+
+	action := "is now an administrator"
+	if *revoke {
+		action = "is no longer an administrator"
+	}
+	log.Printf("Account %d (%s <%s>, %s, created %s) %s",
+		userID, name, accountEmail, role, createdAt.Format("2006-01-02 15:04"), action)
+}
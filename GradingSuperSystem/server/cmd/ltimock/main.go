@@ -0,0 +1,510 @@
+// Command ltimock is a minimal LTI 1.3 platform for trying out the TAlytics
+// tool locally. It launches users into the tool, publishes its keys, issues
+// AGS access tokens against the tool's published keys, and records the
+// scores the tool passes back.
+//
+// Register it with the tool using the JSON it logs at startup, then open
+//
+//	http://localhost:5100/launch?user=alice&role=instructor
+//
+// in a browser. Launch parameters: user, role (instructor, ta or student),
+// context, resource, custom_course_code, custom_assignment_id, and
+// lineitem=false to make the tool create its own line item.
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	mockKeyID = "ltimock-1"
+
+	rolePrefix = "http://purl.imsglobal.org/vocab/lis/v2/membership#"
+	claimLTI   = "https://purl.imsglobal.org/spec/lti/claim/"
+	claimAGS   = "https://purl.imsglobal.org/spec/lti-ags/claim/endpoint"
+	scopeAGS   = "https://purl.imsglobal.org/spec/lti-ags/scope/"
+)
+
+type platform struct {
+	issuer       string
+	toolURL      string
+	clientID     string
+	deploymentID string
+	key          *rsa.PrivateKey
+	client       *http.Client
+
+	mu        sync.Mutex
+	tokens    map[string]time.Time
+	lineItems map[string]*lineItem
+	scores    []score
+	nextItem  int
+}
+
+// launchHint carries a launch's parameters through the tool's login
+type launchHint struct {
+	User         string `json:"user"`
+	Role         string `json:"role"`
+	Context      string `json:"context"`
+	Resource     string `json:"resource"`
+	CourseCode   string `json:"course_code,omitempty"`
+	AssignmentID string `json:"assignment_id,omitempty"`
+	OmitLineItem bool   `json:"omit_line_item,omitempty"`
+}
+
+type lineItem struct {
+	ID             string  `json:"id"`
+	Label          string  `json:"label"`
+	ScoreMaximum   float64 `json:"scoreMaximum"`
+	ResourceLinkID string  `json:"resourceLinkId"`
+}
+
+type score struct {
+	LineItem         string   `json:"lineitem"`
+	UserID           string   `json:"userId"`
+	ScoreGiven       *float64 `json:"scoreGiven"`
+	ScoreMaximum     float64  `json:"scoreMaximum"`
+	Comment          string   `json:"comment,omitempty"`
+	ActivityProgress string   `json:"activityProgress"`
+	GradingProgress  string   `json:"gradingProgress"`
+	Timestamp        string   `json:"timestamp"`
+}
+
+func main() {
+	addr := flag.String("addr", ":5100", "address to listen on")
+	issuer := flag.String("issuer", "http://localhost:5100", "URL the platform is reached at, used as its issuer")
+	toolURL := flag.String("tool", "http://localhost:5000", "URL the TAlytics server is reached at")
+	clientID := flag.String("client-id", "talytics-mock", "client ID the tool is registered under")
+	deploymentID := flag.String("deployment-id", "mock-deployment", "deployment ID of the tool")
+	flag.Parse()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalf("Failed to generate platform key: %v", err)
+	}
+
+	p := &platform{
+		issuer:       strings.TrimSuffix(*issuer, "/"),
+		toolURL:      strings.TrimSuffix(*toolURL, "/"),
+		clientID:     *clientID,
+		deploymentID: *deploymentID,
+		key:          key,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		tokens:       make(map[string]time.Time),
+		lineItems:    make(map[string]*lineItem),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/launch", p.handleLaunch)
+	mux.HandleFunc("/auth", p.handleAuth)
+	mux.HandleFunc("/jwks", p.handleJWKS)
+	mux.HandleFunc("/token", p.handleToken)
+	mux.HandleFunc("/contexts/", p.handleLineItems)
+	mux.HandleFunc("/scores", p.handleScores)
+
+	registration, _ := json.MarshalIndent(map[string]string{
+		"name":           "LTI mock platform",
+		"issuer":         p.issuer,
+		"client_id":      p.clientID,
+		"deployment_id":  p.deploymentID,
+		"auth_login_url": p.issuer + "/auth",
+		"auth_token_url": p.issuer + "/token",
+		"jwks_url":       p.issuer + "/jwks",
+	}, "", "  ")
+	log.Printf("Register this platform with POST %s/api/lti/platforms:\n%s", p.toolURL, registration)
+	log.Printf("LTI mock platform listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
+
+// handleLaunch starts a third-party initiated login with the tool
+func (p *platform) handleLaunch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	hint := launchHint{
+		User:         valueOr(query.Get("user"), "alice"),
+		Role:         valueOr(query.Get("role"), "student"),
+		Context:      valueOr(query.Get("context"), "mock-course"),
+		Resource:     valueOr(query.Get("resource"), "mock-assignment"),
+		CourseCode:   query.Get("custom_course_code"),
+		AssignmentID: query.Get("custom_assignment_id"),
+		OmitLineItem: query.Get("lineitem") == "false",
+	}
+	if _, err := roles(hint.Role); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	encoded, err := json.Marshal(hint)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	params := url.Values{
+		"iss":               {p.issuer},
+		"login_hint":        {hint.User},
+		"target_link_uri":   {p.toolURL + "/lti/launch"},
+		"lti_message_hint":  {base64.RawURLEncoding.EncodeToString(encoded)},
+		"client_id":         {p.clientID},
+		"lti_deployment_id": {p.deploymentID},
+	}
+	http.Redirect(w, r, p.toolURL+"/lti/login?"+params.Encode(), http.StatusFound)
+}
+
+var launchForm = template.Must(template.New("launch").Parse(`<!DOCTYPE html>
+<html><body onload="document.forms[0].submit()">
+<form method="POST" action="{{.Action}}">
+<input type="hidden" name="id_token" value="{{.IDToken}}">
+<input type="hidden" name="state" value="{{.State}}">
+<noscript><button type="submit">Continue to TAlytics</button></noscript>
+</form>
+</body></html>
+`))
+
+// handleAuth answers the tool's authentication request with an id_token,
+// posted back to the tool by the browser
+func (p *platform) handleAuth(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch {
+	case query.Get("client_id") != p.clientID:
+		http.Error(w, "unknown client_id", http.StatusBadRequest)
+		return
+	case query.Get("redirect_uri") != p.toolURL+"/lti/launch":
+		http.Error(w, "redirect_uri is not registered", http.StatusBadRequest)
+		return
+	case query.Get("response_type") != "id_token" || query.Get("scope") != "openid" || query.Get("response_mode") != "form_post":
+		http.Error(w, "unsupported authentication request", http.StatusBadRequest)
+		return
+	case query.Get("nonce") == "" || query.Get("state") == "":
+		http.Error(w, "nonce and state are required", http.StatusBadRequest)
+		return
+	}
+
+	var hint launchHint
+	encoded, err := base64.RawURLEncoding.DecodeString(query.Get("lti_message_hint"))
+	if err == nil {
+		err = json.Unmarshal(encoded, &hint)
+	}
+	if err != nil || hint.User != query.Get("login_hint") {
+		http.Error(w, "invalid lti_message_hint", http.StatusBadRequest)
+		return
+	}
+
+	idToken, err := p.idToken(hint, query.Get("nonce"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	launchForm.Execute(w, map[string]string{
+		"Action":  query.Get("redirect_uri"),
+		"IDToken": idToken,
+		"State":   query.Get("state"),
+	})
+}
+
+func (p *platform) idToken(hint launchHint, nonce string) (string, error) {
+	userRoles, err := roles(hint.Role)
+	if err != nil {
+		return "", err
+	}
+	name := strings.ToUpper(hint.User[:1]) + hint.User[1:]
+	now := time.Now()
+
+	lineItems := p.issuer + "/contexts/" + url.PathEscape(hint.Context) + "/lineitems"
+	endpoint := map[string]interface{}{
+		"scope":     []string{scopeAGS + "lineitem", scopeAGS + "result.readonly", scopeAGS + "score"},
+		"lineitems": lineItems,
+	}
+	if !hint.OmitLineItem {
+		endpoint["lineitem"] = p.resourceLineItem(lineItems, hint.Resource)
+	}
+
+	custom := map[string]string{}
+	if hint.CourseCode != "" {
+		custom["talytics_course_code"] = hint.CourseCode
+	}
+	if hint.AssignmentID != "" {
+		custom["talytics_assignment_id"] = hint.AssignmentID
+	}
+
+	claims := jwt.MapClaims{
+		"iss":         p.issuer,
+		"aud":         p.clientID,
+		"sub":         "mock-" + hint.User,
+		"exp":         now.Add(5 * time.Minute).Unix(),
+		"iat":         now.Unix(),
+		"nonce":       nonce,
+		"name":        name + " Mock",
+		"given_name":  name,
+		"family_name": "Mock",
+		"email":       hint.User + "@ltimock.test",
+		// The mock vouches for its emails, for platforms registered with trust_email
+		"email_verified": true,
+
+		claimLTI + "message_type":    "LtiResourceLinkRequest",
+		claimLTI + "version":         "1.3.0",
+		claimLTI + "deployment_id":   p.deploymentID,
+		claimLTI + "target_link_uri": p.toolURL + "/lti/launch",
+		claimLTI + "roles":           userRoles,
+		claimLTI + "context": map[string]interface{}{
+			"id":    hint.Context,
+			"label": strings.ToUpper(hint.Context),
+			"title": "Mock course " + hint.Context,
+			"type":  []string{"http://purl.imsglobal.org/vocab/lis/v2/course#CourseOffering"},
+		},
+		claimLTI + "resource_link": map[string]interface{}{
+			"id":    hint.Resource,
+			"title": "Mock assignment " + hint.Resource,
+		},
+		claimLTI + "lis":    map[string]interface{}{"person_sourcedid": hint.User},
+		claimLTI + "custom": custom,
+		claimAGS:            endpoint,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = mockKeyID
+	return token.SignedString(p.key)
+}
+
+// resourceLineItem finds or creates the line item of a resource link
+func (p *platform) resourceLineItem(lineItems, resourceLinkID string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for id, item := range p.lineItems {
+		if item.ResourceLinkID == resourceLinkID && strings.HasPrefix(id, lineItems+"/") {
+			return id
+		}
+	}
+	p.nextItem++
+	id := lineItems + "/" + strconv.Itoa(p.nextItem)
+	p.lineItems[id] = &lineItem{ID: id, Label: resourceLinkID, ScoreMaximum: 100, ResourceLinkID: resourceLinkID}
+	return id
+}
+
+func roles(role string) ([]string, error) {
+	switch role {
+	case "instructor":
+		return []string{rolePrefix + "Instructor"}, nil
+	case "ta":
+		return []string{rolePrefix + "Instructor", "http://purl.imsglobal.org/vocab/lis/v2/membership/Instructor#TeachingAssistant"}, nil
+	case "student":
+		return []string{rolePrefix + "Learner"}, nil
+	}
+	return nil, fmt.Errorf("role must be instructor, ta or student, not %q", role)
+}
+
+func (p *platform) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	public := p.key.PublicKey
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": mockKeyID,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}},
+	})
+}
+
+// handleToken issues access tokens to the tool for client assertions signed
+// with a key from the tool's JWKS
+func (p *platform) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.PostFormValue("grant_type") != "client_credentials" ||
+		r.PostFormValue("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+		tokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256"}))
+	_, err := parser.ParseWithClaims(r.PostFormValue("client_assertion"), claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.toolKey(kid)
+	})
+	if err != nil || claims.Issuer != p.clientID || claims.Subject != p.clientID || !claims.VerifyAudience(p.issuer+"/token", true) {
+		log.Printf("Rejected client assertion: %v", err)
+		tokenError(w, "invalid_client")
+		return
+	}
+
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	accessToken := hex.EncodeToString(bytes)
+	p.mu.Lock()
+	p.tokens[accessToken] = time.Now().Add(time.Hour)
+	p.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"scope":        r.PostFormValue("scope"),
+	})
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+// toolKey fetches the tool's JWKS and finds a key in it
+func (p *platform) toolKey(kid string) (*rsa.PublicKey, error) {
+	resp, err := p.client.Get(p.toolURL + "/lti/jwks")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, err
+	}
+	for _, key := range jwks.Keys {
+		if key.Kid != kid {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	}
+	return nil, fmt.Errorf("tool has no key %q", kid)
+}
+
+func (p *platform) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	expiresAt, ok := p.tokens[token]
+	return ok && time.Now().Before(expiresAt)
+}
+
+// handleLineItems serves /contexts/{id}/lineitems, where the tool creates
+// line items, and /contexts/{id}/lineitems/{n}/scores, where it sends scores
+func (p *platform) handleLineItems(w http.ResponseWriter, r *http.Request) {
+	if !p.authorized(r) {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := p.issuer + r.URL.Path
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case strings.HasSuffix(path, "/lineitems"):
+		var item lineItem
+		if err := json.Unmarshal(body, &item); err != nil || item.ScoreMaximum <= 0 || item.Label == "" {
+			http.Error(w, "line items need a label and a positive scoreMaximum", http.StatusBadRequest)
+			return
+		}
+		p.mu.Lock()
+		p.nextItem++
+		item.ID = path + "/" + strconv.Itoa(p.nextItem)
+		p.lineItems[item.ID] = &item
+		p.mu.Unlock()
+
+		log.Printf("Created line item %s for resource link %s", item.ID, item.ResourceLinkID)
+		w.Header().Set("Content-Type", "application/vnd.ims.lis.v2.lineitem+json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(item)
+
+	case strings.HasSuffix(path, "/scores"):
+		lineItemID := strings.TrimSuffix(path, "/scores")
+		var received score
+		err := json.Unmarshal(body, &received)
+		if err == nil && (received.UserID == "" || received.Timestamp == "") {
+			err = errors.New("userId and timestamp are required")
+		}
+		p.mu.Lock()
+		_, known := p.lineItems[lineItemID]
+		if known && err == nil {
+			received.LineItem = lineItemID
+			p.scores = append(p.scores, received)
+		}
+		p.mu.Unlock()
+		if !known {
+			http.Error(w, "line item not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		given := "none"
+		if received.ScoreGiven != nil {
+			given = strconv.FormatFloat(*received.ScoreGiven, 'f', -1, 64)
+		}
+		log.Printf("Score for %s on %s: %s/%s (%s)", received.UserID, lineItemID, given,
+			strconv.FormatFloat(received.ScoreMaximum, 'f', -1, 64), received.GradingProgress)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// handleScores lists the scores received so far
+func (p *platform) handleScores(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	scores := append([]score{}, p.scores...)
+	p.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"scores": scores})
+}
+
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -2,8 +2,8 @@ package main
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -13,14 +13,17 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/rs/cors"
 	"github.com/talytics/server/internal/database"
 	"github.com/talytics/server/internal/middleware"
 	"github.com/talytics/server/internal/services"
+	"github.com/talytics/server/internal/statistics"
 	pb "github.com/talytics/server/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -28,6 +31,13 @@ const (
 	httpPort = ":5000"
 )
 
+// ltiStateCookie keeps an LTI login's state in the browser that started it,
+// for as long as the login's state is valid
+const (
+	ltiStateCookie       = "talytics_lti_state"
+	ltiStateCookieMaxAge = 10 * 60
+)
+
 func main() {
 	// Get the executable's directory to find .env file
 	exePath, err := os.Executable()
@@ -70,13 +80,28 @@ func startGRPCServer(db *database.Database) {
 		log.Fatalf("Failed to listen on %s: %v", grpcPort, err)
 	}
 
-	server := grpc.NewServer()
+	// Authenticate gRPC calls with the same JWT secret as the REST API
+	authMiddleware := middleware.NewAuthMiddleware([]byte("your-256-bit-secret"))
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(authMiddleware.UnaryServerInterceptor),
+		grpc.StreamInterceptor(authMiddleware.StreamServerInterceptor),
+	)
 
 	// Create services
 	userService := services.NewUserService(db)
 	courseService := services.NewCourseService(db, userService)
 	assignmentService := services.NewAssignmentService(db)
 	rubricService := services.NewRubricService(db)
+	submissionService := services.NewSubmissionService(db)
+	gradeService := services.NewGradeService(db)
+	analysisService := services.NewAnalysisService(db)
+	gradingAssignmentService := services.NewGradingAssignmentService(db)
+	calibrationService := services.NewCalibrationService(db)
+	regradeService := services.NewRegradeService(db)
+	studentService := services.NewStudentService(db)
+	commentBankService := services.NewCommentBankService(db)
+	gradebookService := services.NewGradebookService(db)
+	ltiService := services.NewLtiService(db, userService)
 	healthService := services.NewHealthService()
 
 	// Register services
@@ -84,6 +109,16 @@ func startGRPCServer(db *database.Database) {
 	pb.RegisterCourseServiceServer(server, courseService)
 	pb.RegisterAssignmentServiceServer(server, assignmentService)
 	pb.RegisterRubricServiceServer(server, rubricService)
+	pb.RegisterSubmissionServiceServer(server, submissionService)
+	pb.RegisterGradeServiceServer(server, gradeService)
+	pb.RegisterAnalysisServiceServer(server, analysisService)
+	pb.RegisterGradingAssignmentServiceServer(server, gradingAssignmentService)
+	pb.RegisterCalibrationServiceServer(server, calibrationService)
+	pb.RegisterRegradeServiceServer(server, regradeService)
+	pb.RegisterStudentServiceServer(server, studentService)
+	pb.RegisterCommentBankServiceServer(server, commentBankService)
+	pb.RegisterGradebookServiceServer(server, gradebookService)
+	pb.RegisterLtiServiceServer(server, ltiService)
 	pb.RegisterHealthServiceServer(server, healthService)
 
 	log.Printf("gRPC server listening on %s", grpcPort)
@@ -99,6 +134,15 @@ func startHTTPServer(db *database.Database) {
 	assignmentService := services.NewAssignmentService(db)
 	rubricService := services.NewRubricService(db)
 	submissionService := services.NewSubmissionService(db)
+	gradeService := services.NewGradeService(db)
+	analysisService := services.NewAnalysisService(db)
+	gradingAssignmentService := services.NewGradingAssignmentService(db)
+	calibrationService := services.NewCalibrationService(db)
+	regradeService := services.NewRegradeService(db)
+	studentService := services.NewStudentService(db)
+	commentBankService := services.NewCommentBankService(db)
+	gradebookService := services.NewGradebookService(db)
+	ltiService := services.NewLtiService(db, userService)
 	healthService := services.NewHealthService()
 
 	// Create authentication middleware
@@ -281,7 +325,7 @@ func startHTTPServer(db *database.Database) {
 			"year":        course.Year,
 			"created_at":  course.CreatedAt.AsTime(),
 			"updated_at":  course.UpdatedAt.AsTime(),
-			"role":        "ta", // User joining gets TA role
+			"role":        r.Context().Value("user_role").(string), // Students join with the student code
 			"assignment_count": 0,
 			"member_count":     len(course.Members),
 		}
@@ -354,6 +398,12 @@ func startHTTPServer(db *database.Database) {
 				}
 				json.NewEncoder(w).Encode(resp)
 				return
+			} else if pathParts[1] == "gradebook" && r.Method == http.MethodGet {
+				handleExportGradebook(w, r, courseID, gradebookService)
+				return
+			} else if pathParts[1] == "members" && len(pathParts) == 4 && pathParts[3] == "student-id" && r.Method == http.MethodPut {
+				handleSetStudentId(w, r, courseID, pathParts[2], courseService)
+				return
 			} else if pathParts[1] == "members" {
 				// Get course members
 				resp, err := courseService.GetCourse(r.Context(), &pb.GetCourseRequest{Id: courseID})
@@ -419,6 +469,9 @@ func startHTTPServer(db *database.Database) {
 			log.Printf("Creating rubric: course_id=%d, name=%s, criteria_count=%d", req.CourseId, req.Name, len(req.Criteria))
 			
 			resp, err := rubricService.CreateRubric(r.Context(), &req)
+			if writeValidationError(w, err) {
+				return
+			}
 			if err != nil {
 				log.Printf("Error creating rubric: %v", err)
 				http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -429,10 +482,11 @@ func startHTTPServer(db *database.Database) {
 		case "PUT":
 			var requestBody struct {
 				Id             int64     `json:"id"`
-				Name           string    `json:"name"`
-				Criteria       []string  `json:"criteria"`
-				Weights        []float64 `json:"weights"`
-				ForceRegrading bool      `json:"force_regrading"`
+				Name           string                `json:"name"`
+				Criteria       []string              `json:"criteria"`
+				Weights        []float64             `json:"weights"`
+				CriterionTree  []*pb.RubricCriterion `json:"criterion_tree"`
+				ForceRegrading bool                  `json:"force_regrading"`
 			}
 			if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
 				http.Error(w, err.Error(), http.StatusBadRequest)
@@ -442,11 +496,15 @@ func startHTTPServer(db *database.Database) {
 			req := &pb.UpdateRubricRequest{
 				Id:       requestBody.Id,
 				Name:     requestBody.Name,
-				Criteria: requestBody.Criteria,
-				Weights:  requestBody.Weights,
+				Criteria:      requestBody.Criteria,
+				Weights:       requestBody.Weights,
+				CriterionTree: requestBody.CriterionTree,
 			}
 			
 			resp, err := rubricService.UpdateRubric(r.Context(), req)
+			if writeValidationError(w, err) {
+				return
+			}
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
@@ -481,6 +539,47 @@ func startHTTPServer(db *database.Database) {
 			return
 		}
 		
+		if len(parts) >= 2 && parts[1] == "comments" {
+			rubricID, err := strconv.ParseInt(parts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid rubric ID", http.StatusBadRequest)
+				return
+			}
+			
+			switch r.Method {
+			case "GET":
+				handleListBankComments(w, r, &pb.ListBankCommentsRequest{RubricId: rubricID}, commentBankService)
+				return
+			case "POST":
+				handleCreateBankComment(w, r, rubricID, commentBankService)
+				return
+			}
+		}
+		
+		if len(parts) >= 2 && parts[1] == "simulate" && r.Method == "POST" {
+			rubricID, err := strconv.ParseInt(parts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid rubric ID", http.StatusBadRequest)
+				return
+			}
+			handleSimulateRubricChange(w, r, rubricID, rubricService)
+			return
+		}
+		
+		if len(parts) >= 2 && parts[1] == "versions" && r.Method == "GET" {
+			rubricID, err := strconv.ParseInt(parts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid rubric ID", http.StatusBadRequest)
+				return
+			}
+			if len(parts) >= 3 && parts[2] == "compare" {
+				handleCompareRubricVersions(w, r, rubricID, rubricService)
+				return
+			}
+			handleListRubricVersions(w, r, rubricID, rubricService)
+			return
+		}
+		
 		// Handle PUT /api/rubrics/{id} - Update rubric
 		if len(parts) >= 1 && r.Method == "PUT" {
 			rubricID, err := strconv.ParseInt(parts[0], 10, 64)
@@ -490,10 +589,11 @@ func startHTTPServer(db *database.Database) {
 			}
 			
 			var requestBody struct {
-				Name           string    `json:"name"`
-				Criteria       []string  `json:"criteria"`
-				Weights        []float64 `json:"weights"`
-				ForceRegrading bool      `json:"force_regrading"`
+				Name           string                `json:"name"`
+				Criteria       []string              `json:"criteria"`
+				Weights        []float64             `json:"weights"`
+				CriterionTree  []*pb.RubricCriterion `json:"criterion_tree"`
+				ForceRegrading bool                  `json:"force_regrading"`
 			}
 			if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
 				http.Error(w, err.Error(), http.StatusBadRequest)
@@ -503,11 +603,15 @@ func startHTTPServer(db *database.Database) {
 			req := &pb.UpdateRubricRequest{
 				Id:       rubricID,
 				Name:     requestBody.Name,
-				Criteria: requestBody.Criteria,
-				Weights:  requestBody.Weights,
+				Criteria:      requestBody.Criteria,
+				Weights:       requestBody.Weights,
+				CriterionTree: requestBody.CriterionTree,
 			}
 			
 			resp, err := rubricService.UpdateRubric(r.Context(), req)
+			if writeValidationError(w, err) {
+				return
+			}
 			if err != nil {
 				log.Printf("Error updating rubric %d: %v", rubricID, err)
 				http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -579,6 +683,187 @@ func startHTTPServer(db *database.Database) {
 			}
 		}
 		
+		if len(pathParts) >= 2 && pathParts[1] == "grading-assignments" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handleDistributeSubmissions(w, r, assignmentID, gradingAssignmentService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "grading-queue" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleGetGradingQueue(w, r, assignmentID, gradingAssignmentService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "grading-progress" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleGetGradingProgress(w, r, assignmentID, gradingAssignmentService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "overlap" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handleConfigureOverlap(w, r, assignmentID, gradingAssignmentService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "disagreements" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleGetDisagreements(w, r, assignmentID, analysisService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "calibration" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			action := ""
+			if len(pathParts) >= 3 {
+				action = pathParts[2]
+			}
+
+			switch {
+			case action == "" && r.Method == "GET":
+				handleListCalibrationSubmissions(w, r, assignmentID, calibrationService)
+				return
+			case action == "settings" && r.Method == "POST":
+				handleConfigureCalibration(w, r, assignmentID, calibrationService)
+				return
+			case action == "gold" && r.Method == "POST":
+				handleCalibrationGrade(w, r, assignmentID, true, calibrationService)
+				return
+			case action == "grades" && r.Method == "POST":
+				handleCalibrationGrade(w, r, assignmentID, false, calibrationService)
+				return
+			case action == "report" && r.Method == "GET":
+				handleGetCalibrationReport(w, r, assignmentID, calibrationService)
+				return
+			case action == "reset" && r.Method == "POST":
+				handleResetCalibration(w, r, assignmentID, calibrationService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "release" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handleSetReleaseState(w, r, assignmentID, assignmentService)
+				return
+			}
+		}
+		
+		if len(pathParts) == 3 && pathParts[1] == "grades" && pathParts[2] == "import" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handleImportGrades(w, r, assignmentID, gradeService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "blind-grading" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handleSetBlindGrading(w, r, assignmentID, assignmentService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 3 && pathParts[1] == "lti" && pathParts[2] == "passback" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handlePassbackGrades(w, r, assignmentID, ltiService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "regrade-requests" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleListRegradeRequests(w, r, assignmentID, regradeService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "comment-bank" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" && len(pathParts) == 2 {
+				handleListBankComments(w, r, &pb.ListBankCommentsRequest{AssignmentId: assignmentID}, commentBankService)
+				return
+			}
+			if r.Method == "GET" && len(pathParts) == 3 && pathParts[2] == "stats" {
+				handleGetCommentBankStats(w, r, assignmentID, commentBankService)
+				return
+			}
+		}
+		
 		if len(pathParts) >= 2 && pathParts[1] == "analytics" {
 			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
 			if err != nil {
@@ -587,7 +872,59 @@ func startHTTPServer(db *database.Database) {
 			}
 
 			if r.Method == "GET" {
-				handleGetAnalytics(w, r, assignmentID, db)
+				handleGetAnalytics(w, r, assignmentID, db, gradeService, analysisService, regradeService, commentBankService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "anomalies" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "POST" {
+				handleRunAnomalyAnalysis(w, r, assignmentID, analysisService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "analysis-history" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleGetAnalysisHistory(w, r, assignmentID, analysisService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "drift" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleGetDrift(w, r, assignmentID, analysisService)
+				return
+			}
+		}
+		
+		if len(pathParts) >= 2 && pathParts[1] == "leniency" {
+			assignmentID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+
+			if r.Method == "GET" {
+				handleGetLeniency(w, r, assignmentID, analysisService)
 				return
 			}
 		}
@@ -633,6 +970,28 @@ func startHTTPServer(db *database.Database) {
 		path := strings.TrimPrefix(r.URL.Path, "/api/submissions/")
 		pathParts := strings.Split(path, "/")
 		
+		if len(pathParts) >= 2 && pathParts[1] == "bank-comments" {
+			submissionID, err := strconv.ParseInt(pathParts[0], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid submission ID", http.StatusBadRequest)
+				return
+			}
+			
+			switch {
+			case len(pathParts) == 2 && r.Method == "POST":
+				handleApplyBankComment(w, r, submissionID, commentBankService)
+				return
+			case len(pathParts) == 3 && r.Method == "DELETE":
+				commentID, err := strconv.ParseInt(pathParts[2], 10, 64)
+				if err != nil {
+					http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+					return
+				}
+				handleRemoveBankComment(w, r, submissionID, commentID, commentBankService)
+				return
+			}
+		}
+		
 		if len(pathParts) >= 2 && pathParts[1] == "file" {
 			submissionID, err := strconv.ParseInt(pathParts[0], 10, 64)
 			if err != nil {
@@ -656,7 +1015,7 @@ func startHTTPServer(db *database.Database) {
 		log.Printf("Grade endpoint hit: method=%s", r.Method)
 		
 		if r.Method == "POST" {
-			handleSubmitGrade(w, r, db)
+			handleSubmitGrade(w, r, gradeService)
 			return
 		}
 		
@@ -675,7 +1034,7 @@ func startHTTPServer(db *database.Database) {
 		}
 
 		if r.Method == "GET" {
-			handleGetGradeBySubmission(w, r, submissionID, db)
+			handleGetGradeBySubmission(w, r, submissionID, gradeService)
 			return
 		}
 		
@@ -693,7 +1052,7 @@ func startHTTPServer(db *database.Database) {
 		}
 
 		if r.Method == "GET" {
-			handleGetGradesByAssignment(w, r, assignmentID, db)
+			handleGetGradesByAssignment(w, r, assignmentID, gradeService)
 			return
 		}
 		
@@ -708,49 +1067,30 @@ func startHTTPServer(db *database.Database) {
 			return
 		}
 		
-		userID := r.Context().Value("user_id").(int64)
-		
 		// Get all grades by this TA that need regrading
-		rows, err := db.DB.Query(`
-			SELECT g.id, g.assignment_id, g.submission_id, g.student_id, g.total_score, g.updated_at,
-			       a.name as assignment_name, s.student_name
-			FROM grades g
-			JOIN assignments a ON g.assignment_id = a.id
-			JOIN submissions s ON g.submission_id = s.id
-			WHERE g.grader_id = ? AND g.needs_regrading = 1
-			ORDER BY g.updated_at DESC
-		`, userID)
-		
+		resp, err := gradeService.ListRegradeQueue(r.Context(), &pb.ListRegradeQueueRequest{})
 		if err != nil {
 			log.Printf("Error fetching regrading grades: %v", err)
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
-		defer rows.Close()
 		
 		var grades []map[string]interface{}
-		for rows.Next() {
-			var id, assignmentID, submissionID int64
-			var studentID, assignmentName, studentName, updatedAt string
-			var totalScore float64
-			
-			err := rows.Scan(&id, &assignmentID, &submissionID, &studentID, &totalScore, &updatedAt, &assignmentName, &studentName)
-			if err != nil {
-				continue
-			}
-			
-			grade := map[string]interface{}{
-				"id":              id,
-				"assignment_id":   assignmentID,
-				"submission_id":   submissionID,
-				"student_id":      studentID,
-				"student_name":    studentName,
-				"assignment_name": assignmentName,
-				"total_score":     totalScore,
-				"updated_at":      updatedAt,
-			}
-			
-			grades = append(grades, grade)
+		for _, grade := range resp.Grades {
+			grades = append(grades, map[string]interface{}{
+				"id":              grade.Id,
+				"assignment_id":   grade.AssignmentId,
+				"submission_id":   grade.SubmissionId,
+				"student_id":      grade.StudentId,
+				"student_name":    grade.StudentName,
+				"anonymized":      grade.Anonymized,
+				"assignment_name": grade.AssignmentName,
+				"total_score":     grade.TotalScore,
+				"needs_regrading": grade.NeedsRegrading,
+				"rubric_version":  grade.RubricVersion,
+				"rubric_outdated": grade.RubricOutdated,
+				"updated_at":      grade.UpdatedAt.AsTime(),
+			})
 		}
 		
 		json.NewEncoder(w).Encode(map[string]interface{}{
@@ -778,22 +1118,281 @@ func startHTTPServer(db *database.Database) {
 		
 		if parts[1] == "comments" {
 			if r.Method == "GET" {
-				handleGetComments(w, r, gradeID, db)
+				handleGetComments(w, r, gradeID, gradeService)
 				return
 			} else if r.Method == "POST" {
-				handlePostComment(w, r, gradeID, db)
+				handlePostComment(w, r, gradeID, gradeService)
 				return
 			}
 		}
 		
-		http.Error(w, "Not found", http.StatusNotFound)
-	}))
-
+		if parts[1] == "history" && r.Method == "GET" {
+			handleGetGradeHistory(w, r, gradeID, gradeService)
+			return
+		}
+		
+		if parts[1] == "diff" && r.Method == "GET" {
+			handleDiffGradeRevisions(w, r, gradeID, gradeService)
+			return
+		}
+		
+		if parts[1] == "regrade-requests" && r.Method == "POST" {
+			handleCreateRegradeRequest(w, r, gradeID, regradeService)
+			return
+		}
+		
+		http.Error(w, "Not found", http.StatusNotFound)
+	}))
+
+	// Regrade request endpoints
+	mux.HandleFunc("/api/regrade-requests/", authHandler(authMiddleware, func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/api/regrade-requests/")
+		parts := strings.Split(path, "/")
+		
+		requestID, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid regrade request ID", http.StatusBadRequest)
+			return
+		}
+		
+		action := ""
+		if len(parts) >= 2 {
+			action = parts[1]
+		}
+		
+		switch {
+		case action == "" && r.Method == "GET":
+			resp, err := regradeService.GetRegradeRequest(r.Context(), &pb.GetRegradeRequestRequest{Id: requestID})
+			if err != nil {
+				writeRegradeError(w, err, "fetching regrade request")
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"request": regradeRequestJSON(resp.Request),
+			})
+			return
+		case r.Method == "POST" && (action == "assign" || action == "accept" || action == "reject" || action == "resolve"):
+			handleRegradeAction(w, r, requestID, action, regradeService)
+			return
+		}
+		
+		http.Error(w, "Not found", http.StatusNotFound)
+	}))
+
+	// Comment bank entries
+	mux.HandleFunc("/api/bank-comments/", authHandler(authMiddleware, func(w http.ResponseWriter, r *http.Request) {
+		commentID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/bank-comments/"), 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+			return
+		}
+		
+		switch r.Method {
+		case "PUT":
+			handleUpdateBankComment(w, r, commentID, commentBankService)
+		case "DELETE":
+			resp, err := commentBankService.DeleteBankComment(r.Context(), &pb.DeleteBankCommentRequest{Id: commentID})
+			if err != nil {
+				writeCommentBankError(w, err, "deleting bank comment")
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"message": resp.Message})
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}))
+
+	// Student portal endpoints
+	mux.HandleFunc("/api/student/", authHandler(authMiddleware, func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/api/student/")
+		parts := strings.Split(path, "/")
+		
+		// Filing a regrade request is the only thing a student can change
+		if len(parts) == 3 && parts[0] == "assignments" && parts[2] == "regrade-requests" {
+			if r.Method != "POST" {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			assignmentID, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+			handleCreateStudentRegradeRequest(w, r, assignmentID, studentService)
+			return
+		}
+		if r.Method != "GET" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		switch {
+		case len(parts) == 1 && parts[0] == "courses":
+			handleListStudentCourses(w, r, studentService)
+			return
+		case len(parts) == 1 && parts[0] == "submissions":
+			handleListStudentSubmissions(w, r, studentService)
+			return
+		case len(parts) == 3 && parts[0] == "courses" && parts[2] == "assignments":
+			courseID, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid course ID", http.StatusBadRequest)
+				return
+			}
+			handleListStudentAssignments(w, r, courseID, studentService)
+			return
+		case len(parts) == 3 && parts[0] == "submissions" && parts[2] == "file":
+			submissionID, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid submission ID", http.StatusBadRequest)
+				return
+			}
+			handleGetStudentSubmissionFile(w, r, submissionID, studentService)
+			return
+		case len(parts) == 3 && parts[0] == "assignments" && (parts[2] == "grade" || parts[2] == "rubric"):
+			assignmentID, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+				return
+			}
+			if parts[2] == "grade" {
+				handleGetStudentGrade(w, r, assignmentID, studentService)
+			} else {
+				handleGetStudentRubric(w, r, assignmentID, studentService)
+			}
+			return
+		}
+		
+		http.Error(w, "Not found", http.StatusNotFound)
+	}))
+
+	// LTI 1.3 launches come from platforms through the browser and are not
+	// authenticated with a TAlytics token
+	mux.HandleFunc("/lti/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" && r.Method != "POST" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleLtiLogin(w, r, ltiService)
+	})
+
+	mux.HandleFunc("/lti/launch", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleLtiLaunch(w, r, ltiService)
+	})
+
+	// The web client exchanges the one-time code a launch redirected it with
+	// for a session token
+	mux.HandleFunc("/api/lti/session", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req struct {
+			Code string `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := ltiService.ExchangeLoginCode(req.Code)
+		if errors.Is(err, services.ErrLtiLoginCodeInvalid) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			log.Printf("Error exchanging LTI sign-in code: %v", err)
+			http.Error(w, "Error signing in", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	mux.HandleFunc("/lti/jwks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		jwks, err := ltiService.ToolJWKS()
+		if err != nil {
+			log.Printf("Error loading LTI tool keys: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jwks)
+	})
+
+	// LTI platform registration and resource links
+	mux.HandleFunc("/api/lti/platforms", authHandler(authMiddleware, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			resp, err := ltiService.ListPlatforms(r.Context(), &pb.ListLtiPlatformsRequest{})
+			if err != nil {
+				writeLtiError(w, err, "listing LTI platforms")
+				return
+			}
+			platforms := []map[string]interface{}{}
+			for _, platform := range resp.Platforms {
+				platforms = append(platforms, ltiPlatformJSON(platform))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{"platforms": platforms})
+		case "POST":
+			handleRegisterLtiPlatform(w, r, ltiService)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}))
+
+	mux.HandleFunc("/api/lti/links", authHandler(authMiddleware, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		courseID, err := strconv.ParseInt(r.URL.Query().Get("course_id"), 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid course ID", http.StatusBadRequest)
+			return
+		}
+		resp, err := ltiService.ListResourceLinks(r.Context(), &pb.ListLtiResourceLinksRequest{CourseId: courseID})
+		if err != nil {
+			writeLtiError(w, err, "listing LTI resource links")
+			return
+		}
+		links := []map[string]interface{}{}
+		for _, link := range resp.Links {
+			links = append(links, ltiResourceLinkJSON(link))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"links": links})
+	}))
+
+	mux.HandleFunc("/api/lti/links/", authHandler(authMiddleware, func(w http.ResponseWriter, r *http.Request) {
+		linkID, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/lti/links/"), 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid resource link ID", http.StatusBadRequest)
+			return
+		}
+		if r.Method != "PUT" {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		handleLinkLtiResource(w, r, linkID, ltiService)
+	}))
+
 	// Setup CORS
 	c := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:3000", "http://localhost:3001"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
+		ExposedHeaders:   []string{"ETag"},
 		AllowCredentials: true,
 	})
 
@@ -811,6 +1410,23 @@ func startHTTPServer(db *database.Database) {
 	}
 }
 
+// writeValidationError responds 400 with the invalid fields when err is a
+// validation failure, reporting whether it handled the error
+func writeValidationError(w http.ResponseWriter, err error) bool {
+	var validationErr *services.ValidationError
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error":  "validation failed",
+		"fields": validationErr.Fields,
+	})
+	return true
+}
+
 // Helper function to wrap handlers with authentication
 func authHandler(authMiddleware *middleware.AuthMiddleware, next http.HandlerFunc) http.HandlerFunc {
 	return authMiddleware.AuthenticateHTTP(http.HandlerFunc(next)).ServeHTTP
@@ -932,6 +1548,10 @@ func handleGetSubmissionFile(w http.ResponseWriter, r *http.Request, submissionI
 	resp, err := submissionService.GetSubmissionFile(r.Context(), &pb.GetSubmissionRequest{
 		Id: submissionID,
 	})
+	if errors.Is(err, services.ErrNotCourseStaff) || errors.Is(err, services.ErrSubmissionNotAssigned) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -943,15 +1563,17 @@ func handleGetSubmissionFile(w http.ResponseWriter, r *http.Request, submissionI
 }
 
 // Handle submitting a grade
-func handleSubmitGrade(w http.ResponseWriter, r *http.Request, db *database.Database) {
-	userID := r.Context().Value("user_id").(int64)
-	
+func handleSubmitGrade(w http.ResponseWriter, r *http.Request, gradeService *services.GradeService) {
 	var req struct {
-		AssignmentID  int64              `json:"assignment_id"`
-		SubmissionID  int64              `json:"submission_id"`
-		StudentID     string             `json:"student_id"`
-		RubricScores  map[string]float64 `json:"rubric_scores"`
-		TotalScore    float64            `json:"total_score"`
+		AssignmentID      int64              `json:"assignment_id"`
+		SubmissionID      int64              `json:"submission_id"`
+		StudentID         string             `json:"student_id"`
+		RubricScores      map[string]float64 `json:"rubric_scores"`
+		TotalScore        float64            `json:"total_score"`
+		Reason            string             `json:"reason"`
+		Version           int32              `json:"version"`
+		CriterionFeedback map[string]string  `json:"criterion_feedback"`
+		Feedback          string             `json:"feedback"`
 	}
 	
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -960,89 +1582,115 @@ func handleSubmitGrade(w http.ResponseWriter, r *http.Request, db *database.Data
 		return
 	}
 	
-	// Convert rubric scores to JSON
-	rubricScoresJSON, err := json.Marshal(req.RubricScores)
-	if err != nil {
-		http.Error(w, "Error encoding rubric scores", http.StatusInternalServerError)
-		return
-	}
-	
-	// Check if grade already exists
-	var existingID int64
-	err = db.DB.QueryRow(`
-		SELECT id FROM grades WHERE submission_id = ?
-	`, req.SubmissionID).Scan(&existingID)
-	
-	if err == sql.ErrNoRows {
-		// Insert new grade
-		result, err := db.DB.Exec(`
-			INSERT INTO grades (assignment_id, submission_id, student_id, grader_id, rubric_scores, total_score, needs_regrading, graded_at, updated_at)
-			VALUES (?, ?, ?, ?, ?, ?, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-		`, req.AssignmentID, req.SubmissionID, req.StudentID, userID, string(rubricScoresJSON), req.TotalScore)
-		
+	// The version being edited comes from If-Match, or from the body for
+	// clients that cannot set headers
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`), 10, 32)
 		if err != nil {
-			log.Printf("Error inserting grade: %v", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, "Invalid If-Match header", http.StatusBadRequest)
 			return
 		}
-		
-		gradeID, _ := result.LastInsertId()
-		existingID = gradeID
-		log.Printf("Grade created: id=%d, submission=%d, score=%.2f", gradeID, req.SubmissionID, req.TotalScore)
-	} else if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		req.Version = int32(version)
+	}
+	
+	resp, err := gradeService.SubmitGrade(r.Context(), &pb.SubmitGradeRequest{
+		AssignmentId:      req.AssignmentID,
+		SubmissionId:      req.SubmissionID,
+		StudentId:         req.StudentID,
+		RubricScores:      req.RubricScores,
+		TotalScore:        req.TotalScore,
+		Reason:            req.Reason,
+		ExpectedVersion:   req.Version,
+		CriterionFeedback: req.CriterionFeedback,
+		Feedback:          req.Feedback,
+	})
+	if writeValidationError(w, err) {
+		return
+	}
+	var conflict *services.GradeConflictError
+	if errors.As(err, &conflict) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", gradeETag(conflict.Current))
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":   err.Error(),
+			"current": gradeJSON(conflict.Current),
+		})
+		return
+	}
+	if errors.Is(err, services.ErrGradeVersionConflict) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if errors.Is(err, services.ErrSubmissionNotAssigned) || errors.Is(err, services.ErrCalibrationRequired) || errors.Is(err, services.ErrNotCourseStaff) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, services.ErrSubmissionNotFound) || errors.Is(err, services.ErrAssignmentNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		// Anything else is a server fault whose details stay in the log
+		log.Printf("Error submitting grade: %v", err)
+		http.Error(w, "Error submitting grade", http.StatusInternalServerError)
 		return
+	}
+	
+	if resp.Created {
+		log.Printf("Grade created: id=%d, submission=%d, score=%.2f", resp.Grade.Id, req.SubmissionID, resp.Grade.TotalScore)
 	} else {
-		// Update existing grade and clear needs_regrading flag
-		_, err = db.DB.Exec(`
-			UPDATE grades SET rubric_scores = ?, total_score = ?, needs_regrading = 0, updated_at = CURRENT_TIMESTAMP
-			WHERE id = ?
-		`, string(rubricScoresJSON), req.TotalScore, existingID)
-		
-		if err != nil {
-			log.Printf("Error updating grade: %v", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		
-		log.Printf("Grade updated: id=%d, submission=%d, score=%.2f", existingID, req.SubmissionID, req.TotalScore)
+		log.Printf("Grade updated: id=%d, submission=%d, score=%.2f", resp.Grade.Id, req.SubmissionID, resp.Grade.TotalScore)
 	}
 	
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", gradeETag(resp.Grade))
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
-		"message": "Grade submitted successfully",
+		"message": resp.Message,
 		"grade": map[string]interface{}{
-			"id": existingID,
+			"id":          resp.Grade.Id,
+			"version":     resp.Grade.Version,
+			"total_score": resp.Grade.TotalScore,
 		},
 	})
 }
 
-// Handle getting a grade by submission
-func handleGetGradeBySubmission(w http.ResponseWriter, r *http.Request, submissionID int64, db *database.Database) {
-	var grade struct {
-		ID           int64              `json:"id"`
-		AssignmentID int64              `json:"assignment_id"`
-		SubmissionID int64              `json:"submission_id"`
-		StudentID    string             `json:"student_id"`
-		GraderID     int64              `json:"grader_id"`
-		GraderName   string             `json:"grader_name"`
-		RubricScores map[string]float64 `json:"rubric_scores"`
-		TotalScore   float64            `json:"total_score"`
-		GradedAt     string             `json:"graded_at"`
+// gradeETag is the entity tag clients send back in If-Match when editing a grade
+func gradeETag(grade *pb.RubricGrade) string {
+	return fmt.Sprintf(`"%d"`, grade.Version)
+}
+
+func gradeJSON(grade *pb.RubricGrade) map[string]interface{} {
+	criterionFeedback := grade.CriterionFeedback
+	if criterionFeedback == nil {
+		criterionFeedback = map[string]string{}
 	}
-	
-	var rubricScoresJSON string
-	var graderName sql.NullString
-	
-	err := db.DB.QueryRow(`
-		SELECT g.id, g.assignment_id, g.submission_id, g.student_id, g.grader_id, u.name, g.rubric_scores, g.total_score, g.graded_at
-		FROM grades g
-		LEFT JOIN users u ON g.grader_id = u.id
-		WHERE g.submission_id = ?
-	`, submissionID).Scan(&grade.ID, &grade.AssignmentID, &grade.SubmissionID, &grade.StudentID, &grade.GraderID, &graderName, &rubricScoresJSON, &grade.TotalScore, &grade.GradedAt)
-	
-	if err == sql.ErrNoRows {
+	return map[string]interface{}{
+		"id":                 grade.Id,
+		"assignment_id":      grade.AssignmentId,
+		"submission_id":      grade.SubmissionId,
+		"student_id":         grade.StudentId,
+		"anonymized":         grade.Anonymized,
+		"grader_id":          grade.GraderId,
+		"grader_name":        grade.GraderName,
+		"rubric_scores":      grade.RubricScores,
+		"total_score":        grade.TotalScore,
+		"version":            grade.Version,
+		"rubric_version_id":  grade.RubricVersionId,
+		"rubric_version":     grade.RubricVersion,
+		"rubric_outdated":    grade.RubricOutdated,
+		"graded_at":          grade.GradedAt.AsTime(),
+		"criterion_feedback": criterionFeedback,
+		"feedback":           grade.Feedback,
+		"applied_comments":   appliedCommentsJSON(grade.AppliedComments),
+	}
+}
+
+// Handle getting a grade by submission
+func handleGetGradeBySubmission(w http.ResponseWriter, r *http.Request, submissionID int64, gradeService *services.GradeService) {
+	resp, err := gradeService.GetSubmissionGrade(r.Context(), &pb.GetSubmissionGradeRequest{SubmissionId: submissionID})
+	if errors.Is(err, services.ErrGradeNotFound) {
 		http.Error(w, "Grade not found", http.StatusNotFound)
 		return
 	}
@@ -1052,74 +1700,60 @@ func handleGetGradeBySubmission(w http.ResponseWriter, r *http.Request, submissi
 		return
 	}
 	
-	if graderName.Valid {
-		grade.GraderName = graderName.String
-	}
-	
-	// Parse rubric scores JSON
-	if err := json.Unmarshal([]byte(rubricScoresJSON), &grade.RubricScores); err != nil {
-		http.Error(w, "Error parsing rubric scores", http.StatusInternalServerError)
-		return
+	// Submissions graded by more than one TA carry every visible grade
+	grades := []map[string]interface{}{}
+	for _, grade := range resp.Grades {
+		grades = append(grades, gradeJSON(grade))
 	}
 	
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", gradeETag(resp.Grade))
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"grade": grade,
+		"grade":  gradeJSON(resp.Grade),
+		"grades": grades,
 	})
 }
 
 // Handle getting all grades for an assignment
-func handleGetGradesByAssignment(w http.ResponseWriter, r *http.Request, assignmentID int64, db *database.Database) {
-	rows, err := db.DB.Query(`
-		SELECT g.id, g.assignment_id, g.submission_id, g.student_id, g.grader_id, u.name, g.rubric_scores, g.total_score, g.graded_at,
-		       s.student_name, s.file_name
-		FROM grades g
-		LEFT JOIN users u ON g.grader_id = u.id
-		LEFT JOIN submissions s ON g.submission_id = s.id
-		WHERE g.assignment_id = ?
-		ORDER BY g.graded_at DESC
-	`, assignmentID)
-	
+func handleGetGradesByAssignment(w http.ResponseWriter, r *http.Request, assignmentID int64, gradeService *services.GradeService) {
+	resp, err := gradeService.ListAssignmentGrades(r.Context(), &pb.ListAssignmentGradesRequest{AssignmentId: assignmentID})
+	if errors.Is(err, services.ErrNotCourseStaff) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, services.ErrAssignmentNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		log.Printf("Error fetching grades: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, "Error fetching grades", http.StatusInternalServerError)
 		return
 	}
-	defer rows.Close()
 	
 	var grades []map[string]interface{}
 	
-	for rows.Next() {
-		var id, assignmentID, submissionID, graderID int64
-		var studentID, rubricScoresJSON, gradedAt, fileName string
-		var totalScore float64
-		var graderName, studentName sql.NullString
-		
-		err := rows.Scan(&id, &assignmentID, &submissionID, &studentID, &graderID, &graderName, &rubricScoresJSON, &totalScore, &gradedAt, &studentName, &fileName)
-		if err != nil {
-			continue
-		}
-		
-		var rubricScores map[string]float64
-		json.Unmarshal([]byte(rubricScoresJSON), &rubricScores)
-		
+	for _, g := range resp.Grades {
 		grade := map[string]interface{}{
-			"id":            id,
-			"assignment_id": assignmentID,
-			"submission_id": submissionID,
-			"student_id":    studentID,
-			"grader_id":     graderID,
-			"rubric_scores": rubricScores,
-			"total_score":   totalScore,
-			"graded_at":     gradedAt,
-			"file_name":     fileName,
+			"id":              g.Id,
+			"assignment_id":   g.AssignmentId,
+			"submission_id":   g.SubmissionId,
+			"student_id":      g.StudentId,
+			"grader_id":       g.GraderId,
+			"rubric_scores":   g.RubricScores,
+			"total_score":     g.TotalScore,
+			"graded_at":       g.GradedAt.AsTime(),
+			"file_name":       g.FileName,
+			"anonymized":      g.Anonymized,
+			"rubric_version":  g.RubricVersion,
+			"rubric_outdated": g.RubricOutdated,
 		}
 		
-		if graderName.Valid {
-			grade["grader_name"] = graderName.String
+		if g.GraderName != "" {
+			grade["grader_name"] = g.GraderName
 		}
-		if studentName.Valid {
-			grade["student_name"] = studentName.String
+		if g.StudentName != "" {
+			grade["student_name"] = g.StudentName
 		}
 		
 		grades = append(grades, grade)
@@ -1131,11 +1765,13 @@ func handleGetGradesByAssignment(w http.ResponseWriter, r *http.Request, assignm
 	})
 }
 
-// Handle posting a comment on a grade
-func handlePostComment(w http.ResponseWriter, r *http.Request, gradeID int64, db *database.Database) {
+// Handle splitting an assignment's submissions among graders
+func handleDistributeSubmissions(w http.ResponseWriter, r *http.Request, assignmentID int64, gradingAssignmentService *services.GradingAssignmentService) {
 	var req struct {
-		Message  string `json:"message"`
-		ParentID *int64 `json:"parent_id"`
+		Strategy  string  `json:"strategy"`
+		GraderIDs []int64 `json:"grader_ids"`
+		Seed      int64   `json:"seed"`
+		Reassign  bool    `json:"reassign"`
 	}
 	
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -1143,689 +1779,2825 @@ func handlePostComment(w http.ResponseWriter, r *http.Request, gradeID int64, db
 		return
 	}
 	
-	// Get user ID from context
-	userID := r.Context().Value("user_id").(int64)
-	
-	var result sql.Result
-	var err error
-	
-	if req.ParentID != nil {
-		result, err = db.DB.Exec(`
-			INSERT INTO grade_comments (grade_id, user_id, message, parent_id)
-			VALUES (?, ?, ?, ?)
-		`, gradeID, userID, req.Message, *req.ParentID)
-	} else {
-		result, err = db.DB.Exec(`
-			INSERT INTO grade_comments (grade_id, user_id, message)
-			VALUES (?, ?, ?)
-		`, gradeID, userID, req.Message)
-	}
-	
+	resp, err := gradingAssignmentService.DistributeSubmissions(r.Context(), &pb.DistributeSubmissionsRequest{
+		AssignmentId: assignmentID,
+		Strategy:     req.Strategy,
+		GraderIds:    req.GraderIDs,
+		Seed:         req.Seed,
+		Reassign:     req.Reassign,
+	})
 	if err != nil {
-		log.Printf("Error posting comment: %v", err)
+		log.Printf("Error distributing submissions: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	
-	commentID, _ := result.LastInsertId()
-	
-	// Fetch the created comment with user info
-	var comment struct {
-		ID        int64  `json:"id"`
-		GradeID   int64  `json:"grade_id"`
-		UserID    int64  `json:"user_id"`
-		UserName  string `json:"user_name"`
-		UserRole  string `json:"user_role"`
-		Message   string `json:"message"`
-		ParentID  *int64 `json:"parent_id"`
-		CreatedAt string `json:"created_at"`
-	}
-	
-	var parentID sql.NullInt64
-	err = db.DB.QueryRow(`
-		SELECT c.id, c.grade_id, c.user_id, u.name, u.role, c.message, c.parent_id, c.created_at
-		FROM grade_comments c
-		LEFT JOIN users u ON c.user_id = u.id
-		WHERE c.id = ?
-	`, commentID).Scan(&comment.ID, &comment.GradeID, &comment.UserID, &comment.UserName, &comment.UserRole, &comment.Message, &parentID, &comment.CreatedAt)
-	
-	if err != nil {
-		log.Printf("Error fetching comment: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	
-	if parentID.Valid {
-		comment.ParentID = &parentID.Int64
+	assignments := []map[string]interface{}{}
+	for _, a := range resp.Assignments {
+		assignments = append(assignments, map[string]interface{}{
+			"id":            a.Id,
+			"submission_id": a.SubmissionId,
+			"student_id":    a.StudentId,
+			"grader_id":     a.GraderId,
+			"grader_name":   a.GraderName,
+			"strategy":      a.Strategy,
+			"assigned_at":   a.AssignedAt.AsTime(),
+		})
 	}
 	
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"comment": comment,
+		"success":     true,
+		"message":     resp.Message,
+		"seed":        resp.Seed,
+		"assignments": assignments,
 	})
 }
 
-// Handle getting all comments for a grade
-func handleGetComments(w http.ResponseWriter, r *http.Request, gradeID int64, db *database.Database) {
-	rows, err := db.DB.Query(`
-		SELECT c.id, c.grade_id, c.user_id, u.name, u.role, c.message, c.parent_id, c.created_at
-		FROM grade_comments c
-		LEFT JOIN users u ON c.user_id = u.id
-		WHERE c.grade_id = ?
-		ORDER BY c.created_at ASC
-	`, gradeID)
+// Handle listing a grader's queue for an assignment
+func handleGetGradingQueue(w http.ResponseWriter, r *http.Request, assignmentID int64, gradingAssignmentService *services.GradingAssignmentService) {
+	req := &pb.ListGradingQueueRequest{AssignmentId: assignmentID}
+	if graderID := r.URL.Query().Get("grader_id"); graderID != "" {
+		id, err := strconv.ParseInt(graderID, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid grader_id", http.StatusBadRequest)
+			return
+		}
+		req.GraderId = id
+	}
 	
+	resp, err := gradingAssignmentService.ListGradingQueue(r.Context(), req)
+	if errors.Is(err, services.ErrCalibrationRequired) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
 	if err != nil {
-		log.Printf("Error fetching comments: %v", err)
+		log.Printf("Error fetching grading queue: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	defer rows.Close()
-	
-	var comments []map[string]interface{}
 	
-	for rows.Next() {
-		var id, gradeID, userID int64
-		var userName, userRole, message, createdAt string
-		var parentID sql.NullInt64
-		
-		err := rows.Scan(&id, &gradeID, &userID, &userName, &userRole, &message, &parentID, &createdAt)
-		if err != nil {
-			continue
+	items := []map[string]interface{}{}
+	for _, item := range resp.Items {
+		entry := map[string]interface{}{
+			"submission_id": item.SubmissionId,
+			"student_id":    item.StudentId,
+			"student_name":  item.StudentName,
+			"file_name":     item.FileName,
+			"graded":        item.Graded,
+			"assigned_at":   item.AssignedAt.AsTime(),
 		}
-		
-		comment := map[string]interface{}{
-			"id":         id,
-			"grade_id":   gradeID,
-			"user_id":    userID,
-			"user_name":  userName,
-			"user_role":  userRole,
-			"message":    message,
-			"created_at": createdAt,
-		}
-		
-		if parentID.Valid {
-			comment["parent_id"] = parentID.Int64
+		if item.Graded {
+			entry["grade_id"] = item.GradeId
 		}
-		
-		comments = append(comments, comment)
+		items = append(items, entry)
 	}
 	
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"comments": comments,
+		"assignment_id": resp.AssignmentId,
+		"grader_id":     resp.GraderId,
+		"items":         items,
 	})
 }
 
-// Handle getting analytics for an assignment
-func handleGetAnalytics(w http.ResponseWriter, r *http.Request, assignmentID int64, db *database.Database) {
-	// Fetch all grades for the assignment with rubric info
-	rows, err := db.DB.Query(`
-		SELECT g.id, g.rubric_scores, g.total_score, g.grader_id, u.name
-		FROM grades g
-		LEFT JOIN users u ON g.grader_id = u.id
-		WHERE g.assignment_id = ?
-	`, assignmentID)
-	
+// Handle reporting per-grader progress for an assignment
+func handleGetGradingProgress(w http.ResponseWriter, r *http.Request, assignmentID int64, gradingAssignmentService *services.GradingAssignmentService) {
+	resp, err := gradingAssignmentService.GetGradingProgress(r.Context(), &pb.GetGradingProgressRequest{AssignmentId: assignmentID})
+	if errors.Is(err, services.ErrNotCourseStaff) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, services.ErrAssignmentNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
-		log.Printf("Error fetching analytics: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.Printf("Error fetching grading progress: %v", err)
+		http.Error(w, "Error fetching grading progress", http.StatusInternalServerError)
 		return
 	}
-	defer rows.Close()
 	
-	type GradeData struct {
-		ID           int64
-		RubricScores map[string]float64
-		TotalScore   float64
-		GraderID     int64
-		GraderName   string
+	graders := []map[string]interface{}{}
+	for _, g := range resp.Graders {
+		graders = append(graders, map[string]interface{}{
+			"grader_id":        g.GraderId,
+			"grader_name":      g.GraderName,
+			"assigned":         g.Assigned,
+			"graded":           g.Graded,
+			"remaining":        g.Remaining,
+			"percent_complete": g.PercentComplete,
+		})
 	}
 	
-	var grades []GradeData
-	var totalScore float64
-	var highestScore float64
-	var lowestScore float64 = 999999
-	
-	// Map to track grader stats
-	graderStats := make(map[int64]struct {
-		Name           string
-		GradeCount     int
-		TotalAverage   float64
-		CriteriaSum    map[string]float64
-		CriteriaCount  map[string]int
-	})
-	
-	// Map to track criteria stats
-	criteriaStats := make(map[string]struct {
-		Sum   float64
-		Count int
-		Min   float64
-		Max   float64
-		Values []float64
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignment_id":          resp.AssignmentId,
+		"total_submissions":      resp.TotalSubmissions,
+		"unassigned_submissions": resp.UnassignedSubmissions,
+		"overlap_percent":        resp.OverlapPercent,
+		"overlap_graders":        resp.OverlapGraders,
+		"overlap_submissions":    resp.OverlapSubmissions,
+		"graders":                graders,
 	})
-	
-	for rows.Next() {
-		var id, graderID int64
-		var rubricScoresJSON, graderName string
-		var score float64
-		
-		err := rows.Scan(&id, &rubricScoresJSON, &score, &graderID, &graderName)
-		if err != nil {
-			continue
-		}
-		
-		var rubricScores map[string]float64
-		json.Unmarshal([]byte(rubricScoresJSON), &rubricScores)
-		
-		grades = append(grades, GradeData{
-			ID:           id,
-			RubricScores: rubricScores,
-			TotalScore:   score,
-			GraderID:     graderID,
-			GraderName:   graderName,
-		})
-		
-		totalScore += score
-		if score > highestScore {
-			highestScore = score
-		}
-		if score < lowestScore {
-			lowestScore = score
-		}
-		
-		// Update grader stats
-		if _, exists := graderStats[graderID]; !exists {
-			graderStats[graderID] = struct {
-				Name           string
-				GradeCount     int
-				TotalAverage   float64
-				CriteriaSum    map[string]float64
-				CriteriaCount  map[string]int
-			}{
-				Name:          graderName,
-				GradeCount:    0,
-				TotalAverage:  0,
-				CriteriaSum:   make(map[string]float64),
-				CriteriaCount: make(map[string]int),
-			}
-		}
-		
-		stats := graderStats[graderID]
-		stats.GradeCount++
-		stats.TotalAverage += score
-		
-		for criterionIdx, criterionScore := range rubricScores {
-			stats.CriteriaSum[criterionIdx] += criterionScore
-			stats.CriteriaCount[criterionIdx]++
-			
-			// Update criteria stats
-			if _, exists := criteriaStats[criterionIdx]; !exists {
-				criteriaStats[criterionIdx] = struct {
-					Sum   float64
-					Count int
-					Min   float64
-					Max   float64
-					Values []float64
-				}{
-					Sum:   0,
-					Count: 0,
-					Min:   999999,
-					Max:   0,
-					Values: []float64{},
-				}
-			}
-			
-			cStats := criteriaStats[criterionIdx]
-			cStats.Sum += criterionScore
-			cStats.Count++
-			cStats.Values = append(cStats.Values, criterionScore)
-			if criterionScore < cStats.Min {
-				cStats.Min = criterionScore
-			}
-			if criterionScore > cStats.Max {
-				cStats.Max = criterionScore
-			}
-			criteriaStats[criterionIdx] = cStats
-		}
-		
-		graderStats[graderID] = stats
+}
+
+// Handle configuring the share of submissions graded by more than one TA
+func handleConfigureOverlap(w http.ResponseWriter, r *http.Request, assignmentID int64, gradingAssignmentService *services.GradingAssignmentService) {
+	var req struct {
+		OverlapPercent float64 `json:"overlap_percent"`
+		OverlapGraders int32   `json:"overlap_graders"`
 	}
 	
-	if len(grades) == 0 {
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"total_grades": 0,
-			"grades":       []interface{}{},
-		})
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 	
-	// Calculate overall average and std deviation
-	overallAverage := totalScore / float64(len(grades))
+	resp, err := gradingAssignmentService.ConfigureOverlap(r.Context(), &pb.ConfigureOverlapRequest{
+		AssignmentId:   assignmentID,
+		OverlapPercent: req.OverlapPercent,
+		OverlapGraders: req.OverlapGraders,
+	})
+	if err != nil {
+		log.Printf("Error configuring overlap: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	
-	var variance float64
-	for _, grade := range grades {
-		diff := grade.TotalScore - overallAverage
-		variance += diff * diff
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":         true,
+		"message":         resp.Message,
+		"assignment_id":   resp.AssignmentId,
+		"overlap_percent": resp.OverlapPercent,
+		"overlap_graders": resp.OverlapGraders,
+	})
+}
+
+// Handle listing an assignment's calibration submissions without their gold grades
+func handleListCalibrationSubmissions(w http.ResponseWriter, r *http.Request, assignmentID int64, calibrationService *services.CalibrationService) {
+	resp, err := calibrationService.ListCalibrationSubmissions(r.Context(), &pb.ListCalibrationSubmissionsRequest{AssignmentId: assignmentID})
+	if errors.Is(err, services.ErrNotCourseStaff) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
 	}
-	stdDeviation := 0.0
-	if len(grades) > 1 {
-		stdDeviation = variance / float64(len(grades)-1)
-		stdDeviation = float64(int(stdDeviation*100)) / 100 // Round to 2 decimals
+	if errors.Is(err, services.ErrAssignmentNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
 	}
-	
-	// Format grader stats
-	var graderStatsList []map[string]interface{}
-	for graderID, stats := range graderStats {
-		criteriaAverages := make(map[string]float64)
-		for criterionIdx, sum := range stats.CriteriaSum {
-			criteriaAverages[criterionIdx] = sum / float64(stats.CriteriaCount[criterionIdx])
-		}
-		
-		graderStatsList = append(graderStatsList, map[string]interface{}{
-			"grader_id":         graderID,
-			"grader_name":       stats.Name,
-			"grade_count":       stats.GradeCount,
-			"average":           stats.TotalAverage / float64(stats.GradeCount),
-			"criteria_averages": criteriaAverages,
-		})
+	if err != nil {
+		log.Printf("Error fetching calibration submissions: %v", err)
+		http.Error(w, "Error fetching calibration submissions", http.StatusInternalServerError)
+		return
 	}
 	
-	// Format criteria stats
-	var criteriaStatsList []map[string]interface{}
-	for criterionIdx, stats := range criteriaStats {
-		average := stats.Sum / float64(stats.Count)
-		
-		// Calculate std dev for this criterion
-		var criterionVariance float64
-		for _, val := range stats.Values {
-			diff := val - average
-			criterionVariance += diff * diff
+	submissions := []map[string]interface{}{}
+	for _, submission := range resp.Submissions {
+		entry := map[string]interface{}{
+			"submission_id": submission.SubmissionId,
+			"student_id":    submission.StudentId,
+			"student_name":  submission.StudentName,
+			"file_name":     submission.FileName,
+			"graded":        submission.Graded,
 		}
-		criterionStdDev := 0.0
-		if len(stats.Values) > 1 {
-			criterionStdDev = criterionVariance / float64(len(stats.Values)-1)
+		if submission.Graded {
+			entry["grade_id"] = submission.GradeId
 		}
-		
-		criteriaStatsList = append(criteriaStatsList, map[string]interface{}{
-			"criterion_index": criterionIdx,
-			"average":         average,
-			"min":             stats.Min,
-			"max":             stats.Max,
-			"std_dev":         criterionStdDev,
-		})
-	}
-	
-	// Get max score from assignment
-	var assignmentMaxScore float64
-	db.DB.QueryRow("SELECT max_score FROM assignments WHERE id = ?", assignmentID).Scan(&assignmentMaxScore)
-	if assignmentMaxScore == 0 {
-		assignmentMaxScore = 100
+		submissions = append(submissions, entry)
 	}
 	
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"total_grades":      len(grades),
-		"overall_average":   overallAverage,
-		"highest_score":     highestScore,
-		"lowest_score":      lowestScore,
-		"std_deviation":     stdDeviation,
-		"max_score":         assignmentMaxScore,
-		"grades":            grades,
-		"grader_stats":      graderStatsList,
-		"criteria_stats":    criteriaStatsList,
+		"assignment_id": resp.AssignmentId,
+		"threshold":     resp.Threshold,
+		"status":        resp.Status,
+		"submissions":   submissions,
 	})
 }
 
-// Handle getting AI insights for analytics
-func handleGetAIInsights(w http.ResponseWriter, r *http.Request, assignmentID int64, db *database.Database) {
-	log.Printf("AI Insights request received for assignment %d", assignmentID)
-	
+// Handle saving an instructor gold grade or a TA calibration grade
+func handleCalibrationGrade(w http.ResponseWriter, r *http.Request, assignmentID int64, gold bool, calibrationService *services.CalibrationService) {
 	var req struct {
-		AnalyticsData map[string]interface{} `json:"analytics_data"`
+		SubmissionID int64              `json:"submission_id"`
+		RubricScores map[string]float64 `json:"rubric_scores"`
+		TotalScore   float64            `json:"total_score"`
 	}
 	
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Printf("Error decoding request body: %v", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 	
-	log.Printf("Analytics data received, size: %d fields", len(req.AnalyticsData))
-	
-	// Get Claude API key from environment
-	claudeAPIKey := os.Getenv("CLAUDE_API_KEY")
-	if claudeAPIKey == "" {
-		log.Printf("ERROR: Claude API key not configured")
-		http.Error(w, "Claude API key not configured", http.StatusInternalServerError)
+	var resp *pb.CalibrationGradeResponse
+	var err error
+	if gold {
+		resp, err = calibrationService.SetGoldGrade(r.Context(), &pb.SetGoldGradeRequest{
+			AssignmentId: assignmentID,
+			SubmissionId: req.SubmissionID,
+			RubricScores: req.RubricScores,
+			TotalScore:   req.TotalScore,
+		})
+	} else {
+		resp, err = calibrationService.SubmitCalibrationGrade(r.Context(), &pb.SubmitCalibrationGradeRequest{
+			AssignmentId: assignmentID,
+			SubmissionId: req.SubmissionID,
+			RubricScores: req.RubricScores,
+			TotalScore:   req.TotalScore,
+		})
+	}
+	if writeValidationError(w, err) {
+		return
+	}
+	if errors.Is(err, services.ErrCalibrationGoldOutdated) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Printf("Error saving calibration grade: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	log.Printf("Claude API key found (length: %d)", len(claudeAPIKey))
 	
-	// Prepare analytics summary for Claude
-	analyticsJSON, _ := json.MarshalIndent(req.AnalyticsData, "", "  ")
-	
-	prompt := fmt.Sprintf(`You are an educational assessment expert. Analyze this grading data and provide a BRIEF summary.
-
-Analytics Data:
-%s
-
-Provide a concise analysis in ONE of these formats (but don't say which one in the response):
-- Option 1: 1-2 short paragraphs (max 4-5 sentences total)
-- Option 2: 1 paragraph followed by 2-4 bullet points
-
-Focus on the most important insights only:
-- Key grading consistency issues (if any)
-- Most significant criteria concerns
-- 1+ actionable recommendation
+	grade := resp.Grade
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": resp.Message,
+		"grade": map[string]interface{}{
+			"id":            grade.Id,
+			"assignment_id": grade.AssignmentId,
+			"submission_id": grade.SubmissionId,
+			"grader_id":     grade.GraderId,
+			"grader_name":   grade.GraderName,
+			"gold":          grade.Gold,
+			"rubric_scores": grade.RubricScores,
+			"total_score":   grade.TotalScore,
+			"rubric_version_id": grade.RubricVersionId,
+			"graded_at":     grade.GradedAt.AsTime(),
+		},
+	})
+}
 
-Be specific but concise. Reference actual numbers from the data.`, string(analyticsJSON))
+// Handle reporting each TA's deviation from the gold grades
+func handleGetCalibrationReport(w http.ResponseWriter, r *http.Request, assignmentID int64, calibrationService *services.CalibrationService) {
+	req := &pb.GetCalibrationReportRequest{AssignmentId: assignmentID}
+	if graderID := r.URL.Query().Get("grader_id"); graderID != "" {
+		id, err := strconv.ParseInt(graderID, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid grader_id", http.StatusBadRequest)
+			return
+		}
+		req.GraderId = id
+	}
 	
-	log.Printf("Calling Claude API...")
-	// Call Claude API
-	insights, err := callClaudeAPI(claudeAPIKey, prompt)
+	resp, err := calibrationService.GetCalibrationReport(r.Context(), req)
 	if err != nil {
-		log.Printf("Error calling Claude API: %v", err)
-		http.Error(w, fmt.Sprintf("Failed to get AI insights: %v", err), http.StatusInternalServerError)
+		log.Printf("Error fetching calibration report: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	
-	log.Printf("Claude API response received (length: %d)", len(insights))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Handle setting the deviation threshold TAs must meet before grading
+func handleConfigureCalibration(w http.ResponseWriter, r *http.Request, assignmentID int64, calibrationService *services.CalibrationService) {
+	var req struct {
+		Threshold float64 `json:"threshold"`
+	}
+	
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := calibrationService.ConfigureCalibration(r.Context(), &pb.ConfigureCalibrationRequest{
+		AssignmentId: assignmentID,
+		Threshold:    req.Threshold,
+	})
+	if err != nil {
+		log.Printf("Error configuring calibration: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"insights": insights,
+		"success":          true,
+		"message":          resp.Message,
+		"assignment_id":    resp.AssignmentId,
+		"threshold":        resp.Threshold,
+		"gold_submissions": resp.GoldSubmissions,
 	})
-	log.Printf("AI insights response sent successfully")
 }
 
-// Call Claude API
-func callClaudeAPI(apiKey, prompt string) (string, error) {
-	url := "https://api.anthropic.com/v1/messages"
+// Handle clearing a TA's calibration grades so they can recalibrate
+func handleResetCalibration(w http.ResponseWriter, r *http.Request, assignmentID int64, calibrationService *services.CalibrationService) {
+	var req struct {
+		GraderID int64 `json:"grader_id"`
+	}
 	
-	requestBody := map[string]interface{}{
-		"model": "claude-3-haiku-20240307",
-		"max_tokens": 2000,
-		"messages": []map[string]interface{}{
-			{
-				"role":    "user",
-				"content": prompt,
-			},
-		},
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 	
-	jsonData, err := json.Marshal(requestBody)
+	resp, err := calibrationService.ResetCalibration(r.Context(), &pb.ResetCalibrationRequest{
+		AssignmentId: assignmentID,
+		GraderId:     req.GraderID,
+	})
 	if err != nil {
-		log.Printf("Error marshaling request: %v", err)
-		return "", err
+		log.Printf("Error resetting calibration: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 	
-	log.Printf("Making request to Claude API...")
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": resp.Success,
+		"message": resp.Message,
+		"cleared": resp.Cleared,
+	})
+}
+
+// writeAnalysisError maps analysis errors to HTTP statuses
+func writeAnalysisError(w http.ResponseWriter, err error, action string) {
+	if writeValidationError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, services.ErrNotCourseStaff) || errors.Is(err, services.ErrNotCourseInstructor):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, services.ErrAssignmentNotFound) || errors.Is(err, services.ErrRubricNotFound) || errors.Is(err, services.ErrRubricVersionNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		// Anything else is a server fault whose details stay in the log
+		log.Printf("Error %s: %v", action, err)
+		http.Error(w, "Error "+action, http.StatusInternalServerError)
+	}
+}
+
+// rubricVersionParam reads the optional rubric_version_id an analysis is run
+// against; zero means the rubric's current version
+func rubricVersionParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	value := r.URL.Query().Get("rubric_version_id")
+	if value == "" {
+		return 0, true
+	}
+	versionID, err := strconv.ParseInt(value, 10, 64)
 	if err != nil {
-		log.Printf("Error creating request: %v", err)
-		return "", err
+		http.Error(w, "Invalid rubric_version_id", http.StatusBadRequest)
+		return 0, false
+	}
+	return versionID, true
+}
+
+// Handle running anomaly analysis for an assignment
+func handleRunAnomalyAnalysis(w http.ResponseWriter, r *http.Request, assignmentID int64, analysisService *services.AnalysisService) {
+	versionID, ok := rubricVersionParam(w, r)
+	if !ok {
+		return
+	}
+	resp, err := analysisService.RunAnomalyAnalysis(r.Context(), &pb.RunAnomalyAnalysisRequest{AssignmentId: assignmentID, RubricVersionId: versionID})
+	if err != nil {
+		writeAnalysisError(w, err, "running anomaly analysis")
+		return
 	}
 	
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", apiKey)
-	req.Header.Set("anthropic-version", "2023-06-01")
+	anomalies := resp.Anomalies
+	if anomalies == nil {
+		anomalies = []*pb.Anomaly{}
+	}
 	
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"analysis_id":       resp.AnalysisId,
+		"course_id":         resp.CourseId,
+		"assignment_id":     resp.AssignmentId,
+		"rubric_id":         resp.RubricId,
+		"rubric_version_id": resp.RubricVersionId,
+		"anomalies":         anomalies,
+		"statistics":        resp.Statistics,
+		"total_grades":      resp.TotalGrades,
+		"excluded_grades":   resp.ExcludedGrades,
+		"analyzed_at":       resp.AnalysisTimestamp.AsTime(),
+	})
+}
+
+// Handle listing previous analysis runs for an assignment, newest first
+func handleGetAnalysisHistory(w http.ResponseWriter, r *http.Request, assignmentID int64, analysisService *services.AnalysisService) {
+	req := &pb.GetAnalysisHistoryRequest{AssignmentId: assignmentID}
+	
+	if pageSize := r.URL.Query().Get("page_size"); pageSize != "" {
+		size, err := strconv.ParseInt(pageSize, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid page_size", http.StatusBadRequest)
+			return
+		}
+		req.PageSize = int32(size)
+	}
+	if beforeID := r.URL.Query().Get("before_id"); beforeID != "" {
+		id, err := strconv.ParseInt(beforeID, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid before_id", http.StatusBadRequest)
+			return
+		}
+		req.BeforeId = id
+	}
+	
+	resp, err := analysisService.GetAnalysisHistory(r.Context(), req)
 	if err != nil {
-		log.Printf("Error making request: %v", err)
-		return "", err
+		log.Printf("Error fetching analysis history: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
-	defer resp.Body.Close()
 	
-	log.Printf("Claude API responded with status: %d", resp.StatusCode)
+	results := []map[string]interface{}{}
+	for _, result := range resp.Results {
+		results = append(results, map[string]interface{}{
+			"id":            result.Id,
+			"course_id":     result.CourseId,
+			"assignment_id": result.AssignmentId,
+			"rubric_id":     result.RubricId,
+			"analysis_type": result.AnalysisType,
+			"total_grades":  result.TotalGrades,
+			"anomaly_count": result.AnomalyCount,
+			"results":       json.RawMessage(result.ResultsJson),
+			"created_at":    result.CreatedAt.AsTime(),
+		})
+	}
 	
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Claude API error response: %s", string(body))
-		return "", fmt.Errorf("Claude API error: %s - %s", resp.Status, string(body))
+	response := map[string]interface{}{
+		"results": results,
+	}
+	if resp.NextBeforeId != 0 {
+		response["next_before_id"] = resp.NextBeforeId
 	}
 	
-	var result struct {
-		Content []struct {
-			Text string `json:"text"`
-		} `json:"content"`
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Handle getting grading drift control charts for an assignment
+func handleGetDrift(w http.ResponseWriter, r *http.Request, assignmentID int64, analysisService *services.AnalysisService) {
+	versionID, ok := rubricVersionParam(w, r)
+	if !ok {
+		return
+	}
+	resp, err := analysisService.DetectDrift(r.Context(), &pb.DetectDriftRequest{
+		AssignmentId:    assignmentID,
+		QuestionId:      r.URL.Query().Get("question_id"),
+		RubricVersionId: versionID,
+	})
+	if err != nil {
+		writeAnalysisError(w, err, "detecting drift")
+		return
 	}
 	
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		log.Printf("Error decoding response: %v", err)
-		return "", err
+	graders := []map[string]interface{}{}
+	for _, series := range resp.Graders {
+		graders = append(graders, driftSeriesJSON(series))
 	}
 	
-	if len(result.Content) == 0 {
-		log.Printf("No content in Claude response")
-		return "", fmt.Errorf("no content in response")
+	anomalies := resp.Anomalies
+	if anomalies == nil {
+		anomalies = []*pb.Anomaly{}
 	}
 	
-	log.Printf("Successfully extracted insights from Claude")
-	return result.Content[0].Text, nil
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignment_id":       resp.AssignmentId,
+		"rubric_version_id":   resp.RubricVersionId,
+		"excluded_grades":     resp.ExcludedGrades,
+		"question_id":         resp.QuestionId,
+		"ewma_lambda":         resp.EwmaLambda,
+		"control_limit_width": resp.ControlLimitWidth,
+		"cusum_k":             resp.CusumK,
+		"cusum_h":             resp.CusumH,
+		"course":              driftSeriesJSON(resp.Course),
+		"graders":             graders,
+		"anomalies":           anomalies,
+	})
 }
 
-// Handle AI rubric suggestions based on grading data
-func handleAIRubricSuggest(w http.ResponseWriter, r *http.Request, rubricID int64, db *database.Database) {
-	log.Printf("AI Rubric Suggest request received for rubric %d", rubricID)
+// driftSeriesJSON formats a control chart series; chart values are null
+// when the series was too short to establish a baseline
+func driftSeriesJSON(series *pb.DriftSeries) map[string]interface{} {
+	charted := series.BaselineStdDev != 0
 	
-	// Get Claude API key
-	claudeAPIKey := os.Getenv("CLAUDE_API_KEY")
-	if claudeAPIKey == "" {
-		log.Printf("ERROR: Claude API key not configured")
-		http.Error(w, "Claude API key not configured", http.StatusInternalServerError)
+	points := []map[string]interface{}{}
+	for _, p := range series.Points {
+		point := map[string]interface{}{
+			"sequence":       p.Sequence,
+			"grade_id":       p.GradeId,
+			"graded_at":      p.GradedAt.AsTime(),
+			"score":          p.Score,
+			"ewma":           nil,
+			"ewma_lower":     nil,
+			"ewma_upper":     nil,
+			"cusum_upper":    nil,
+			"cusum_lower":    nil,
+			"out_of_control": p.OutOfControl,
+		}
+		if charted {
+			point["ewma"] = p.Ewma
+			point["ewma_lower"] = p.EwmaLower
+			point["ewma_upper"] = p.EwmaUpper
+			point["cusum_upper"] = p.CusumUpper
+			point["cusum_lower"] = p.CusumLower
+		}
+		points = append(points, point)
+	}
+	
+	result := map[string]interface{}{
+		"scope":            series.Scope,
+		"baseline_mean":    nil,
+		"baseline_std_dev": nil,
+		"baseline_size":    series.BaselineSize,
+		"points":           points,
+	}
+	if series.GraderId != 0 {
+		result["grader_id"] = series.GraderId
+		result["grader_name"] = series.GraderName
+	}
+	if charted {
+		result["baseline_mean"] = series.BaselineMean
+		result["baseline_std_dev"] = series.BaselineStdDev
+	}
+	return result
+}
+
+// Handle previewing grader leniency and bias-adjusted scores for an assignment
+func handleGetLeniency(w http.ResponseWriter, r *http.Request, assignmentID int64, analysisService *services.AnalysisService) {
+	versionID, ok := rubricVersionParam(w, r)
+	if !ok {
+		return
+	}
+	resp, err := analysisService.EstimateLeniency(r.Context(), &pb.EstimateLeniencyRequest{AssignmentId: assignmentID, RubricVersionId: versionID})
+	if err != nil {
+		writeAnalysisError(w, err, "estimating leniency")
 		return
 	}
 	
-	// 1. Fetch the current rubric
-	var rubricName string
-	var rubricCriteria, rubricWeights string
-	err := db.DB.QueryRow(`
-		SELECT name, criteria, weights FROM rubrics WHERE id = ?
-	`, rubricID).Scan(&rubricName, &rubricCriteria, &rubricWeights)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Handle reporting disagreement between TAs on double-graded submissions
+func handleGetDisagreements(w http.ResponseWriter, r *http.Request, assignmentID int64, analysisService *services.AnalysisService) {
+	versionID, ok := rubricVersionParam(w, r)
+	if !ok {
+		return
+	}
+	req := &pb.GetDisagreementReportRequest{AssignmentId: assignmentID, RubricVersionId: versionID}
+	if threshold := r.URL.Query().Get("threshold"); threshold != "" {
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil {
+			http.Error(w, "Invalid threshold", http.StatusBadRequest)
+			return
+		}
+		req.Threshold = value
+	}
 	
+	resp, err := analysisService.GetDisagreementReport(r.Context(), req)
 	if err != nil {
-		log.Printf("Error fetching rubric: %v", err)
-		http.Error(w, "Rubric not found", http.StatusNotFound)
+		writeAnalysisError(w, err, "fetching disagreement report")
 		return
 	}
 	
-	// Parse criteria and weights
-	var criteria []string
-	var weights []float64
-	json.Unmarshal([]byte(rubricCriteria), &criteria)
-	json.Unmarshal([]byte(rubricWeights), &weights)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Handle listing every recorded change to a grade
+func handleGetGradeHistory(w http.ResponseWriter, r *http.Request, gradeID int64, gradeService *services.GradeService) {
+	resp, err := gradeService.GetGradeHistory(r.Context(), &pb.GetGradeHistoryRequest{GradeId: gradeID})
+	if errors.Is(err, services.ErrGradeNotFound) {
+		http.Error(w, "Grade not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Error fetching grade history: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	
-	// Build current rubric structure
-	currentRubric := make([]map[string]interface{}, len(criteria))
-	for i, criterion := range criteria {
-		weight := 0.0
-		if i < len(weights) {
-			weight = weights[i]
+	revisions := []map[string]interface{}{}
+	for _, revision := range resp.Revisions {
+		entry := map[string]interface{}{
+			"id":                revision.Id,
+			"revision":          revision.Revision,
+			"changed_by":        revision.ChangedBy,
+			"changed_by_name":   revision.ChangedByName,
+			"source":            revision.Source,
+			"new_rubric_scores": revision.NewRubricScores,
+			"new_total_score":   revision.NewTotalScore,
+			"reason":            revision.Reason,
+			"changed_at":        revision.ChangedAt.AsTime(),
 		}
-		currentRubric[i] = map[string]interface{}{
-			"name":       criterion,
-			"max_points": weight,
-			"index":      i,
+		if revision.HasPrevious {
+			entry["old_rubric_scores"] = revision.OldRubricScores
+			entry["old_total_score"] = revision.OldTotalScore
 		}
+		revisions = append(revisions, entry)
 	}
 	
-	// 2. Fetch all assignments using this rubric
-	assignmentRows, err := db.DB.Query(`
-		SELECT id FROM assignments WHERE rubric_id = ?
-	`, rubricID)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"grade_id":  resp.GradeId,
+		"revisions": revisions,
+	})
+}
+
+// Handle comparing two revisions of a grade
+func handleDiffGradeRevisions(w http.ResponseWriter, r *http.Request, gradeID int64, gradeService *services.GradeService) {
+	req := &pb.DiffGradeRevisionsRequest{GradeId: gradeID}
+	for param, target := range map[string]*int32{"from": &req.FromRevision, "to": &req.ToRevision} {
+		if value := r.URL.Query().Get(param); value != "" {
+			revision, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				http.Error(w, "Invalid "+param+" revision", http.StatusBadRequest)
+				return
+			}
+			*target = int32(revision)
+		}
+	}
+	
+	resp, err := gradeService.DiffGradeRevisions(r.Context(), req)
+	if errors.Is(err, services.ErrGradeNotFound) {
+		http.Error(w, "Grade not found", http.StatusNotFound)
+		return
+	}
 	if err != nil {
-		log.Printf("Error fetching assignments: %v", err)
-		http.Error(w, "Error fetching assignments", http.StatusInternalServerError)
+		log.Printf("Error comparing grade revisions: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	defer assignmentRows.Close()
 	
-	var assignmentIDs []int64
-	for assignmentRows.Next() {
-		var id int64
-		assignmentRows.Scan(&id)
-		assignmentIDs = append(assignmentIDs, id)
+	changes := []map[string]interface{}{}
+	for _, change := range resp.Changes {
+		entry := map[string]interface{}{
+			"key":   change.Key,
+			"label": change.Label,
+			"delta": change.Delta,
+		}
+		if change.HasOld {
+			entry["old_score"] = change.OldScore
+		}
+		if change.HasNew {
+			entry["new_score"] = change.NewScore
+		}
+		changes = append(changes, entry)
 	}
 	
-	// 3. Fetch analytics data for all assignments
-	analyticsData := make([]map[string]interface{}, 0)
-	for _, assignmentID := range assignmentIDs {
-		// Get grades with rubric scores
-		gradeRows, err := db.DB.Query(`
-			SELECT g.id, g.rubric_scores, g.total_score, u.name, u.role
-			FROM grades g
-			JOIN users u ON g.grader_id = u.id
-			WHERE g.assignment_id = ?
-		`, assignmentID)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"grade_id":        resp.GradeId,
+		"from_revision":   resp.FromRevision,
+		"to_revision":     resp.ToRevision,
+		"old_total_score": resp.OldTotalScore,
+		"new_total_score": resp.NewTotalScore,
+		"total_delta":     resp.TotalDelta,
+		"changes":         changes,
+	})
+}
+
+// Handle filing a regrade request against specific criteria of a grade
+func handleCreateRegradeRequest(w http.ResponseWriter, r *http.Request, gradeID int64, regradeService *services.RegradeService) {
+	var req struct {
+		Criteria      []string `json:"criteria"`
+		Justification string   `json:"justification"`
+	}
+	
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := regradeService.CreateRegradeRequest(r.Context(), &pb.CreateRegradeRequestRequest{
+		GradeId:       gradeID,
+		Criteria:      req.Criteria,
+		Justification: req.Justification,
+	})
+	if err != nil {
+		writeRegradeError(w, err, "creating regrade request")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": resp.Message,
+		"request": regradeRequestJSON(resp.Request),
+	})
+}
+
+// Handle listing an assignment's regrade requests
+func handleListRegradeRequests(w http.ResponseWriter, r *http.Request, assignmentID int64, regradeService *services.RegradeService) {
+	resp, err := regradeService.ListRegradeRequests(r.Context(), &pb.ListRegradeRequestsRequest{
+		AssignmentId: assignmentID,
+		Status:       r.URL.Query().Get("status"),
+		AssignedToMe: r.URL.Query().Get("mine") == "true",
+	})
+	if err != nil {
+		writeRegradeError(w, err, "fetching regrade requests")
+		return
+	}
+	
+	requests := []map[string]interface{}{}
+	for _, request := range resp.Requests {
+		requests = append(requests, regradeRequestJSON(request))
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"requests": requests,
+	})
+}
+
+// Handle moving a regrade request through its workflow
+func handleRegradeAction(w http.ResponseWriter, r *http.Request, requestID int64, action string, regradeService *services.RegradeService) {
+	var req struct {
+		AssigneeID   int64              `json:"assignee_id"`
+		RubricScores map[string]float64 `json:"rubric_scores"`
+		Response     string             `json:"response"`
+		Version      int32              `json:"version"`
+	}
+	
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	var resp *pb.RegradeRequestResponse
+	var err error
+	switch action {
+	case "assign":
+		resp, err = regradeService.AssignRegradeRequest(r.Context(), &pb.AssignRegradeRequestRequest{Id: requestID, AssigneeId: req.AssigneeID})
+	case "accept":
+		resp, err = regradeService.AcceptRegradeRequest(r.Context(), &pb.AcceptRegradeRequestRequest{
+			Id:              requestID,
+			RubricScores:    req.RubricScores,
+			Response:        req.Response,
+			ExpectedVersion: req.Version,
+		})
+	case "reject":
+		resp, err = regradeService.RejectRegradeRequest(r.Context(), &pb.RejectRegradeRequestRequest{Id: requestID, Response: req.Response})
+	case "resolve":
+		resp, err = regradeService.ResolveRegradeRequest(r.Context(), &pb.ResolveRegradeRequestRequest{Id: requestID, Response: req.Response})
+	}
+	if err != nil {
+		writeRegradeError(w, err, "updating regrade request")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": resp.Message,
+		"request": regradeRequestJSON(resp.Request),
+	})
+}
+
+// writeRegradeError maps regrade workflow errors to HTTP statuses
+func writeRegradeError(w http.ResponseWriter, err error, action string) {
+	if writeValidationError(w, err) {
+		return
+	}
+	var conflict *services.GradeConflictError
+	switch {
+	case errors.As(err, &conflict):
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", gradeETag(conflict.Current))
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":   err.Error(),
+			"current": gradeJSON(conflict.Current),
+		})
+	case errors.Is(err, services.ErrRegradeRequestNotFound) || errors.Is(err, services.ErrGradeNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, services.ErrRegradeRequestExists) || errors.Is(err, services.ErrRegradeRequestStatus) || errors.Is(err, services.ErrGradeVersionConflict) || errors.Is(err, services.ErrGradeRubricOutdated):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, services.ErrRegradeAccessDenied):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		log.Printf("Error %s: %v", action, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func regradeRequestJSON(request *pb.RegradeRequest) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":                request.Id,
+		"grade_id":          request.GradeId,
+		"assignment_id":     request.AssignmentId,
+		"submission_id":     request.SubmissionId,
+		"student_id":        request.StudentId,
+		"grader_id":         request.GraderId,
+		"grader_name":       request.GraderName,
+		"criteria":          request.Criteria,
+		"criterion_labels":  request.CriterionLabels,
+		"justification":     request.Justification,
+		"status":            request.Status,
+		"requested_by":      request.RequestedBy,
+		"requested_by_name": request.RequestedByName,
+		"response":          request.Response,
+		"comment_id":        request.CommentId,
+		"created_at":        request.CreatedAt.AsTime(),
+		"updated_at":        request.UpdatedAt.AsTime(),
+	}
+	if request.AssignedTo != 0 {
+		result["assigned_to"] = request.AssignedTo
+		result["assigned_to_name"] = request.AssignedToName
+	}
+	if request.RevisionId != 0 {
+		result["revision_id"] = request.RevisionId
+	}
+	if request.ResolvedAt != nil {
+		result["resolved_at"] = request.ResolvedAt.AsTime()
+	}
+	return result
+}
+
+// Handle posting a comment on a grade
+func handlePostComment(w http.ResponseWriter, r *http.Request, gradeID int64, gradeService *services.GradeService) {
+	var req struct {
+		Message  string `json:"message"`
+		ParentID int64  `json:"parent_id"`
+	}
+	
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := gradeService.PostGradeComment(r.Context(), &pb.PostGradeCommentRequest{
+		GradeId:  gradeID,
+		Message:  req.Message,
+		ParentId: req.ParentID,
+	})
+	if err != nil {
+		writeGradeCommentError(w, err, "posting comment")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"comment": gradeCommentJSON(resp.Comment),
+	})
+}
+
+// Handle getting all comments for a grade
+func handleGetComments(w http.ResponseWriter, r *http.Request, gradeID int64, gradeService *services.GradeService) {
+	resp, err := gradeService.ListGradeComments(r.Context(), &pb.ListGradeCommentsRequest{GradeId: gradeID})
+	if err != nil {
+		writeGradeCommentError(w, err, "fetching comments")
+		return
+	}
+	
+	comments := []map[string]interface{}{}
+	for _, comment := range resp.Comments {
+		comments = append(comments, gradeCommentJSON(comment))
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"comments": comments,
+	})
+}
+
+// writeGradeCommentError maps grade comment errors to HTTP statuses
+func writeGradeCommentError(w http.ResponseWriter, err error, action string) {
+	if writeValidationError(w, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, services.ErrNotCourseStaff):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, services.ErrGradeNotFound):
+		http.Error(w, "Grade not found", http.StatusNotFound)
+	default:
+		log.Printf("Error %s: %v", action, err)
+		http.Error(w, "Error "+action, http.StatusInternalServerError)
+	}
+}
+
+func gradeCommentJSON(comment *pb.GradeComment) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":         comment.Id,
+		"grade_id":   comment.GradeId,
+		"user_id":    comment.UserId,
+		"user_name":  comment.UserName,
+		"user_role":  comment.UserRole,
+		"message":    comment.Message,
+		"created_at": comment.CreatedAt.AsTime(),
+	}
+	if comment.ParentId != 0 {
+		result["parent_id"] = comment.ParentId
+	}
+	return result
+}
+
+// Handle getting analytics for an assignment
+func handleGetAnalytics(w http.ResponseWriter, r *http.Request, assignmentID int64, db *database.Database, gradeService *services.GradeService, analysisService *services.AnalysisService, regradeService *services.RegradeService, commentBankService *services.CommentBankService) {
+	userID := r.Context().Value("user_id").(int64)
+	if err := services.CheckAssignmentStaff(db, assignmentID, userID); err != nil {
+		writeAnalysisError(w, err, "fetching analytics")
+		return
+	}
+	
+	// Fetch all grades for the assignment with rubric info. Criterion stats
+	// only count grades scored against the rubric's current version.
+	rows, err := db.DB.Query(`
+		SELECT g.id, g.rubric_scores, g.total_score, g.grader_id, u.name,
+		       (g.rubric_version_id IS NULL OR r.current_version_id IS NULL OR g.rubric_version_id = r.current_version_id)
+		FROM grades g
+		LEFT JOIN users u ON g.grader_id = u.id
+		JOIN assignments a ON g.assignment_id = a.id
+		LEFT JOIN rubrics r ON a.rubric_id = r.id
+		WHERE g.assignment_id = ?
+	`, assignmentID)
+	
+	if err != nil {
+		writeAnalysisError(w, err, "fetching analytics")
+		return
+	}
+	defer rows.Close()
+	
+	type GradeData struct {
+		ID           int64
+		RubricScores map[string]float64
+		TotalScore   float64
+		GraderID     int64
+		GraderName   string
+	}
+	
+	var grades []GradeData
+	var outdatedGrades int
+	var totalScore float64
+	var highestScore float64
+	var lowestScore float64 = 999999
+	
+	// Map to track grader stats
+	graderStats := make(map[int64]struct {
+		Name           string
+		GradeCount     int
+		TotalAverage   float64
+		CriteriaSum    map[string]float64
+		CriteriaCount  map[string]int
+	})
+	
+	// Map to track criteria stats
+	criteriaStats := make(map[string]struct {
+		Sum   float64
+		Count int
+		Min   float64
+		Max   float64
+		Values []float64
+	})
+	
+	for rows.Next() {
+		var id, graderID int64
+		var rubricScoresJSON, graderName string
+		var score float64
+		var currentRubric bool
 		
+		err := rows.Scan(&id, &rubricScoresJSON, &score, &graderID, &graderName, &currentRubric)
 		if err != nil {
 			continue
 		}
 		
-		var scores []float64
-		var graderNames []string
+		var rubricScores map[string]float64
+		json.Unmarshal([]byte(rubricScoresJSON), &rubricScores)
+		// Scores given on an earlier rubric version still count toward the totals
+		if !currentRubric {
+			outdatedGrades++
+		}
 		
-		for gradeRows.Next() {
-			var gradeID int64
-			var rubricScoresJSON string
-			var totalScore float64
-			var graderName, graderRole string
-			
-			gradeRows.Scan(&gradeID, &rubricScoresJSON, &totalScore, &graderName, &graderRole)
-			
-			var rubricScores map[string]float64
-			json.Unmarshal([]byte(rubricScoresJSON), &rubricScores)
-			
-			scores = append(scores, totalScore)
-			graderNames = append(graderNames, graderName)
+		grades = append(grades, GradeData{
+			ID:           id,
+			RubricScores: rubricScores,
+			TotalScore:   score,
+			GraderID:     graderID,
+			GraderName:   graderName,
+		})
+		
+		totalScore += score
+		if score > highestScore {
+			highestScore = score
+		}
+		if score < lowestScore {
+			lowestScore = score
 		}
-		gradeRows.Close()
 		
-		if len(scores) > 0 {
-			analyticsData = append(analyticsData, map[string]interface{}{
-				"assignment_id": assignmentID,
-				"grade_count":   len(scores),
-				"graders":       graderNames,
-			})
+		// Update grader stats
+		if _, exists := graderStats[graderID]; !exists {
+			graderStats[graderID] = struct {
+				Name           string
+				GradeCount     int
+				TotalAverage   float64
+				CriteriaSum    map[string]float64
+				CriteriaCount  map[string]int
+			}{
+				Name:          graderName,
+				GradeCount:    0,
+				TotalAverage:  0,
+				CriteriaSum:   make(map[string]float64),
+				CriteriaCount: make(map[string]int),
+			}
+		}
+		
+		stats := graderStats[graderID]
+		stats.GradeCount++
+		stats.TotalAverage += score
+		
+		criterionScores := rubricScores
+		if !currentRubric {
+			criterionScores = nil
 		}
+		for criterionIdx, criterionScore := range criterionScores {
+			stats.CriteriaSum[criterionIdx] += criterionScore
+			stats.CriteriaCount[criterionIdx]++
+			
+			// Update criteria stats
+			if _, exists := criteriaStats[criterionIdx]; !exists {
+				criteriaStats[criterionIdx] = struct {
+					Sum   float64
+					Count int
+					Min   float64
+					Max   float64
+					Values []float64
+				}{
+					Sum:   0,
+					Count: 0,
+					Min:   999999,
+					Max:   0,
+					Values: []float64{},
+				}
+			}
+			
+			cStats := criteriaStats[criterionIdx]
+			cStats.Sum += criterionScore
+			cStats.Count++
+			cStats.Values = append(cStats.Values, criterionScore)
+			if criterionScore < cStats.Min {
+				cStats.Min = criterionScore
+			}
+			if criterionScore > cStats.Max {
+				cStats.Max = criterionScore
+			}
+			criteriaStats[criterionIdx] = cStats
+		}
+		
+		graderStats[graderID] = stats
+	}
+	
+	if len(grades) == 0 {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"total_grades": 0,
+			"grades":       []interface{}{},
+		})
+		return
+	}
+	
+	// Calculate overall average and std deviation
+	overallAverage := totalScore / float64(len(grades))
+	
+	totalScores := make([]float64, len(grades))
+	for i, grade := range grades {
+		totalScores[i] = grade.TotalScore
+	}
+	stdDeviation := statistics.StdDev(totalScores)
+	stdDeviation = float64(int(stdDeviation*100)) / 100 // Round to 2 decimals
+	
+	// Per-grade rows follow the same visibility as the grade list, so TAs do
+	// not see other graders' scores on overlap submissions still in their queue
+	visible, err := gradeService.ListAssignmentGrades(r.Context(), &pb.ListAssignmentGradesRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeAnalysisError(w, err, "fetching grades")
+		return
+	}
+	visibleGrades := []GradeData{}
+	for _, grade := range visible.Grades {
+		visibleGrades = append(visibleGrades, GradeData{
+			ID:           grade.Id,
+			RubricScores: grade.RubricScores,
+			TotalScore:   grade.TotalScore,
+			GraderID:     grade.GraderId,
+			GraderName:   grade.GraderName,
+		})
+	}
+	
+	// Inter-grader reliability from double-graded submissions
+	reliability, err := analysisService.GetReliability(r.Context(), &pb.GetReliabilityRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeAnalysisError(w, err, "computing reliability")
+		return
+	}
+	
+	// Significance tests of differences between TAs
+	graderComparison, err := analysisService.CompareGraders(r.Context(), &pb.CompareGradersRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeAnalysisError(w, err, "comparing graders")
+		return
+	}
+	
+	// Regrade requests filed against each TA's grades
+	regradeStats, err := regradeService.GetRegradeStats(r.Context(), &pb.GetRegradeStatsRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeAnalysisError(w, err, "fetching regrade stats")
+		return
+	}
+	regradesByGrader := make(map[int64]*pb.GraderRegradeStats)
+	regradeSummary := map[string]int32{"total": regradeStats.Total}
+	for _, stats := range regradeStats.Graders {
+		regradesByGrader[stats.GraderId] = stats
+		regradeSummary["open"] += stats.Open + stats.Assigned
+		regradeSummary["accepted"] += stats.Accepted
+		regradeSummary["rejected"] += stats.Rejected
+		regradeSummary["resolved"] += stats.Resolved
+	}
+	
+	// Comment bank usage, and comments graders deduct differently for
+	bankStats, err := commentBankService.GetCommentBankStats(r.Context(), &pb.GetCommentBankStatsRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeAnalysisError(w, err, "fetching comment bank stats")
+		return
+	}
+	bankUsageByGrader := make(map[int64]*pb.GraderBankUsage)
+	for _, usage := range bankStats.Graders {
+		bankUsageByGrader[usage.GraderId] = usage
+	}
+	inconsistentComments := []map[string]interface{}{}
+	for _, comment := range bankStats.Comments {
+		if comment.Inconsistent {
+			inconsistentComments = append(inconsistentComments, bankCommentStatsJSON(comment))
+		}
+	}
+	
+	// Format grader stats
+	var graderStatsList []map[string]interface{}
+	for graderID, stats := range graderStats {
+		criteriaAverages := make(map[string]float64)
+		for criterionIdx, sum := range stats.CriteriaSum {
+			criteriaAverages[criterionIdx] = sum / float64(stats.CriteriaCount[criterionIdx])
+		}
+		
+		regrades := regradesByGrader[graderID]
+		if regrades == nil {
+			regrades = &pb.GraderRegradeStats{GraderId: graderID}
+		}
+		bankUsage := bankUsageByGrader[graderID]
+		if bankUsage == nil {
+			bankUsage = &pb.GraderBankUsage{GraderId: graderID}
+		}
+		
+		graderStatsList = append(graderStatsList, map[string]interface{}{
+			"grader_id":         graderID,
+			"grader_name":       stats.Name,
+			"grade_count":       stats.GradeCount,
+			"average":           stats.TotalAverage / float64(stats.GradeCount),
+			"criteria_averages": criteriaAverages,
+			"regrade_requests": map[string]interface{}{
+				"total":          regrades.Total,
+				"open":           regrades.Open + regrades.Assigned,
+				"accepted":       regrades.Accepted,
+				"rejected":       regrades.Rejected,
+				"resolved":       regrades.Resolved,
+				"points_changed": regrades.PointsChanged,
+			},
+			"comment_bank": map[string]interface{}{
+				"applied":           bankUsage.Applied,
+				"distinct_comments": bankUsage.DistinctComments,
+			},
+		})
+	}
+	
+	// Format criteria stats
+	var criteriaStatsList []map[string]interface{}
+	for criterionIdx, stats := range criteriaStats {
+		average := stats.Sum / float64(stats.Count)
+		
+		criterionStats := map[string]interface{}{
+			"criterion_index": criterionIdx,
+			"average":         average,
+			"min":             stats.Min,
+			"max":             stats.Max,
+			"std_dev":         statistics.StdDev(stats.Values),
+		}
+		for _, estimate := range reliability.Criteria {
+			if estimate.Key == criterionIdx {
+				criterionStats["reliability"] = reliabilityJSON(estimate)
+			}
+		}
+		
+		criteriaStatsList = append(criteriaStatsList, criterionStats)
+	}
+	
+	// Get max score from assignment
+	var assignmentMaxScore float64
+	db.DB.QueryRow("SELECT max_score FROM assignments WHERE id = ?", assignmentID).Scan(&assignmentMaxScore)
+	if assignmentMaxScore == 0 {
+		assignmentMaxScore = 100
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"total_grades":      len(grades),
+		"outdated_grades":   outdatedGrades,
+		"overall_average":   overallAverage,
+		"highest_score":     highestScore,
+		"lowest_score":      lowestScore,
+		"std_deviation":     stdDeviation,
+		"max_score":         assignmentMaxScore,
+		"grades":            visibleGrades,
+		"grader_stats":      graderStatsList,
+		"criteria_stats":    criteriaStatsList,
+		"regrade_requests":  regradeSummary,
+		"comment_bank": map[string]interface{}{
+			"comments_used":         len(bankStats.Comments),
+			"inconsistent_comments": inconsistentComments,
+		},
+		"reliability": map[string]interface{}{
+			"double_graded_submissions": reliability.DoubleGradedSubmissions,
+			"overall":                   reliabilityJSON(reliability.Overall),
+		},
+		"grader_differences": map[string]interface{}{
+			"significance_level": graderComparison.SignificanceLevel,
+			"overall":            graderComparison.Overall,
+			"criteria":           graderComparison.Criteria,
+		},
+	})
+}
+
+// reliabilityJSON formats a reliability estimate, leaving alphas null when
+// there are not enough double-graded submissions to compute them
+func reliabilityJSON(estimate *pb.ReliabilityEstimate) map[string]interface{} {
+	result := map[string]interface{}{
+		"interval_alpha":  nil,
+		"ordinal_alpha":   nil,
+		"units":           0,
+		"pairable_values": 0,
+	}
+	if estimate == nil {
+		return result
+	}
+	
+	result["units"] = estimate.Units
+	result["pairable_values"] = estimate.PairableValues
+	if estimate.SufficientData {
+		result["interval_alpha"] = estimate.IntervalAlpha
+		result["ordinal_alpha"] = estimate.OrdinalAlpha
+	}
+	return result
+}
+
+// Handle getting AI insights for analytics
+func handleGetAIInsights(w http.ResponseWriter, r *http.Request, assignmentID int64, db *database.Database) {
+	log.Printf("AI Insights request received for assignment %d", assignmentID)
+	
+	var req struct {
+		AnalyticsData map[string]interface{} `json:"analytics_data"`
+	}
+	
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Printf("Error decoding request body: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	log.Printf("Analytics data received, size: %d fields", len(req.AnalyticsData))
+	
+	// Get Claude API key from environment
+	claudeAPIKey := os.Getenv("CLAUDE_API_KEY")
+	if claudeAPIKey == "" {
+		log.Printf("ERROR: Claude API key not configured")
+		http.Error(w, "Claude API key not configured", http.StatusInternalServerError)
+		return
+	}
+	log.Printf("Claude API key found (length: %d)", len(claudeAPIKey))
+	
+	// Prepare analytics summary for Claude
+	analyticsJSON, _ := json.MarshalIndent(req.AnalyticsData, "", "  ")
+	
+	prompt := fmt.Sprintf(`You are an educational assessment expert. Analyze this grading data and provide a BRIEF summary.
+
+Analytics Data:
+%s
+
+Provide a concise analysis in ONE of these formats (but don't say which one in the response):
+- Option 1: 1-2 short paragraphs (max 4-5 sentences total)
+- Option 2: 1 paragraph followed by 2-4 bullet points
+
+Focus on the most important insights only:
+- Key grading consistency issues (if any)
+- Most significant criteria concerns
+- 1+ actionable recommendation
+
+Be specific but concise. Reference actual numbers from the data.`, string(analyticsJSON))
+	
+	log.Printf("Calling Claude API...")
+	// Call Claude API
+	insights, err := callClaudeAPI(claudeAPIKey, prompt)
+	if err != nil {
+		log.Printf("Error calling Claude API: %v", err)
+		http.Error(w, fmt.Sprintf("Failed to get AI insights: %v", err), http.StatusInternalServerError)
+		return
+	}
+	
+	log.Printf("Claude API response received (length: %d)", len(insights))
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"insights": insights,
+	})
+	log.Printf("AI insights response sent successfully")
+}
+
+// Call Claude API
+func callClaudeAPI(apiKey, prompt string) (string, error) {
+	url := "https://api.anthropic.com/v1/messages"
+	
+	requestBody := map[string]interface{}{
+		"model": "claude-3-haiku-20240307",
+		"max_tokens": 2000,
+		"messages": []map[string]interface{}{
+			{
+				"role":    "user",
+				"content": prompt,
+			},
+		},
+	}
+	
+	jsonData, err := json.Marshal(requestBody)
+	if err != nil {
+		log.Printf("Error marshaling request: %v", err)
+		return "", err
+	}
+	
+	log.Printf("Making request to Claude API...")
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		log.Printf("Error creating request: %v", err)
+		return "", err
+	}
+	
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", apiKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+	
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Printf("Error making request: %v", err)
+		return "", err
+	}
+	defer resp.Body.Close()
+	
+	log.Printf("Claude API responded with status: %d", resp.StatusCode)
+	
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		log.Printf("Claude API error response: %s", string(body))
+		return "", fmt.Errorf("Claude API error: %s - %s", resp.Status, string(body))
+	}
+	
+	var result struct {
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+	}
+	
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		log.Printf("Error decoding response: %v", err)
+		return "", err
+	}
+	
+	if len(result.Content) == 0 {
+		log.Printf("No content in Claude response")
+		return "", fmt.Errorf("no content in response")
+	}
+	
+	log.Printf("Successfully extracted insights from Claude")
+	return result.Content[0].Text, nil
+}
+
+// Handle AI rubric suggestions based on grading data
+func handleAIRubricSuggest(w http.ResponseWriter, r *http.Request, rubricID int64, db *database.Database) {
+	log.Printf("AI Rubric Suggest request received for rubric %d", rubricID)
+	
+	// Get Claude API key
+	claudeAPIKey := os.Getenv("CLAUDE_API_KEY")
+	if claudeAPIKey == "" {
+		log.Printf("ERROR: Claude API key not configured")
+		http.Error(w, "Claude API key not configured", http.StatusInternalServerError)
+		return
+	}
+	
+	// 1. Fetch the current rubric
+	var rubricName string
+	var rubricCriteria, rubricWeights string
+	err := db.DB.QueryRow(`
+		SELECT name, criteria, weights FROM rubrics WHERE id = ?
+	`, rubricID).Scan(&rubricName, &rubricCriteria, &rubricWeights)
+	
+	if err != nil {
+		log.Printf("Error fetching rubric: %v", err)
+		http.Error(w, "Rubric not found", http.StatusNotFound)
+		return
+	}
+	
+	// Parse criteria and weights
+	var criteria []string
+	var weights []float64
+	json.Unmarshal([]byte(rubricCriteria), &criteria)
+	json.Unmarshal([]byte(rubricWeights), &weights)
+	
+	// Build current rubric structure
+	currentRubric := make([]map[string]interface{}, len(criteria))
+	for i, criterion := range criteria {
+		weight := 0.0
+		if i < len(weights) {
+			weight = weights[i]
+		}
+		currentRubric[i] = map[string]interface{}{
+			"name":       criterion,
+			"max_points": weight,
+			"index":      i,
+		}
+	}
+	
+	// 2. Fetch all assignments using this rubric
+	assignmentRows, err := db.DB.Query(`
+		SELECT id FROM assignments WHERE rubric_id = ?
+	`, rubricID)
+	if err != nil {
+		log.Printf("Error fetching assignments: %v", err)
+		http.Error(w, "Error fetching assignments", http.StatusInternalServerError)
+		return
+	}
+	defer assignmentRows.Close()
+	
+	var assignmentIDs []int64
+	for assignmentRows.Next() {
+		var id int64
+		assignmentRows.Scan(&id)
+		assignmentIDs = append(assignmentIDs, id)
+	}
+	
+	// 3. Fetch analytics data for all assignments
+	analyticsData := make([]map[string]interface{}, 0)
+	for _, assignmentID := range assignmentIDs {
+		// Get grades with rubric scores
+		gradeRows, err := db.DB.Query(`
+			SELECT g.id, g.rubric_scores, g.total_score, u.name, u.role
+			FROM grades g
+			JOIN users u ON g.grader_id = u.id
+			WHERE g.assignment_id = ?
+		`, assignmentID)
+		
+		if err != nil {
+			continue
+		}
+		
+		var scores []float64
+		var graderNames []string
+		
+		for gradeRows.Next() {
+			var gradeID int64
+			var rubricScoresJSON string
+			var totalScore float64
+			var graderName, graderRole string
+			
+			gradeRows.Scan(&gradeID, &rubricScoresJSON, &totalScore, &graderName, &graderRole)
+			
+			var rubricScores map[string]float64
+			json.Unmarshal([]byte(rubricScoresJSON), &rubricScores)
+			
+			scores = append(scores, totalScore)
+			graderNames = append(graderNames, graderName)
+		}
+		gradeRows.Close()
+		
+		if len(scores) > 0 {
+			analyticsData = append(analyticsData, map[string]interface{}{
+				"assignment_id": assignmentID,
+				"grade_count":   len(scores),
+				"graders":       graderNames,
+			})
+		}
+	}
+	
+	// 4. Fetch ALL TA comments for assignments using this rubric
+	var allComments []map[string]interface{}
+	for _, assignmentID := range assignmentIDs {
+		commentRows, err := db.DB.Query(`
+			SELECT gc.message, u.name, u.role, gc.created_at
+			FROM grade_comments gc
+			JOIN grades g ON gc.grade_id = g.id
+			JOIN users u ON gc.user_id = u.id
+			WHERE g.assignment_id = ? AND u.role = 'TA'
+			ORDER BY gc.created_at DESC
+		`, assignmentID)
+		
+		if err != nil {
+			continue
+		}
+		
+		for commentRows.Next() {
+			var message, userName, userRole, createdAt string
+			commentRows.Scan(&message, &userName, &userRole, &createdAt)
+			
+			allComments = append(allComments, map[string]interface{}{
+				"message":    message,
+				"ta_name":    userName,
+				"created_at": createdAt,
+			})
+		}
+		commentRows.Close()
+	}
+	
+	log.Printf("Collected %d TA comments for rubric analysis", len(allComments))
+	
+	// 5. Build prompt for Claude
+	currentRubricJSON, _ := json.MarshalIndent(currentRubric, "", "  ")
+	analyticsJSON, _ := json.MarshalIndent(analyticsData, "", "  ")
+	commentsJSON, _ := json.MarshalIndent(allComments, "", "  ")
+	
+	prompt := fmt.Sprintf(`You are an educational assessment expert. Analyze this grading rubric based on actual usage data and suggest improvements.
+
+CURRENT RUBRIC:
+%s
+
+GRADING ANALYTICS:
+%s
+
+TA COMMENTS (feedback left during grading):
+%s
+
+Based on this data, suggest an improved version of the rubric. ALL SUGGESTIONS MUST KEEP THE TOTAL AT 100 POINTS. IF YOU SPLIT A CRITERIA INTO MULTIPLE SUBCRITERIA, THE SUM OF THOSE POINTS MUST BE THE SAME AS THE ORIGINAL. Consider:
+
+YOU CANNOT, I REPEAT CANNOT, SUGGEST THAT YOU INCREASE OR DECREASE THE POINTS A SPECIFIC CRITERIA IS WORTH, ONLY THE CRITERIA ITSELF CAN CHANGE, NOT ITS VALUE.
+
+1. Are criterion names clear and specific enough based on how TAs are using them?
+2. Should any criteria be split into multiple criteria for clarity?
+3. Should any criteria be merged?
+4. Are the point allocations appropriate based on grading patterns?
+5. What issues do the TA comments reveal about the rubric?
+
+Return ONLY a valid JSON array with the same structure as the input rubric, but with your suggested improvements. Each criterion should have:
+- "name": string (the criterion name/description)
+- "max_points": number (the point value)
+- "index": number (position in rubric)
+- "change_reason": string (brief explanation of what you changed and why)
+
+Example output format:
+[
+  {
+    "name": "Code Quality and Style",
+    "max_points": 25,
+    "index": 0,
+    "change_reason": "Split from original 'Code' criterion for clarity based on TA comments about ambiguity"
+  }
+]
+
+Return ONLY the JSON array, no other text.`, string(currentRubricJSON), string(analyticsJSON), string(commentsJSON))
+	
+	// 6. Call Claude API
+	log.Printf("Calling Claude API for rubric suggestions...")
+	suggestedRubricText, err := callClaudeAPI(claudeAPIKey, prompt)
+	if err != nil {
+		log.Printf("Error calling Claude API: %v", err)
+		http.Error(w, fmt.Sprintf("Failed to get AI suggestions: %v", err), http.StatusInternalServerError)
+		return
+	}
+	
+	// 7. Parse the suggested rubric JSON
+	var suggestedRubric []map[string]interface{}
+	if err := json.Unmarshal([]byte(suggestedRubricText), &suggestedRubric); err != nil {
+		log.Printf("Error parsing Claude response as JSON: %v", err)
+		// Try to extract JSON from markdown code blocks
+		start := strings.Index(suggestedRubricText, "[")
+		end := strings.LastIndex(suggestedRubricText, "]")
+		if start >= 0 && end > start {
+			jsonPart := suggestedRubricText[start : end+1]
+			if err := json.Unmarshal([]byte(jsonPart), &suggestedRubric); err != nil {
+				log.Printf("Still failed to parse JSON: %v", err)
+				http.Error(w, "Failed to parse AI response", http.StatusInternalServerError)
+				return
+			}
+		} else {
+			http.Error(w, "Failed to parse AI response", http.StatusInternalServerError)
+			return
+		}
+	}
+	
+	log.Printf("Successfully generated AI rubric suggestions")
+	
+	// 8. Return both old and new rubrics
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"current_rubric":   currentRubric,
+		"suggested_rubric": suggestedRubric,
+		"rubric_name":      rubricName,
+		"rubric_id":        rubricID,
+	})
+}
+
+// Handle moving an assignment's grades between draft, in review and released,
+// optionally scheduling the release
+func handleSetReleaseState(w http.ResponseWriter, r *http.Request, assignmentID int64, assignmentService *services.AssignmentService) {
+	var req struct {
+		State     string     `json:"state"`
+		ReleaseAt *time.Time `json:"release_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	releaseReq := &pb.SetReleaseStateRequest{
+		AssignmentId: assignmentID,
+		State:        req.State,
+	}
+	if req.ReleaseAt != nil {
+		releaseReq.ReleaseAt = timestamppb.New(*req.ReleaseAt)
+	}
+	
+	resp, err := assignmentService.SetReleaseState(r.Context(), releaseReq)
+	if err != nil {
+		log.Printf("Error setting release state: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	response := map[string]interface{}{
+		"assignment_id": resp.Assignment.Id,
+		"release_state": resp.Assignment.ReleaseState,
+		"message":       resp.Message,
+	}
+	if resp.Assignment.ReleaseAt != nil {
+		response["release_at"] = resp.Assignment.ReleaseAt.AsTime()
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// maxGradeImportSize caps the size of an uploaded grade CSV
+const maxGradeImportSize = 32 << 20
+
+// Handle importing grades from a CSV file, sent either as the request body or
+// as the "file" field of a multipart form. The file is read as it streams in;
+// dry_run=true validates it without saving anything.
+func handleImportGrades(w http.ResponseWriter, r *http.Request, assignmentID int64, gradeService *services.GradeService) {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	r.Body = http.MaxBytesReader(w, r.Body, maxGradeImportSize)
+	
+	var file io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				http.Error(w, "No file uploaded", http.StatusBadRequest)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if part.FormName() == "file" {
+				file = part
+				break
+			}
+		}
+	}
+	
+	resp, err := gradeService.ImportGradesCSV(r.Context(), assignmentID, file, dryRun)
+	if err != nil {
+		if writeValidationError(w, err) {
+			return
+		}
+		log.Printf("Error importing grades: %v", err)
+		if strings.HasPrefix(err.Error(), "access denied") || errors.Is(err, services.ErrCalibrationRequired) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	rowErrors := []map[string]interface{}{}
+	for _, rowError := range resp.RowErrors {
+		rowErrors = append(rowErrors, map[string]interface{}{
+			"row":     rowError.Row,
+			"column":  rowError.Column,
+			"message": rowError.Message,
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	if len(rowErrors) > 0 {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message":        resp.Message,
+		"dry_run":        resp.DryRun,
+		"committed":      resp.Committed,
+		"rows_read":      resp.RowsRead,
+		"grades_created": resp.GradesCreated,
+		"grades_updated": resp.GradesUpdated,
+		"errors":         rowErrors,
+	})
+}
+
+// Handle exporting a course's gradebook, or one assignment's grades, as CSV
+func handleExportGradebook(w http.ResponseWriter, r *http.Request, courseID int64, gradebookService *services.GradebookService) {
+	query := r.URL.Query()
+	req := &pb.ExportGradebookRequest{
+		CourseId: courseID,
+		Format:   query.Get("format"),
+	}
+	if assignmentID := query.Get("assignment_id"); assignmentID != "" {
+		id, err := strconv.ParseInt(assignmentID, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid assignment ID", http.StatusBadRequest)
+			return
+		}
+		req.AssignmentId = id
+	}
+	req.IncludeCriteria, _ = strconv.ParseBool(query.Get("criteria"))
+	req.IncludeFeedback, _ = strconv.ParseBool(query.Get("feedback"))
+	req.IncludeUnreleased, _ = strconv.ParseBool(query.Get("include_unreleased"))
+	
+	gradebook, err := gradebookService.PrepareGradebook(r.Context(), req)
+	if err != nil {
+		if writeValidationError(w, err) {
+			return
+		}
+		log.Printf("Error exporting gradebook: %v", err)
+		if strings.HasPrefix(err.Error(), "access denied") {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", gradebook.FileName()))
+	if err := gradebook.Write(w); err != nil {
+		log.Printf("Error writing gradebook: %v", err)
+	}
+}
+
+// Handle linking a student member to their student ID in a course
+func handleSetStudentId(w http.ResponseWriter, r *http.Request, courseID int64, userIDPart string, courseService *services.CourseService) {
+	userID, err := strconv.ParseInt(userIDPart, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+	var body struct {
+		StudentID string `json:"student_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := courseService.SetStudentId(r.Context(), &pb.SetStudentIdRequest{
+		CourseId:  courseID,
+		UserId:    userID,
+		StudentId: body.StudentID,
+	})
+	if err != nil {
+		if writeValidationError(w, err) {
+			return
+		}
+		if strings.HasPrefix(err.Error(), "access denied") {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		log.Printf("Error setting student ID: %v", err)
+		http.Error(w, "Error setting student ID", http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+// writeLtiError maps LTI service errors to HTTP statuses
+func writeLtiError(w http.ResponseWriter, err error, action string) {
+	if writeValidationError(w, err) {
+		return
+	}
+	log.Printf("Error %s: %v", action, err)
+	switch {
+	case strings.HasPrefix(err.Error(), "invalid LTI launch"):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case strings.HasPrefix(err.Error(), "access denied"):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case strings.Contains(err.Error(), "not found"):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func ltiPlatformJSON(platform *pb.LtiPlatform) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             platform.Id,
+		"name":           platform.Name,
+		"issuer":         platform.Issuer,
+		"client_id":      platform.ClientId,
+		"deployment_id":  platform.DeploymentId,
+		"auth_login_url": platform.AuthLoginUrl,
+		"auth_token_url": platform.AuthTokenUrl,
+		"jwks_url":       platform.JwksUrl,
+		"trust_email":    platform.TrustEmail,
+		"created_at":     platform.CreatedAt.AsTime(),
+	}
+}
+
+func ltiResourceLinkJSON(link *pb.LtiResourceLink) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               link.Id,
+		"platform_id":      link.PlatformId,
+		"resource_link_id": link.ResourceLinkId,
+		"course_id":        link.CourseId,
+		"assignment_id":    link.AssignmentId,
+		"title":            link.Title,
+		"lineitem_url":     link.LineitemUrl,
+		"can_pass_back":    link.CanPassBack,
+	}
+}
+
+// Handle an LTI 1.3 third-party initiated login, sending the browser on to
+// the platform's authorization endpoint
+func handleLtiLogin(w http.ResponseWriter, r *http.Request, ltiService *services.LtiService) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	redirectURL, state, err := ltiService.LoginRedirect(services.LtiLogin{
+		Issuer:        r.Form.Get("iss"),
+		LoginHint:     r.Form.Get("login_hint"),
+		TargetLinkURI: r.Form.Get("target_link_uri"),
+		MessageHint:   r.Form.Get("lti_message_hint"),
+		ClientID:      r.Form.Get("client_id"),
+		DeploymentID:  r.Form.Get("lti_deployment_id"),
+	})
+	if err != nil {
+		writeLtiError(w, err, "starting LTI login")
+		return
+	}
+	// Launches are posted cross-site from the platform, so the cookie must
+	// be sent with them
+	http.SetCookie(w, &http.Cookie{
+		Name:     ltiStateCookie,
+		Value:    state,
+		Path:     "/lti/launch",
+		MaxAge:   ltiStateCookieMaxAge,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// Handle an LTI 1.3 launch posted back by the platform, signing the user in
+// to the web client
+func handleLtiLaunch(w http.ResponseWriter, r *http.Request, ltiService *services.LtiService) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	browserState := ""
+	if cookie, err := r.Cookie(ltiStateCookie); err == nil {
+		browserState = cookie.Value
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     ltiStateCookie,
+		Path:     "/lti/launch",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+	
+	launch, err := ltiService.Launch(r.PostForm.Get("id_token"), r.PostForm.Get("state"), browserState)
+	if err != nil {
+		writeLtiError(w, err, "launching from LTI")
+		return
+	}
+	log.Printf("LTI launch signed in user %d to course %d", launch.UserID, launch.CourseID)
+	http.Redirect(w, r, launch.RedirectURL, http.StatusSeeOther)
+}
+
+// Handle registering an LTI platform
+func handleRegisterLtiPlatform(w http.ResponseWriter, r *http.Request, ltiService *services.LtiService) {
+	var req struct {
+		Name         string `json:"name"`
+		Issuer       string `json:"issuer"`
+		ClientID     string `json:"client_id"`
+		DeploymentID string `json:"deployment_id"`
+		AuthLoginURL string `json:"auth_login_url"`
+		AuthTokenURL string `json:"auth_token_url"`
+		JwksURL      string `json:"jwks_url"`
+		TrustEmail   bool   `json:"trust_email"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := ltiService.RegisterPlatform(r.Context(), &pb.RegisterLtiPlatformRequest{
+		Name:         req.Name,
+		Issuer:       req.Issuer,
+		ClientId:     req.ClientID,
+		DeploymentId: req.DeploymentID,
+		AuthLoginUrl: req.AuthLoginURL,
+		AuthTokenUrl: req.AuthTokenURL,
+		JwksUrl:      req.JwksURL,
+		TrustEmail:   req.TrustEmail,
+	})
+	if err != nil {
+		writeLtiError(w, err, "registering LTI platform")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"platform": ltiPlatformJSON(resp.Platform),
+		"message":  resp.Message,
+	})
+}
+
+// Handle linking an LTI resource link to an assignment
+func handleLinkLtiResource(w http.ResponseWriter, r *http.Request, linkID int64, ltiService *services.LtiService) {
+	var req struct {
+		AssignmentID int64 `json:"assignment_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := ltiService.LinkResource(r.Context(), &pb.LinkLtiResourceRequest{
+		LinkId:       linkID,
+		AssignmentId: req.AssignmentID,
+	})
+	if err != nil {
+		writeLtiError(w, err, "linking LTI resource")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"link":    ltiResourceLinkJSON(resp.Link),
+		"message": resp.Message,
+	})
+}
+
+// Handle passing an assignment's released grades back to LTI platforms
+func handlePassbackGrades(w http.ResponseWriter, r *http.Request, assignmentID int64, ltiService *services.LtiService) {
+	resp, err := ltiService.PassbackGrades(r.Context(), &pb.PassbackGradesRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeLtiError(w, err, "passing back grades")
+		return
+	}
+	
+	results := []map[string]interface{}{}
+	for _, result := range resp.Results {
+		results = append(results, map[string]interface{}{
+			"link_id":      result.LinkId,
+			"user_id":      result.UserId,
+			"student_id":   result.StudentId,
+			"student_name": result.StudentName,
+			"score":        result.Score,
+			"max_score":    result.MaxScore,
+			"status":       result.Status,
+			"message":      result.Message,
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignment_id": resp.AssignmentId,
+		"sent":          resp.Sent,
+		"skipped":       resp.Skipped,
+		"failed":        resp.Failed,
+		"results":       results,
+		"message":       resp.Message,
+	})
+}
+
+// Handle turning blind grading on or off for an assignment
+func handleSetBlindGrading(w http.ResponseWriter, r *http.Request, assignmentID int64, assignmentService *services.AssignmentService) {
+	var req struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := assignmentService.SetBlindGrading(r.Context(), &pb.SetBlindGradingRequest{
+		AssignmentId: assignmentID,
+		Enabled:      req.Enabled,
+	})
+	if err != nil {
+		log.Printf("Error setting blind grading: %v", err)
+		if strings.HasPrefix(err.Error(), "access denied") {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignment_id": resp.Assignment.Id,
+		"blind_grading": resp.Assignment.BlindGrading,
+		"message":       resp.Message,
+	})
+}
+
+// writeStudentError maps student portal errors to HTTP statuses
+func writeStudentError(w http.ResponseWriter, err error, action string) {
+	switch {
+	case errors.Is(err, services.ErrGradeNotReleased), errors.Is(err, services.ErrGradeNotFound), errors.Is(err, services.ErrStudentSubmissionNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case strings.HasPrefix(err.Error(), "access denied"):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		log.Printf("Error %s: %v", action, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Handle a student filing a regrade request against their released grade
+func handleCreateStudentRegradeRequest(w http.ResponseWriter, r *http.Request, assignmentID int64, studentService *services.StudentService) {
+	var req struct {
+		Criteria      []string `json:"criteria"`
+		Justification string   `json:"justification"`
+	}
+	
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := studentService.CreateStudentRegradeRequest(r.Context(), &pb.CreateStudentRegradeRequestRequest{
+		AssignmentId:  assignmentID,
+		Criteria:      req.Criteria,
+		Justification: req.Justification,
+	})
+	if err != nil {
+		if writeValidationError(w, err) {
+			return
+		}
+		if errors.Is(err, services.ErrRegradeRequestExists) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		writeStudentError(w, err, "creating regrade request")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": resp.Message,
+		"request": regradeRequestJSON(resp.Request),
+	})
+}
+
+// Handle listing the courses a student is enrolled in
+func handleListStudentCourses(w http.ResponseWriter, r *http.Request, studentService *services.StudentService) {
+	resp, err := studentService.ListStudentCourses(r.Context(), &pb.ListStudentCoursesRequest{})
+	if err != nil {
+		writeStudentError(w, err, "listing student courses")
+		return
+	}
+	
+	courses := []map[string]interface{}{}
+	for _, course := range resp.Courses {
+		courses = append(courses, map[string]interface{}{
+			"id":              course.Id,
+			"name":            course.Name,
+			"code":            course.Code,
+			"description":     course.Description,
+			"semester":        course.Semester,
+			"year":            course.Year,
+			"instructor_name": course.InstructorName,
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"courses": courses,
+	})
+}
+
+// Handle listing a course's assignments for a student, with released scores
+func handleListStudentAssignments(w http.ResponseWriter, r *http.Request, courseID int64, studentService *services.StudentService) {
+	resp, err := studentService.ListStudentAssignments(r.Context(), &pb.ListStudentAssignmentsRequest{CourseId: courseID})
+	if err != nil {
+		writeStudentError(w, err, "listing student assignments")
+		return
+	}
+	
+	assignments := []map[string]interface{}{}
+	for _, assignment := range resp.Assignments {
+		item := map[string]interface{}{
+			"id":               assignment.Id,
+			"course_id":        assignment.CourseId,
+			"name":             assignment.Name,
+			"description":      assignment.Description,
+			"max_score":        assignment.MaxScore,
+			"released":         assignment.Released,
+			"submission_count": assignment.SubmissionCount,
+			"graded":           assignment.Graded,
+		}
+		if assignment.DueDate != nil {
+			item["due_date"] = assignment.DueDate.AsTime()
+		}
+		if assignment.Graded {
+			item["total_score"] = assignment.TotalScore
+		}
+		assignments = append(assignments, item)
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignments": assignments,
+	})
+}
+
+// Handle listing a student's own submissions, optionally for one course or assignment
+func handleListStudentSubmissions(w http.ResponseWriter, r *http.Request, studentService *services.StudentService) {
+	req := &pb.ListStudentSubmissionsRequest{}
+	if courseID := r.URL.Query().Get("course_id"); courseID != "" {
+		id, err := strconv.ParseInt(courseID, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid course_id", http.StatusBadRequest)
+			return
+		}
+		req.CourseId = id
+	}
+	if assignmentID := r.URL.Query().Get("assignment_id"); assignmentID != "" {
+		id, err := strconv.ParseInt(assignmentID, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid assignment_id", http.StatusBadRequest)
+			return
+		}
+		req.AssignmentId = id
+	}
+	
+	resp, err := studentService.ListStudentSubmissions(r.Context(), req)
+	if err != nil {
+		writeStudentError(w, err, "listing student submissions")
+		return
+	}
+	
+	submissions := []map[string]interface{}{}
+	for _, submission := range resp.Submissions {
+		submissions = append(submissions, map[string]interface{}{
+			"id":              submission.Id,
+			"assignment_id":   submission.AssignmentId,
+			"assignment_name": submission.AssignmentName,
+			"file_name":       submission.FileName,
+			"uploaded_at":     submission.UploadedAt.AsTime(),
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"submissions": submissions,
+	})
+}
+
+// Handle a student downloading their own submission
+func handleGetStudentSubmissionFile(w http.ResponseWriter, r *http.Request, submissionID int64, studentService *services.StudentService) {
+	resp, err := studentService.GetStudentSubmissionFile(r.Context(), &pb.GetSubmissionRequest{Id: submissionID})
+	if err != nil {
+		writeStudentError(w, err, "fetching student submission file")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", "inline; filename=\""+resp.FileName+"\"")
+	w.Write(resp.FileData)
+}
+
+// Handle a student viewing their released grade for an assignment
+func handleGetStudentGrade(w http.ResponseWriter, r *http.Request, assignmentID int64, studentService *services.StudentService) {
+	resp, err := studentService.GetStudentGrade(r.Context(), &pb.GetStudentGradeRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeStudentError(w, err, "fetching student grade")
+		return
+	}
+	
+	grade := resp.Grade
+	criteria := []map[string]interface{}{}
+	for _, criterion := range grade.Criteria {
+		criteria = append(criteria, map[string]interface{}{
+			"key":       criterion.Key,
+			"label":     criterion.Label,
+			"score":     criterion.Score,
+			"max_score": criterion.MaxScore,
+			"graded":    criterion.Graded,
+			"feedback":  criterion.Feedback,
+		})
+	}
+	
+	gradeData := map[string]interface{}{
+		"assignment_id":   grade.AssignmentId,
+		"assignment_name": grade.AssignmentName,
+		"submission_id":   grade.SubmissionId,
+		"total_score":     grade.TotalScore,
+		"max_score":       grade.MaxScore,
+		"criteria":        criteria,
+		"feedback":        grade.Feedback,
+		"graded_at":       grade.GradedAt.AsTime(),
+		"updated_at":      grade.UpdatedAt.AsTime(),
+	}
+	if grade.GraderName != "" {
+		gradeData["grader_name"] = grade.GraderName
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"grade": gradeData,
+	})
+}
+
+// Handle a student viewing the rubric of an assignment whose grades are released
+func handleGetStudentRubric(w http.ResponseWriter, r *http.Request, assignmentID int64, studentService *services.StudentService) {
+	resp, err := studentService.GetStudentRubric(r.Context(), &pb.GetStudentRubricRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeStudentError(w, err, "fetching student rubric")
+		return
+	}
+	
+	criteria := []map[string]interface{}{}
+	for _, criterion := range resp.Criteria {
+		criteria = append(criteria, map[string]interface{}{
+			"key":       criterion.Key,
+			"label":     criterion.Label,
+			"max_score": criterion.MaxScore,
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignment_id":  resp.AssignmentId,
+		"rubric_name":    resp.RubricName,
+		"criteria":       criteria,
+		"criterion_tree": rubricCriteriaJSON(resp.CriterionTree),
+	})
+}
+
+// writeCommentBankError maps comment bank errors to HTTP statuses
+func writeCommentBankError(w http.ResponseWriter, err error, action string) {
+	if writeValidationError(w, err) {
+		return
+	}
+	var conflict *services.GradeConflictError
+	switch {
+	case errors.As(err, &conflict):
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", gradeETag(conflict.Current))
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":   err.Error(),
+			"current": gradeJSON(conflict.Current),
+		})
+	case errors.Is(err, services.ErrBankCommentNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, services.ErrBankCommentApplied) || errors.Is(err, services.ErrBankCommentNotApplied) || errors.Is(err, services.ErrBankCommentOutdated) || errors.Is(err, services.ErrGradeVersionConflict) || errors.Is(err, services.ErrGradeRubricOutdated):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, services.ErrSubmissionNotAssigned) || errors.Is(err, services.ErrCalibrationRequired) || strings.HasPrefix(err.Error(), "access denied"):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		log.Printf("Error %s: %v", action, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Handle listing a rubric's versions, newest first
+func handleListRubricVersions(w http.ResponseWriter, r *http.Request, rubricID int64, rubricService *services.RubricService) {
+	resp, err := rubricService.ListRubricVersions(r.Context(), &pb.ListRubricVersionsRequest{RubricId: rubricID})
+	if err != nil {
+		writeRubricVersionError(w, err, "listing rubric versions")
+		return
 	}
 	
-	// 4. Fetch ALL TA comments for assignments using this rubric
-	var allComments []map[string]interface{}
-	for _, assignmentID := range assignmentIDs {
-		commentRows, err := db.DB.Query(`
-			SELECT gc.message, u.name, u.role, gc.created_at
-			FROM grade_comments gc
-			JOIN grades g ON gc.grade_id = g.id
-			JOIN users u ON gc.user_id = u.id
-			WHERE g.assignment_id = ? AND u.role = 'TA'
-			ORDER BY gc.created_at DESC
-		`, assignmentID)
-		
-		if err != nil {
+	versions := []map[string]interface{}{}
+	for _, version := range resp.Versions {
+		versions = append(versions, rubricVersionJSON(version))
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"rubric_id":       resp.RubricId,
+		"current_version": resp.CurrentVersion,
+		"versions":        versions,
+	})
+}
+
+// Handle comparing two versions of a rubric; to defaults to the current version
+func handleCompareRubricVersions(w http.ResponseWriter, r *http.Request, rubricID int64, rubricService *services.RubricService) {
+	req := &pb.CompareRubricVersionsRequest{RubricId: rubricID}
+	for _, param := range []struct {
+		name  string
+		value *int32
+	}{
+		{"from", &req.FromVersion},
+		{"to", &req.ToVersion},
+	} {
+		raw := r.URL.Query().Get(param.name)
+		if raw == "" {
 			continue
 		}
-		
-		for commentRows.Next() {
-			var message, userName, userRole, createdAt string
-			commentRows.Scan(&message, &userName, &userRole, &createdAt)
-			
-			allComments = append(allComments, map[string]interface{}{
-				"message":    message,
-				"ta_name":    userName,
-				"created_at": createdAt,
+		version, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid "+param.name+" version", http.StatusBadRequest)
+			return
+		}
+		*param.value = int32(version)
+	}
+	
+	resp, err := rubricService.CompareRubricVersions(r.Context(), req)
+	if err != nil {
+		writeRubricVersionError(w, err, "comparing rubric versions")
+		return
+	}
+	
+	criteria := []map[string]interface{}{}
+	for _, change := range resp.Criteria {
+		criteria = append(criteria, map[string]interface{}{
+			"key":         change.Key,
+			"change":      change.Change,
+			"from_label":  change.FromLabel,
+			"to_label":    change.ToLabel,
+			"from_weight": change.FromWeight,
+			"to_weight":   change.ToWeight,
+			"from_mean":   change.FromMean,
+			"to_mean":     change.ToMean,
+			"from_graded": change.FromGraded,
+			"to_graded":   change.ToGraded,
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"rubric_id":   resp.RubricId,
+		"from":        rubricVersionJSON(resp.From),
+		"to":          rubricVersionJSON(resp.To),
+		"criteria":    criteria,
+		"from_scores": rubricVersionScoresJSON(resp.FromScores),
+		"to_scores":   rubricVersionScoresJSON(resp.ToScores),
+	})
+}
+
+// Handle replaying current grades against a proposed rubric without saving it
+func handleSimulateRubricChange(w http.ResponseWriter, r *http.Request, rubricID int64, rubricService *services.RubricService) {
+	var requestBody struct {
+		AssignmentID  int64                  `json:"assignment_id"`
+		Criteria      []string               `json:"criteria"`
+		Weights       []float64              `json:"weights"`
+		CriterionTree []*pb.RubricCriterion  `json:"criterion_tree"`
+		Mappings      []*pb.CriterionMapping `json:"mappings"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := rubricService.SimulateRubricChange(r.Context(), &pb.SimulateRubricChangeRequest{
+		RubricId:      rubricID,
+		AssignmentId:  requestBody.AssignmentID,
+		Criteria:      requestBody.Criteria,
+		Weights:       requestBody.Weights,
+		CriterionTree: requestBody.CriterionTree,
+		Mappings:      requestBody.Mappings,
+	})
+	if err != nil {
+		writeRubricVersionError(w, err, "simulating rubric change")
+		return
+	}
+	
+	criteria := []map[string]interface{}{}
+	for _, criterion := range resp.Criteria {
+		sources := []map[string]interface{}{}
+		for _, source := range criterion.Sources {
+			sources = append(sources, map[string]interface{}{
+				"key":   source.Key,
+				"share": source.Share,
 			})
 		}
-		commentRows.Close()
+		criteria = append(criteria, map[string]interface{}{
+			"index":            criterion.Index,
+			"label":            criterion.Label,
+			"weight":           criterion.Weight,
+			"sources":          sources,
+			"assumed_fraction": criterion.AssumedFraction,
+			"mean_after":       criterion.MeanAfter,
+		})
 	}
 	
-	log.Printf("Collected %d TA comments for rubric analysis", len(allComments))
+	histogram := []map[string]interface{}{}
+	for _, bin := range resp.Histogram {
+		histogram = append(histogram, map[string]interface{}{
+			"lower_fraction": bin.LowerFraction,
+			"upper_fraction": bin.UpperFraction,
+			"before_count":   bin.BeforeCount,
+			"after_count":    bin.AfterCount,
+		})
+	}
 	
-	// 5. Build prompt for Claude
-	currentRubricJSON, _ := json.MarshalIndent(currentRubric, "", "  ")
-	analyticsJSON, _ := json.MarshalIndent(analyticsData, "", "  ")
-	commentsJSON, _ := json.MarshalIndent(allComments, "", "  ")
+	students := []map[string]interface{}{}
+	for _, student := range resp.Students {
+		students = append(students, map[string]interface{}{
+			"assignment_id":   student.AssignmentId,
+			"student_id":      student.StudentId,
+			"submission_id":   student.SubmissionId,
+			"grade_id":        student.GradeId,
+			"before":          student.Before,
+			"after":           student.After,
+			"delta":           student.Delta,
+			"before_fraction": student.BeforeFraction,
+			"after_fraction":  student.AfterFraction,
+		})
+	}
 	
-	prompt := fmt.Sprintf(`You are an educational assessment expert. Analyze this grading rubric based on actual usage data and suggest improvements.
+	graders := []map[string]interface{}{}
+	for _, grader := range resp.Graders {
+		graders = append(graders, map[string]interface{}{
+			"grader_id":   grader.GraderId,
+			"grader_name": grader.GraderName,
+			"count":       grader.Count,
+			"mean_before": grader.MeanBefore,
+			"mean_after":  grader.MeanAfter,
+		})
+	}
+	
+	droppedCriteria := resp.DroppedCriteria
+	if droppedCriteria == nil {
+		droppedCriteria = []string{}
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"rubric_id":          resp.RubricId,
+		"assignment_id":      resp.AssignmentId,
+		"current_version":    resp.CurrentVersion,
+		"replayed_grades":    resp.ReplayedGrades,
+		"outdated_grades":    resp.OutdatedGrades,
+		"criteria":           criteria,
+		"dropped_criteria":   droppedCriteria,
+		"before":             scoreDistributionJSON(resp.Before),
+		"after":              scoreDistributionJSON(resp.After),
+		"histogram":          histogram,
+		"students":           students,
+		"students_up":        resp.StudentsUp,
+		"students_down":      resp.StudentsDown,
+		"students_unchanged": resp.StudentsUnchanged,
+		"grader_variance": map[string]interface{}{
+			"before": graderVarianceJSON(resp.GraderVarianceBefore),
+			"after":  graderVarianceJSON(resp.GraderVarianceAfter),
+		},
+		"graders": graders,
+	})
+}
 
-CURRENT RUBRIC:
-%s
+func writeRubricVersionError(w http.ResponseWriter, err error, action string) {
+	if writeValidationError(w, err) {
+		return
+	}
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case strings.HasSuffix(err.Error(), "not found"):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		log.Printf("Error %s: %v", action, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
 
-GRADING ANALYTICS:
-%s
+func rubricVersionJSON(version *pb.RubricVersion) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             version.Id,
+		"rubric_id":      version.RubricId,
+		"version":        version.Version,
+		"criteria":       version.Criteria,
+		"weights":        version.Weights,
+		"created_by":     version.CreatedBy,
+		"creator_name":   version.CreatorName,
+		"created_at":     version.CreatedAt.AsTime(),
+		"current":        version.Current,
+		"grade_count":    version.GradeCount,
+		"criterion_tree": rubricCriteriaJSON(version.CriterionTree),
+	}
+}
 
-TA COMMENTS (feedback left during grading):
-%s
+// rubricCriteriaJSON renders a rubric's criterion tree with its performance levels
+func rubricCriteriaJSON(criteria []*pb.RubricCriterion) []map[string]interface{} {
+	out := []map[string]interface{}{}
+	for _, criterion := range criteria {
+		levels := []map[string]interface{}{}
+		for _, level := range criterion.Levels {
+			entry := map[string]interface{}{
+				"label":       level.Label,
+				"description": level.Description,
+				"points":      level.Points,
+			}
+			if level.PointRange {
+				entry["min_points"] = level.MinPoints
+			}
+			levels = append(levels, entry)
+		}
+		out = append(out, map[string]interface{}{
+			"key":          criterion.Key,
+			"title":        criterion.Title,
+			"description":  criterion.Description,
+			"points":       criterion.Points,
+			"levels":       levels,
+			"sub_criteria": rubricCriteriaJSON(criterion.SubCriteria),
+		})
+	}
+	return out
+}
 
-Based on this data, suggest an improved version of the rubric. ALL SUGGESTIONS MUST KEEP THE TOTAL AT 100 POINTS. IF YOU SPLIT A CRITERIA INTO MULTIPLE SUBCRITERIA, THE SUM OF THOSE POINTS MUST BE THE SAME AS THE ORIGINAL. Consider:
+func rubricVersionScoresJSON(scores *pb.RubricVersionScores) map[string]interface{} {
+	return map[string]interface{}{
+		"grade_count":   scores.GradeCount,
+		"mean_total":    scores.MeanTotal,
+		"max_total":     scores.MaxTotal,
+		"mean_fraction": scores.MeanFraction,
+	}
+}
 
-YOU CANNOT, I REPEAT CANNOT, SUGGEST THAT YOU INCREASE OR DECREASE THE POINTS A SPECIFIC CRITERIA IS WORTH, ONLY THE CRITERIA ITSELF CAN CHANGE, NOT ITS VALUE.
+func scoreDistributionJSON(distribution *pb.ScoreDistribution) map[string]interface{} {
+	return map[string]interface{}{
+		"count":         distribution.Count,
+		"max_points":    distribution.MaxPoints,
+		"mean":          distribution.Mean,
+		"std_dev":       distribution.StdDev,
+		"median":        distribution.Median,
+		"min":           distribution.Min,
+		"max":           distribution.Max,
+		"mean_fraction": distribution.MeanFraction,
+	}
+}
 
-1. Are criterion names clear and specific enough based on how TAs are using them?
-2. Should any criteria be split into multiple criteria for clarity?
-3. Should any criteria be merged?
-4. Are the point allocations appropriate based on grading patterns?
-5. What issues do the TA comments reveal about the rubric?
+func graderVarianceJSON(variance *pb.GraderVariance) map[string]interface{} {
+	return map[string]interface{}{
+		"sufficient_data":         variance.SufficientData,
+		"between_grader_variance": variance.BetweenGraderVariance,
+		"within_grader_variance":  variance.WithinGraderVariance,
+		"grader_share":            variance.GraderShare,
+		"anova_p_value":           variance.AnovaPValue,
+	}
+}
 
-Return ONLY a valid JSON array with the same structure as the input rubric, but with your suggested improvements. Each criterion should have:
-- "name": string (the criterion name/description)
-- "max_points": number (the point value)
-- "index": number (position in rubric)
-- "change_reason": string (brief explanation of what you changed and why)
+func bankCommentJSON(comment *pb.BankComment) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                comment.Id,
+		"rubric_id":         comment.RubricId,
+		"rubric_version_id": comment.RubricVersionId,
+		"criterion_key":     comment.CriterionKey,
+		"criterion_label":   comment.CriterionLabel,
+		"text":              comment.Text,
+		"deduction":         comment.Deduction,
+		"created_by":        comment.CreatedBy,
+		"created_by_name":   comment.CreatedByName,
+		"usage_count":       comment.UsageCount,
+		"created_at":        comment.CreatedAt.AsTime(),
+		"updated_at":        comment.UpdatedAt.AsTime(),
+	}
+}
 
-Example output format:
-[
-  {
-    "name": "Code Quality and Style",
-    "max_points": 25,
-    "index": 0,
-    "change_reason": "Split from original 'Code' criterion for clarity based on TA comments about ambiguity"
-  }
-]
+func appliedCommentsJSON(applied []*pb.AppliedBankComment) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, comment := range applied {
+		result = append(result, map[string]interface{}{
+			"comment_id":    comment.CommentId,
+			"criterion_key": comment.CriterionKey,
+			"text":          comment.Text,
+			"deduction":     comment.Deduction,
+			"applied_at":    comment.AppliedAt.AsTime(),
+		})
+	}
+	return result
+}
 
-Return ONLY the JSON array, no other text.`, string(currentRubricJSON), string(analyticsJSON), string(commentsJSON))
+func bankCommentStatsJSON(comment *pb.BankCommentStats) map[string]interface{} {
+	graders := []map[string]interface{}{}
+	for _, usage := range comment.Graders {
+		graders = append(graders, map[string]interface{}{
+			"grader_id":        usage.GraderId,
+			"grader_name":      usage.GraderName,
+			"usage_count":      usage.UsageCount,
+			"mean_points_lost": usage.MeanPointsLost,
+		})
+	}
+	return map[string]interface{}{
+		"comment_id":         comment.CommentId,
+		"criterion_key":      comment.CriterionKey,
+		"criterion_label":    comment.CriterionLabel,
+		"text":               comment.Text,
+		"deduction":          comment.Deduction,
+		"usage_count":        comment.UsageCount,
+		"graders":            graders,
+		"points_lost_spread": comment.PointsLostSpread,
+		"inconsistent":       comment.Inconsistent,
+	}
+}
+
+// Handle listing a rubric's comment bank, or the bank of an assignment's rubric
+func handleListBankComments(w http.ResponseWriter, r *http.Request, req *pb.ListBankCommentsRequest, commentBankService *services.CommentBankService) {
+	req.CriterionKey = r.URL.Query().Get("criterion")
 	
-	// 6. Call Claude API
-	log.Printf("Calling Claude API for rubric suggestions...")
-	suggestedRubricText, err := callClaudeAPI(claudeAPIKey, prompt)
+	resp, err := commentBankService.ListBankComments(r.Context(), req)
 	if err != nil {
-		log.Printf("Error calling Claude API: %v", err)
-		http.Error(w, fmt.Sprintf("Failed to get AI suggestions: %v", err), http.StatusInternalServerError)
+		writeCommentBankError(w, err, "listing bank comments")
 		return
 	}
 	
-	// 7. Parse the suggested rubric JSON
-	var suggestedRubric []map[string]interface{}
-	if err := json.Unmarshal([]byte(suggestedRubricText), &suggestedRubric); err != nil {
-		log.Printf("Error parsing Claude response as JSON: %v", err)
-		// Try to extract JSON from markdown code blocks
-		start := strings.Index(suggestedRubricText, "[")
-		end := strings.LastIndex(suggestedRubricText, "]")
-		if start >= 0 && end > start {
-			jsonPart := suggestedRubricText[start : end+1]
-			if err := json.Unmarshal([]byte(jsonPart), &suggestedRubric); err != nil {
-				log.Printf("Still failed to parse JSON: %v", err)
-				http.Error(w, "Failed to parse AI response", http.StatusInternalServerError)
-				return
-			}
-		} else {
-			http.Error(w, "Failed to parse AI response", http.StatusInternalServerError)
-			return
-		}
+	comments := []map[string]interface{}{}
+	for _, comment := range resp.Comments {
+		comments = append(comments, bankCommentJSON(comment))
 	}
 	
-	log.Printf("Successfully generated AI rubric suggestions")
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"comments": comments,
+	})
+}
+
+// Handle adding a comment to a rubric's bank
+func handleCreateBankComment(w http.ResponseWriter, r *http.Request, rubricID int64, commentBankService *services.CommentBankService) {
+	var req struct {
+		CriterionKey string  `json:"criterion_key"`
+		Text         string  `json:"text"`
+		Deduction    float64 `json:"deduction"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := commentBankService.CreateBankComment(r.Context(), &pb.CreateBankCommentRequest{
+		RubricId:     rubricID,
+		CriterionKey: req.CriterionKey,
+		Text:         req.Text,
+		Deduction:    req.Deduction,
+	})
+	if err != nil {
+		writeCommentBankError(w, err, "creating bank comment")
+		return
+	}
 	
-	// 8. Return both old and new rubrics
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"current_rubric":   currentRubric,
-		"suggested_rubric": suggestedRubric,
-		"rubric_name":      rubricName,
-		"rubric_id":        rubricID,
+		"comment": bankCommentJSON(resp.Comment),
+		"message": resp.Message,
+	})
+}
+
+// Handle changing a bank comment's text or deduction
+func handleUpdateBankComment(w http.ResponseWriter, r *http.Request, commentID int64, commentBankService *services.CommentBankService) {
+	var req struct {
+		Text      string  `json:"text"`
+		Deduction float64 `json:"deduction"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := commentBankService.UpdateBankComment(r.Context(), &pb.UpdateBankCommentRequest{
+		Id:        commentID,
+		Text:      req.Text,
+		Deduction: req.Deduction,
+	})
+	if err != nil {
+		writeCommentBankError(w, err, "updating bank comment")
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"comment": bankCommentJSON(resp.Comment),
+		"message": resp.Message,
+	})
+}
+
+// Handle applying a bank comment to the caller's grade of a submission
+func handleApplyBankComment(w http.ResponseWriter, r *http.Request, submissionID int64, commentBankService *services.CommentBankService) {
+	var req struct {
+		CommentID int64 `json:"comment_id"`
+		Version   int32 `json:"version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := commentBankService.ApplyBankComment(r.Context(), &pb.ApplyBankCommentRequest{
+		SubmissionId:    submissionID,
+		CommentId:       req.CommentID,
+		ExpectedVersion: req.Version,
+	})
+	writeBankCommentGrade(w, resp, err, "applying bank comment")
+}
+
+// Handle removing a bank comment from the caller's grade; the grade version
+// comes from If-Match or the version query parameter
+func handleRemoveBankComment(w http.ResponseWriter, r *http.Request, submissionID, commentID int64, commentBankService *services.CommentBankService) {
+	versionText := r.URL.Query().Get("version")
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		versionText = strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+	}
+	version, err := strconv.ParseInt(versionText, 10, 32)
+	if err != nil {
+		http.Error(w, "A grade version is required in If-Match or the version parameter", http.StatusBadRequest)
+		return
+	}
+	
+	resp, err := commentBankService.RemoveBankComment(r.Context(), &pb.ApplyBankCommentRequest{
+		SubmissionId:    submissionID,
+		CommentId:       commentID,
+		ExpectedVersion: int32(version),
+	})
+	writeBankCommentGrade(w, resp, err, "removing bank comment")
+}
+
+func writeBankCommentGrade(w http.ResponseWriter, resp *pb.SubmitGradeResponse, err error, action string) {
+	if err != nil {
+		writeCommentBankError(w, err, action)
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", gradeETag(resp.Grade))
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": resp.Message,
+		"created": resp.Created,
+		"grade":   gradeJSON(resp.Grade),
+	})
+}
+
+// Handle reporting comment bank usage per TA for an assignment
+func handleGetCommentBankStats(w http.ResponseWriter, r *http.Request, assignmentID int64, commentBankService *services.CommentBankService) {
+	resp, err := commentBankService.GetCommentBankStats(r.Context(), &pb.GetCommentBankStatsRequest{AssignmentId: assignmentID})
+	if err != nil {
+		writeCommentBankError(w, err, "fetching comment bank stats")
+		return
+	}
+	
+	comments := []map[string]interface{}{}
+	for _, comment := range resp.Comments {
+		comments = append(comments, bankCommentStatsJSON(comment))
+	}
+	graders := []map[string]interface{}{}
+	for _, usage := range resp.Graders {
+		graders = append(graders, map[string]interface{}{
+			"grader_id":         usage.GraderId,
+			"grader_name":       usage.GraderName,
+			"applied":           usage.Applied,
+			"distinct_comments": usage.DistinctComments,
+		})
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"assignment_id": resp.AssignmentId,
+		"comments":      comments,
+		"graders":       graders,
 	})
 }
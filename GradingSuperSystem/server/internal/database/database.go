@@ -3,6 +3,7 @@ package database
 import (
 	"database/sql"
 	"log"
+	"strings"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -12,7 +13,12 @@ type Database struct {
 }
 
 func New() (*Database, error) {
-	db, err := sql.Open("sqlite3", "./talytics.db")
+	return Open("./talytics.db")
+}
+
+// Open opens the SQLite database at path, creating and migrating its tables
+func Open(path string) (*Database, error) {
+	db, err := sql.Open("sqlite3", path)
 	if err != nil {
 		return nil, err
 	}
@@ -31,10 +37,11 @@ func (d *Database) createTables() error {
 		// Users table for authentication
 		`CREATE TABLE IF NOT EXISTS users (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			email TEXT UNIQUE NOT NULL,
+			email TEXT UNIQUE NOT NULL COLLATE NOCASE,
 			name TEXT NOT NULL,
 			password_hash TEXT NOT NULL,
-			role TEXT NOT NULL CHECK (role IN ('instructor', 'ta')),
+			role TEXT NOT NULL CHECK (role IN ('instructor', 'ta', 'student')),
+			is_admin INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
 		)`,
@@ -48,21 +55,39 @@ func (d *Database) createTables() error {
 			description TEXT,
 			semester TEXT,
 			year INTEGER,
+			student_join_code TEXT,
+			show_grader_to_students INTEGER NOT NULL DEFAULT 0,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (instructor_id) REFERENCES users (id)
 		)`,
-		// Course members (instructors and TAs)
+		// Course members (instructors, TAs and students)
 		`CREATE TABLE IF NOT EXISTS course_members (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
 			course_id INTEGER NOT NULL,
 			user_id INTEGER NOT NULL,
-			role TEXT NOT NULL CHECK (role IN ('instructor', 'ta')),
+			role TEXT NOT NULL CHECK (role IN ('instructor', 'ta', 'student')),
 			joined_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
 			FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
 			UNIQUE(course_id, user_id)
 		)`,
+		// Student IDs linked to student members, per course. Set by instructors
+		// or taken from the platform's enrollment on LTI launches.
+		`CREATE TABLE IF NOT EXISTS course_roster (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			course_id INTEGER NOT NULL,
+			user_id INTEGER NOT NULL,
+			student_id TEXT NOT NULL,
+			source TEXT NOT NULL CHECK (source IN ('roster', 'lti')),
+			bound_by INTEGER,
+			bound_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+			FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+			FOREIGN KEY (bound_by) REFERENCES users (id) ON DELETE SET NULL,
+			UNIQUE(course_id, user_id),
+			UNIQUE(course_id, student_id)
+		)`,
 		// Assignments table
 		`CREATE TABLE IF NOT EXISTS assignments (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -72,6 +97,12 @@ func (d *Database) createTables() error {
 			due_date DATETIME,
 			max_score REAL NOT NULL DEFAULT 100,
 			rubric_id INTEGER,
+			overlap_percent REAL NOT NULL DEFAULT 0,
+			overlap_graders INTEGER NOT NULL DEFAULT 2,
+			calibration_threshold REAL NOT NULL DEFAULT 0,
+			release_state TEXT NOT NULL DEFAULT 'draft',
+			release_at DATETIME,
+			blind_grading INTEGER NOT NULL DEFAULT 0,
 			created_by INTEGER NOT NULL,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
@@ -86,12 +117,53 @@ func (d *Database) createTables() error {
 			course_id INTEGER NOT NULL,
 			criteria TEXT NOT NULL,
 			weights TEXT NOT NULL,
+			current_version_id INTEGER,
 			created_by INTEGER NOT NULL,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
 			FOREIGN KEY (created_by) REFERENCES users (id)
 		)`,
+		// Immutable snapshots of a rubric's criteria and weights; every change
+		// to them adds a version so grades keep the rubric they were scored on
+		`CREATE TABLE IF NOT EXISTS rubric_versions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			rubric_id INTEGER NOT NULL,
+			version INTEGER NOT NULL,
+			criteria TEXT NOT NULL,
+			weights TEXT NOT NULL,
+			created_by INTEGER NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (rubric_id, version),
+			FOREIGN KEY (rubric_id) REFERENCES rubrics (id) ON DELETE CASCADE,
+			FOREIGN KEY (created_by) REFERENCES users (id)
+		)`,
+		// The criterion structure of a rubric version. Criteria with children
+		// group them; the rest are scored and carry the rubric_scores key.
+		`CREATE TABLE IF NOT EXISTS rubric_criteria (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			rubric_version_id INTEGER NOT NULL,
+			parent_id INTEGER,
+			position INTEGER NOT NULL,
+			criterion_key TEXT,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			points REAL NOT NULL,
+			FOREIGN KEY (rubric_version_id) REFERENCES rubric_versions (id) ON DELETE CASCADE,
+			FOREIGN KEY (parent_id) REFERENCES rubric_criteria (id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_rubric_criteria_version ON rubric_criteria (rubric_version_id)`,
+		// Ordered performance levels of a scored criterion, best first
+		`CREATE TABLE IF NOT EXISTS rubric_levels (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			criterion_id INTEGER NOT NULL,
+			position INTEGER NOT NULL,
+			label TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			points REAL NOT NULL,
+			min_points REAL,
+			FOREIGN KEY (criterion_id) REFERENCES rubric_criteria (id) ON DELETE CASCADE
+		)`,
 		// Submissions table for uploaded PDFs
 		`CREATE TABLE IF NOT EXISTS submissions (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -100,6 +172,7 @@ func (d *Database) createTables() error {
 			student_name TEXT NOT NULL,
 			file_path TEXT NOT NULL,
 			file_name TEXT NOT NULL,
+			blind_token TEXT,
 			uploaded_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE
 		)`,
@@ -113,11 +186,35 @@ func (d *Database) createTables() error {
 			rubric_scores TEXT NOT NULL,
 			total_score REAL NOT NULL,
 			needs_regrading INTEGER DEFAULT 0,
+			version INTEGER NOT NULL DEFAULT 1,
+			criterion_feedback TEXT NOT NULL DEFAULT '{}',
+			feedback TEXT,
+			rubric_version_id INTEGER,
 			graded_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE,
 			FOREIGN KEY (submission_id) REFERENCES submissions (id) ON DELETE CASCADE,
-			FOREIGN KEY (grader_id) REFERENCES users (id)
+			FOREIGN KEY (grader_id) REFERENCES users (id),
+			FOREIGN KEY (rubric_version_id) REFERENCES rubric_versions (id)
+		)`,
+		// Each grader grades a submission at most once; overlap sets add more graders
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_grades_submission_grader ON grades (submission_id, grader_id)`,
+		// Audit trail of every change to a grade's scores
+		`CREATE TABLE IF NOT EXISTS grade_revisions (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			grade_id INTEGER NOT NULL,
+			revision INTEGER NOT NULL,
+			changed_by INTEGER NOT NULL,
+			source TEXT NOT NULL,
+			old_rubric_scores TEXT,
+			new_rubric_scores TEXT NOT NULL,
+			old_total_score REAL,
+			new_total_score REAL NOT NULL,
+			reason TEXT,
+			changed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (grade_id) REFERENCES grades (id) ON DELETE CASCADE,
+			FOREIGN KEY (changed_by) REFERENCES users (id),
+			UNIQUE(grade_id, revision)
 		)`,
 		// Grade comments for instructor-TA conversations
 		`CREATE TABLE IF NOT EXISTS grade_comments (
@@ -131,6 +228,62 @@ func (d *Database) createTables() error {
 			FOREIGN KEY (user_id) REFERENCES users (id),
 			FOREIGN KEY (parent_id) REFERENCES grade_comments (id) ON DELETE CASCADE
 		)`,
+		// Regrade requests disputing specific criteria of a grade
+		`CREATE TABLE IF NOT EXISTS regrade_requests (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			grade_id INTEGER NOT NULL,
+			assignment_id INTEGER NOT NULL,
+			criteria TEXT NOT NULL,
+			justification TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'assigned', 'accepted', 'rejected', 'resolved')),
+			requested_by INTEGER NOT NULL,
+			assigned_to INTEGER,
+			response TEXT,
+			comment_id INTEGER,
+			revision_id INTEGER,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			resolved_at DATETIME,
+			FOREIGN KEY (grade_id) REFERENCES grades (id) ON DELETE CASCADE,
+			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE,
+			FOREIGN KEY (requested_by) REFERENCES users (id),
+			FOREIGN KEY (assigned_to) REFERENCES users (id),
+			FOREIGN KEY (comment_id) REFERENCES grade_comments (id) ON DELETE SET NULL,
+			FOREIGN KEY (revision_id) REFERENCES grade_revisions (id) ON DELETE SET NULL
+		)`,
+		// A grade has at most one regrade request awaiting a decision
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_regrade_requests_active ON regrade_requests (grade_id) WHERE status IN ('open', 'assigned')`,
+		// Reusable feedback comments for a rubric criterion with a preset deduction
+		`CREATE TABLE IF NOT EXISTS bank_comments (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			rubric_id INTEGER NOT NULL,
+			rubric_version_id INTEGER,
+			criterion_key TEXT NOT NULL,
+			text TEXT NOT NULL,
+			deduction REAL NOT NULL DEFAULT 0 CHECK (deduction >= 0),
+			created_by INTEGER NOT NULL,
+			deleted_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (rubric_id) REFERENCES rubrics (id) ON DELETE CASCADE,
+			FOREIGN KEY (rubric_version_id) REFERENCES rubric_versions (id),
+			FOREIGN KEY (created_by) REFERENCES users (id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_bank_comments_rubric ON bank_comments (rubric_id, criterion_key)`,
+		// Bank comments applied to grades, with the points actually taken off
+		`CREATE TABLE IF NOT EXISTS grade_bank_comments (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			grade_id INTEGER NOT NULL,
+			comment_id INTEGER NOT NULL,
+			criterion_key TEXT NOT NULL,
+			grader_id INTEGER NOT NULL,
+			deduction REAL NOT NULL,
+			applied_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (grade_id) REFERENCES grades (id) ON DELETE CASCADE,
+			FOREIGN KEY (comment_id) REFERENCES bank_comments (id),
+			FOREIGN KEY (grader_id) REFERENCES users (id),
+			UNIQUE(grade_id, comment_id)
+		)`,
 		// Updated analysis results with course context
 		`CREATE TABLE IF NOT EXISTS analysis_results (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -144,6 +297,119 @@ func (d *Database) createTables() error {
 			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE,
 			FOREIGN KEY (rubric_id) REFERENCES rubrics (id)
 		)`,
+		// Submissions assigned to each grader for an assignment
+		`CREATE TABLE IF NOT EXISTS grading_assignments (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			assignment_id INTEGER NOT NULL,
+			submission_id INTEGER NOT NULL,
+			grader_id INTEGER NOT NULL,
+			strategy TEXT NOT NULL,
+			assigned_by INTEGER NOT NULL,
+			assigned_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE,
+			FOREIGN KEY (submission_id) REFERENCES submissions (id) ON DELETE CASCADE,
+			FOREIGN KEY (grader_id) REFERENCES users (id),
+			FOREIGN KEY (assigned_by) REFERENCES users (id),
+			UNIQUE(submission_id, grader_id)
+		)`,
+		// Calibration grades: instructor gold references and TA attempts on the same submissions
+		`CREATE TABLE IF NOT EXISTS calibration_grades (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			assignment_id INTEGER NOT NULL,
+			submission_id INTEGER NOT NULL,
+			grader_id INTEGER NOT NULL,
+			gold INTEGER NOT NULL DEFAULT 0,
+			rubric_scores TEXT NOT NULL,
+			total_score REAL NOT NULL,
+			rubric_version_id INTEGER REFERENCES rubric_versions (id),
+			graded_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE CASCADE,
+			FOREIGN KEY (submission_id) REFERENCES submissions (id) ON DELETE CASCADE,
+			FOREIGN KEY (grader_id) REFERENCES users (id),
+			UNIQUE(submission_id, grader_id)
+		)`,
+		// A calibration submission has exactly one gold grade
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_calibration_gold ON calibration_grades (submission_id) WHERE gold = 1`,
+		// LTI 1.3 platforms (LMSs) that can launch the tool
+		`CREATE TABLE IF NOT EXISTS lti_platforms (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			issuer TEXT NOT NULL,
+			client_id TEXT NOT NULL,
+			deployment_id TEXT,
+			auth_login_url TEXT NOT NULL,
+			auth_token_url TEXT NOT NULL,
+			jwks_url TEXT NOT NULL,
+			trust_email INTEGER NOT NULL DEFAULT 0,
+			created_by INTEGER NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (created_by) REFERENCES users (id),
+			UNIQUE(issuer, client_id)
+		)`,
+		// Key pairs the tool signs its service requests with
+		`CREATE TABLE IF NOT EXISTS lti_tool_keys (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			kid TEXT UNIQUE NOT NULL,
+			private_key TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`,
+		// OIDC login states awaiting their launch; each is used once
+		`CREATE TABLE IF NOT EXISTS lti_launch_states (
+			state TEXT PRIMARY KEY,
+			nonce TEXT NOT NULL,
+			platform_id INTEGER NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (platform_id) REFERENCES lti_platforms (id) ON DELETE CASCADE
+		)`,
+		// One-time codes launches sign users in with; each is used once
+		`CREATE TABLE IF NOT EXISTS lti_login_codes (
+			code TEXT PRIMARY KEY,
+			user_id INTEGER NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+		)`,
+		// Platform contexts (LMS courses) and the courses they were provisioned into
+		`CREATE TABLE IF NOT EXISTS lti_contexts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			platform_id INTEGER NOT NULL,
+			context_id TEXT NOT NULL,
+			course_id INTEGER NOT NULL,
+			title TEXT,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (platform_id) REFERENCES lti_platforms (id) ON DELETE CASCADE,
+			FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+			UNIQUE(platform_id, context_id)
+		)`,
+		// Platform users and the accounts they were provisioned into
+		`CREATE TABLE IF NOT EXISTS lti_users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			platform_id INTEGER NOT NULL,
+			subject TEXT NOT NULL,
+			user_id INTEGER NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (platform_id) REFERENCES lti_platforms (id) ON DELETE CASCADE,
+			FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+			UNIQUE(platform_id, subject)
+		)`,
+		// Resource links launched from a platform, the assignment each stands
+		// for, and the AGS line item its grades are passed back to
+		`CREATE TABLE IF NOT EXISTS lti_resource_links (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			platform_id INTEGER NOT NULL,
+			resource_link_id TEXT NOT NULL,
+			course_id INTEGER NOT NULL,
+			assignment_id INTEGER,
+			title TEXT,
+			lineitems_url TEXT,
+			lineitem_url TEXT,
+			ags_scopes TEXT NOT NULL DEFAULT '',
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			FOREIGN KEY (platform_id) REFERENCES lti_platforms (id) ON DELETE CASCADE,
+			FOREIGN KEY (course_id) REFERENCES courses (id) ON DELETE CASCADE,
+			FOREIGN KEY (assignment_id) REFERENCES assignments (id) ON DELETE SET NULL,
+			UNIQUE(platform_id, resource_link_id)
+		)`,
 		// User sessions for JWT token management
 		`CREATE TABLE IF NOT EXISTS user_sessions (
 			id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -161,9 +427,146 @@ func (d *Database) createTables() error {
 		}
 	}
 
+	return d.migrate()
+}
+
+// migrate adds columns introduced after a table was first created. SQLite has
+// no ADD COLUMN IF NOT EXISTS, so columns that already exist are skipped.
+func (d *Database) migrate() error {
+	migrations := []string{
+		`ALTER TABLE assignments ADD COLUMN overlap_percent REAL NOT NULL DEFAULT 0`,
+		`ALTER TABLE assignments ADD COLUMN overlap_graders INTEGER NOT NULL DEFAULT 2`,
+		`ALTER TABLE assignments ADD COLUMN calibration_threshold REAL NOT NULL DEFAULT 0`,
+		`ALTER TABLE grades ADD COLUMN version INTEGER NOT NULL DEFAULT 1`,
+		`ALTER TABLE grades ADD COLUMN criterion_feedback TEXT NOT NULL DEFAULT '{}'`,
+		`ALTER TABLE grades ADD COLUMN feedback TEXT`,
+		`ALTER TABLE courses ADD COLUMN student_join_code TEXT`,
+		`ALTER TABLE courses ADD COLUMN show_grader_to_students INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE assignments ADD COLUMN release_state TEXT NOT NULL DEFAULT 'draft'`,
+		`ALTER TABLE assignments ADD COLUMN release_at DATETIME`,
+		`ALTER TABLE assignments ADD COLUMN blind_grading INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE submissions ADD COLUMN blind_token TEXT`,
+		`ALTER TABLE rubrics ADD COLUMN current_version_id INTEGER`,
+		`ALTER TABLE grades ADD COLUMN rubric_version_id INTEGER REFERENCES rubric_versions (id)`,
+		`ALTER TABLE lti_platforms ADD COLUMN trust_email INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE bank_comments ADD COLUMN rubric_version_id INTEGER REFERENCES rubric_versions (id)`,
+		`ALTER TABLE users ADD COLUMN is_admin INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE calibration_grades ADD COLUMN rubric_version_id INTEGER REFERENCES rubric_versions (id)`,
+	}
+
+	for _, migration := range migrations {
+		if _, err := d.DB.Exec(migration); err != nil && !strings.Contains(err.Error(), "duplicate column name") {
+			return err
+		}
+	}
+
+	// Student IDs are bound per course in course_roster, so the account-wide
+	// column and its index from before that are dropped
+	if _, err := d.DB.Exec(`DROP INDEX IF EXISTS idx_users_student_id`); err != nil {
+		return err
+	}
+	if _, err := d.DB.Exec(`ALTER TABLE users DROP COLUMN student_id`); err != nil && !strings.Contains(err.Error(), "no such column") {
+		return err
+	}
+
+	for _, table := range []string{"users", "course_members"} {
+		if err := d.allowStudentRole(table); err != nil {
+			return err
+		}
+	}
+
+	// Backfills and indexes that depend on the columns added above
+	statements := []string{
+		`UPDATE courses SET student_join_code = upper(hex(randomblob(4))) WHERE student_join_code IS NULL`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_courses_student_join_code ON courses (student_join_code)`,
+		// Emails are unique regardless of case, also in tables created before
+		// the column was NOCASE
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_nocase ON users (email COLLATE NOCASE)`,
+		`UPDATE submissions SET blind_token = lower(hex(randomblob(6))) WHERE blind_token IS NULL`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_submissions_blind_token ON submissions (blind_token)`,
+		// Rubrics from before versioning start at version 1 with their current
+		// criteria, which is also the best record of what older grades used
+		`INSERT INTO rubric_versions (rubric_id, version, criteria, weights, created_by, created_at)
+			SELECT id, 1, criteria, weights, created_by, updated_at FROM rubrics
+			WHERE id NOT IN (SELECT rubric_id FROM rubric_versions)`,
+		`UPDATE rubrics SET current_version_id = (
+			SELECT id FROM rubric_versions v WHERE v.rubric_id = rubrics.id ORDER BY v.version DESC LIMIT 1
+		) WHERE current_version_id IS NULL`,
+		`UPDATE grades SET rubric_version_id = (
+			SELECT r.current_version_id FROM assignments a JOIN rubrics r ON a.rubric_id = r.id WHERE a.id = grades.assignment_id
+		) WHERE rubric_version_id IS NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_grades_rubric_version ON grades (rubric_version_id)`,
+		`UPDATE calibration_grades SET rubric_version_id = (
+			SELECT r.current_version_id FROM assignments a JOIN rubrics r ON a.rubric_id = r.id WHERE a.id = calibration_grades.assignment_id
+		) WHERE rubric_version_id IS NULL`,
+		// Bank comments belong to the latest version when they were written,
+		// or to the first version if they predate versioning
+		`UPDATE bank_comments SET rubric_version_id = COALESCE(
+			(SELECT v.id FROM rubric_versions v WHERE v.rubric_id = bank_comments.rubric_id AND v.created_at <= bank_comments.created_at ORDER BY v.version DESC LIMIT 1),
+			(SELECT v.id FROM rubric_versions v WHERE v.rubric_id = bank_comments.rubric_id ORDER BY v.version LIMIT 1)
+		) WHERE rubric_version_id IS NULL`,
+		// Versions from before criterion structure become one scored
+		// criterion per entry of their criteria and weights
+		`INSERT INTO rubric_criteria (rubric_version_id, position, criterion_key, title, points)
+			SELECT v.id, c.key, CAST(c.key AS TEXT), c.value, w.value
+			FROM rubric_versions v
+			JOIN json_each(v.criteria) c
+			JOIN json_each(v.weights) w ON w.key = c.key
+			WHERE v.id NOT IN (SELECT rubric_version_id FROM rubric_criteria)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := d.DB.Exec(statement); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+const (
+	staffRoleCheck = "CHECK (role IN ('instructor', 'ta'))"
+	roleCheck      = "CHECK (role IN ('instructor', 'ta', 'student'))"
+)
+
+// allowStudentRole widens the role CHECK of a table created before students
+// existed. SQLite cannot alter a constraint, so the table is rebuilt from its
+// stored schema with the new constraint and its rows copied across.
+func (d *Database) allowStudentRole(table string) error {
+	var schema string
+	err := d.DB.QueryRow("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&schema)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(schema, staffRoleCheck) {
+		return nil
+	}
+
+	rebuilt := strings.Replace(schema, staffRoleCheck, roleCheck, 1)
+	rebuilt = strings.Replace(rebuilt, "CREATE TABLE "+table, "CREATE TABLE "+table+"_rebuild", 1)
+
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		rebuilt,
+		"INSERT INTO " + table + "_rebuild SELECT * FROM " + table,
+		"DROP TABLE " + table,
+		"ALTER TABLE " + table + "_rebuild RENAME TO " + table,
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Rebuilt %s to allow the student role", table)
+	return tx.Commit()
+}
+
 func (d *Database) Close() error {
 	return d.DB.Close()
 }
\ No newline at end of file
@@ -7,6 +7,10 @@ import (
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type AuthMiddleware struct {
@@ -55,6 +59,12 @@ func (m *AuthMiddleware) AuthenticateHTTP(next http.Handler) http.Handler {
 			return
 		}
 
+		// Students are limited to the student portal
+		if claims.Role == "student" && !isStudentEndpoint(r.URL.Path) {
+			http.Error(w, "access denied: students can only use the student portal", http.StatusForbidden)
+			return
+		}
+
 		// Add user information to request context
 		ctx := context.WithValue(r.Context(), "user_id", claims.UserID)
 		ctx = context.WithValue(ctx, "user_email", claims.Email)
@@ -66,6 +76,70 @@ func (m *AuthMiddleware) AuthenticateHTTP(next http.Handler) http.Handler {
 	})
 }
 
+// UnaryServerInterceptor authenticates gRPC calls from the "authorization"
+// metadata and sets the same context values as AuthenticateHTTP.
+func (m *AuthMiddleware) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := m.authenticateGRPC(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming calls
+func (m *AuthMiddleware) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := m.authenticateGRPC(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticatedStream carries the authenticated context into a stream handler
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (m *AuthMiddleware) authenticateGRPC(ctx context.Context, fullMethod string) (context.Context, error) {
+	// Skip authentication for public methods
+	if isPublicMethod(fullMethod) {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata required")
+	}
+
+	// Extract token from "Bearer <token>"
+	parts := strings.Split(md.Get("authorization")[0], " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization metadata format")
+	}
+
+	tokenString := parts[1]
+	claims, err := m.validateToken(tokenString)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token: "+err.Error())
+	}
+
+	if claims.Role == "student" && !isStudentMethod(fullMethod) {
+		return nil, status.Error(codes.PermissionDenied, "access denied: students can only use the student portal")
+	}
+
+	ctx = context.WithValue(ctx, "user_id", claims.UserID)
+	ctx = context.WithValue(ctx, "user_email", claims.Email)
+	ctx = context.WithValue(ctx, "user_role", claims.Role)
+	ctx = context.WithValue(ctx, "token", tokenString)
+
+	return ctx, nil
+}
+
 func (m *AuthMiddleware) validateToken(tokenString string) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
@@ -97,4 +171,58 @@ func isPublicEndpoint(path string) bool {
 	}
 
 	return false
-}
\ No newline at end of file
+}
+
+func isPublicMethod(method string) bool {
+	publicMethods := []string{
+		"/talytics.HealthService/Check",
+		"/talytics.UserService/Register",
+		"/talytics.UserService/Login",
+		"/talytics.UserService/VerifyToken",
+	}
+
+	for _, publicMethod := range publicMethods {
+		if method == publicMethod {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isStudentEndpoint(path string) bool {
+	studentEndpoints := []string{
+		"/api/student/",
+		"/api/auth/profile",
+		"/api/auth/logout",
+		"/api/courses/join",
+	}
+
+	for _, endpoint := range studentEndpoints {
+		if strings.HasPrefix(path, endpoint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isStudentMethod(method string) bool {
+	if strings.HasPrefix(method, "/talytics.StudentService/") {
+		return true
+	}
+
+	studentMethods := []string{
+		"/talytics.UserService/GetProfile",
+		"/talytics.UserService/Logout",
+		"/talytics.CourseService/JoinCourse",
+	}
+
+	for _, studentMethod := range studentMethods {
+		if method == studentMethod {
+			return true
+		}
+	}
+
+	return false
+}
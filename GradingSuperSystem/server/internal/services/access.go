@@ -0,0 +1,74 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/talytics/server/internal/database"
+)
+
+// ErrNotCourseStaff is returned when a user outside a course's staff reaches
+// for its data
+var ErrNotCourseStaff = errors.New("access denied: you are not a member of this course")
+
+// ErrNotCourseInstructor is returned when a course action is reserved for its instructors
+var ErrNotCourseInstructor = errors.New("access denied: only course instructors can perform this action")
+
+// checkCourseMembership returns an error unless the user is on the course
+// staff. Students are members too but only reach course data through the
+// student portal.
+func checkCourseMembership(db *database.Database, courseID, userID int64) error {
+	var memberCount int
+	err := db.DB.QueryRow(`
+		SELECT COUNT(*) FROM course_members
+		WHERE course_id = ? AND user_id = ? AND role IN ('instructor', 'ta')
+	`, courseID, userID).Scan(&memberCount)
+	if err != nil {
+		return err
+	}
+	if memberCount == 0 {
+		return ErrNotCourseStaff
+	}
+	return nil
+}
+
+// checkCourseInstructor returns an error unless the user is an instructor member of the course
+func checkCourseInstructor(db *database.Database, courseID, userID int64) error {
+	var memberCount int
+	err := db.DB.QueryRow(`
+		SELECT COUNT(*) FROM course_members
+		WHERE course_id = ? AND user_id = ? AND role = 'instructor'
+	`, courseID, userID).Scan(&memberCount)
+	if err != nil {
+		return err
+	}
+	if memberCount == 0 {
+		return ErrNotCourseInstructor
+	}
+	return nil
+}
+
+// checkCourseStudent returns an error unless the user is enrolled in the course as a student
+func checkCourseStudent(db *database.Database, courseID, userID int64) error {
+	var memberCount int
+	err := db.DB.QueryRow(`
+		SELECT COUNT(*) FROM course_members
+		WHERE course_id = ? AND user_id = ? AND role = 'student'
+	`, courseID, userID).Scan(&memberCount)
+	if err != nil {
+		return err
+	}
+	if memberCount == 0 {
+		return errors.New("access denied: you are not enrolled in this course")
+	}
+	return nil
+}
+
+// CheckAssignmentStaff returns an error unless the user is on the staff of
+// the assignment's course, for handlers that query grades directly
+func CheckAssignmentStaff(db *database.Database, assignmentID, userID int64) error {
+	courseID, _, err := getAssignmentCourse(db, assignmentID)
+	if err != nil {
+		return err
+	}
+	return checkCourseMembership(db, courseID, userID)
+}
@@ -0,0 +1,822 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	"github.com/talytics/server/internal/statistics"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Minimum number of grades a TA needs on a criterion before we compare them to others
+	minGradesPerGrader = 3
+	// Cohen's d thresholds for small, medium and large grader effects
+	smallEffectSize  = 0.5
+	mediumEffectSize = 0.8
+	largeEffectSize  = 1.2
+
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+
+	// Smallest sample a modified Z-score is computed over
+	minOutlierSample = 5
+	// Modified Z-score above which a single-scope outlier is reported as medium severity
+	extremeModifiedZ = 5.0
+
+	// Significance level for grader comparison tests
+	significanceLevel = 0.05
+	// Minimum number of grades a TA needs to be included in a grader comparison
+	minGroupSize = 2
+
+	// Statistics key used for the total score alongside per-criterion indices
+	totalScoreKey = "total"
+)
+
+type AnalysisService struct {
+	pb.UnimplementedAnalysisServiceServer
+	db *database.Database
+}
+
+func NewAnalysisService(db *database.Database) *AnalysisService {
+	return &AnalysisService{db: db}
+}
+
+// analysisScope identifies the grades covered by an analysis run. Only grades
+// scored against one version of the rubric are analyzed together, so criterion
+// keys mean the same criteria in every grade. That is the current version
+// unless the caller picks an earlier one.
+type analysisScope struct {
+	courseID        int64
+	assignmentID    int64
+	rubricID        int64
+	rubricVersionID int64
+}
+
+// gradeRecord is a grade flattened for statistical analysis
+type gradeRecord struct {
+	id           int64
+	assignmentID int64
+	submissionID int64
+	studentID    string
+	graderID     int64
+	graderName   string
+	rubricScores map[string]float64
+	totalScore   float64
+	gradedAt     time.Time
+}
+
+func (s *AnalysisService) RunAnomalyAnalysis(ctx context.Context, req *pb.RunAnomalyAnalysisRequest) (*pb.AnomalyAnalysisResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	// Only the course's instructors can run analyses
+	if err := checkCourseInstructor(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).records(records); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AnomalyAnalysisResponse{
+		Statistics:        make(map[string]*pb.Statistics),
+		TotalGrades:       int32(len(records)),
+		AnalysisTimestamp: timestamppb.Now(),
+		CourseId:          scope.courseID,
+		AssignmentId:      scope.assignmentID,
+		RubricId:          scope.rubricID,
+		RubricVersionId:   scope.rubricVersionID,
+		ExcludedGrades:    excluded,
+	}
+
+	for _, key := range scoreKeys(records) {
+		values := scoreValues(records, key)
+		if len(values) == 0 {
+			continue
+		}
+		variance := statistics.Variance(values)
+		stats := &pb.Statistics{
+			Mean:     statistics.Mean(values),
+			Variance: variance,
+			StdDev:   math.Sqrt(variance),
+			Count:    int32(len(values)),
+		}
+		if alpha, err := statistics.KrippendorffAlpha(gradeUnits(records, key), statistics.Interval); err == nil {
+			stats.Reliability = alpha.Alpha
+		}
+		resp.Statistics[key] = stats
+	}
+
+	resp.Anomalies = append(detectGraderBias(records), detectGradeOutliers(records)...)
+	_, _, driftAnomalies := detectDrift(records, totalScoreKey)
+	resp.Anomalies = append(resp.Anomalies, driftAnomalies...)
+
+	threshold, err := s.defaultDisagreementThreshold(scope)
+	if err != nil {
+		return nil, err
+	}
+	resp.Anomalies = append(resp.Anomalies, disagreementAnomalies(records, threshold)...)
+
+	// Persist the full run so instructors can compare against later analyses
+	resultsJSON, err := protojson.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.DB.Exec(`
+		INSERT INTO analysis_results (course_id, assignment_id, rubric_id, analysis_type, results, created_at)
+		VALUES (?, ?, ?, 'anomaly', ?, CURRENT_TIMESTAMP)
+	`, scope.courseID, nullableID(scope.assignmentID), nullableID(scope.rubricID), string(resultsJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	resp.AnalysisId, err = result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *AnalysisService) GetAnalysisHistory(ctx context.Context, req *pb.GetAnalysisHistoryRequest) (*pb.GetAnalysisHistoryResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultHistoryPageSize
+	}
+	if pageSize > maxHistoryPageSize {
+		pageSize = maxHistoryPageSize
+	}
+
+	filter := "rubric_id = ?"
+	filterID := scope.rubricID
+	if scope.assignmentID != 0 {
+		filter = "assignment_id = ?"
+		filterID = scope.assignmentID
+	}
+
+	// Fetch one extra row to know whether an older page exists
+	rows, err := s.db.DB.Query(`
+		SELECT id, course_id, assignment_id, rubric_id, analysis_type, results, created_at
+		FROM analysis_results
+		WHERE `+filter+` AND (? = 0 OR id < ?)
+		ORDER BY id DESC
+		LIMIT ?
+	`, filterID, req.BeforeId, req.BeforeId, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mask := newBlindMask(s.db, userID)
+	var results []*pb.AnalysisResult
+	for rows.Next() {
+		var result pb.AnalysisResult
+		var assignmentID, rubricID sql.NullInt64
+		var createdAt time.Time
+
+		err := rows.Scan(&result.Id, &result.CourseId, &assignmentID, &rubricID,
+			&result.AnalysisType, &result.ResultsJson, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		result.AssignmentId = assignmentID.Int64
+		result.RubricId = rubricID.Int64
+		result.CreatedAt = timestamppb.New(createdAt)
+
+		// Surface headline numbers without making clients parse every run
+		var analysis pb.AnomalyAnalysisResponse
+		if err := protojson.Unmarshal([]byte(result.ResultsJson), &analysis); err == nil {
+			result.TotalGrades = analysis.TotalGrades
+			result.AnomalyCount = int32(len(analysis.Anomalies))
+
+			// Stored runs name students, which blind-graded assignments hide from TAs
+			masked, err := mask.anomalies(analysis.Anomalies)
+			if err != nil {
+				return nil, err
+			}
+			if masked {
+				resultsJSON, err := protojson.Marshal(&analysis)
+				if err != nil {
+					return nil, err
+				}
+				result.ResultsJson = string(resultsJSON)
+			}
+		}
+
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetAnalysisHistoryResponse{}
+	if len(results) > pageSize {
+		results = results[:pageSize]
+		resp.NextBeforeId = results[pageSize-1].Id
+	}
+	resp.Results = results
+
+	return resp, nil
+}
+
+func (s *AnalysisService) GetReliability(ctx context.Context, req *pb.GetReliabilityRequest) (*pb.ReliabilityResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := scopeCriteria(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ReliabilityResponse{
+		AssignmentId:    scope.assignmentID,
+		RubricId:        scope.rubricID,
+		RubricVersionId: scope.rubricVersionID,
+		ExcludedGrades:  excluded,
+	}
+
+	gradesPerSubmission := make(map[int64]int)
+	for _, record := range records {
+		gradesPerSubmission[record.submissionID]++
+	}
+	for _, count := range gradesPerSubmission {
+		if count > 1 {
+			resp.DoubleGradedSubmissions++
+		}
+	}
+
+	for _, key := range scoreKeys(records) {
+		estimate := reliabilityEstimate(records, key)
+		estimate.Label = criterionLabel(criteria, key)
+		if key == totalScoreKey {
+			resp.Overall = estimate
+			continue
+		}
+		resp.Criteria = append(resp.Criteria, estimate)
+	}
+
+	return resp, nil
+}
+
+// reliabilityEstimate computes interval and ordinal alpha for one score key,
+// treating each submission as a unit and each grader as a coder.
+func reliabilityEstimate(records []gradeRecord, key string) *pb.ReliabilityEstimate {
+	units := gradeUnits(records, key)
+	estimate := &pb.ReliabilityEstimate{Key: key}
+
+	interval, err := statistics.KrippendorffAlpha(units, statistics.Interval)
+	estimate.Units = int32(interval.Units)
+	estimate.PairableValues = int32(interval.PairableValues)
+	if err != nil {
+		return estimate
+	}
+	ordinal, err := statistics.KrippendorffAlpha(units, statistics.Ordinal)
+	if err != nil {
+		return estimate
+	}
+
+	estimate.IntervalAlpha = interval.Alpha
+	estimate.OrdinalAlpha = ordinal.Alpha
+	estimate.SufficientData = true
+	return estimate
+}
+
+// gradeUnits groups the scores for a key by submission, one value per grade
+func gradeUnits(records []gradeRecord, key string) [][]float64 {
+	bySubmission := make(map[int64][]float64)
+	var order []int64
+	for _, record := range records {
+		value, ok := scoreFor(record, key)
+		if !ok {
+			continue
+		}
+		if _, seen := bySubmission[record.submissionID]; !seen {
+			order = append(order, record.submissionID)
+		}
+		bySubmission[record.submissionID] = append(bySubmission[record.submissionID], value)
+	}
+
+	units := make([][]float64, 0, len(order))
+	for _, submissionID := range order {
+		units = append(units, bySubmission[submissionID])
+	}
+	return units
+}
+
+func (s *AnalysisService) CompareGraders(ctx context.Context, req *pb.CompareGradersRequest) (*pb.GraderComparisonResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := scopeCriteria(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GraderComparisonResponse{
+		AssignmentId:      scope.assignmentID,
+		RubricId:          scope.rubricID,
+		SignificanceLevel: significanceLevel,
+		RubricVersionId:   scope.rubricVersionID,
+		ExcludedGrades:    excluded,
+	}
+
+	for _, key := range scoreKeys(records) {
+		test := graderDifferenceTest(records, key)
+		test.Label = criterionLabel(criteria, key)
+		if key == totalScoreKey {
+			resp.Overall = test
+			continue
+		}
+		resp.Criteria = append(resp.Criteria, test)
+	}
+
+	return resp, nil
+}
+
+// graderDifferenceTest compares the scores each TA gave for a key with one-way
+// ANOVA (Tukey HSD post-hoc) and Kruskal-Wallis (Dunn post-hoc).
+func graderDifferenceTest(records []gradeRecord, key string) *pb.GraderDifferenceTest {
+	byGrader := make(map[int64][]float64)
+	graderNames := make(map[int64]string)
+	for _, record := range records {
+		if value, ok := scoreFor(record, key); ok {
+			byGrader[record.graderID] = append(byGrader[record.graderID], value)
+			graderNames[record.graderID] = record.graderName
+		}
+	}
+
+	test := &pb.GraderDifferenceTest{Key: key}
+	var graderIDs []int64
+	var groups [][]float64
+	for _, graderID := range sortedGraderIDs(byGrader) {
+		values := byGrader[graderID]
+		if len(values) < minGroupSize {
+			continue
+		}
+		graderIDs = append(graderIDs, graderID)
+		groups = append(groups, values)
+		test.Graders = append(test.Graders, &pb.GraderGroup{
+			GraderId:   graderID,
+			GraderName: graderNames[graderID],
+			Count:      int32(len(values)),
+			Mean:       statistics.Mean(values),
+		})
+	}
+
+	comparisons := func(results []statistics.PairwiseComparison) []*pb.PairwiseComparison {
+		var pairs []*pb.PairwiseComparison
+		for _, result := range results {
+			pairs = append(pairs, &pb.PairwiseComparison{
+				GraderAId:   graderIDs[result.A],
+				GraderBId:   graderIDs[result.B],
+				Difference:  result.Difference,
+				Statistic:   result.Statistic,
+				PValue:      result.PValue,
+				Significant: result.PValue < significanceLevel,
+			})
+		}
+		return pairs
+	}
+
+	if anova, err := statistics.OneWayANOVA(groups); err == nil {
+		test.Anova = &pb.AnovaTest{
+			F:          anova.F,
+			DfBetween:  int32(anova.DFBetween),
+			DfWithin:   int32(anova.DFWithin),
+			PValue:     anova.PValue,
+			EtaSquared: anova.EtaSquared,
+		}
+		if tukey, err := statistics.TukeyHSD(groups); err == nil {
+			test.Anova.TukeyHsd = comparisons(tukey)
+		}
+	}
+
+	if kruskal, err := statistics.KruskalWallis(groups); err == nil {
+		test.KruskalWallis = &pb.KruskalWallisTest{
+			H:          kruskal.H,
+			Df:         int32(kruskal.DF),
+			PValue:     kruskal.PValue,
+			EtaSquared: kruskal.EtaSquared,
+		}
+		for i, meanRank := range kruskal.MeanRanks {
+			test.Graders[i].MeanRank = meanRank
+		}
+		if dunn, err := statistics.DunnTest(groups); err == nil {
+			test.KruskalWallis.Dunn = comparisons(dunn)
+		}
+	}
+
+	return test
+}
+
+// criterionLabel names a score key using the rubric's criteria
+func criterionLabel(criteria []string, key string) string {
+	if key == totalScoreKey {
+		return "Total score"
+	}
+	if index, err := strconv.Atoi(key); err == nil && index >= 0 && index < len(criteria) {
+		return criteria[index]
+	}
+	return key
+}
+
+// scopeCriteria returns the criteria of the analyzed rubric version, which
+// score keys are labelled with. Assignments graded without a rubric have none
+// and their keys are shown as is.
+func scopeCriteria(db *database.Database, scope *analysisScope) ([]string, error) {
+	if scope.rubricVersionID == 0 {
+		return nil, nil
+	}
+	criteria, _, err := getRubricVersionCriteria(db, scope.rubricVersionID)
+	return criteria, err
+}
+
+// resolveScope validates the assignment or rubric an analysis refers to and
+// finds its course. versionID picks the rubric version whose grades are
+// analyzed and defaults to the current one.
+func (s *AnalysisService) resolveScope(assignmentID, rubricID, versionID int64) (*analysisScope, error) {
+	scope := &analysisScope{assignmentID: assignmentID, rubricID: rubricID}
+
+	if assignmentID != 0 {
+		courseID, assignmentRubricID, err := getAssignmentCourse(s.db, assignmentID)
+		if err != nil {
+			return nil, err
+		}
+		if rubricID != 0 && rubricID != assignmentRubricID {
+			verr := &ValidationError{}
+			verr.add("rubric_id", "does not match the assignment's rubric")
+			return nil, verr
+		}
+		scope.courseID = courseID
+		scope.rubricID = assignmentRubricID
+	} else {
+		if rubricID == 0 {
+			verr := &ValidationError{}
+			verr.add("assignment_id", "assignment_id or rubric_id is required")
+			return nil, verr
+		}
+		err := s.db.DB.QueryRow("SELECT course_id FROM rubrics WHERE id = ?", rubricID).Scan(&scope.courseID)
+		if err == sql.ErrNoRows {
+			return nil, ErrRubricNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if scope.rubricID == 0 {
+		if versionID != 0 {
+			verr := &ValidationError{}
+			verr.add("rubric_version_id", "assignment does not have a rubric")
+			return nil, verr
+		}
+		return scope, nil
+	}
+
+	if versionID == 0 {
+		var err error
+		if scope.rubricVersionID, err = currentRubricVersionID(s.db, scope.rubricID); err != nil {
+			return nil, err
+		}
+		return scope, nil
+	}
+	var versionRubricID int64
+	err := s.db.DB.QueryRow("SELECT rubric_id FROM rubric_versions WHERE id = ?", versionID).Scan(&versionRubricID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if err == sql.ErrNoRows || versionRubricID != scope.rubricID {
+		return nil, ErrRubricVersionNotFound
+	}
+	scope.rubricVersionID = versionID
+	return scope, nil
+}
+
+// loadGradeRecords fetches every grade in scope in grading order, along with
+// the number of grades left out for being scored against another version of
+// the rubric
+func loadGradeRecords(db *database.Database, scope *analysisScope) ([]gradeRecord, int32, error) {
+	filter := "a.rubric_id = ?"
+	args := []interface{}{scope.rubricID}
+	if scope.assignmentID != 0 {
+		filter = "g.assignment_id = ?"
+		args[0] = scope.assignmentID
+	}
+
+	var excluded int32
+	if scope.rubricVersionID != 0 {
+		err := db.DB.QueryRow(`
+			SELECT COUNT(*) FROM grades g
+			JOIN assignments a ON g.assignment_id = a.id
+			WHERE `+filter+` AND COALESCE(g.rubric_version_id, 0) != ?
+		`, append(args, scope.rubricVersionID)...).Scan(&excluded)
+		if err != nil {
+			return nil, 0, err
+		}
+		filter += " AND g.rubric_version_id = ?"
+		args = append(args, scope.rubricVersionID)
+	}
+
+	rows, err := db.DB.Query(`
+		SELECT g.id, g.assignment_id, g.submission_id, g.student_id, g.grader_id, u.name,
+		       g.rubric_scores, g.total_score, g.graded_at
+		FROM grades g
+		JOIN assignments a ON g.assignment_id = a.id
+		LEFT JOIN users u ON g.grader_id = u.id
+		WHERE `+filter+`
+		ORDER BY g.graded_at, g.id
+	`, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var records []gradeRecord
+	for rows.Next() {
+		var record gradeRecord
+		var graderName sql.NullString
+		var rubricScoresJSON string
+
+		err := rows.Scan(&record.id, &record.assignmentID, &record.submissionID, &record.studentID,
+			&record.graderID, &graderName, &rubricScoresJSON, &record.totalScore, &record.gradedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		record.graderName = graderName.String
+		if err := json.Unmarshal([]byte(rubricScoresJSON), &record.rubricScores); err != nil {
+			return nil, 0, fmt.Errorf("error parsing rubric scores for grade %d: %v", record.id, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, excluded, rows.Err()
+}
+
+// detectGraderBias flags graders whose scores on a criterion differ from the
+// rest of the graders by at least a small effect size (Cohen's d).
+func detectGraderBias(records []gradeRecord) []*pb.Anomaly {
+	var anomalies []*pb.Anomaly
+
+	for _, key := range scoreKeys(records) {
+		byGrader := make(map[int64][]float64)
+		graderNames := make(map[int64]string)
+		for _, record := range records {
+			if value, ok := scoreFor(record, key); ok {
+				byGrader[record.graderID] = append(byGrader[record.graderID], value)
+				graderNames[record.graderID] = record.graderName
+			}
+		}
+		if len(byGrader) < 2 {
+			continue
+		}
+
+		for _, graderID := range sortedGraderIDs(byGrader) {
+			graderValues := byGrader[graderID]
+			if len(graderValues) < minGradesPerGrader {
+				continue
+			}
+
+			var otherValues []float64
+			for otherID, values := range byGrader {
+				if otherID != graderID {
+					otherValues = append(otherValues, values...)
+				}
+			}
+			if len(otherValues) < minGradesPerGrader {
+				continue
+			}
+
+			d := statistics.CohensD(graderValues, otherValues)
+			if math.IsNaN(d) || math.Abs(d) < smallEffectSize {
+				continue
+			}
+
+			direction := "lenient"
+			if d < 0 {
+				direction = "harsh"
+			}
+
+			anomalies = append(anomalies, &pb.Anomaly{
+				Type:       "high_ta_variance",
+				QuestionId: key,
+				Severity:   effectSeverity(d),
+				Details: map[string]string{
+					"grader_id":   strconv.FormatInt(graderID, 10),
+					"grader_name": graderNames[graderID],
+					"grade_count": strconv.Itoa(len(graderValues)),
+					"grader_mean": formatStat(statistics.Mean(graderValues)),
+					"others_mean": formatStat(statistics.Mean(otherValues)),
+					"cohens_d":    formatStat(d),
+					"direction":   direction,
+				},
+			})
+		}
+	}
+
+	return anomalies
+}
+
+// detectGradeOutliers flags individual grades whose score on a criterion or
+// total is an outlier by modified Z-score, both against the whole class and
+// against the other grades given by the same TA.
+func detectGradeOutliers(records []gradeRecord) []*pb.Anomaly {
+	var anomalies []*pb.Anomaly
+
+	for _, key := range scoreKeys(records) {
+		var indices []int
+		var values []float64
+		byGrader := make(map[int64][]int)
+		for i, record := range records {
+			if value, ok := scoreFor(record, key); ok {
+				byGrader[record.graderID] = append(byGrader[record.graderID], len(values))
+				indices = append(indices, i)
+				values = append(values, value)
+			}
+		}
+
+		classScores := make([]float64, len(values))
+		if len(values) >= minOutlierSample {
+			classScores = statistics.ModifiedZScores(values)
+		}
+
+		// With a single grader the TA scope is the class scope, so skip it
+		graderScores := make([]float64, len(values))
+		for _, positions := range byGrader {
+			if len(byGrader) < 2 || len(positions) < minOutlierSample {
+				continue
+			}
+			graderValues := make([]float64, len(positions))
+			for i, position := range positions {
+				graderValues[i] = values[position]
+			}
+			for i, z := range statistics.ModifiedZScores(graderValues) {
+				graderScores[positions[i]] = z
+			}
+		}
+
+		for position, recordIndex := range indices {
+			classZ, graderZ := classScores[position], graderScores[position]
+			classOutlier := math.Abs(classZ) > statistics.ModifiedZThreshold
+			graderOutlier := math.Abs(graderZ) > statistics.ModifiedZThreshold
+			if !classOutlier && !graderOutlier {
+				continue
+			}
+
+			scope := "class"
+			severity := "low"
+			switch {
+			case classOutlier && graderOutlier:
+				scope = "class,ta"
+				severity = "high"
+			case graderOutlier:
+				scope = "ta"
+			}
+			if severity == "low" && math.Max(math.Abs(classZ), math.Abs(graderZ)) >= extremeModifiedZ {
+				severity = "medium"
+			}
+
+			record := records[recordIndex]
+			anomalies = append(anomalies, &pb.Anomaly{
+				Type:         "grade_outlier",
+				QuestionId:   key,
+				Severity:     severity,
+				GradeId:      record.id,
+				SubmissionId: record.submissionID,
+				Details: map[string]string{
+					"grade_id":    strconv.FormatInt(record.id, 10),
+					"student_id":  record.studentID,
+					"grader_id":   strconv.FormatInt(record.graderID, 10),
+					"grader_name": record.graderName,
+					"score":       formatStat(values[position]),
+					"class_z":     formatStat(classZ),
+					"ta_z":        formatStat(graderZ),
+					"scope":       scope,
+				},
+			})
+		}
+	}
+
+	return anomalies
+}
+
+func effectSeverity(d float64) string {
+	switch {
+	case math.Abs(d) >= largeEffectSize:
+		return "high"
+	case math.Abs(d) >= mediumEffectSize:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// scoreKeys lists the total score key followed by every criterion index in the records
+func scoreKeys(records []gradeRecord) []string {
+	seen := make(map[string]bool)
+	var criteria []string
+	for _, record := range records {
+		for criterion := range record.rubricScores {
+			if !seen[criterion] {
+				seen[criterion] = true
+				criteria = append(criteria, criterion)
+			}
+		}
+	}
+	sort.Slice(criteria, func(i, j int) bool {
+		return criterionLess(criteria[i], criteria[j])
+	})
+
+	if len(records) == 0 {
+		return criteria
+	}
+	return append([]string{totalScoreKey}, criteria...)
+}
+
+func scoreFor(record gradeRecord, key string) (float64, bool) {
+	if key == totalScoreKey {
+		return record.totalScore, true
+	}
+	value, ok := record.rubricScores[key]
+	return value, ok
+}
+
+func scoreValues(records []gradeRecord, key string) []float64 {
+	var values []float64
+	for _, record := range records {
+		if value, ok := scoreFor(record, key); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func sortedGraderIDs(byGrader map[int64][]float64) []int64 {
+	ids := make([]int64, 0, len(byGrader))
+	for id := range byGrader {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func formatStat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
+
+func nullableID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
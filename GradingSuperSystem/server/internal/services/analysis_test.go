@@ -0,0 +1,83 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestAnalysesCoverOneRubricVersionAndCountTheRest(t *testing.T) {
+	course := newTestCourse(t)
+	grades := NewGradeService(course.db)
+	analysis := NewAnalysisService(course.db)
+	firstVersion, err := currentRubricVersionID(course.db, course.rubricID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, student := range []string{"s1", "s2"} {
+		submissionID := course.addSubmission(t, student)
+		if _, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 0, 40, 20, 10)); err != nil {
+			t.Fatalf("grade %s: %v", student, err)
+		}
+	}
+	_, err = NewRubricService(course.db).UpdateRubric(course.ctx(course.instructorID), &pb.UpdateRubricRequest{
+		Id:       course.rubricID,
+		Name:     "Rubric",
+		Criteria: []string{"Correctness", "Style", "Tests"},
+		Weights:  []float64{60, 20, 20},
+	})
+	if err != nil {
+		t.Fatalf("update rubric: %v", err)
+	}
+	submissionID := course.addSubmission(t, "s3")
+	if _, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 0, 50, 15, 15)); err != nil {
+		t.Fatalf("grade s3: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		versionID int64
+		graded    int32
+		excluded  int32
+	}{
+		{"current version", 0, 1, 2},
+		{"earlier version", firstVersion, 2, 1},
+	}
+	for _, test := range tests {
+		resp, err := analysis.RunAnomalyAnalysis(course.ctx(course.instructorID), &pb.RunAnomalyAnalysisRequest{
+			AssignmentId:    course.assignmentID,
+			RubricVersionId: test.versionID,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if resp.TotalGrades != test.graded || resp.ExcludedGrades != test.excluded {
+			t.Errorf("%s: analyzed %d grades and excluded %d, want %d and %d",
+				test.name, resp.TotalGrades, resp.ExcludedGrades, test.graded, test.excluded)
+		}
+	}
+
+	// Versions of other rubrics are refused
+	other, err := NewRubricService(course.db).CreateRubric(course.ctx(course.instructorID), &pb.CreateRubricRequest{
+		Name:     "Other",
+		CourseId: course.courseID,
+		Criteria: []string{"Overall"},
+		Weights:  []float64{100},
+	})
+	if err != nil {
+		t.Fatalf("create rubric: %v", err)
+	}
+	otherVersion, err := currentRubricVersionID(course.db, other.Rubric.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = analysis.GetReliability(course.ctx(course.taID), &pb.GetReliabilityRequest{
+		AssignmentId:    course.assignmentID,
+		RubricVersionId: otherVersion,
+	})
+	if !errors.Is(err, ErrRubricVersionNotFound) {
+		t.Fatalf("reliability against another rubric's version: err = %v, want ErrRubricVersionNotFound", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/talytics/server/internal/database"
@@ -12,6 +13,17 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Release states of an assignment's grades
+const (
+	releaseDraft    = "draft"
+	releaseInReview = "in_review"
+	releaseReleased = "released"
+)
+
+// releaseStateColumn selects an assignment's release state, treating a
+// scheduled release whose time has passed as released
+const releaseStateColumn = `CASE WHEN a.release_state != 'released' AND a.release_at IS NOT NULL AND datetime(a.release_at) <= datetime('now') THEN 'released' ELSE a.release_state END`
+
 type AssignmentService struct {
 	pb.UnimplementedAssignmentServiceServer
 	db *database.Database
@@ -70,9 +82,9 @@ func (s *AssignmentService) CreateAssignment(ctx context.Context, req *pb.Create
 
 	// Insert assignment
 	result, err := s.db.DB.Exec(`
-		INSERT INTO assignments (course_id, name, description, rubric_id, created_by, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-	`, req.CourseId, req.Name, req.Description, req.RubricId, userID)
+		INSERT INTO assignments (course_id, name, description, rubric_id, blind_grading, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, req.CourseId, req.Name, req.Description, req.RubricId, req.BlindGrading, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +162,7 @@ func (s *AssignmentService) ListAssignments(ctx context.Context, req *pb.ListAss
 	// Get assignments for this course
 	rows, err := s.db.DB.Query(`
 		SELECT a.id, a.course_id, a.name, a.description, a.rubric_id, 
-		       a.created_by, u.name, a.created_at, a.updated_at
+		       a.created_by, u.name, `+releaseStateColumn+`, a.release_at, a.blind_grading, a.created_at, a.updated_at
 		FROM assignments a
 		LEFT JOIN users u ON a.created_by = u.id
 		WHERE a.course_id = ?
@@ -167,16 +179,20 @@ func (s *AssignmentService) ListAssignments(ctx context.Context, req *pb.ListAss
 		var createdAt, updatedAt time.Time
 		var rubricID sql.NullInt64
 		var creatorName sql.NullString
+		var releaseAt sql.NullTime
 
 		err := rows.Scan(&assignment.Id, &assignment.CourseId, &assignment.Name, 
 			&assignment.Description, &rubricID,
-			&assignment.CreatedBy, &creatorName, &createdAt, &updatedAt)
+			&assignment.CreatedBy, &creatorName, &assignment.ReleaseState, &releaseAt, &assignment.BlindGrading, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, err
 		}
 
 		assignment.CreatedAt = timestamppb.New(createdAt)
 		assignment.UpdatedAt = timestamppb.New(updatedAt)
+		if releaseAt.Valid {
+			assignment.ReleaseAt = timestamppb.New(releaseAt.Time)
+		}
 
 		if rubricID.Valid {
 			assignment.RubricId = rubricID.Int64
@@ -309,27 +325,131 @@ func (s *AssignmentService) DeleteAssignment(ctx context.Context, req *pb.Delete
 	}, nil
 }
 
+func (s *AssignmentService) SetReleaseState(ctx context.Context, req *pb.SetReleaseStateRequest) (*pb.AssignmentResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	switch req.State {
+	case releaseDraft, releaseInReview, releaseReleased:
+	default:
+		return nil, fmt.Errorf("release state must be %q, %q or %q", releaseDraft, releaseInReview, releaseReleased)
+	}
+
+	// release_at records when grades were released, or when a draft or
+	// in-review assignment is scheduled to release; leaving it unset on
+	// those clears any earlier schedule
+	var releaseAt sql.NullTime
+	if req.State == releaseReleased {
+		if req.ReleaseAt != nil {
+			return nil, errors.New("release_at can only be scheduled for a draft or in_review assignment")
+		}
+		releaseAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	} else if req.ReleaseAt != nil {
+		if !req.ReleaseAt.AsTime().After(time.Now()) {
+			return nil, errors.New("release_at must be in the future")
+		}
+		releaseAt = sql.NullTime{Time: req.ReleaseAt.AsTime().UTC(), Valid: true}
+	}
+
+	_, err = s.db.DB.Exec(`
+		UPDATE assignments SET release_state = ?, release_at = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, req.State, releaseAt, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.getAssignmentByID(req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var message string
+	switch {
+	case req.State == releaseReleased:
+		message = "Grades released to students"
+	case releaseAt.Valid:
+		message = "Grades will be released to students at " + releaseAt.Time.Format(time.RFC3339)
+	case req.State == releaseInReview:
+		message = "Grades are in review and hidden from students"
+	default:
+		message = "Grades are hidden from students"
+	}
+
+	return &pb.AssignmentResponse{
+		Assignment: assignment,
+		Message:    message,
+	}, nil
+}
+
+// SetBlindGrading turns blind grading on or off. While it is on, TAs see
+// submission tokens instead of student identities until grades are released.
+func (s *AssignmentService) SetBlindGrading(ctx context.Context, req *pb.SetBlindGradingRequest) (*pb.AssignmentResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.DB.Exec(`
+		UPDATE assignments SET blind_grading = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, req.Enabled, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.getAssignmentByID(req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Blind grading disabled"
+	if req.Enabled {
+		message = "Blind grading enabled"
+	}
+	return &pb.AssignmentResponse{
+		Assignment: assignment,
+		Message:    message,
+	}, nil
+}
+
 func (s *AssignmentService) getAssignmentByID(assignmentID int64) (*pb.Assignment, error) {
 	var assignment pb.Assignment
 	var createdAt, updatedAt time.Time
 	var rubricID sql.NullInt64
 	var creatorName sql.NullString
+	var releaseAt sql.NullTime
 
 	err := s.db.DB.QueryRow(`
 		SELECT a.id, a.course_id, a.name, a.description, a.rubric_id, 
-		       a.created_by, u.name, a.created_at, a.updated_at
+		       a.created_by, u.name, `+releaseStateColumn+`, a.release_at, a.blind_grading, a.created_at, a.updated_at
 		FROM assignments a
 		LEFT JOIN users u ON a.created_by = u.id
 		WHERE a.id = ?
 	`, assignmentID).Scan(&assignment.Id, &assignment.CourseId, &assignment.Name, 
 		&assignment.Description, &rubricID,
-		&assignment.CreatedBy, &creatorName, &createdAt, &updatedAt)
+		&assignment.CreatedBy, &creatorName, &assignment.ReleaseState, &releaseAt, &assignment.BlindGrading, &createdAt, &updatedAt)
 	if err != nil {
 		return nil, err
 	}
 
 	assignment.CreatedAt = timestamppb.New(createdAt)
 	assignment.UpdatedAt = timestamppb.New(updatedAt)
+	if releaseAt.Valid {
+		assignment.ReleaseAt = timestamppb.New(releaseAt.Time)
+	}
 
 	if rubricID.Valid {
 		assignment.RubricId = rubricID.Int64
@@ -381,4 +501,17 @@ func (s *AssignmentService) getRubricByID(rubricID int64) (*pb.Rubric, error) {
 	}
 	
 	return &rubric, nil
-}
\ No newline at end of file
+}
+
+// assignmentReleased reports whether an assignment's grades are visible to students
+func assignmentReleased(db *database.Database, assignmentID int64) (bool, error) {
+	var releaseState string
+	err := db.DB.QueryRow("SELECT "+releaseStateColumn+" FROM assignments a WHERE a.id = ?", assignmentID).Scan(&releaseState)
+	if err == sql.ErrNoRows {
+		return false, errors.New("assignment not found")
+	}
+	if err != nil {
+		return false, err
+	}
+	return releaseState == releaseReleased, nil
+}
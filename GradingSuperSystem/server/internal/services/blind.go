@@ -0,0 +1,165 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+)
+
+// blindMask hides student identities from TAs grading blind-graded
+// assignments. Instructors of the course always see identities, and TAs see
+// them once the assignment's grades are released. A mask belongs to one
+// request and caches what it looks up.
+type blindMask struct {
+	db          *database.Database
+	userID      int64
+	assignments map[int64]bool
+	submissions map[int64]blindSubmission
+}
+
+type blindSubmission struct {
+	assignmentID int64
+	token        string
+}
+
+func newBlindMask(db *database.Database, userID int64) *blindMask {
+	return &blindMask{
+		db:          db,
+		userID:      userID,
+		assignments: make(map[int64]bool),
+		submissions: make(map[int64]blindSubmission),
+	}
+}
+
+// hides reports whether student identities on an assignment are hidden from
+// the user
+func (m *blindMask) hides(assignmentID int64) (bool, error) {
+	if hidden, ok := m.assignments[assignmentID]; ok {
+		return hidden, nil
+	}
+
+	var courseID int64
+	var blind bool
+	var releaseState string
+	err := m.db.DB.QueryRow("SELECT a.course_id, a.blind_grading, "+releaseStateColumn+" FROM assignments a WHERE a.id = ?", assignmentID).
+		Scan(&courseID, &blind, &releaseState)
+	if err == sql.ErrNoRows {
+		return false, errors.New("assignment not found")
+	}
+	if err != nil {
+		return false, err
+	}
+
+	hidden := blind && releaseState != releaseReleased && checkCourseInstructor(m.db, courseID, m.userID) != nil
+	m.assignments[assignmentID] = hidden
+	return hidden, nil
+}
+
+// studentID returns the identity to show for a submission's student: the
+// student ID itself, or the submission's blind token when it is hidden
+func (m *blindMask) studentID(submissionID int64, studentID string) (string, bool, error) {
+	submission, ok := m.submissions[submissionID]
+	if !ok {
+		var token sql.NullString
+		err := m.db.DB.QueryRow("SELECT assignment_id, blind_token FROM submissions WHERE id = ?", submissionID).
+			Scan(&submission.assignmentID, &token)
+		if err == sql.ErrNoRows {
+			return studentID, false, nil
+		}
+		if err != nil {
+			return "", false, err
+		}
+		submission.token = token.String
+		m.submissions[submissionID] = submission
+	}
+
+	hidden, err := m.hides(submission.assignmentID)
+	if err != nil || !hidden {
+		return studentID, false, err
+	}
+	return submission.token, true, nil
+}
+
+// blindFileName stands in for a submission's file name, which is derived
+// from the student ID
+func blindFileName(token string) string {
+	return "submission_" + token + ".pdf"
+}
+
+func (m *blindMask) submission(submission *pb.Submission) error {
+	hidden, err := m.hides(submission.AssignmentId)
+	if err != nil || !hidden {
+		return err
+	}
+
+	submission.StudentId = submission.BlindToken
+	submission.StudentName = ""
+	submission.FileName = blindFileName(submission.BlindToken)
+	submission.FilePath = ""
+	submission.Anonymized = true
+	return nil
+}
+
+// identity masks the student fields of a row describing a submission,
+// reporting whether they were hidden
+func (m *blindMask) identity(submissionID int64, studentID, studentName, fileName *string) (bool, error) {
+	token, hidden, err := m.studentID(submissionID, *studentID)
+	if err != nil || !hidden {
+		return false, err
+	}
+
+	*studentID = token
+	*studentName = ""
+	*fileName = blindFileName(token)
+	return true, nil
+}
+
+func (m *blindMask) grade(grade *pb.RubricGrade) error {
+	hidden, err := m.identity(grade.SubmissionId, &grade.StudentId, &grade.StudentName, &grade.FileName)
+	grade.Anonymized = hidden
+	return err
+}
+
+func (m *blindMask) grades(grades []*pb.RubricGrade) error {
+	for _, grade := range grades {
+		if err := m.grade(grade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// records masks the student IDs of grade records used by the analyses
+func (m *blindMask) records(records []gradeRecord) error {
+	for i := range records {
+		studentID, _, err := m.studentID(records[i].submissionID, records[i].studentID)
+		if err != nil {
+			return err
+		}
+		records[i].studentID = studentID
+	}
+	return nil
+}
+
+// anomalies masks the student IDs in anomaly details, reporting whether any
+// were hidden
+func (m *blindMask) anomalies(anomalies []*pb.Anomaly) (bool, error) {
+	masked := false
+	for _, anomaly := range anomalies {
+		studentID, ok := anomaly.Details["student_id"]
+		if !ok || anomaly.SubmissionId == 0 {
+			continue
+		}
+		token, hidden, err := m.studentID(anomaly.SubmissionId, studentID)
+		if err != nil {
+			return false, err
+		}
+		if hidden {
+			anomaly.Details["student_id"] = token
+			masked = true
+		}
+	}
+	return masked, nil
+}
@@ -0,0 +1,647 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	"github.com/talytics/server/internal/statistics"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrCalibrationRequired is returned when a TA tries to grade before passing calibration
+var ErrCalibrationRequired = errors.New("access denied: pass calibration for this assignment before grading")
+
+// ErrCalibrationGoldOutdated is returned when a calibration submission's gold
+// grade was given against an earlier version of the rubric
+var ErrCalibrationGoldOutdated = errors.New("the gold grade for this submission was set on an earlier version of the rubric; an instructor must set it again")
+
+const (
+	calibrationNotStarted = "not_started"
+	calibrationInProgress = "in_progress"
+	calibrationPassed     = "passed"
+	calibrationFailed     = "failed"
+)
+
+type CalibrationService struct {
+	pb.UnimplementedCalibrationServiceServer
+	db *database.Database
+}
+
+func NewCalibrationService(db *database.Database) *CalibrationService {
+	return &CalibrationService{db: db}
+}
+
+// calibrationScores is one grade on a calibration submission
+type calibrationScores struct {
+	rubricScores map[string]float64
+	totalScore   float64
+}
+
+const calibrationGradeSelectQuery = `
+	SELECT c.id, c.assignment_id, c.submission_id, c.grader_id, u.name, c.gold,
+	       c.rubric_scores, c.total_score, COALESCE(c.rubric_version_id, 0), c.graded_at
+	FROM calibration_grades c
+	LEFT JOIN users u ON c.grader_id = u.id
+`
+
+func (s *CalibrationService) SetGoldGrade(ctx context.Context, req *pb.SetGoldGradeRequest) (*pb.CalibrationGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	if err := s.checkSubmission(req.AssignmentId, req.SubmissionId); err != nil {
+		return nil, err
+	}
+
+	validator, err := newRubricScoreValidator(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	totalScore, err := validator.validate(req.RubricScores)
+	if err != nil {
+		return nil, err
+	}
+	rubricScoresJSON, err := marshalRubricScores(req.RubricScores)
+	if err != nil {
+		return nil, err
+	}
+
+	// Instructors can revise the gold grade; TA deviations are recomputed from
+	// it. Attempts on another rubric version than the gold grade's no longer
+	// count, so TAs grade the submission again after the rubric changes.
+	var gradeID int64
+	err = s.db.DB.QueryRow("SELECT id FROM calibration_grades WHERE submission_id = ? AND gold = 1", req.SubmissionId).Scan(&gradeID)
+	if err == sql.ErrNoRows {
+		result, err := s.db.DB.Exec(`
+			INSERT INTO calibration_grades (assignment_id, submission_id, grader_id, gold, rubric_scores, total_score, rubric_version_id, graded_at)
+			VALUES (?, ?, ?, 1, ?, ?, ?, CURRENT_TIMESTAMP)
+		`, req.AssignmentId, req.SubmissionId, userID, rubricScoresJSON, totalScore, validator.versionID)
+		if err != nil {
+			return nil, err
+		}
+		gradeID, err = result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else {
+		_, err = s.db.DB.Exec(`
+			UPDATE calibration_grades SET grader_id = ?, rubric_scores = ?, total_score = ?, rubric_version_id = ?, graded_at = CURRENT_TIMESTAMP
+			WHERE id = ?
+		`, userID, rubricScoresJSON, totalScore, validator.versionID, gradeID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	grade, err := s.getCalibrationGrade(gradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CalibrationGradeResponse{
+		Grade:   grade,
+		Message: "Gold grade saved",
+	}, nil
+}
+
+func (s *CalibrationService) ListCalibrationSubmissions(ctx context.Context, req *pb.ListCalibrationSubmissionsRequest) (*pb.CalibrationSubmissionsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CalibrationSubmissionsResponse{AssignmentId: req.AssignmentId}
+	if resp.Threshold, err = getCalibrationThreshold(s.db, req.AssignmentId); err != nil {
+		return nil, err
+	}
+
+	// Gold scores are never returned here so TAs grade calibration submissions blind
+	rows, err := s.db.DB.Query(`
+		SELECT s.id, s.student_id, s.student_name, s.file_name, own.id
+		FROM calibration_grades gold
+		JOIN submissions s ON gold.submission_id = s.id
+		LEFT JOIN calibration_grades own ON own.submission_id = gold.submission_id AND own.grader_id = ? AND own.gold = 0
+		WHERE gold.assignment_id = ? AND gold.gold = 1
+		ORDER BY s.student_name ASC
+	`, userID, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var submission pb.CalibrationSubmission
+		var gradeID sql.NullInt64
+		if err := rows.Scan(&submission.SubmissionId, &submission.StudentId, &submission.StudentName, &submission.FileName, &gradeID); err != nil {
+			return nil, err
+		}
+		submission.Graded = gradeID.Valid
+		submission.GradeId = gradeID.Int64
+		resp.Submissions = append(resp.Submissions, &submission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	mask := newBlindMask(s.db, userID)
+	for _, submission := range resp.Submissions {
+		if _, err := mask.identity(submission.SubmissionId, &submission.StudentId, &submission.StudentName, &submission.FileName); err != nil {
+			return nil, err
+		}
+	}
+	if hidden, err := mask.hides(req.AssignmentId); err != nil {
+		return nil, err
+	} else if hidden {
+		sort.Slice(resp.Submissions, func(i, j int) bool { return resp.Submissions[i].StudentId < resp.Submissions[j].StudentId })
+	}
+
+	if checkCourseInstructor(s.db, courseID, userID) != nil {
+		calibration, err := evaluateGraderCalibration(s.db, req.AssignmentId, userID, resp.Threshold, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp.Status = calibration.Status
+	}
+
+	return resp, nil
+}
+
+func (s *CalibrationService) SubmitCalibrationGrade(ctx context.Context, req *pb.SubmitCalibrationGradeRequest) (*pb.CalibrationGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	if checkCourseInstructor(s.db, courseID, userID) == nil {
+		return nil, errors.New("instructors set gold grades instead of calibration grades")
+	}
+
+	var goldVersionID int64
+	err = s.db.DB.QueryRow(`
+		SELECT COALESCE(rubric_version_id, 0) FROM calibration_grades
+		WHERE assignment_id = ? AND submission_id = ? AND gold = 1
+	`, req.AssignmentId, req.SubmissionId).Scan(&goldVersionID)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("submission is not part of this assignment's calibration set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Scores are only comparable with a gold grade on the same rubric version
+	validator, err := newRubricScoreValidator(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if goldVersionID != validator.versionID {
+		return nil, ErrCalibrationGoldOutdated
+	}
+
+	// Attempts are final so a TA cannot tune scores against their report,
+	// unless the gold grade has since been set again on a new rubric version
+	var existing int
+	err = s.db.DB.QueryRow(`
+		SELECT COUNT(*) FROM calibration_grades
+		WHERE submission_id = ? AND grader_id = ? AND rubric_version_id IS ?
+	`, req.SubmissionId, userID, goldVersionID).Scan(&existing)
+	if err != nil {
+		return nil, err
+	}
+	if existing > 0 {
+		return nil, errors.New("calibration grade already submitted; an instructor must reset your calibration before you can grade it again")
+	}
+
+	totalScore, err := validator.validate(req.RubricScores)
+	if err != nil {
+		return nil, err
+	}
+	rubricScoresJSON, err := marshalRubricScores(req.RubricScores)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		DELETE FROM calibration_grades
+		WHERE submission_id = ? AND grader_id = ? AND gold = 0
+	`, req.SubmissionId, userID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tx.Exec(`
+		INSERT INTO calibration_grades (assignment_id, submission_id, grader_id, gold, rubric_scores, total_score, rubric_version_id, graded_at)
+		VALUES (?, ?, ?, 0, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, req.AssignmentId, req.SubmissionId, userID, rubricScoresJSON, totalScore, validator.versionID)
+	if err != nil {
+		return nil, err
+	}
+	gradeID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	grade, err := s.getCalibrationGrade(gradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CalibrationGradeResponse{
+		Grade:   grade,
+		Message: "Calibration grade submitted successfully",
+	}, nil
+}
+
+func (s *CalibrationService) GetCalibrationReport(ctx context.Context, req *pb.GetCalibrationReportRequest) (*pb.CalibrationReportResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, rubricID, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	// TAs only see their own report
+	isInstructor := checkCourseInstructor(s.db, courseID, userID) == nil
+	var graderIDs []int64
+	switch {
+	case req.GraderId != 0 && (req.GraderId == userID || isInstructor):
+		graderIDs = []int64{req.GraderId}
+	case req.GraderId == 0 && !isInstructor:
+		graderIDs = []int64{userID}
+	case req.GraderId == 0:
+		if graderIDs, err = courseTAs(s.db, courseID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("access denied: only course instructors can view other graders' calibration")
+	}
+
+	resp := &pb.CalibrationReportResponse{AssignmentId: req.AssignmentId}
+	if resp.Threshold, err = getCalibrationThreshold(s.db, req.AssignmentId); err != nil {
+		return nil, err
+	}
+
+	var criteria []string
+	if rubricID != 0 {
+		if criteria, _, err = getRubricCriteria(s.db, rubricID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, graderID := range graderIDs {
+		calibration, err := evaluateGraderCalibration(s.db, req.AssignmentId, graderID, resp.Threshold, criteria)
+		if err != nil {
+			return nil, err
+		}
+		resp.Graders = append(resp.Graders, calibration)
+	}
+
+	gold, err := loadCalibrationGrades(s.db, req.AssignmentId, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	resp.GoldSubmissions = int32(len(gold))
+
+	return resp, nil
+}
+
+func (s *CalibrationService) ConfigureCalibration(ctx context.Context, req *pb.ConfigureCalibrationRequest) (*pb.CalibrationSettingsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	if req.Threshold < 0 {
+		return nil, errors.New("threshold must not be negative")
+	}
+
+	_, err = s.db.DB.Exec(`
+		UPDATE assignments SET calibration_threshold = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, req.Threshold, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	gold, err := loadCalibrationGrades(s.db, req.AssignmentId, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Calibration blocking is off"
+	if req.Threshold > 0 {
+		message = fmt.Sprintf("TAs must stay within %g points of the gold grade on every criterion before grading", req.Threshold)
+	}
+
+	return &pb.CalibrationSettingsResponse{
+		AssignmentId:    req.AssignmentId,
+		Threshold:       req.Threshold,
+		GoldSubmissions: int32(len(gold)),
+		Message:         message,
+	}, nil
+}
+
+func (s *CalibrationService) ResetCalibration(ctx context.Context, req *pb.ResetCalibrationRequest) (*pb.ResetCalibrationResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	if req.GraderId == 0 {
+		return nil, errors.New("grader_id is required")
+	}
+
+	result, err := s.db.DB.Exec(`
+		DELETE FROM calibration_grades
+		WHERE assignment_id = ? AND grader_id = ? AND gold = 0
+	`, req.AssignmentId, req.GraderId)
+	if err != nil {
+		return nil, err
+	}
+	cleared, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetCalibrationResponse{
+		Success: true,
+		Message: fmt.Sprintf("Cleared %d calibration grades; the grader can now recalibrate", cleared),
+		Cleared: int32(cleared),
+	}, nil
+}
+
+// checkSubmission returns an error unless the submission belongs to the assignment
+func (s *CalibrationService) checkSubmission(assignmentID, submissionID int64) error {
+	var count int
+	err := s.db.DB.QueryRow("SELECT COUNT(*) FROM submissions WHERE id = ? AND assignment_id = ?", submissionID, assignmentID).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("submission not found for this assignment")
+	}
+	return nil
+}
+
+func (s *CalibrationService) getCalibrationGrade(id int64) (*pb.CalibrationGrade, error) {
+	var grade pb.CalibrationGrade
+	var graderName sql.NullString
+	var rubricScoresJSON string
+	var gradedAt time.Time
+
+	err := s.db.DB.QueryRow(calibrationGradeSelectQuery+" WHERE c.id = ?", id).Scan(
+		&grade.Id, &grade.AssignmentId, &grade.SubmissionId, &grade.GraderId, &graderName, &grade.Gold,
+		&rubricScoresJSON, &grade.TotalScore, &grade.RubricVersionId, &gradedAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(rubricScoresJSON), &grade.RubricScores); err != nil {
+		return nil, err
+	}
+
+	grade.GraderName = graderName.String
+	grade.GradedAt = timestamppb.New(gradedAt)
+	return &grade, nil
+}
+
+func marshalRubricScores(rubricScores map[string]float64) (string, error) {
+	if rubricScores == nil {
+		rubricScores = map[string]float64{}
+	}
+	data, err := json.Marshal(rubricScores)
+	return string(data), err
+}
+
+func getCalibrationThreshold(db *database.Database, assignmentID int64) (float64, error) {
+	var threshold float64
+	err := db.DB.QueryRow("SELECT calibration_threshold FROM assignments WHERE id = ?", assignmentID).Scan(&threshold)
+	return threshold, err
+}
+
+// courseTAs lists the IDs of every TA in the course
+func courseTAs(db *database.Database, courseID int64) ([]int64, error) {
+	rows, err := db.DB.Query(`
+		SELECT user_id FROM course_members
+		WHERE course_id = ? AND role = 'ta'
+		ORDER BY user_id
+	`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var graderIDs []int64
+	for rows.Next() {
+		var graderID int64
+		if err := rows.Scan(&graderID); err != nil {
+			return nil, err
+		}
+		graderIDs = append(graderIDs, graderID)
+	}
+	return graderIDs, rows.Err()
+}
+
+// loadCalibrationGrades returns calibration grades by submission, either the
+// gold grades or one grader's attempts on submissions that have a gold grade
+// on the same rubric version as the attempt.
+func loadCalibrationGrades(db *database.Database, assignmentID, graderID int64, gold bool) (map[int64]calibrationScores, error) {
+	query := `
+		SELECT c.submission_id, c.rubric_scores, c.total_score
+		FROM calibration_grades c
+		WHERE c.assignment_id = ? AND c.gold = 1
+	`
+	args := []interface{}{assignmentID}
+	if !gold {
+		query = `
+			SELECT c.submission_id, c.rubric_scores, c.total_score
+			FROM calibration_grades c
+			JOIN calibration_grades gold ON gold.submission_id = c.submission_id AND gold.gold = 1
+				AND gold.rubric_version_id IS c.rubric_version_id
+			WHERE c.assignment_id = ? AND c.grader_id = ? AND c.gold = 0
+		`
+		args = append(args, graderID)
+	}
+
+	rows, err := db.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grades := make(map[int64]calibrationScores)
+	for rows.Next() {
+		var submissionID int64
+		var rubricScoresJSON string
+		var scores calibrationScores
+		if err := rows.Scan(&submissionID, &rubricScoresJSON, &scores.totalScore); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(rubricScoresJSON), &scores.rubricScores); err != nil {
+			return nil, err
+		}
+		grades[submissionID] = scores
+	}
+	return grades, rows.Err()
+}
+
+// evaluateGraderCalibration compares a grader's calibration grades with the
+// gold grades criterion by criterion. A grader passes once they have graded
+// every calibration submission with no criterion's mean absolute deviation
+// above the threshold.
+func evaluateGraderCalibration(db *database.Database, assignmentID, graderID int64, threshold float64, criteria []string) (*pb.GraderCalibration, error) {
+	calibration := &pb.GraderCalibration{GraderId: graderID}
+	err := db.DB.QueryRow("SELECT name FROM users WHERE id = ?", graderID).Scan(&calibration.GraderName)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	gold, err := loadCalibrationGrades(db, assignmentID, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := loadCalibrationGrades(db, assignmentID, graderID, false)
+	if err != nil {
+		return nil, err
+	}
+	calibration.Graded = int32(len(attempts))
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, scores := range gold {
+		for key := range scores.rubricScores {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return criterionLess(keys[i], keys[j]) })
+
+	if len(attempts) > 0 {
+		for _, key := range keys {
+			var goldScores, graderScores, deviations []float64
+			for submissionID, attempt := range attempts {
+				goldScore, ok := gold[submissionID].rubricScores[key]
+				if !ok {
+					continue
+				}
+				// A criterion left out of the attempt counts as no points given
+				graderScore := attempt.rubricScores[key]
+				goldScores = append(goldScores, goldScore)
+				graderScores = append(graderScores, graderScore)
+				deviations = append(deviations, graderScore-goldScore)
+			}
+			if len(deviations) == 0 {
+				continue
+			}
+
+			deviation := &pb.CriterionDeviation{
+				Key:                   key,
+				Label:                 criterionLabel(criteria, key),
+				GoldMean:              statistics.Mean(goldScores),
+				GraderMean:            statistics.Mean(graderScores),
+				MeanDeviation:         statistics.Mean(deviations),
+				MeanAbsoluteDeviation: meanAbsolute(deviations),
+			}
+			deviation.ExceedsThreshold = threshold > 0 && deviation.MeanAbsoluteDeviation > threshold
+			calibration.MaxCriterionDeviation = math.Max(calibration.MaxCriterionDeviation, deviation.MeanAbsoluteDeviation)
+			calibration.Criteria = append(calibration.Criteria, deviation)
+		}
+
+		var totalDeviations []float64
+		for submissionID, attempt := range attempts {
+			totalDeviations = append(totalDeviations, attempt.totalScore-gold[submissionID].totalScore)
+		}
+		calibration.TotalMeanDeviation = statistics.Mean(totalDeviations)
+		calibration.TotalMeanAbsoluteDeviation = meanAbsolute(totalDeviations)
+	}
+
+	switch {
+	case len(attempts) == 0:
+		calibration.Status = calibrationNotStarted
+	case len(attempts) < len(gold):
+		calibration.Status = calibrationInProgress
+	case threshold > 0 && calibration.MaxCriterionDeviation > threshold:
+		calibration.Status = calibrationFailed
+	default:
+		calibration.Status = calibrationPassed
+	}
+	calibration.Blocked = threshold > 0 && len(gold) > 0 && calibration.Status != calibrationPassed
+
+	return calibration, nil
+}
+
+func meanAbsolute(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += math.Abs(value)
+	}
+	return sum / float64(len(values))
+}
+
+// checkCalibration blocks TAs from grading an assignment with a calibration
+// threshold until they pass calibration. Instructors are never blocked.
+func checkCalibration(db *database.Database, courseID, assignmentID, userID int64) error {
+	if checkCourseInstructor(db, courseID, userID) == nil {
+		return nil
+	}
+
+	threshold, err := getCalibrationThreshold(db, assignmentID)
+	if err != nil {
+		return err
+	}
+	if threshold == 0 {
+		return nil
+	}
+
+	calibration, err := evaluateGraderCalibration(db, assignmentID, userID, threshold, nil)
+	if err != nil {
+		return err
+	}
+	if calibration.Blocked {
+		return ErrCalibrationRequired
+	}
+	return nil
+}
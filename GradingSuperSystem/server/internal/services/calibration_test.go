@@ -0,0 +1,85 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestCalibrationComparesGradesOnOneRubricVersion(t *testing.T) {
+	course := newTestCourse(t)
+	submissionID := course.addSubmission(t, "s1")
+	calibration := NewCalibrationService(course.db)
+	instructor := course.ctx(course.instructorID)
+	ta := course.ctx(course.taID)
+	scores := map[string]float64{"0": 40, "1": 20, "2": 10}
+
+	gold := &pb.SetGoldGradeRequest{AssignmentId: course.assignmentID, SubmissionId: submissionID, RubricScores: scores}
+	if _, err := calibration.SetGoldGrade(instructor, gold); err != nil {
+		t.Fatalf("set gold grade: %v", err)
+	}
+	attempt := &pb.SubmitCalibrationGradeRequest{AssignmentId: course.assignmentID, SubmissionId: submissionID, RubricScores: scores}
+	if _, err := calibration.SubmitCalibrationGrade(ta, attempt); err != nil {
+		t.Fatalf("calibration attempt: %v", err)
+	}
+
+	// Tests moves to the front, so key 0 now means something else
+	_, err := NewRubricService(course.db).UpdateRubric(instructor, &pb.UpdateRubricRequest{
+		Id:       course.rubricID,
+		Name:     "Rubric",
+		Criteria: []string{"Tests", "Correctness", "Style"},
+		Weights:  []float64{20, 50, 30},
+	})
+	if err != nil {
+		t.Fatalf("update rubric: %v", err)
+	}
+	newVersion, err := currentRubricVersionID(course.db, course.rubricID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attempt.RubricScores = map[string]float64{"0": 10, "1": 40, "2": 20}
+	if _, err := calibration.SubmitCalibrationGrade(ta, attempt); !errors.Is(err, ErrCalibrationGoldOutdated) {
+		t.Fatalf("attempt against an outdated gold grade: err = %v, want ErrCalibrationGoldOutdated", err)
+	}
+
+	gold.RubricScores = map[string]float64{"0": 20, "1": 50, "2": 30}
+	resp, err := calibration.SetGoldGrade(instructor, gold)
+	if err != nil {
+		t.Fatalf("set gold grade again: %v", err)
+	}
+	if resp.Grade.RubricVersionId != newVersion {
+		t.Fatalf("gold grade on rubric version %d, want %d", resp.Grade.RubricVersionId, newVersion)
+	}
+	report, err := calibration.GetCalibrationReport(instructor, &pb.GetCalibrationReportRequest{AssignmentId: course.assignmentID, GraderId: course.taID})
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	if graded := report.Graders[0].Graded; graded != 0 {
+		t.Fatalf("report counts %d attempts, want the one on the old rubric left out", graded)
+	}
+
+	resp, err = calibration.SubmitCalibrationGrade(ta, attempt)
+	if err != nil {
+		t.Fatalf("attempt on the new rubric version: %v", err)
+	}
+	if resp.Grade.RubricVersionId != newVersion {
+		t.Fatalf("attempt on rubric version %d, want %d", resp.Grade.RubricVersionId, newVersion)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM calibration_grades WHERE grader_id = ?", course.taID); n != 1 {
+		t.Fatalf("TA has %d calibration grades, want the outdated one replaced", n)
+	}
+	if _, err := calibration.SubmitCalibrationGrade(ta, attempt); err == nil {
+		t.Fatal("TA submitted a second attempt on the same rubric version")
+	}
+
+	report, err = calibration.GetCalibrationReport(instructor, &pb.GetCalibrationReportRequest{AssignmentId: course.assignmentID, GraderId: course.taID})
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	grader := report.Graders[0]
+	if grader.Graded != 1 || grader.TotalMeanAbsoluteDeviation != 30 {
+		t.Fatalf("report has %d attempts with total deviation %v, want 1 with 30", grader.Graded, grader.TotalMeanAbsoluteDeviation)
+	}
+}
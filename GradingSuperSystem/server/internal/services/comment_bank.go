@@ -0,0 +1,839 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrBankCommentNotFound is returned when a bank comment does not exist or was deleted
+var ErrBankCommentNotFound = errors.New("bank comment not found")
+
+// ErrBankCommentApplied is returned when a comment is applied to a grade twice
+var ErrBankCommentApplied = errors.New("bank comment is already applied to this grade")
+
+// ErrBankCommentNotApplied is returned when removing a comment a grade does not have
+var ErrBankCommentNotApplied = errors.New("bank comment is not applied to this grade")
+
+// ErrBankCommentOutdated is returned when a comment written for an earlier
+// rubric version is used; its criterion key may now name another criterion
+var ErrBankCommentOutdated = errors.New("bank comment was written for an earlier version of the rubric")
+
+// bankInconsistencyShare is how far apart, as a share of the criterion's
+// weight, graders' mean points lost for one comment may be before it is
+// flagged as inconsistent
+const bankInconsistencyShare = 0.1
+
+type CommentBankService struct {
+	pb.UnimplementedCommentBankServiceServer
+	db *database.Database
+}
+
+func NewCommentBankService(db *database.Database) *CommentBankService {
+	return &CommentBankService{db: db}
+}
+
+const bankCommentSelectQuery = `
+	SELECT c.id, c.rubric_id, c.criterion_key, c.text, c.deduction, c.created_by, u.name,
+	       (SELECT COUNT(*) FROM grade_bank_comments gb WHERE gb.comment_id = c.id),
+	       c.created_at, c.updated_at, COALESCE(c.rubric_version_id, 0)
+	FROM bank_comments c
+	LEFT JOIN users u ON c.created_by = u.id
+`
+
+func (s *CommentBankService) CreateBankComment(ctx context.Context, req *pb.CreateBankCommentRequest) (*pb.BankCommentResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, err := getRubricCourse(s.db, req.RubricId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	versionID, err := currentRubricVersionID(s.db, req.RubricId)
+	if err != nil {
+		return nil, err
+	}
+	criteria, weights, err := getRubricVersionCriteria(s.db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(req.Text)
+	if err := validateBankComment(criteria, weights, req.CriterionKey, text, req.Deduction); err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.DB.Exec(`
+		INSERT INTO bank_comments (rubric_id, rubric_version_id, criterion_key, text, deduction, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, req.RubricId, versionID, req.CriterionKey, text, req.Deduction, userID)
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commentResponse(commentID, "Comment added to the bank")
+}
+
+// ListBankComments lists the comments written for the rubric's current
+// version; comments for earlier versions can no longer be applied
+func (s *CommentBankService) ListBankComments(ctx context.Context, req *pb.ListBankCommentsRequest) (*pb.ListBankCommentsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	rubricID := req.RubricId
+	var courseID int64
+	var err error
+	if req.AssignmentId != 0 {
+		courseID, rubricID, err = getAssignmentCourse(s.db, req.AssignmentId)
+	} else {
+		courseID, err = getRubricCourse(s.db, rubricID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	if rubricID == 0 {
+		return &pb.ListBankCommentsResponse{}, nil
+	}
+
+	versionID, err := currentRubricVersionID(s.db, rubricID)
+	if err != nil {
+		return nil, err
+	}
+	criteria, _, err := getRubricVersionCriteria(s.db, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := bankCommentSelectQuery + " WHERE c.rubric_id = ? AND c.rubric_version_id = ? AND c.deleted_at IS NULL"
+	args := []interface{}{rubricID, versionID}
+	if req.CriterionKey != "" {
+		query += " AND c.criterion_key = ?"
+		args = append(args, req.CriterionKey)
+	}
+	query += " ORDER BY CAST(c.criterion_key AS INTEGER), c.id"
+
+	rows, err := s.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.ListBankCommentsResponse{}
+	for rows.Next() {
+		comment, err := scanBankComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comment.CriterionLabel = criterionLabel(criteria, comment.CriterionKey)
+		resp.Comments = append(resp.Comments, comment)
+	}
+	return resp, rows.Err()
+}
+
+// UpdateBankComment changes a comment's text or deduction. Grades it was
+// already applied to keep the points that were taken off at the time.
+func (s *CommentBankService) UpdateBankComment(ctx context.Context, req *pb.UpdateBankCommentRequest) (*pb.BankCommentResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	comment, err := s.loadForEdit(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBankCommentCurrent(s.db, comment); err != nil {
+		return nil, err
+	}
+
+	criteria, weights, err := getRubricVersionCriteria(s.db, comment.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(req.Text)
+	if err := validateBankComment(criteria, weights, comment.CriterionKey, text, req.Deduction); err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.DB.Exec(`
+		UPDATE bank_comments SET text = ?, deduction = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, text, req.Deduction, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commentResponse(req.Id, "Bank comment updated")
+}
+
+func (s *CommentBankService) DeleteBankComment(ctx context.Context, req *pb.DeleteBankCommentRequest) (*pb.DeleteBankCommentResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	if _, err := s.loadForEdit(req.Id, userID); err != nil {
+		return nil, err
+	}
+
+	// Applied comments stay on their grades and in the usage statistics
+	_, err := s.db.DB.Exec("UPDATE bank_comments SET deleted_at = CURRENT_TIMESTAMP WHERE id = ?", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteBankCommentResponse{
+		Message: "Bank comment deleted",
+	}, nil
+}
+
+// ApplyBankComment takes the comment's deduction off its criterion and adds
+// its text to the criterion's feedback on the caller's grade
+func (s *CommentBankService) ApplyBankComment(ctx context.Context, req *pb.ApplyBankCommentRequest) (*pb.SubmitGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	comment, err := s.getBankComment(req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.deleted {
+		return nil, ErrBankCommentNotFound
+	}
+	target, err := s.resolveTarget(req.SubmissionId, comment, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	grade, err := loadGradeForEdit(tx, req.SubmissionId, userID, target.instructor)
+	if err != nil {
+		return nil, err
+	}
+	created := grade == nil
+	if created {
+		grade = &editableGrade{
+			submissionID:      req.SubmissionId,
+			rubricScores:      map[string]float64{},
+			criterionFeedback: map[string]string{},
+		}
+	} else {
+		if req.ExpectedVersion != grade.version {
+			return nil, gradeConflict(s.db, grade.id, userID)
+		}
+		var applied int
+		err := tx.QueryRow("SELECT COUNT(*) FROM grade_bank_comments WHERE grade_id = ? AND comment_id = ?", grade.id, comment.Id).Scan(&applied)
+		if err != nil {
+			return nil, err
+		}
+		if applied > 0 {
+			return nil, ErrBankCommentApplied
+		}
+	}
+
+	// Ungraded criteria start from full marks
+	before, graded := grade.rubricScores[comment.CriterionKey]
+	if !graded {
+		before = target.weight
+	}
+	after := math.Max(0, before-comment.Deduction)
+	grade.rubricScores[comment.CriterionKey] = after
+	grade.criterionFeedback[comment.CriterionKey] = addFeedbackLine(grade.criterionFeedback[comment.CriterionKey], comment.Text)
+
+	reason := "Applied bank comment: " + comment.Text
+	if err := grade.save(tx, target, userID, reason); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO grade_bank_comments (grade_id, comment_id, criterion_key, grader_id, deduction, applied_at)
+		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, grade.id, comment.Id, comment.CriterionKey, userID, before-after)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	index, _ := strconv.Atoi(comment.CriterionKey)
+	return s.gradeResponse(grade.id, userID, created, fmt.Sprintf("Applied comment; criterion #%d %g -> %g", index+1, before, after))
+}
+
+// RemoveBankComment gives back the points the comment took off and removes
+// its text from the criterion's feedback
+func (s *CommentBankService) RemoveBankComment(ctx context.Context, req *pb.ApplyBankCommentRequest) (*pb.SubmitGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	comment, err := s.getBankComment(req.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.resolveTarget(req.SubmissionId, comment, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	grade, err := loadGradeForEdit(tx, req.SubmissionId, userID, target.instructor)
+	if err != nil {
+		return nil, err
+	}
+	if grade == nil {
+		return nil, ErrBankCommentNotApplied
+	}
+	if req.ExpectedVersion != grade.version {
+		return nil, gradeConflict(s.db, grade.id, userID)
+	}
+
+	var deducted float64
+	err = tx.QueryRow("SELECT deduction FROM grade_bank_comments WHERE grade_id = ? AND comment_id = ?", grade.id, comment.Id).Scan(&deducted)
+	if err == sql.ErrNoRows {
+		return nil, ErrBankCommentNotApplied
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	before, graded := grade.rubricScores[comment.CriterionKey]
+	after := before
+	if graded {
+		after = math.Min(target.weight, before+deducted)
+		grade.rubricScores[comment.CriterionKey] = after
+	}
+	if feedback := removeFeedbackLine(grade.criterionFeedback[comment.CriterionKey], comment.Text); feedback != "" {
+		grade.criterionFeedback[comment.CriterionKey] = feedback
+	} else {
+		delete(grade.criterionFeedback, comment.CriterionKey)
+	}
+
+	reason := "Removed bank comment: " + comment.Text
+	if err := grade.save(tx, target, userID, reason); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec("DELETE FROM grade_bank_comments WHERE grade_id = ? AND comment_id = ?", grade.id, comment.Id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	index, _ := strconv.Atoi(comment.CriterionKey)
+	return s.gradeResponse(grade.id, userID, false, fmt.Sprintf("Removed comment; criterion #%d %g -> %g", index+1, before, after))
+}
+
+// GetCommentBankStats reports how each grader used the bank on an assignment.
+// Points lost are measured from the grades' current scores, so manual edits
+// after applying a comment show up as inconsistency.
+func (s *CommentBankService) GetCommentBankStats(ctx context.Context, req *pb.GetCommentBankStatsRequest) (*pb.CommentBankStatsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, rubricID, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CommentBankStatsResponse{AssignmentId: req.AssignmentId}
+	if rubricID == 0 {
+		return resp, nil
+	}
+	criteria, weights, err := getRubricCriteria(s.db, rubricID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT gb.comment_id, c.criterion_key, c.text, c.deduction, gb.grader_id, u.name, g.rubric_scores
+		FROM grade_bank_comments gb
+		JOIN grades g ON gb.grade_id = g.id
+		JOIN bank_comments c ON gb.comment_id = c.id
+		LEFT JOIN users u ON gb.grader_id = u.id
+		WHERE g.assignment_id = ?
+		ORDER BY gb.comment_id, gb.grader_id
+	`, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type usage struct {
+		stats     *pb.GraderBankCommentUsage
+		lostSum   float64
+		lostCount int
+	}
+	byComment := make(map[int64]*pb.BankCommentStats)
+	usages := make(map[int64]map[int64]*usage)
+	byGrader := make(map[int64]*pb.GraderBankUsage)
+	for rows.Next() {
+		var commentID, graderID int64
+		var key, text, scoresJSON string
+		var deduction float64
+		var graderName sql.NullString
+		if err := rows.Scan(&commentID, &key, &text, &deduction, &graderID, &graderName, &scoresJSON); err != nil {
+			return nil, err
+		}
+		var scores map[string]float64
+		if err := json.Unmarshal([]byte(scoresJSON), &scores); err != nil {
+			return nil, err
+		}
+
+		comment, ok := byComment[commentID]
+		if !ok {
+			comment = &pb.BankCommentStats{
+				CommentId:      commentID,
+				CriterionKey:   key,
+				CriterionLabel: criterionLabel(criteria, key),
+				Text:           text,
+				Deduction:      deduction,
+			}
+			byComment[commentID] = comment
+			usages[commentID] = make(map[int64]*usage)
+			resp.Comments = append(resp.Comments, comment)
+		}
+		comment.UsageCount++
+
+		u, ok := usages[commentID][graderID]
+		if !ok {
+			u = &usage{stats: &pb.GraderBankCommentUsage{GraderId: graderID, GraderName: graderName.String}}
+			usages[commentID][graderID] = u
+			comment.Graders = append(comment.Graders, u.stats)
+		}
+		u.stats.UsageCount++
+		if score, graded := scores[key]; graded {
+			if index, err := strconv.Atoi(key); err == nil && index >= 0 && index < len(weights) {
+				u.lostSum += weights[index] - score
+				u.lostCount++
+			}
+		}
+
+		grader, ok := byGrader[graderID]
+		if !ok {
+			grader = &pb.GraderBankUsage{GraderId: graderID, GraderName: graderName.String}
+			byGrader[graderID] = grader
+			resp.Graders = append(resp.Graders, grader)
+		}
+		grader.Applied++
+		if u.stats.UsageCount == 1 {
+			grader.DistinctComments++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, comment := range resp.Comments {
+		var means []float64
+		for _, u := range usages[comment.CommentId] {
+			if u.lostCount > 0 {
+				u.stats.MeanPointsLost = u.lostSum / float64(u.lostCount)
+				means = append(means, u.stats.MeanPointsLost)
+			}
+		}
+		if len(means) >= 2 {
+			sort.Float64s(means)
+			comment.PointsLostSpread = means[len(means)-1] - means[0]
+			weight := 0.0
+			if index, err := strconv.Atoi(comment.CriterionKey); err == nil && index >= 0 && index < len(weights) {
+				weight = weights[index]
+			}
+			comment.Inconsistent = comment.PointsLostSpread > bankInconsistencyShare*weight
+		}
+	}
+
+	sort.Slice(resp.Comments, func(i, j int) bool {
+		if resp.Comments[i].Inconsistent != resp.Comments[j].Inconsistent {
+			return resp.Comments[i].Inconsistent
+		}
+		return resp.Comments[i].UsageCount > resp.Comments[j].UsageCount
+	})
+	sort.Slice(resp.Graders, func(i, j int) bool { return resp.Graders[i].GraderId < resp.Graders[j].GraderId })
+	return resp, nil
+}
+
+// bankComment is a comment with whether it was deleted from the bank
+type bankComment struct {
+	*pb.BankComment
+	deleted bool
+}
+
+func (s *CommentBankService) getBankComment(id int64) (*bankComment, error) {
+	comment, err := scanBankComment(s.db.DB.QueryRow(bankCommentSelectQuery+" WHERE c.id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, ErrBankCommentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedAt sql.NullTime
+	if err := s.db.DB.QueryRow("SELECT deleted_at FROM bank_comments WHERE id = ?", id).Scan(&deletedAt); err != nil {
+		return nil, err
+	}
+	return &bankComment{BankComment: comment, deleted: deletedAt.Valid}, nil
+}
+
+// loadForEdit returns a comment the caller may change: its author or an
+// instructor of the course
+func (s *CommentBankService) loadForEdit(id, userID int64) (*bankComment, error) {
+	comment, err := s.getBankComment(id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.deleted {
+		return nil, ErrBankCommentNotFound
+	}
+
+	courseID, err := getRubricCourse(s.db, comment.RubricId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, ErrBankCommentNotFound
+	}
+	if comment.CreatedBy != userID && checkCourseInstructor(s.db, courseID, userID) != nil {
+		return nil, errors.New("access denied: only the comment's author or an instructor can change it")
+	}
+	return comment, nil
+}
+
+// checkBankCommentCurrent refuses a comment written for an earlier version
+// of its rubric
+func checkBankCommentCurrent(db *database.Database, comment *bankComment) error {
+	versionID, err := currentRubricVersionID(db, comment.RubricId)
+	if err != nil {
+		return err
+	}
+	if comment.RubricVersionId != versionID {
+		return ErrBankCommentOutdated
+	}
+	return nil
+}
+
+func (s *CommentBankService) commentResponse(id int64, message string) (*pb.BankCommentResponse, error) {
+	comment, err := s.getBankComment(id)
+	if err != nil {
+		return nil, err
+	}
+	criteria, _, err := getRubricVersionCriteria(s.db, comment.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	comment.CriterionLabel = criterionLabel(criteria, comment.CriterionKey)
+
+	return &pb.BankCommentResponse{
+		Comment: comment.BankComment,
+		Message: message,
+	}, nil
+}
+
+func (s *CommentBankService) gradeResponse(gradeID, userID int64, created bool, message string) (*pb.SubmitGradeResponse, error) {
+	grade, err := scanGrade(s.db.DB.QueryRow(gradeSelectQuery+" WHERE g.id = ?", gradeID))
+	if err != nil {
+		return nil, err
+	}
+	if grade.AppliedComments, err = loadAppliedComments(s.db, gradeID); err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).grade(grade); err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitGradeResponse{
+		Grade:   grade,
+		Created: created,
+		Message: message,
+	}, nil
+}
+
+// bankTarget is the submission a bank comment is applied to
+type bankTarget struct {
+	assignmentID int64
+	studentID    string
+	weight       float64
+	validator    *rubricScoreValidator
+	// Instructors' comments go to the submission's authoritative grade
+	instructor bool
+}
+
+// resolveTarget checks the caller may grade the submission and that its
+// assignment is graded with the version of the rubric the comment was
+// written for
+func (s *CommentBankService) resolveTarget(submissionID int64, comment *bankComment, userID int64) (*bankTarget, error) {
+	var assignmentID int64
+	err := s.db.DB.QueryRow("SELECT assignment_id FROM submissions WHERE id = ?", submissionID).Scan(&assignmentID)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("submission not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	studentID, err := checkCanGrade(s.db, assignmentID, submissionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	courseID, rubricID, err := getAssignmentCourse(s.db, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if rubricID != comment.RubricId {
+		verr := &ValidationError{}
+		verr.add("comment_id", "comment belongs to a different rubric than this assignment")
+		return nil, verr
+	}
+
+	validator, err := newRubricScoreValidator(s.db, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.RubricVersionId != validator.versionID {
+		return nil, ErrBankCommentOutdated
+	}
+	index, ok := validator.criterionIndex(comment.CriterionKey)
+	if !ok || index >= len(validator.weights) {
+		verr := &ValidationError{}
+		verr.add("comment_id", "comment's criterion is no longer part of the rubric")
+		return nil, verr
+	}
+
+	return &bankTarget{
+		assignmentID: assignmentID,
+		studentID:    studentID,
+		weight:       validator.weights[index],
+		validator:    validator,
+		instructor:   checkCourseInstructor(s.db, courseID, userID) == nil,
+	}, nil
+}
+
+// editableGrade is the caller's grade of a submission as loaded for a change
+type editableGrade struct {
+	id                int64
+	submissionID      int64
+	version           int32
+	previous          *gradeSnapshot
+	rubricScores      map[string]float64
+	criterionFeedback map[string]string
+}
+
+// loadGradeForEdit returns the grade the user's edits to a submission go to,
+// or nil when there is none yet
+func loadGradeForEdit(tx *sql.Tx, submissionID, userID int64, instructor bool) (*editableGrade, error) {
+	grade := &editableGrade{submissionID: submissionID}
+	var scoresJSON, feedbackJSON string
+	var total float64
+	filter, args := gradeEditFilter(instructor, submissionID, userID)
+	err := tx.QueryRow("SELECT id, rubric_scores, total_score, criterion_feedback, version FROM grades WHERE "+filter, args...).
+		Scan(&grade.id, &scoresJSON, &total, &feedbackJSON, &grade.version)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	previous := &gradeSnapshot{totalScore: total}
+	if err := json.Unmarshal([]byte(scoresJSON), &previous.rubricScores); err != nil {
+		return nil, err
+	}
+	grade.previous = previous
+	grade.rubricScores = make(map[string]float64, len(previous.rubricScores))
+	for key, score := range previous.rubricScores {
+		grade.rubricScores[key] = score
+	}
+	if err := json.Unmarshal([]byte(feedbackJSON), &grade.criterionFeedback); err != nil {
+		return nil, err
+	}
+	if grade.criterionFeedback == nil {
+		grade.criterionFeedback = map[string]string{}
+	}
+	return grade, nil
+}
+
+// save writes the grade's scores and feedback, creating it when new, and
+// records the change in its revision history
+func (g *editableGrade) save(tx *sql.Tx, target *bankTarget, userID int64, reason string) error {
+	if g.id != 0 {
+		if err := checkGradeRubricVersion(tx, g.id, target.validator.versionID); err != nil {
+			return err
+		}
+	}
+	totalScore, err := target.validator.validate(g.rubricScores)
+	if err != nil {
+		return err
+	}
+	scoresJSON, err := marshalRubricScores(g.rubricScores)
+	if err != nil {
+		return err
+	}
+	feedbackJSON, err := json.Marshal(g.criterionFeedback)
+	if err != nil {
+		return err
+	}
+
+	if g.id == 0 {
+		result, err := tx.Exec(`
+			INSERT INTO grades (assignment_id, submission_id, student_id, grader_id, rubric_scores, total_score, criterion_feedback, rubric_version_id, needs_regrading, graded_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, target.assignmentID, g.submissionID, target.studentID, userID, scoresJSON, totalScore, string(feedbackJSON), target.validator.versionID)
+		if err != nil {
+			return err
+		}
+		if g.id, err = result.LastInsertId(); err != nil {
+			return err
+		}
+	} else {
+		result, err := tx.Exec(`
+			UPDATE grades SET rubric_scores = ?, total_score = ?, criterion_feedback = ?, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND version = ?
+		`, scoresJSON, totalScore, string(feedbackJSON), g.id, g.version)
+		if err != nil {
+			return err
+		}
+		if updated, err := result.RowsAffected(); err != nil {
+			return err
+		} else if updated == 0 {
+			return ErrGradeVersionConflict
+		}
+	}
+
+	current := gradeSnapshot{rubricScores: g.rubricScores, totalScore: totalScore}
+	_, err = recordGradeRevision(tx, g.id, userID, revisionSourceCommentBank, g.previous, current, reason)
+	return err
+}
+
+// loadAppliedComments lists the bank comments applied to a grade, oldest first
+func loadAppliedComments(db *database.Database, gradeID int64) ([]*pb.AppliedBankComment, error) {
+	rows, err := db.DB.Query(`
+		SELECT gb.comment_id, gb.criterion_key, c.text, gb.deduction, gb.applied_at
+		FROM grade_bank_comments gb
+		JOIN bank_comments c ON gb.comment_id = c.id
+		WHERE gb.grade_id = ?
+		ORDER BY gb.applied_at ASC, gb.id ASC
+	`, gradeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applied []*pb.AppliedBankComment
+	for rows.Next() {
+		var comment pb.AppliedBankComment
+		var appliedAt time.Time
+		if err := rows.Scan(&comment.CommentId, &comment.CriterionKey, &comment.Text, &comment.Deduction, &appliedAt); err != nil {
+			return nil, err
+		}
+		comment.AppliedAt = timestamppb.New(appliedAt)
+		applied = append(applied, &comment)
+	}
+	return applied, rows.Err()
+}
+
+func scanBankComment(row rowScanner) (*pb.BankComment, error) {
+	var comment pb.BankComment
+	var createdByName sql.NullString
+	var createdAt, updatedAt time.Time
+
+	err := row.Scan(&comment.Id, &comment.RubricId, &comment.CriterionKey, &comment.Text, &comment.Deduction,
+		&comment.CreatedBy, &createdByName, &comment.UsageCount, &createdAt, &updatedAt, &comment.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.CreatedByName = createdByName.String
+	comment.CreatedAt = timestamppb.New(createdAt)
+	comment.UpdatedAt = timestamppb.New(updatedAt)
+	return &comment, nil
+}
+
+// validateBankComment checks a comment names a criterion of the rubric and
+// deducts no more than the criterion is worth
+func validateBankComment(criteria []string, weights []float64, key, text string, deduction float64) error {
+	verr := &ValidationError{}
+	validator := &rubricScoreValidator{criteria: criteria, weights: weights}
+	index, ok := validator.criterionIndex(key)
+	if !ok {
+		verr.add("criterion_key", "unknown criterion; expected an index from 0 to %d", len(criteria)-1)
+	}
+	if text == "" {
+		verr.add("text", "text is required")
+	} else if utf8.RuneCountInString(text) > maxFeedbackLength {
+		verr.add("text", "text is longer than %d characters", maxFeedbackLength)
+	}
+	if ok {
+		weight := 0.0
+		if index < len(weights) {
+			weight = weights[index]
+		}
+		if math.IsNaN(deduction) || deduction < 0 || deduction > weight {
+			verr.add("deduction", "deduction %g is outside 0 to %g", deduction, weight)
+		}
+	}
+
+	if len(verr.Fields) > 0 {
+		return verr
+	}
+	return nil
+}
+
+func getRubricCourse(db *database.Database, rubricID int64) (int64, error) {
+	var courseID int64
+	err := db.DB.QueryRow("SELECT course_id FROM rubrics WHERE id = ?", rubricID).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return 0, errors.New("rubric not found")
+	}
+	return courseID, err
+}
+
+// addFeedbackLine appends a line to criterion feedback unless it is already there
+func addFeedbackLine(feedback, line string) string {
+	for _, existing := range strings.Split(feedback, "\n") {
+		if strings.TrimSpace(existing) == line {
+			return feedback
+		}
+	}
+	if feedback == "" {
+		return line
+	}
+	return feedback + "\n" + line
+}
+
+// removeFeedbackLine drops every line of criterion feedback equal to line
+func removeFeedbackLine(feedback, line string) string {
+	var kept []string
+	for _, existing := range strings.Split(feedback, "\n") {
+		if strings.TrimSpace(existing) != line {
+			kept = append(kept, existing)
+		}
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
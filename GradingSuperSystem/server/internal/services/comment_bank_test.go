@@ -0,0 +1,73 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestBankCommentsAreRefusedAfterTheRubricChanges(t *testing.T) {
+	course := newTestCourse(t)
+	bank := NewCommentBankService(course.db)
+	ta := course.ctx(course.taID)
+	submissionID := course.addSubmission(t, "s1")
+
+	created, err := bank.CreateBankComment(ta, &pb.CreateBankCommentRequest{
+		RubricId:     course.rubricID,
+		CriterionKey: "2",
+		Text:         "Missing edge case tests",
+		Deduction:    5,
+	})
+	if err != nil {
+		t.Fatalf("create comment: %v", err)
+	}
+
+	// Tests moves to the front, so key 2 now names Style
+	_, err = NewRubricService(course.db).UpdateRubric(course.ctx(course.instructorID), &pb.UpdateRubricRequest{
+		Id:       course.rubricID,
+		Name:     "Rubric",
+		Criteria: []string{"Tests", "Correctness", "Style"},
+		Weights:  []float64{20, 50, 30},
+	})
+	if err != nil {
+		t.Fatalf("update rubric: %v", err)
+	}
+
+	apply := &pb.ApplyBankCommentRequest{SubmissionId: submissionID, CommentId: created.Comment.Id}
+	if _, err := bank.ApplyBankComment(ta, apply); !errors.Is(err, ErrBankCommentOutdated) {
+		t.Fatalf("applying an outdated comment: err = %v, want ErrBankCommentOutdated", err)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grades"); n != 0 {
+		t.Fatalf("applying an outdated comment wrote %d grades, want none", n)
+	}
+	_, err = bank.UpdateBankComment(ta, &pb.UpdateBankCommentRequest{Id: created.Comment.Id, Text: "Missing tests", Deduction: 5})
+	if !errors.Is(err, ErrBankCommentOutdated) {
+		t.Fatalf("editing an outdated comment: err = %v, want ErrBankCommentOutdated", err)
+	}
+	listed, err := bank.ListBankComments(ta, &pb.ListBankCommentsRequest{RubricId: course.rubricID})
+	if err != nil {
+		t.Fatalf("list comments: %v", err)
+	}
+	if len(listed.Comments) != 0 {
+		t.Fatalf("listed %d comments, want the outdated one left out", len(listed.Comments))
+	}
+
+	created, err = bank.CreateBankComment(ta, &pb.CreateBankCommentRequest{
+		RubricId:     course.rubricID,
+		CriterionKey: "0",
+		Text:         "Missing edge case tests",
+		Deduction:    5,
+	})
+	if err != nil {
+		t.Fatalf("create comment for the current rubric: %v", err)
+	}
+	apply.CommentId = created.Comment.Id
+	resp, err := bank.ApplyBankComment(ta, apply)
+	if err != nil {
+		t.Fatalf("apply comment: %v", err)
+	}
+	if score := resp.Grade.RubricScores["0"]; score != 15 {
+		t.Fatalf("Tests scored %g after the comment, want 15", score)
+	}
+}
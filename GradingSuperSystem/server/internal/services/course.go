@@ -50,17 +50,21 @@ func (s *CourseService) CreateCourse(ctx context.Context, req *pb.CreateCourseRe
 		return nil, errors.New("course with this code already exists")
 	}
 
-	// Generate join code
+	// Generate join codes; students get their own so the staff code is never shared with them
 	joinCode, err := generateCourseJoinCode()
 	if err != nil {
 		return nil, err
 	}
+	studentJoinCode, err := generateCourseJoinCode()
+	if err != nil {
+		return nil, err
+	}
 
 	// Insert course
 	result, err := s.db.DB.Exec(`
-		INSERT INTO courses (name, code, join_code, instructor_id, description, semester, year, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-	`, req.Name, req.Code, joinCode, userID, req.Description, req.Semester, req.Year)
+		INSERT INTO courses (name, code, join_code, student_join_code, instructor_id, description, semester, year, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, req.Name, req.Code, joinCode, studentJoinCode, userID, req.Description, req.Semester, req.Year)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +127,8 @@ func (s *CourseService) ListCourses(ctx context.Context, req *pb.ListCoursesRequ
 
 	// Get courses where user is a member
 	rows, err := s.db.DB.Query(`
-		SELECT c.id, c.name, c.code, c.join_code, c.instructor_id, c.description, c.semester, c.year, c.created_at, c.updated_at
+		SELECT c.id, c.name, c.code, c.join_code, c.instructor_id, c.description, c.semester, c.year,
+		       COALESCE(c.student_join_code, ''), c.show_grader_to_students, c.created_at, c.updated_at
 		FROM courses c
 		JOIN course_members cm ON c.id = cm.course_id
 		WHERE cm.user_id = ?
@@ -141,7 +146,7 @@ func (s *CourseService) ListCourses(ctx context.Context, req *pb.ListCoursesRequ
 		
 		err := rows.Scan(&course.Id, &course.Name, &course.Code, &course.JoinCode, 
 			&course.InstructorId, &course.Description, &course.Semester, &course.Year,
-			&createdAt, &updatedAt)
+			&course.StudentJoinCode, &course.ShowGraderToStudents, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -189,9 +194,9 @@ func (s *CourseService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseRe
 	// Update course
 	_, err = s.db.DB.Exec(`
 		UPDATE courses 
-		SET name = ?, code = ?, description = ?, semester = ?, year = ?, updated_at = CURRENT_TIMESTAMP
+		SET name = ?, code = ?, description = ?, semester = ?, year = ?, show_grader_to_students = ?, updated_at = CURRENT_TIMESTAMP
 		WHERE id = ?
-	`, req.Name, req.Code, req.Description, req.Semester, req.Year, req.Id)
+	`, req.Name, req.Code, req.Description, req.Semester, req.Year, req.ShowGraderToStudents, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -210,10 +215,15 @@ func (s *CourseService) UpdateCourse(ctx context.Context, req *pb.UpdateCourseRe
 
 func (s *CourseService) JoinCourse(ctx context.Context, req *pb.JoinCourseRequest) (*pb.CourseResponse, error) {
 	userID := ctx.Value("user_id").(int64)
+	userRole := ctx.Value("user_role").(string)
 
-	// Find course by join code
+	// Find course by join code; students can only use the student code
+	codeColumn := "join_code"
+	if userRole == "student" {
+		codeColumn = "student_join_code"
+	}
 	var courseID int64
-	err := s.db.DB.QueryRow("SELECT id FROM courses WHERE join_code = ?", req.JoinCode).Scan(&courseID)
+	err := s.db.DB.QueryRow("SELECT id FROM courses WHERE "+codeColumn+" = ?", req.JoinCode).Scan(&courseID)
 	if err == sql.ErrNoRows {
 		return nil, errors.New("invalid join code")
 	}
@@ -234,9 +244,6 @@ func (s *CourseService) JoinCourse(ctx context.Context, req *pb.JoinCourseReques
 		return nil, errors.New("you are already a member of this course")
 	}
 
-	// Get user role
-	userRole := ctx.Value("user_role").(string)
-
 	// Add user to course
 	_, err = s.db.DB.Exec(`
 		INSERT INTO course_members (course_id, user_id, role, joined_at)
@@ -252,6 +259,13 @@ func (s *CourseService) JoinCourse(ctx context.Context, req *pb.JoinCourseReques
 		return nil, err
 	}
 
+	// Students never see the roster or the join codes
+	if userRole == "student" {
+		course.JoinCode = ""
+		course.StudentJoinCode = ""
+		course.Members = nil
+	}
+
 	return &pb.CourseResponse{
 		Course:  course,
 		Message: "Successfully joined course",
@@ -292,6 +306,10 @@ func (s *CourseService) LeaveCourse(ctx context.Context, req *pb.LeaveCourseRequ
 	if err != nil {
 		return nil, err
 	}
+	_, err = s.db.DB.Exec("DELETE FROM course_roster WHERE course_id = ? AND user_id = ?", req.CourseId, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	return &pb.LeaveCourseResponse{
 		Message: "Successfully left course",
@@ -342,11 +360,12 @@ func (s *CourseService) getCourseByID(courseID int64) (*pb.Course, error) {
 	var createdAt, updatedAt time.Time
 
 	err := s.db.DB.QueryRow(`
-		SELECT id, name, code, join_code, instructor_id, description, semester, year, created_at, updated_at
+		SELECT id, name, code, join_code, instructor_id, description, semester, year,
+		       COALESCE(student_join_code, ''), show_grader_to_students, created_at, updated_at
 		FROM courses WHERE id = ?
 	`, courseID).Scan(&course.Id, &course.Name, &course.Code, &course.JoinCode,
 		&course.InstructorId, &course.Description, &course.Semester, &course.Year,
-		&createdAt, &updatedAt)
+		&course.StudentJoinCode, &course.ShowGraderToStudents, &createdAt, &updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -366,9 +385,10 @@ func (s *CourseService) getCourseByID(courseID int64) (*pb.Course, error) {
 
 func (s *CourseService) getCourseMembers(courseID int64) ([]*pb.CourseMember, error) {
 	rows, err := s.db.DB.Query(`
-		SELECT u.id, u.name, u.email, cm.role, cm.joined_at
+		SELECT u.id, u.name, u.email, cm.role, cm.joined_at, COALESCE(cr.student_id, '')
 		FROM course_members cm
 		JOIN users u ON cm.user_id = u.id
+		LEFT JOIN course_roster cr ON cr.course_id = cm.course_id AND cr.user_id = cm.user_id
 		WHERE cm.course_id = ?
 		ORDER BY cm.joined_at
 	`, courseID)
@@ -382,7 +402,7 @@ func (s *CourseService) getCourseMembers(courseID int64) ([]*pb.CourseMember, er
 		var member pb.CourseMember
 		var joinedAt time.Time
 		
-		err := rows.Scan(&member.UserId, &member.Name, &member.Email, &member.Role, &joinedAt)
+		err := rows.Scan(&member.UserId, &member.Name, &member.Email, &member.Role, &joinedAt, &member.StudentId)
 		if err != nil {
 			return nil, err
 		}
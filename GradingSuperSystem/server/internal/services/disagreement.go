@@ -0,0 +1,235 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/talytics/server/internal/statistics"
+	pb "github.com/talytics/server/proto"
+)
+
+// Default disagreement threshold as a fraction of the rubric's total points
+const defaultDisagreementFraction = 0.1
+
+func (s *AnalysisService) GetDisagreementReport(ctx context.Context, req *pb.GetDisagreementReportRequest) (*pb.DisagreementReportResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).records(records); err != nil {
+		return nil, err
+	}
+
+	threshold := req.Threshold
+	if threshold <= 0 {
+		if threshold, err = s.defaultDisagreementThreshold(scope); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &pb.DisagreementReportResponse{
+		AssignmentId:    scope.assignmentID,
+		RubricId:        scope.rubricID,
+		Threshold:       threshold,
+		RubricVersionId: scope.rubricVersionID,
+		ExcludedGrades:  excluded,
+	}
+
+	for _, disagreement := range submissionDisagreements(records, threshold) {
+		resp.OverlapSubmissions++
+		if disagreement.Flagged {
+			resp.FlaggedSubmissions++
+		}
+		resp.Submissions = append(resp.Submissions, disagreement)
+	}
+	resp.GraderPairs = graderPairAgreement(records)
+
+	return resp, nil
+}
+
+// defaultDisagreementThreshold is a fixed share of the points available on
+// the analyzed rubric version, or of 100 points without a rubric
+func (s *AnalysisService) defaultDisagreementThreshold(scope *analysisScope) (float64, error) {
+	var weights []float64
+	if scope.rubricVersionID != 0 {
+		var err error
+		if _, weights, err = getRubricVersionCriteria(s.db, scope.rubricVersionID); err != nil {
+			return 0, err
+		}
+	}
+
+	var totalPoints float64
+	for _, weight := range weights {
+		totalPoints += weight
+	}
+	if totalPoints <= 0 {
+		totalPoints = 100
+	}
+	return totalPoints * defaultDisagreementFraction, nil
+}
+
+// submissionDisagreements compares the grades of every submission graded more
+// than once, largest total score spread first.
+func submissionDisagreements(records []gradeRecord, threshold float64) []*pb.SubmissionDisagreement {
+	bySubmission := make(map[int64][]gradeRecord)
+	var order []int64
+	for _, record := range records {
+		if _, seen := bySubmission[record.submissionID]; !seen {
+			order = append(order, record.submissionID)
+		}
+		bySubmission[record.submissionID] = append(bySubmission[record.submissionID], record)
+	}
+
+	var disagreements []*pb.SubmissionDisagreement
+	for _, submissionID := range order {
+		grades := bySubmission[submissionID]
+		if len(grades) < 2 {
+			continue
+		}
+
+		disagreement := &pb.SubmissionDisagreement{
+			SubmissionId:     submissionID,
+			StudentId:        grades[0].studentID,
+			CriterionSpreads: make(map[string]float64),
+		}
+
+		var totals []float64
+		criterionScores := make(map[string][]float64)
+		for _, grade := range grades {
+			disagreement.Grades = append(disagreement.Grades, &pb.GraderScore{
+				GradeId:      grade.id,
+				GraderId:     grade.graderID,
+				GraderName:   grade.graderName,
+				TotalScore:   grade.totalScore,
+				RubricScores: grade.rubricScores,
+			})
+			totals = append(totals, grade.totalScore)
+			for criterion, score := range grade.rubricScores {
+				criterionScores[criterion] = append(criterionScores[criterion], score)
+			}
+		}
+
+		disagreement.TotalSpread = statistics.Max(totals) - statistics.Min(totals)
+		for criterion, scores := range criterionScores {
+			if len(scores) > 1 {
+				disagreement.CriterionSpreads[criterion] = statistics.Max(scores) - statistics.Min(scores)
+			}
+		}
+		disagreement.Flagged = disagreement.TotalSpread > threshold
+
+		disagreements = append(disagreements, disagreement)
+	}
+
+	sort.SliceStable(disagreements, func(i, j int) bool {
+		return disagreements[i].TotalSpread > disagreements[j].TotalSpread
+	})
+
+	return disagreements
+}
+
+// graderPairAgreement summarizes how closely each pair of graders agrees on
+// the total score of the submissions they both graded.
+func graderPairAgreement(records []gradeRecord) []*pb.GraderPairAgreement {
+	type pairKey struct{ a, b int64 }
+
+	bySubmission := make(map[int64][]gradeRecord)
+	graderNames := make(map[int64]string)
+	for _, record := range records {
+		bySubmission[record.submissionID] = append(bySubmission[record.submissionID], record)
+		graderNames[record.graderID] = record.graderName
+	}
+
+	differences := make(map[pairKey][]float64)
+	for _, grades := range bySubmission {
+		for i := 0; i < len(grades); i++ {
+			for j := i + 1; j < len(grades); j++ {
+				a, b := grades[i], grades[j]
+				if a.graderID > b.graderID {
+					a, b = b, a
+				}
+				key := pairKey{a.graderID, b.graderID}
+				differences[key] = append(differences[key], a.totalScore-b.totalScore)
+			}
+		}
+	}
+
+	var pairs []*pb.GraderPairAgreement
+	for key, diffs := range differences {
+		var absolute float64
+		for _, diff := range diffs {
+			absolute += math.Abs(diff)
+		}
+		pairs = append(pairs, &pb.GraderPairAgreement{
+			GraderAId:              key.a,
+			GraderAName:            graderNames[key.a],
+			GraderBId:              key.b,
+			GraderBName:            graderNames[key.b],
+			SharedSubmissions:      int32(len(diffs)),
+			MeanDifference:         statistics.Mean(diffs),
+			MeanAbsoluteDifference: absolute / float64(len(diffs)),
+		})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].GraderAId != pairs[j].GraderAId {
+			return pairs[i].GraderAId < pairs[j].GraderAId
+		}
+		return pairs[i].GraderBId < pairs[j].GraderBId
+	})
+
+	return pairs
+}
+
+// disagreementAnomalies turns flagged overlap submissions into anomalies,
+// more severe the further the spread exceeds the threshold.
+func disagreementAnomalies(records []gradeRecord, threshold float64) []*pb.Anomaly {
+	var anomalies []*pb.Anomaly
+	for _, disagreement := range submissionDisagreements(records, threshold) {
+		if !disagreement.Flagged {
+			continue
+		}
+
+		severity := "low"
+		switch {
+		case disagreement.TotalSpread >= 2*threshold:
+			severity = "high"
+		case disagreement.TotalSpread >= 1.5*threshold:
+			severity = "medium"
+		}
+
+		details := map[string]string{
+			"student_id":   disagreement.StudentId,
+			"total_spread": formatStat(disagreement.TotalSpread),
+			"threshold":    formatStat(threshold),
+			"grade_count":  strconv.Itoa(len(disagreement.Grades)),
+		}
+		for i, grade := range disagreement.Grades {
+			prefix := "grader_" + strconv.Itoa(i+1)
+			details[prefix+"_id"] = strconv.FormatInt(grade.GraderId, 10)
+			details[prefix+"_name"] = grade.GraderName
+			details[prefix+"_total"] = formatStat(grade.TotalScore)
+		}
+
+		anomalies = append(anomalies, &pb.Anomaly{
+			Type:         "grader_disagreement",
+			QuestionId:   totalScoreKey,
+			Severity:     severity,
+			Details:      details,
+			GradeId:      disagreement.Grades[0].GradeId,
+			SubmissionId: disagreement.SubmissionId,
+		})
+	}
+	return anomalies
+}
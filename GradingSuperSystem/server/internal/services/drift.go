@@ -0,0 +1,226 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talytics/server/internal/statistics"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// EWMA smoothing weight and control limit width in standard deviations
+	ewmaLambda        = 0.2
+	controlLimitWidth = 3.0
+	// CUSUM allowance and decision interval in standard deviations
+	cusumK = 0.5
+	cusumH = 5.0
+
+	// Shortest series a control chart is drawn for
+	minDriftSeries = 10
+	// Largest number of leading grades used to estimate the in-control baseline
+	driftBaselineSize = 10
+)
+
+func (s *AnalysisService) DetectDrift(ctx context.Context, req *pb.DetectDriftRequest) (*pb.DriftResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	key := req.QuestionId
+	if key == "" {
+		key = totalScoreKey
+	}
+
+	resp := &pb.DriftResponse{
+		AssignmentId:      scope.assignmentID,
+		RubricId:          scope.rubricID,
+		QuestionId:        key,
+		EwmaLambda:        ewmaLambda,
+		ControlLimitWidth: controlLimitWidth,
+		CusumK:            cusumK,
+		CusumH:            cusumH,
+		RubricVersionId:   scope.rubricVersionID,
+		ExcludedGrades:    excluded,
+	}
+	resp.Course, resp.Graders, resp.Anomalies = detectDrift(records, key)
+
+	return resp, nil
+}
+
+// detectDrift charts scores for a key in grading order for the whole course
+// and for each TA, returning drift anomalies for series that go out of control.
+func detectDrift(records []gradeRecord, key string) (*pb.DriftSeries, []*pb.DriftSeries, []*pb.Anomaly) {
+	course := driftSeries(records, key)
+	course.Scope = "course"
+
+	byGrader := make(map[int64][]gradeRecord)
+	var graderIDs []int64
+	for _, record := range records {
+		if _, seen := byGrader[record.graderID]; !seen {
+			graderIDs = append(graderIDs, record.graderID)
+		}
+		byGrader[record.graderID] = append(byGrader[record.graderID], record)
+	}
+	sort.Slice(graderIDs, func(i, j int) bool { return graderIDs[i] < graderIDs[j] })
+
+	var graders []*pb.DriftSeries
+	for _, graderID := range graderIDs {
+		series := driftSeries(byGrader[graderID], key)
+		series.Scope = "ta"
+		series.GraderId = graderID
+		series.GraderName = byGrader[graderID][0].graderName
+		graders = append(graders, series)
+	}
+
+	var anomalies []*pb.Anomaly
+	for _, series := range append([]*pb.DriftSeries{course}, graders...) {
+		if anomaly := driftAnomaly(series, key); anomaly != nil {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	return course, graders, anomalies
+}
+
+// driftSeries builds EWMA and CUSUM charts for records, which must already be
+// in grading order. Charts are only drawn once there are enough grades to
+// estimate a baseline with some variation.
+func driftSeries(records []gradeRecord, key string) *pb.DriftSeries {
+	series := &pb.DriftSeries{}
+	var values []float64
+	for _, record := range records {
+		value, ok := scoreFor(record, key)
+		if !ok {
+			continue
+		}
+		values = append(values, value)
+		series.Points = append(series.Points, &pb.DriftPoint{
+			Sequence: int32(len(values)),
+			GradeId:  record.id,
+			GradedAt: timestamppb.New(record.gradedAt),
+			Score:    value,
+		})
+	}
+	if len(values) < minDriftSeries {
+		return series
+	}
+
+	baselineSize := driftBaselineSize
+	if len(values)/2 < baselineSize {
+		baselineSize = len(values) / 2
+	}
+	baseline := values[:baselineSize]
+	sigma := statistics.StdDev(baseline)
+	if sigma == 0 {
+		// A perfectly consistent start gives no scale, so fall back to the whole series
+		sigma = statistics.StdDev(values)
+	}
+	if sigma == 0 {
+		return series
+	}
+
+	series.BaselineMean = statistics.Mean(baseline)
+	series.BaselineStdDev = sigma
+	series.BaselineSize = int32(baselineSize)
+
+	ewma := statistics.EWMAChart(values, series.BaselineMean, sigma, ewmaLambda, controlLimitWidth)
+	cusum := statistics.CUSUMChart(values, series.BaselineMean, sigma, cusumK, cusumH)
+	for i, point := range series.Points {
+		point.Ewma = ewma[i].Value
+		point.EwmaLower = ewma[i].Lower
+		point.EwmaUpper = ewma[i].Upper
+		point.CusumUpper = cusum[i].Upper
+		point.CusumLower = cusum[i].Lower
+		point.OutOfControl = ewma[i].OutOfControl || cusum[i].OutOfControl
+	}
+
+	return series
+}
+
+// driftAnomaly reports the first out-of-control point of a series, with
+// severity based on how far scores after it moved from the baseline.
+func driftAnomaly(series *pb.DriftSeries, key string) *pb.Anomaly {
+	if series.BaselineStdDev == 0 {
+		return nil
+	}
+
+	first := -1
+	var charts []string
+	for i, point := range series.Points {
+		if !point.OutOfControl {
+			continue
+		}
+		first = i
+		if point.Ewma < point.EwmaLower || point.Ewma > point.EwmaUpper {
+			charts = append(charts, "ewma")
+		}
+		if point.CusumUpper > cusumH || point.CusumLower > cusumH {
+			charts = append(charts, "cusum")
+		}
+		break
+	}
+	if first < 0 {
+		return nil
+	}
+
+	var recent []float64
+	for _, point := range series.Points[first:] {
+		recent = append(recent, point.Score)
+	}
+	recentMean := statistics.Mean(recent)
+	shift := (recentMean - series.BaselineMean) / series.BaselineStdDev
+
+	direction := "lenient"
+	if shift < 0 {
+		direction = "harsh"
+	}
+
+	severity := "low"
+	switch {
+	case math.Abs(shift) >= 1.5:
+		severity = "high"
+	case math.Abs(shift) >= 1:
+		severity = "medium"
+	}
+
+	signal := series.Points[first]
+	details := map[string]string{
+		"scope":         series.Scope,
+		"charts":        strings.Join(charts, ","),
+		"after_grades":  strconv.Itoa(first),
+		"baseline_mean": formatStat(series.BaselineMean),
+		"recent_mean":   formatStat(recentMean),
+		"shift_sigma":   formatStat(shift),
+		"direction":     direction,
+		"signaled_at":   signal.GradedAt.AsTime().Format(time.RFC3339),
+	}
+	if series.GraderId != 0 {
+		details["grader_id"] = strconv.FormatInt(series.GraderId, 10)
+		details["grader_name"] = series.GraderName
+	}
+
+	return &pb.Anomaly{
+		Type:       "grading_drift",
+		QuestionId: key,
+		Severity:   severity,
+		Details:    details,
+		GradeId:    signal.GradeId,
+	}
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+)
+
+// testCourse is a course seeded into a fresh database: an instructor, a TA,
+// a three-criterion rubric and one assignment graded with it
+type testCourse struct {
+	db           *database.Database
+	courseID     int64
+	instructorID int64
+	taID         int64
+	rubricID     int64
+	assignmentID int64
+}
+
+func newTestCourse(t *testing.T) *testCourse {
+	t.Helper()
+	db, err := database.Open(filepath.Join(t.TempDir(), "talytics.db"))
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	course := &testCourse{db: db}
+	course.instructorID = course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('prof@example.edu', 'Prof', '', 'instructor')")
+	course.taID = course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('ta@example.edu', 'TA', '', 'ta')")
+	course.courseID = course.insert(t, "INSERT INTO courses (name, code, join_code, instructor_id) VALUES ('Course', 'C101', 'JOIN', ?)", course.instructorID)
+	course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'instructor')", course.courseID, course.instructorID)
+	course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'ta')", course.courseID, course.taID)
+
+	rubric, err := NewRubricService(db).CreateRubric(course.ctx(course.instructorID), &pb.CreateRubricRequest{
+		Name:     "Rubric",
+		CourseId: course.courseID,
+		Criteria: []string{"Correctness", "Style", "Tests"},
+		Weights:  []float64{50, 30, 20},
+	})
+	if err != nil {
+		t.Fatalf("create rubric: %v", err)
+	}
+	course.rubricID = rubric.Rubric.Id
+	course.assignmentID = course.insert(t, "INSERT INTO assignments (course_id, name, rubric_id, created_by) VALUES (?, 'Homework', ?, ?)",
+		course.courseID, course.rubricID, course.instructorID)
+	return course
+}
+
+// ctx returns a request context authenticated as the user, with their account role
+func (c *testCourse) ctx(userID int64) context.Context {
+	var role string
+	if err := c.db.DB.QueryRow("SELECT role FROM users WHERE id = ?", userID).Scan(&role); err != nil {
+		panic(err)
+	}
+	ctx := context.WithValue(context.Background(), "user_id", userID)
+	return context.WithValue(ctx, "user_role", role)
+}
+
+func (c *testCourse) insert(t *testing.T, query string, args ...interface{}) int64 {
+	t.Helper()
+	result, err := c.db.DB.Exec(query, args...)
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return id
+}
+
+// addSubmission adds a submission by the student to the course's assignment
+func (c *testCourse) addSubmission(t *testing.T, studentID string) int64 {
+	t.Helper()
+	return c.insert(t, "INSERT INTO submissions (assignment_id, student_id, student_name, file_path, file_name) VALUES (?, ?, ?, '', 'work.pdf')",
+		c.assignmentID, studentID, studentID)
+}
+
+func (c *testCourse) count(t *testing.T, query string, args ...interface{}) int {
+	t.Helper()
+	var count int
+	if err := c.db.DB.QueryRow(query, args...).Scan(&count); err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return count
+}
@@ -0,0 +1,679 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrGradeNotFound is returned when a submission has not been graded yet.
+var ErrGradeNotFound = errors.New("grade not found")
+
+// ErrAssignmentNotFound is returned when a request names an assignment that does not exist
+var ErrAssignmentNotFound = errors.New("assignment not found")
+
+// ErrSubmissionNotFound is returned when a grade names a submission that is
+// not part of its assignment
+var ErrSubmissionNotFound = errors.New("submission not found for this assignment")
+
+// ErrGradeVersionConflict is matched by GradeConflictError
+var ErrGradeVersionConflict = errors.New("grade was changed by someone else")
+
+// GradeConflictError is returned when a grade update starts from an outdated
+// version. Current is the grade as it is now stored.
+type GradeConflictError struct {
+	Current *pb.RubricGrade
+}
+
+func (e *GradeConflictError) Error() string {
+	return fmt.Sprintf("%v: current version is %d", ErrGradeVersionConflict, e.Current.Version)
+}
+
+func (e *GradeConflictError) Is(target error) bool {
+	return target == ErrGradeVersionConflict
+}
+
+// GRPCStatus reports conflicts as ABORTED with the current grade attached
+func (e *GradeConflictError) GRPCStatus() *status.Status {
+	st := status.New(codes.Aborted, e.Error())
+	if detailed, err := st.WithDetails(e.Current); err == nil {
+		return detailed
+	}
+	return st
+}
+
+type GradeService struct {
+	pb.UnimplementedGradeServiceServer
+	db *database.Database
+}
+
+func NewGradeService(db *database.Database) *GradeService {
+	return &GradeService{db: db}
+}
+
+// Shared SELECT for reading a grade together with its submission, grader and assignment
+const gradeSelectQuery = `
+	SELECT g.id, g.assignment_id, g.submission_id, g.student_id, g.grader_id, u.name,
+	       g.rubric_scores, g.total_score, g.needs_regrading, g.version, g.criterion_feedback, g.feedback,
+	       g.graded_at, g.updated_at,
+	       s.student_name, s.file_name, a.name,
+	       COALESCE(g.rubric_version_id, 0), COALESCE(rv.version, 0), ` + gradeRubricOutdated + `
+	FROM grades g
+	LEFT JOIN users u ON g.grader_id = u.id
+	LEFT JOIN submissions s ON g.submission_id = s.id
+	LEFT JOIN assignments a ON g.assignment_id = a.id
+	LEFT JOIN rubric_versions rv ON g.rubric_version_id = rv.id
+	LEFT JOIN rubrics r ON a.rubric_id = r.id
+`
+
+// gradeRubricOutdated is true for a grade scored against an earlier version
+// of its assignment's rubric. It needs the g, a and r aliases of
+// gradeSelectQuery.
+const gradeRubricOutdated = `(g.rubric_version_id IS NOT NULL AND r.current_version_id IS NOT NULL AND g.rubric_version_id != r.current_version_id)`
+
+// gradeVisibleToGrader hides other graders' grades on submissions still
+// waiting in the caller's queue, so overlap grades are given independently.
+// It takes the caller's user ID twice.
+const gradeVisibleToGrader = `(g.grader_id = ? OR g.submission_id NOT IN (
+	SELECT ga.submission_id FROM grading_assignments ga
+	WHERE ga.grader_id = ?
+	  AND NOT EXISTS (SELECT 1 FROM grades own WHERE own.submission_id = ga.submission_id AND own.grader_id = ga.grader_id)
+))`
+
+func (s *GradeService) SubmitGrade(ctx context.Context, req *pb.SubmitGradeRequest) (*pb.SubmitGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	studentID, err := checkCanGrade(s.db, req.AssignmentId, req.SubmissionId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scores are checked against the rubric and the total is always computed
+	// here so stored grades cannot drift from the rubric
+	validator, err := newRubricScoreValidator(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	rubricScores := req.RubricScores
+	if rubricScores == nil {
+		rubricScores = map[string]float64{}
+	}
+	totalScore, err := validator.validate(rubricScores)
+	if err != nil {
+		return nil, err
+	}
+	rubricScoresJSON, err := json.Marshal(rubricScores)
+	if err != nil {
+		return nil, err
+	}
+	criterionFeedback, err := validator.validateFeedback(req.CriterionFeedback, req.Feedback)
+	if err != nil {
+		return nil, err
+	}
+	criterionFeedbackJSON, err := json.Marshal(criterionFeedback)
+	if err != nil {
+		return nil, err
+	}
+	feedback := strings.TrimSpace(req.Feedback)
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Instructors' corrections land on the authoritative grade, under the
+	// same version check
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	gradeFilter, gradeArgs := gradeEditFilter(checkCourseInstructor(s.db, courseID, userID) == nil, req.SubmissionId, userID)
+	gradeQuery := "SELECT id, rubric_scores, total_score, version FROM grades WHERE " + gradeFilter
+
+	var gradeID int64
+	var previous *gradeSnapshot
+	created := false
+	var existingJSON string
+	var existingTotal float64
+	var version int32
+	err = tx.QueryRow(gradeQuery, gradeArgs...).Scan(&gradeID, &existingJSON, &existingTotal, &version)
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`
+			INSERT INTO grades (assignment_id, submission_id, student_id, grader_id, rubric_scores, total_score, criterion_feedback, feedback, rubric_version_id, needs_regrading, graded_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, req.AssignmentId, req.SubmissionId, studentID, userID, string(rubricScoresJSON), totalScore, string(criterionFeedbackJSON), feedback, validator.versionID)
+		if err != nil {
+			return nil, err
+		}
+
+		gradeID, err = result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		created = true
+	} else if err != nil {
+		return nil, err
+	} else {
+		if req.ExpectedVersion != version {
+			return nil, gradeConflict(s.db, gradeID, userID)
+		}
+
+		previous = &gradeSnapshot{totalScore: existingTotal}
+		if err := json.Unmarshal([]byte(existingJSON), &previous.rubricScores); err != nil {
+			return nil, err
+		}
+
+		// Update existing grade, clear needs_regrading and pin it to the rubric
+		// version it was just scored against. The version check is repeated in
+		// the WHERE clause so concurrent saves cannot both succeed.
+		result, err := tx.Exec(`
+			UPDATE grades SET rubric_scores = ?, total_score = ?, criterion_feedback = ?, feedback = ?, rubric_version_id = ?,
+			       needs_regrading = 0, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND version = ?
+		`, string(rubricScoresJSON), totalScore, string(criterionFeedbackJSON), feedback, validator.versionID, gradeID, version)
+		if err != nil {
+			return nil, err
+		}
+		if updated, err := result.RowsAffected(); err != nil {
+			return nil, err
+		} else if updated == 0 {
+			return nil, gradeConflict(s.db, gradeID, userID)
+		}
+	}
+
+	current := gradeSnapshot{rubricScores: rubricScores, totalScore: totalScore}
+	if _, err := recordGradeRevision(tx, gradeID, userID, revisionSourceSubmit, previous, current, req.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	grade, err := s.getGradeByID(gradeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).grade(grade); err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitGradeResponse{
+		Grade:   grade,
+		Created: created,
+		Message: "Grade submitted successfully",
+	}, nil
+}
+
+func (s *GradeService) GetSubmissionGrade(ctx context.Context, req *pb.GetSubmissionGradeRequest) (*pb.SubmissionGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	var courseID int64
+	err := s.db.DB.QueryRow(`
+		SELECT a.course_id FROM submissions s
+		JOIN assignments a ON s.assignment_id = a.id
+		WHERE s.id = ?
+	`, req.SubmissionId).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return nil, ErrGradeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	isInstructor := checkCourseInstructor(s.db, courseID, userID) == nil
+
+	rows, err := s.db.DB.Query(gradeSelectQuery+`
+		WHERE g.submission_id = ? AND (? OR `+gradeVisibleToGrader+`)
+		ORDER BY g.graded_at ASC, g.id ASC
+	`, req.SubmissionId, isInstructor, userID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.SubmissionGradeResponse{}
+	for rows.Next() {
+		grade, err := scanGrade(rows)
+		if err != nil {
+			return nil, err
+		}
+		resp.Grades = append(resp.Grades, grade)
+
+		// Prefer the caller's own grade, otherwise the first one given
+		if resp.Grade == nil || grade.GraderId == userID {
+			resp.Grade = grade
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if resp.Grade == nil {
+		return nil, ErrGradeNotFound
+	}
+
+	for _, grade := range resp.Grades {
+		if grade.AppliedComments, err = loadAppliedComments(s.db, grade.Id); err != nil {
+			return nil, err
+		}
+	}
+	if err := newBlindMask(s.db, userID).grades(resp.Grades); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *GradeService) ListAssignmentGrades(ctx context.Context, req *pb.ListAssignmentGradesRequest) (*pb.ListGradesResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	isInstructor := checkCourseInstructor(s.db, courseID, userID) == nil
+
+	rows, err := s.db.DB.Query(gradeSelectQuery+`
+		WHERE g.assignment_id = ? AND (? OR `+gradeVisibleToGrader+`)
+		ORDER BY g.graded_at DESC
+	`, req.AssignmentId, isInstructor, userID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grades []*pb.RubricGrade
+	for rows.Next() {
+		grade, err := scanGrade(rows)
+		if err != nil {
+			return nil, err
+		}
+		grades = append(grades, grade)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).grades(grades); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListGradesResponse{
+		Grades: grades,
+	}, nil
+}
+
+// ListRegradeQueue returns the caller's grades that were flagged by a rubric
+// change or were scored against an earlier version of the rubric
+func (s *GradeService) ListRegradeQueue(ctx context.Context, req *pb.ListRegradeQueueRequest) (*pb.ListGradesResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	rows, err := s.db.DB.Query(gradeSelectQuery+`
+		WHERE g.grader_id = ? AND (g.needs_regrading = 1 OR `+gradeRubricOutdated+`)
+		ORDER BY g.updated_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grades []*pb.RubricGrade
+	for rows.Next() {
+		grade, err := scanGrade(rows)
+		if err != nil {
+			return nil, err
+		}
+		grades = append(grades, grade)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).grades(grades); err != nil {
+		return nil, err
+	}
+
+	return &pb.ListGradesResponse{
+		Grades: grades,
+	}, nil
+}
+
+// GetGradeStats summarizes scores per grader and rubric criterion across every
+// assignment that uses the rubric.
+func (s *GradeService) GetGradeStats(ctx context.Context, req *pb.GetGradeStatsRequest) (*pb.GetGradeStatsResponse, error) {
+	rubricScores, err := s.getRubricScores(ctx, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	scores, weights := rubricScores.scores, rubricScores.weights
+
+	var stats []*pb.GradeStat
+	for _, key := range sortedScoreKeys(scores) {
+		values := scores[key]
+		stat := &pb.GradeStat{
+			TaId:       key.graderID,
+			QuestionId: key.criterion,
+			Count:      int32(len(values)),
+			MinScore:   values[0],
+			MaxScore:   values[0],
+		}
+
+		var sum float64
+		for _, value := range values {
+			sum += value
+			if value < stat.MinScore {
+				stat.MinScore = value
+			}
+			if value > stat.MaxScore {
+				stat.MaxScore = value
+			}
+		}
+		stat.AvgScore = sum / float64(len(values))
+
+		if idx, err := strconv.Atoi(key.criterion); err == nil && idx >= 0 && idx < len(weights) && weights[idx] > 0 {
+			stat.AvgPercentage = stat.AvgScore / weights[idx] * 100
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return &pb.GetGradeStatsResponse{
+		Stats:           stats,
+		RubricVersionId: rubricScores.versionID,
+		ExcludedGrades:  rubricScores.excluded,
+	}, nil
+}
+
+// GetGradeDistribution returns the raw criterion scores for every grader so
+// clients can plot how each TA's scores are distributed.
+func (s *GradeService) GetGradeDistribution(ctx context.Context, req *pb.GetGradeDistributionRequest) (*pb.GetGradeDistributionResponse, error) {
+	rubricScores, err := s.getRubricScores(ctx, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	scores := rubricScores.scores
+
+	distributionsByQuestion := make(map[string]*pb.QuestionDistribution)
+	var distributions []*pb.QuestionDistribution
+	for _, key := range sortedScoreKeys(scores) {
+		distribution, exists := distributionsByQuestion[key.criterion]
+		if !exists {
+			distribution = &pb.QuestionDistribution{
+				QuestionId:      key.criterion,
+				TaDistributions: make(map[string]*pb.TADistribution),
+			}
+			distributionsByQuestion[key.criterion] = distribution
+			distributions = append(distributions, distribution)
+		}
+		distribution.TaDistributions[key.graderID] = &pb.TADistribution{Scores: scores[key]}
+	}
+
+	sort.Slice(distributions, func(i, j int) bool {
+		return criterionLess(distributions[i].QuestionId, distributions[j].QuestionId)
+	})
+
+	return &pb.GetGradeDistributionResponse{
+		Distributions:   distributions,
+		RubricVersionId: rubricScores.versionID,
+		ExcludedGrades:  rubricScores.excluded,
+	}, nil
+}
+
+type graderCriterionKey struct {
+	graderID  string
+	criterion string
+}
+
+// rubricScores are the criterion scores per grader on one version of a rubric
+type rubricScores struct {
+	scores    map[graderCriterionKey][]float64
+	weights   []float64
+	versionID int64
+	// Grades scored against other versions of the rubric, left out
+	excluded int32
+}
+
+// getRubricScores collects criterion scores per grader for all assignments
+// using a rubric, from the grades on the version the analyses would pick
+func (s *GradeService) getRubricScores(ctx context.Context, rubricID, versionID int64) (*rubricScores, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := NewAnalysisService(s.db).resolveScope(0, rubricID, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	_, weights, err := getRubricVersionCriteria(s.db, scope.rubricVersionID)
+	if err != nil {
+		return nil, err
+	}
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rubricScores{
+		scores:    make(map[graderCriterionKey][]float64),
+		weights:   weights,
+		versionID: scope.rubricVersionID,
+		excluded:  excluded,
+	}
+	for _, record := range records {
+		for criterion, score := range record.rubricScores {
+			key := graderCriterionKey{graderID: strconv.FormatInt(record.graderID, 10), criterion: criterion}
+			result.scores[key] = append(result.scores[key], score)
+		}
+	}
+	return result, nil
+}
+
+func sortedScoreKeys(scores map[graderCriterionKey][]float64) []graderCriterionKey {
+	keys := make([]graderCriterionKey, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].criterion != keys[j].criterion {
+			return criterionLess(keys[i].criterion, keys[j].criterion)
+		}
+		return keys[i].graderID < keys[j].graderID
+	})
+	return keys
+}
+
+// criterionLess orders criterion keys numerically when possible
+func criterionLess(a, b string) bool {
+	ai, errA := strconv.Atoi(a)
+	bi, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return ai < bi
+	}
+	return a < b
+}
+
+// resolveCriterion maps an uploaded question_id to a rubric criterion index.
+// It accepts a zero-based index ("0"), a one-based question label ("q1") or
+// the criterion name itself.
+func resolveCriterion(questionID string, criteria []string) (int, error) {
+	questionID = strings.TrimSpace(questionID)
+	if questionID == "" {
+		return 0, errors.New("question_id is required")
+	}
+
+	if idx, err := strconv.Atoi(questionID); err == nil {
+		if idx < 0 || idx >= len(criteria) {
+			return 0, fmt.Errorf("question_id %s is out of range for a rubric with %d criteria", questionID, len(criteria))
+		}
+		return idx, nil
+	}
+
+	lower := strings.ToLower(questionID)
+	if strings.HasPrefix(lower, "q") {
+		if n, err := strconv.Atoi(lower[1:]); err == nil {
+			if n < 1 || n > len(criteria) {
+				return 0, fmt.Errorf("question_id %s is out of range for a rubric with %d criteria", questionID, len(criteria))
+			}
+			return n - 1, nil
+		}
+	}
+
+	for i, criterion := range criteria {
+		if strings.EqualFold(strings.TrimSpace(criterion), questionID) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("question_id %s does not match any rubric criterion", questionID)
+}
+
+// checkCanGrade checks the submission belongs to the assignment and the user
+// may grade it, returning the submission's student ID
+func checkCanGrade(db *database.Database, assignmentID, submissionID, userID int64) (string, error) {
+	var courseID int64
+	var studentID string
+	err := db.DB.QueryRow(`
+		SELECT a.course_id, s.student_id
+		FROM submissions s
+		JOIN assignments a ON s.assignment_id = a.id
+		WHERE s.id = ? AND s.assignment_id = ?
+	`, submissionID, assignmentID).Scan(&courseID, &studentID)
+	if err == sql.ErrNoRows {
+		return "", ErrSubmissionNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := checkCourseMembership(db, courseID, userID); err != nil {
+		return "", err
+	}
+	if err := checkGradingAssignment(db, courseID, assignmentID, submissionID, userID); err != nil {
+		return "", err
+	}
+	if err := checkCalibration(db, courseID, assignmentID, userID); err != nil {
+		return "", err
+	}
+	return studentID, nil
+}
+
+// gradeEditFilter is the WHERE clause picking the grade a user's edits to a
+// submission go to. Each TA keeps their own grade so overlap submissions stay
+// independent. Instructors edit the submission's authoritative grade
+// instead: the first grade given, which students see, the gradebook exports
+// and LTI passes back.
+func gradeEditFilter(instructor bool, submissionID, userID int64) (string, []interface{}) {
+	if instructor {
+		return "submission_id = ? ORDER BY graded_at ASC, id ASC LIMIT 1", []interface{}{submissionID}
+	}
+	return "submission_id = ? AND grader_id = ?", []interface{}{submissionID, userID}
+}
+
+func getAssignmentCourse(db *database.Database, assignmentID int64) (int64, int64, error) {
+	var courseID int64
+	var rubricID sql.NullInt64
+	err := db.DB.QueryRow("SELECT course_id, rubric_id FROM assignments WHERE id = ?", assignmentID).Scan(&courseID, &rubricID)
+	if err == sql.ErrNoRows {
+		return 0, 0, ErrAssignmentNotFound
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return courseID, rubricID.Int64, nil
+}
+
+func getRubricCriteria(db *database.Database, rubricID int64) ([]string, []float64, error) {
+	var criteriaJSON, weightsJSON string
+	err := db.DB.QueryRow("SELECT criteria, weights FROM rubrics WHERE id = ?", rubricID).Scan(&criteriaJSON, &weightsJSON)
+	if err == sql.ErrNoRows {
+		return nil, nil, errors.New("rubric not found")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var criteria []string
+	var weights []float64
+	if err := json.Unmarshal([]byte(criteriaJSON), &criteria); err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal([]byte(weightsJSON), &weights); err != nil {
+		return nil, nil, err
+	}
+	return criteria, weights, nil
+}
+
+func (s *GradeService) getGradeByID(gradeID int64) (*pb.RubricGrade, error) {
+	return scanGrade(s.db.DB.QueryRow(gradeSelectQuery+" WHERE g.id = ?", gradeID))
+}
+
+// gradeConflict builds the conflict error for a grade, falling back to the
+// plain sentinel if the current grade cannot be read
+func gradeConflict(db *database.Database, gradeID, userID int64) error {
+	current, err := scanGrade(db.DB.QueryRow(gradeSelectQuery+" WHERE g.id = ?", gradeID))
+	if err != nil {
+		return ErrGradeVersionConflict
+	}
+	if current.AppliedComments, err = loadAppliedComments(db, gradeID); err != nil {
+		return ErrGradeVersionConflict
+	}
+	if err := newBlindMask(db, userID).grade(current); err != nil {
+		return ErrGradeVersionConflict
+	}
+	return &GradeConflictError{Current: current}
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanGrade(row rowScanner) (*pb.RubricGrade, error) {
+	var grade pb.RubricGrade
+	var rubricScoresJSON, criterionFeedbackJSON string
+	var gradedAt, updatedAt time.Time
+	var graderName, studentName, fileName, assignmentName, feedback sql.NullString
+
+	err := row.Scan(&grade.Id, &grade.AssignmentId, &grade.SubmissionId, &grade.StudentId, &grade.GraderId, &graderName,
+		&rubricScoresJSON, &grade.TotalScore, &grade.NeedsRegrading, &grade.Version, &criterionFeedbackJSON, &feedback,
+		&gradedAt, &updatedAt, &studentName, &fileName, &assignmentName,
+		&grade.RubricVersionId, &grade.RubricVersion, &grade.RubricOutdated)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(rubricScoresJSON), &grade.RubricScores); err != nil {
+		return nil, fmt.Errorf("error parsing rubric scores: %v", err)
+	}
+	if err := json.Unmarshal([]byte(criterionFeedbackJSON), &grade.CriterionFeedback); err != nil {
+		return nil, fmt.Errorf("error parsing criterion feedback: %v", err)
+	}
+	grade.Feedback = feedback.String
+
+	grade.GradedAt = timestamppb.New(gradedAt)
+	grade.UpdatedAt = timestamppb.New(updatedAt)
+	grade.GraderName = graderName.String
+	grade.StudentName = studentName.String
+	grade.FileName = fileName.String
+	grade.AssignmentName = assignmentName.String
+
+	return &grade, nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const gradeCommentSelectQuery = `
+	SELECT c.id, c.grade_id, c.user_id, COALESCE(u.name, ''), COALESCE(u.role, ''), c.message, COALESCE(c.parent_id, 0), c.created_at
+	FROM grade_comments c
+	LEFT JOIN users u ON c.user_id = u.id
+`
+
+// ListGradeComments returns a grade's discussion thread, including the
+// justifications and decisions of its regrade requests
+func (s *GradeService) ListGradeComments(ctx context.Context, req *pb.ListGradeCommentsRequest) (*pb.ListGradeCommentsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if _, err := checkGradeAccess(s.db, req.GradeId, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(gradeCommentSelectQuery+" WHERE c.grade_id = ? ORDER BY c.created_at ASC, c.id ASC", req.GradeId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.ListGradeCommentsResponse{GradeId: req.GradeId}
+	for rows.Next() {
+		comment, err := scanGradeComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		resp.Comments = append(resp.Comments, comment)
+	}
+	return resp, rows.Err()
+}
+
+// PostGradeComment adds a comment to a grade's thread, optionally as a reply
+// to another comment on the same grade
+func (s *GradeService) PostGradeComment(ctx context.Context, req *pb.PostGradeCommentRequest) (*pb.GradeCommentResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if _, err := checkGradeAccess(s.db, req.GradeId, userID); err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		verr.add("message", "is required")
+	}
+	if req.ParentId != 0 {
+		var parents int
+		err := s.db.DB.QueryRow("SELECT COUNT(*) FROM grade_comments WHERE id = ? AND grade_id = ?", req.ParentId, req.GradeId).Scan(&parents)
+		if err != nil {
+			return nil, err
+		}
+		if parents == 0 {
+			verr.add("parent_id", "is not a comment on this grade")
+		}
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+
+	result, err := s.db.DB.Exec(`
+		INSERT INTO grade_comments (grade_id, user_id, message, parent_id)
+		VALUES (?, ?, ?, ?)
+	`, req.GradeId, userID, message, sql.NullInt64{Int64: req.ParentId, Valid: req.ParentId != 0})
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := scanGradeComment(s.db.DB.QueryRow(gradeCommentSelectQuery+" WHERE c.id = ?", commentID))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GradeCommentResponse{
+		Comment: comment,
+		Message: "Comment posted",
+	}, nil
+}
+
+func scanGradeComment(row rowScanner) (*pb.GradeComment, error) {
+	var comment pb.GradeComment
+	var createdAt time.Time
+	err := row.Scan(&comment.Id, &comment.GradeId, &comment.UserId, &comment.UserName, &comment.UserRole,
+		&comment.Message, &comment.ParentId, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	comment.CreatedAt = timestamppb.New(createdAt)
+	return &comment, nil
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestGradeCommentsStayWithinTheGradesCourse(t *testing.T) {
+	course := newTestCourse(t)
+	grades := NewGradeService(course.db)
+	first, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, course.addSubmission(t, "s1"), 0, 40, 20, 10))
+	if err != nil {
+		t.Fatalf("grade s1: %v", err)
+	}
+	second, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, course.addSubmission(t, "s2"), 0, 40, 20, 10))
+	if err != nil {
+		t.Fatalf("grade s2: %v", err)
+	}
+
+	posted, err := grades.PostGradeComment(course.ctx(course.taID), &pb.PostGradeCommentRequest{GradeId: first.Grade.Id, Message: "Checked with the key"})
+	if err != nil {
+		t.Fatalf("post comment: %v", err)
+	}
+	_, err = grades.PostGradeComment(course.ctx(course.instructorID), &pb.PostGradeCommentRequest{
+		GradeId: second.Grade.Id, Message: "Reply", ParentId: posted.Comment.Id,
+	})
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("replying to another grade's comment: err = %v, want a validation error", err)
+	}
+
+	// Staff of another course can neither read nor post
+	outsiderID := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('other@example.edu', 'Other', '', 'instructor')")
+	otherCourse := course.insert(t, "INSERT INTO courses (name, code, join_code, instructor_id) VALUES ('Other', 'O101', 'OTHER', ?)", outsiderID)
+	course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'instructor')", otherCourse, outsiderID)
+	if _, err := grades.ListGradeComments(course.ctx(outsiderID), &pb.ListGradeCommentsRequest{GradeId: first.Grade.Id}); !errors.Is(err, ErrNotCourseStaff) {
+		t.Fatalf("outsider reading comments: err = %v, want ErrNotCourseStaff", err)
+	}
+	_, err = grades.PostGradeComment(course.ctx(outsiderID), &pb.PostGradeCommentRequest{GradeId: first.Grade.Id, Message: "Hi"})
+	if !errors.Is(err, ErrNotCourseStaff) {
+		t.Fatalf("outsider posting a comment: err = %v, want ErrNotCourseStaff", err)
+	}
+
+	thread, err := grades.ListGradeComments(course.ctx(course.instructorID), &pb.ListGradeCommentsRequest{GradeId: first.Grade.Id})
+	if err != nil {
+		t.Fatalf("list comments: %v", err)
+	}
+	if len(thread.Comments) != 1 || thread.Comments[0].Message != "Checked with the key" {
+		t.Fatalf("thread has %v, want the TA's comment only", thread.Comments)
+	}
+}
@@ -0,0 +1,639 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+)
+
+const (
+	// maxImportRows caps the data rows of a single import
+	maxImportRows = 50000
+	// maxImportRowErrors caps the row errors reported; validation carries on
+	// past it so rows_read stays accurate
+	maxImportRowErrors = 500
+)
+
+// importColumns are the CSV columns an import understands; the rest are ignored
+var importColumns = []string{"student_id", "ta_id", "question_id", "score", "max_score", "feedback"}
+
+// gradeImport validates the rows of a grade import and applies them as one
+// transaction. Rows for the same submission and grader are merged into a
+// single grade, on top of any grade that grader already gave.
+type gradeImport struct {
+	db           *database.Database
+	assignmentID int64
+	courseID     int64
+	userID       int64
+	isInstructor bool
+	versionID    int64
+	criteria     []string
+	weights      []float64
+	levels       [][]*pb.RubricLevel
+
+	staff       []importStaff
+	submissions map[string]importSubmission
+	grades      map[importKey]*importedGrade
+	order       []importKey
+	cells       map[importCell]int32
+
+	rowsRead  int32
+	rowErrors []*pb.GradeImportRowError
+	errCount  int
+}
+
+type importStaff struct {
+	id    int64
+	email string
+	name  string
+}
+
+type importSubmission struct {
+	id        int64
+	studentID string
+	err       string
+}
+
+type importKey struct {
+	submissionID int64
+	graderID     int64
+}
+
+// importCell identifies one score so duplicates within an import are caught
+type importCell struct {
+	importKey
+	criterion int
+}
+
+type importedGrade struct {
+	studentID         string
+	rubricScores      map[string]float64
+	criterionFeedback map[string]string
+}
+
+func newGradeImport(db *database.Database, assignmentID, rubricID, userID int64) (*gradeImport, error) {
+	courseID, assignmentRubricID, err := getAssignmentCourse(db, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(db, courseID, userID); err != nil {
+		return nil, err
+	}
+	if assignmentRubricID == 0 {
+		return nil, errors.New("assignment does not have a rubric")
+	}
+	if rubricID != 0 && rubricID != assignmentRubricID {
+		return nil, errors.New("rubric does not match the assignment's rubric")
+	}
+
+	isInstructor := checkCourseInstructor(db, courseID, userID) == nil
+	if !isInstructor {
+		if err := checkCalibration(db, courseID, assignmentID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	versionID, err := currentRubricVersionID(db, assignmentRubricID)
+	if err != nil {
+		return nil, err
+	}
+	criteria, weights, err := getRubricVersionCriteria(db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := criterionLevels(db, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	imp := &gradeImport{
+		db:           db,
+		assignmentID: assignmentID,
+		courseID:     courseID,
+		userID:       userID,
+		isInstructor: isInstructor,
+		versionID:    versionID,
+		criteria:     criteria,
+		weights:      weights,
+		levels:       levels,
+		submissions:  make(map[string]importSubmission),
+		grades:       make(map[importKey]*importedGrade),
+		cells:        make(map[importCell]int32),
+	}
+	if imp.staff, err = loadImportStaff(db, courseID); err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+func loadImportStaff(db *database.Database, courseID int64) ([]importStaff, error) {
+	rows, err := db.DB.Query(`
+		SELECT u.id, u.email, u.name
+		FROM course_members cm
+		JOIN users u ON cm.user_id = u.id
+		WHERE cm.course_id = ? AND cm.role IN ('instructor', 'ta')
+	`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var staff []importStaff
+	for rows.Next() {
+		var member importStaff
+		if err := rows.Scan(&member.id, &member.email, &member.name); err != nil {
+			return nil, err
+		}
+		staff = append(staff, member)
+	}
+	return staff, rows.Err()
+}
+
+func (imp *gradeImport) rowError(row int32, column, format string, args ...interface{}) {
+	imp.errCount++
+	if len(imp.rowErrors) < maxImportRowErrors {
+		imp.rowErrors = append(imp.rowErrors, &pb.GradeImportRowError{
+			Row:     row,
+			Column:  column,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+}
+
+// add validates one row, recording any problems against it. score and
+// maxScore are the row's unparsed cells; maxScore may be empty.
+func (imp *gradeImport) add(row int32, data *pb.GradeData, score, maxScore string) error {
+	imp.rowsRead++
+	if imp.rowsRead > maxImportRows {
+		return fmt.Errorf("imports are limited to %d rows", maxImportRows)
+	}
+	errorsBefore := imp.errCount
+
+	var submission importSubmission
+	studentID := strings.TrimSpace(data.StudentId)
+	if studentID == "" {
+		imp.rowError(row, "student_id", "student_id is required")
+	} else {
+		var err error
+		if submission, err = imp.submission(studentID); err != nil {
+			return err
+		}
+		if submission.err != "" {
+			imp.rowError(row, "student_id", "%s", submission.err)
+		}
+	}
+
+	graderID, err := imp.grader(strings.TrimSpace(data.TaId))
+	if err != nil {
+		imp.rowError(row, "ta_id", "%v", err)
+	}
+
+	criterionIdx, err := resolveCriterion(data.QuestionId, imp.criteria)
+	criterionOK := err == nil
+	if !criterionOK {
+		imp.rowError(row, "question_id", "%v", err)
+	}
+
+	value, err := parseImportNumber(score)
+	if err != nil {
+		imp.rowError(row, "score", "%v", err)
+	}
+	var scale float64
+	if maxScore != "" {
+		if scale, err = parseImportNumber(maxScore); err != nil {
+			imp.rowError(row, "max_score", "%v", err)
+		} else if scale <= 0 {
+			imp.rowError(row, "max_score", "max_score must be greater than 0")
+		}
+	}
+	if criterionOK && imp.errCount == errorsBefore {
+		// Rescale scores reported on a different point scale to the criterion's weight
+		weight := imp.weights[criterionIdx]
+		if scale > 0 && scale != weight {
+			value = value / scale * weight
+		}
+		if value < 0 || value > weight {
+			imp.rowError(row, "score", "score %g is outside 0 to %g", value, weight)
+		} else if criterionIdx < len(imp.levels) && !matchesLevel(imp.levels[criterionIdx], value) {
+			imp.rowError(row, "score", "score %g does not match a performance level; expected %s", value, describeLevels(imp.levels[criterionIdx]))
+		}
+	}
+
+	feedback := strings.TrimSpace(data.Feedback)
+	if utf8.RuneCountInString(feedback) > maxFeedbackLength {
+		imp.rowError(row, "feedback", "feedback is longer than %d characters", maxFeedbackLength)
+	}
+
+	if imp.errCount != errorsBefore {
+		return nil
+	}
+
+	// TAs can only import into submissions they could grade by hand
+	if !imp.isInstructor {
+		if err := checkGradingAssignment(imp.db, imp.courseID, imp.assignmentID, submission.id, imp.userID); err != nil {
+			imp.rowError(row, "student_id", "%v", err)
+			return nil
+		}
+	}
+
+	key := importKey{submissionID: submission.id, graderID: graderID}
+	cell := importCell{importKey: key, criterion: criterionIdx}
+	if first, exists := imp.cells[cell]; exists {
+		imp.rowError(row, "question_id", "duplicates row %d for the same student, grader and question", first)
+		return nil
+	}
+	imp.cells[cell] = row
+
+	grade, exists := imp.grades[key]
+	if !exists {
+		grade = &importedGrade{
+			studentID:         submission.studentID,
+			rubricScores:      make(map[string]float64),
+			criterionFeedback: make(map[string]string),
+		}
+		imp.grades[key] = grade
+		imp.order = append(imp.order, key)
+	}
+	criterionKey := strconv.Itoa(criterionIdx)
+	grade.rubricScores[criterionKey] = value
+	if feedback != "" {
+		grade.criterionFeedback[criterionKey] = feedback
+	}
+	return nil
+}
+
+// submission resolves a student ID, or a submission's blind token, to the
+// student's latest submission for the assignment
+func (imp *gradeImport) submission(studentID string) (importSubmission, error) {
+	if submission, ok := imp.submissions[studentID]; ok {
+		return submission, nil
+	}
+
+	var submission importSubmission
+	err := imp.db.DB.QueryRow(`
+		SELECT id, student_id FROM submissions
+		WHERE assignment_id = ? AND (student_id = ? OR blind_token = ?)
+		ORDER BY uploaded_at DESC, id DESC
+		LIMIT 1
+	`, imp.assignmentID, studentID, studentID).Scan(&submission.id, &submission.studentID)
+	if err == sql.ErrNoRows {
+		submission.err = fmt.Sprintf("no submission found for student %s", studentID)
+	} else if err != nil {
+		return submission, err
+	}
+
+	imp.submissions[studentID] = submission
+	return submission, nil
+}
+
+// grader resolves a ta_id to a member of the course staff
+func (imp *gradeImport) grader(taID string) (int64, error) {
+	if taID == "" {
+		return imp.userID, nil
+	}
+
+	var matches []int64
+	id, idErr := strconv.ParseInt(taID, 10, 64)
+	for _, member := range imp.staff {
+		localPart := member.email
+		if at := strings.Index(localPart, "@"); at >= 0 {
+			localPart = localPart[:at]
+		}
+		if (idErr == nil && member.id == id) || strings.EqualFold(member.email, taID) ||
+			strings.EqualFold(member.name, taID) || strings.EqualFold(localPart, taID) {
+			matches = append(matches, member.id)
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		return 0, fmt.Errorf("ta_id %s is not a TA or instructor of this course", taID)
+	case len(matches) > 1:
+		return 0, fmt.Errorf("ta_id %s matches more than one member of the course staff", taID)
+	case matches[0] != imp.userID && !imp.isInstructor:
+		return 0, errors.New("only instructors can import grades for other graders")
+	}
+	return matches[0], nil
+}
+
+func parseImportNumber(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0, errors.New("a number is required")
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%q is not a number", text)
+	}
+	return value, nil
+}
+
+// apply writes the imported grades in one transaction unless the import is a
+// dry run or has errors; either way it reports the grades that would be
+// created and updated
+func (imp *gradeImport) apply(dryRun bool) (*pb.UploadGradesResponse, error) {
+	resp := &pb.UploadGradesResponse{
+		DryRun:    dryRun,
+		RowsRead:  imp.rowsRead,
+		RowErrors: imp.rowErrors,
+	}
+	for _, rowError := range imp.rowErrors {
+		resp.Errors = append(resp.Errors, fmt.Sprintf("row %d: %s: %s", rowError.Row, rowError.Column, rowError.Message))
+	}
+
+	tx, err := imp.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, key := range imp.order {
+		created, err := imp.applyGrade(tx, key, imp.grades[key])
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			resp.GradesCreated++
+		} else {
+			resp.GradesUpdated++
+		}
+	}
+
+	switch {
+	case imp.errCount > 0:
+		resp.Message = fmt.Sprintf("Found %d errors in %d rows; nothing was imported", imp.errCount, imp.rowsRead)
+	case dryRun:
+		resp.Message = fmt.Sprintf("Dry run: %d rows would create %d grades and update %d", imp.rowsRead, resp.GradesCreated, resp.GradesUpdated)
+	default:
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		resp.Committed = true
+		resp.TotalUploaded = resp.GradesCreated + resp.GradesUpdated
+		resp.Message = fmt.Sprintf("Imported %d rows: created %d grades and updated %d", imp.rowsRead, resp.GradesCreated, resp.GradesUpdated)
+	}
+	return resp, nil
+}
+
+// applyGrade merges one imported grade into the grader's existing grade for
+// the submission, reporting whether a new grade was created. A grade scored
+// against an earlier rubric version is replaced rather than merged, since its
+// criterion keys may refer to different criteria.
+func (imp *gradeImport) applyGrade(tx *sql.Tx, key importKey, grade *importedGrade) (bool, error) {
+	rubricScores := make(map[string]float64)
+	criterionFeedback := make(map[string]string)
+	var gradeID int64
+	var existingJSON, existingFeedbackJSON string
+	var existingTotal float64
+	var existingVersionID sql.NullInt64
+	var previous *gradeSnapshot
+
+	// Rows an instructor imports for themselves correct the authoritative grade
+	filter, args := gradeEditFilter(imp.isInstructor && key.graderID == imp.userID, key.submissionID, key.graderID)
+	err := tx.QueryRow("SELECT id, rubric_scores, total_score, criterion_feedback, rubric_version_id FROM grades WHERE "+filter, args...).
+		Scan(&gradeID, &existingJSON, &existingTotal, &existingFeedbackJSON, &existingVersionID)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+	if err == nil {
+		var existingScores map[string]float64
+		if err := json.Unmarshal([]byte(existingJSON), &existingScores); err != nil {
+			return false, fmt.Errorf("error parsing rubric scores: %v", err)
+		}
+		previous = &gradeSnapshot{rubricScores: existingScores, totalScore: existingTotal}
+		if !existingVersionID.Valid || existingVersionID.Int64 == imp.versionID {
+			for criterion, score := range existingScores {
+				rubricScores[criterion] = score
+			}
+			if err := json.Unmarshal([]byte(existingFeedbackJSON), &criterionFeedback); err != nil {
+				return false, fmt.Errorf("error parsing criterion feedback: %v", err)
+			}
+		}
+	}
+	for criterion, score := range grade.rubricScores {
+		rubricScores[criterion] = score
+	}
+	for criterion, feedback := range grade.criterionFeedback {
+		criterionFeedback[criterion] = feedback
+	}
+
+	var totalScore float64
+	for _, score := range rubricScores {
+		totalScore += score
+	}
+
+	rubricScoresJSON, err := marshalRubricScores(rubricScores)
+	if err != nil {
+		return false, err
+	}
+	criterionFeedbackJSON, err := json.Marshal(criterionFeedback)
+	if err != nil {
+		return false, err
+	}
+
+	created := gradeID == 0
+	if created {
+		var result sql.Result
+		result, err = tx.Exec(`
+			INSERT INTO grades (assignment_id, submission_id, student_id, grader_id, rubric_scores, total_score, criterion_feedback, rubric_version_id, needs_regrading, graded_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, imp.assignmentID, key.submissionID, grade.studentID, key.graderID, rubricScoresJSON, totalScore, string(criterionFeedbackJSON), imp.versionID)
+		if err == nil {
+			gradeID, err = result.LastInsertId()
+		}
+	} else {
+		_, err = tx.Exec(`
+			UPDATE grades SET rubric_scores = ?, total_score = ?, criterion_feedback = ?, rubric_version_id = ?, needs_regrading = 0, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ?
+		`, rubricScoresJSON, totalScore, string(criterionFeedbackJSON), imp.versionID, gradeID)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	current := gradeSnapshot{rubricScores: rubricScores, totalScore: totalScore}
+	if _, err := recordGradeRevision(tx, gradeID, imp.userID, revisionSourceUpload, previous, current, ""); err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+// UploadGrades imports per-question scores for an assignment. Each GradeData
+// entry is matched to the student's latest submission and its question_id is
+// resolved against the assignment's rubric criteria; entries for the same
+// student and grader are merged into a single grade.
+func (s *GradeService) UploadGrades(ctx context.Context, req *pb.UploadGradesRequest) (*pb.UploadGradesResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	imp, err := newGradeImport(s.db, req.AssignmentId, req.RubricId, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i, data := range req.Grades {
+		var maxScore string
+		if data.MaxScore != 0 {
+			maxScore = strconv.FormatFloat(data.MaxScore, 'g', -1, 64)
+		}
+		if err := imp.add(int32(i+1), data, strconv.FormatFloat(data.Score, 'g', -1, 64), maxScore); err != nil {
+			return nil, err
+		}
+	}
+	return imp.apply(req.DryRun)
+}
+
+// ImportGradesCSV imports grades from a CSV file as it is read, so large
+// files are never held in memory. The header row must name student_id,
+// question_id and score columns; ta_id, max_score and feedback are optional.
+func (s *GradeService) ImportGradesCSV(ctx context.Context, assignmentID int64, file io.Reader, dryRun bool) (*pb.UploadGradesResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	imp, err := newGradeImport(s.db, assignmentID, 0, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		verr := &ValidationError{}
+		verr.add("file", "file is empty")
+		return nil, verr
+	}
+	if err != nil {
+		return nil, importParseError(err)
+	}
+	columns, err := importHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, importParseError(err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		cell := func(column string) string {
+			if index, ok := columns[column]; ok && index < len(record) {
+				return strings.TrimSpace(record[index])
+			}
+			return ""
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+
+		data := &pb.GradeData{
+			StudentId:  cell("student_id"),
+			TaId:       cell("ta_id"),
+			QuestionId: cell("question_id"),
+			Feedback:   cell("feedback"),
+		}
+		if err := imp.add(int32(line), data, cell("score"), cell("max_score")); err != nil {
+			return nil, err
+		}
+	}
+
+	return imp.apply(dryRun)
+}
+
+// ImportGrades is the gRPC form of ImportGradesCSV, taking the file in chunks
+func (s *GradeService) ImportGrades(stream pb.GradeService_ImportGradesServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return errors.New("import stream is empty")
+	}
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.ImportGradesCSV(stream.Context(), first.AssignmentId, &chunkReader{stream: stream, buf: first.Data}, first.DryRun)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// chunkReader reads the data of a stream of import chunks
+type chunkReader struct {
+	stream pb.GradeService_ImportGradesServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// importHeader maps the known columns of a header row to their positions
+func importHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		for _, known := range importColumns {
+			if name == known {
+				if _, seen := columns[name]; !seen {
+					columns[name] = i
+				}
+			}
+		}
+	}
+
+	verr := &ValidationError{}
+	for _, required := range []string{"student_id", "question_id", "score"} {
+		if _, ok := columns[required]; !ok {
+			verr.add("header", "missing required column %s", required)
+		}
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	return columns, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// importParseError reports malformed CSV, which stops the import
+func importParseError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		verr := &ValidationError{}
+		verr.add("file", "line %d: %v", parseErr.Line, parseErr.Err)
+		return verr
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImportGradesCSVIsAllOrNothing(t *testing.T) {
+	course := newTestCourse(t)
+	course.addSubmission(t, "s1")
+	course.addSubmission(t, "s2")
+	grades := NewGradeService(course.db)
+	ctx := course.ctx(course.instructorID)
+
+	// Row 4 is out of range, so the valid rows before it are not written either
+	invalid := "student_id,ta_id,question_id,score\n" +
+		"s1,ta,Correctness,45\n" +
+		"s1,ta,Style,25\n" +
+		"s2,ta,Correctness,60\n"
+	resp, err := grades.ImportGradesCSV(ctx, course.assignmentID, strings.NewReader(invalid), false)
+	if err != nil {
+		t.Fatalf("import with a bad row: %v", err)
+	}
+	if resp.Committed || len(resp.RowErrors) != 1 || resp.RowErrors[0].Row != 4 || resp.RowErrors[0].Column != "score" {
+		t.Fatalf("import with a bad row: committed = %v, row errors = %v; want only row 4's score rejected", resp.Committed, resp.RowErrors)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grades"); n != 0 {
+		t.Fatalf("import with a bad row wrote %d grades, want none", n)
+	}
+
+	valid := "student_id,ta_id,question_id,score\n" +
+		"s1,ta,Correctness,45\n" +
+		"s1,ta,Style,25\n" +
+		"s2,ta,Correctness,40\n"
+	resp, err = grades.ImportGradesCSV(ctx, course.assignmentID, strings.NewReader(valid), true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if resp.Committed || resp.GradesCreated != 2 || resp.RowsRead != 3 {
+		t.Fatalf("dry run: committed = %v, created %d from %d rows; want 2 grades from 3 rows, uncommitted",
+			resp.Committed, resp.GradesCreated, resp.RowsRead)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grades"); n != 0 {
+		t.Fatalf("dry run wrote %d grades, want none", n)
+	}
+
+	resp, err = grades.ImportGradesCSV(ctx, course.assignmentID, strings.NewReader(valid), false)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if !resp.Committed || resp.TotalUploaded != 2 {
+		t.Fatalf("import: committed = %v, uploaded %d; want 2 grades committed", resp.Committed, resp.TotalUploaded)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grades WHERE grader_id = ?", course.taID); n != 2 {
+		t.Fatalf("import wrote %d grades for the TA, want 2", n)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grade_revisions"); n != 2 {
+		t.Fatalf("import recorded %d revisions, want 2", n)
+	}
+}
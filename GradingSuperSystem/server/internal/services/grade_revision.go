@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Where a grade change came from
+const (
+	revisionSourceSubmit      = "submit"
+	revisionSourceUpload      = "upload"
+	revisionSourceRegrade     = "regrade"
+	revisionSourceCommentBank = "comment_bank"
+)
+
+// gradeSnapshot is a grade's scores at one point in its history
+type gradeSnapshot struct {
+	rubricScores map[string]float64
+	totalScore   float64
+}
+
+func (a gradeSnapshot) equal(b gradeSnapshot) bool {
+	if a.totalScore != b.totalScore || len(a.rubricScores) != len(b.rubricScores) {
+		return false
+	}
+	for key, score := range a.rubricScores {
+		if other, ok := b.rubricScores[key]; !ok || other != score {
+			return false
+		}
+	}
+	return true
+}
+
+// recordGradeRevision appends a revision to a grade's history inside the
+// transaction that changed it, returning the revision's row ID. previous is
+// nil when the grade was just created. Saves that leave the scores unchanged
+// are not recorded and return 0.
+func recordGradeRevision(tx *sql.Tx, gradeID, changedBy int64, source string, previous *gradeSnapshot, current gradeSnapshot, reason string) (int64, error) {
+	if previous != nil && previous.equal(current) {
+		return 0, nil
+	}
+
+	var revision int
+	err := tx.QueryRow("SELECT COALESCE(MAX(revision), 0) + 1 FROM grade_revisions WHERE grade_id = ?", gradeID).Scan(&revision)
+	if err != nil {
+		return 0, err
+	}
+
+	newScoresJSON, err := marshalRubricScores(current.rubricScores)
+	if err != nil {
+		return 0, err
+	}
+	var oldScoresJSON sql.NullString
+	var oldTotal sql.NullFloat64
+	if previous != nil {
+		if oldScoresJSON.String, err = marshalRubricScores(previous.rubricScores); err != nil {
+			return 0, err
+		}
+		oldScoresJSON.Valid = true
+		oldTotal = sql.NullFloat64{Float64: previous.totalScore, Valid: true}
+	}
+
+	result, err := tx.Exec(`
+		INSERT INTO grade_revisions (grade_id, revision, changed_by, source, old_rubric_scores, new_rubric_scores, old_total_score, new_total_score, reason, changed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, gradeID, revision, changedBy, source, oldScoresJSON, newScoresJSON, oldTotal, current.totalScore, reason)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func (s *GradeService) GetGradeHistory(ctx context.Context, req *pb.GetGradeHistoryRequest) (*pb.GradeHistoryResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if _, err := checkGradeAccess(s.db, req.GradeId, userID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.loadGradeRevisions(req.GradeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GradeHistoryResponse{
+		GradeId:   req.GradeId,
+		Revisions: revisions,
+	}, nil
+}
+
+func (s *GradeService) DiffGradeRevisions(ctx context.Context, req *pb.DiffGradeRevisionsRequest) (*pb.GradeRevisionDiffResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if _, err := checkGradeAccess(s.db, req.GradeId, userID); err != nil {
+		return nil, err
+	}
+
+	grade, err := s.getGradeByID(req.GradeId)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.loadGradeRevisions(req.GradeId)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, errors.New("grade has no recorded revisions")
+	}
+
+	latest := int32(len(revisions))
+	to := req.ToRevision
+	if to == 0 {
+		to = latest
+	}
+	from := req.FromRevision
+	if from == 0 && req.ToRevision == 0 {
+		from = to - 1
+	}
+	if to < 1 || to > latest || from < 0 || from > latest {
+		return nil, fmt.Errorf("revisions must be between 0 and %d", latest)
+	}
+
+	// The grade after revision n holds revision n's new scores; before the
+	// first revision it holds that revision's old scores, if any
+	snapshot := func(n int32) (map[string]float64, float64) {
+		if n == 0 {
+			return revisions[0].OldRubricScores, revisions[0].OldTotalScore
+		}
+		return revisions[n-1].NewRubricScores, revisions[n-1].NewTotalScore
+	}
+	oldScores, oldTotal := snapshot(from)
+	newScores, newTotal := snapshot(to)
+
+	var criteria []string
+	if _, rubricID, err := getAssignmentCourse(s.db, grade.AssignmentId); err != nil {
+		return nil, err
+	} else if rubricID != 0 {
+		if criteria, _, err = getRubricCriteria(s.db, rubricID); err != nil {
+			return nil, err
+		}
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, scores := range []map[string]float64{oldScores, newScores} {
+		for key := range scores {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return criterionLess(keys[i], keys[j]) })
+
+	resp := &pb.GradeRevisionDiffResponse{
+		GradeId:       req.GradeId,
+		FromRevision:  from,
+		ToRevision:    to,
+		OldTotalScore: oldTotal,
+		NewTotalScore: newTotal,
+		TotalDelta:    newTotal - oldTotal,
+	}
+	for _, key := range keys {
+		oldScore, hasOld := oldScores[key]
+		newScore, hasNew := newScores[key]
+		if hasOld && hasNew && oldScore == newScore {
+			continue
+		}
+		resp.Changes = append(resp.Changes, &pb.CriterionChange{
+			Key:      key,
+			Label:    criterionLabel(criteria, key),
+			HasOld:   hasOld,
+			HasNew:   hasNew,
+			OldScore: oldScore,
+			NewScore: newScore,
+			Delta:    newScore - oldScore,
+		})
+	}
+
+	return resp, nil
+}
+
+// checkGradeAccess allows instructors of the grade's course, and TAs the grade
+// is visible to, to read it. It returns the grade's course.
+func checkGradeAccess(db *database.Database, gradeID, userID int64) (int64, error) {
+	var courseID int64
+	err := db.DB.QueryRow(`
+		SELECT a.course_id FROM grades g
+		JOIN assignments a ON g.assignment_id = a.id
+		WHERE g.id = ?
+	`, gradeID).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return 0, ErrGradeNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := checkCourseMembership(db, courseID, userID); err != nil {
+		return 0, err
+	}
+	if checkCourseInstructor(db, courseID, userID) == nil {
+		return courseID, nil
+	}
+
+	var visible int
+	err = db.DB.QueryRow("SELECT COUNT(*) FROM grades g WHERE g.id = ? AND "+gradeVisibleToGrader, gradeID, userID, userID).Scan(&visible)
+	if err != nil {
+		return 0, err
+	}
+	if visible == 0 {
+		return 0, ErrGradeNotFound
+	}
+	return courseID, nil
+}
+
+func (s *GradeService) loadGradeRevisions(gradeID int64) ([]*pb.GradeRevision, error) {
+	rows, err := s.db.DB.Query(`
+		SELECT r.id, r.grade_id, r.revision, r.changed_by, u.name, r.source,
+		       r.old_rubric_scores, r.new_rubric_scores, r.old_total_score, r.new_total_score,
+		       r.reason, r.changed_at
+		FROM grade_revisions r
+		LEFT JOIN users u ON r.changed_by = u.id
+		WHERE r.grade_id = ?
+		ORDER BY r.revision ASC
+	`, gradeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*pb.GradeRevision
+	for rows.Next() {
+		var revision pb.GradeRevision
+		var changedByName, oldScoresJSON, reason sql.NullString
+		var newScoresJSON string
+		var oldTotal sql.NullFloat64
+		var changedAt time.Time
+
+		err := rows.Scan(&revision.Id, &revision.GradeId, &revision.Revision, &revision.ChangedBy, &changedByName, &revision.Source,
+			&oldScoresJSON, &newScoresJSON, &oldTotal, &revision.NewTotalScore, &reason, &changedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal([]byte(newScoresJSON), &revision.NewRubricScores); err != nil {
+			return nil, err
+		}
+		if oldScoresJSON.Valid {
+			if err := json.Unmarshal([]byte(oldScoresJSON.String), &revision.OldRubricScores); err != nil {
+				return nil, err
+			}
+			revision.HasPrevious = true
+			revision.OldTotalScore = oldTotal.Float64
+		}
+
+		revision.ChangedByName = changedByName.String
+		revision.Reason = reason.String
+		revision.ChangedAt = timestamppb.New(changedAt)
+		revisions = append(revisions, &revision)
+	}
+
+	return revisions, rows.Err()
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func gradeRequest(course *testCourse, submissionID int64, version int32, scores ...float64) *pb.SubmitGradeRequest {
+	return &pb.SubmitGradeRequest{
+		AssignmentId:    course.assignmentID,
+		SubmissionId:    submissionID,
+		RubricScores:    map[string]float64{"0": scores[0], "1": scores[1], "2": scores[2]},
+		ExpectedVersion: version,
+	}
+}
+
+func TestSubmitGradeRejectsStaleVersion(t *testing.T) {
+	course := newTestCourse(t)
+	submissionID := course.addSubmission(t, "s1")
+	grades := NewGradeService(course.db)
+
+	created, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 0, 40, 20, 10))
+	if err != nil {
+		t.Fatalf("TA grade: %v", err)
+	}
+	if !created.Created || created.Grade.Version != 1 {
+		t.Fatalf("TA grade created = %v at version %d, want a new grade at version 1", created.Created, created.Grade.Version)
+	}
+
+	// The instructor's correction edits the TA's grade rather than adding one
+	corrected, err := grades.SubmitGrade(course.ctx(course.instructorID), gradeRequest(course, submissionID, 1, 45, 25, 15))
+	if err != nil {
+		t.Fatalf("instructor correction: %v", err)
+	}
+	if corrected.Created || corrected.Grade.Id != created.Grade.Id || corrected.Grade.Version != 2 {
+		t.Fatalf("correction created = %v, grade %d at version %d; want grade %d updated to version 2",
+			corrected.Created, corrected.Grade.Id, corrected.Grade.Version, created.Grade.Id)
+	}
+
+	// The TA saves over the version they loaded before the correction
+	_, err = grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 1, 50, 30, 20))
+	var conflict *GradeConflictError
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrGradeVersionConflict) {
+		t.Fatalf("stale save err = %v, want a GradeConflictError", err)
+	}
+	if conflict.Current.Version != 2 || conflict.Current.TotalScore != 85 {
+		t.Errorf("conflict reports version %d with total %v, want version 2 with total 85", conflict.Current.Version, conflict.Current.TotalScore)
+	}
+
+	if n := course.count(t, "SELECT COUNT(*) FROM grades WHERE submission_id = ?", submissionID); n != 1 {
+		t.Errorf("submission has %d grades, want 1", n)
+	}
+	var total float64
+	if err := course.db.DB.QueryRow("SELECT total_score FROM grades WHERE id = ?", created.Grade.Id).Scan(&total); err != nil {
+		t.Fatal(err)
+	}
+	if total != 85 {
+		t.Errorf("stored total = %v, want the instructor's 85", total)
+	}
+}
+
+func TestSubmitGradeRejectsConcurrentFirstSave(t *testing.T) {
+	course := newTestCourse(t)
+	submissionID := course.addSubmission(t, "s1")
+	grades := NewGradeService(course.db)
+
+	if _, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 0, 40, 20, 10)); err != nil {
+		t.Fatalf("first save: %v", err)
+	}
+	// A second editor that also started from an ungraded submission
+	_, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 0, 30, 20, 10))
+	if !errors.Is(err, ErrGradeVersionConflict) {
+		t.Fatalf("second save err = %v, want ErrGradeVersionConflict", err)
+	}
+}
+
+func TestInstructorBankCommentsAndImportsEditTheAuthoritativeGrade(t *testing.T) {
+	course := newTestCourse(t)
+	submissionID := course.addSubmission(t, "s1")
+	grades := NewGradeService(course.db)
+	instructor := course.ctx(course.instructorID)
+
+	created, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, submissionID, 0, 40, 20, 10))
+	if err != nil {
+		t.Fatalf("TA grade: %v", err)
+	}
+
+	bank := NewCommentBankService(course.db)
+	comment, err := bank.CreateBankComment(instructor, &pb.CreateBankCommentRequest{
+		RubricId:     course.rubricID,
+		CriterionKey: "0",
+		Text:         "Off by one",
+		Deduction:    5,
+	})
+	if err != nil {
+		t.Fatalf("create comment: %v", err)
+	}
+	applied, err := bank.ApplyBankComment(instructor, &pb.ApplyBankCommentRequest{
+		SubmissionId:    submissionID,
+		CommentId:       comment.Comment.Id,
+		ExpectedVersion: created.Grade.Version,
+	})
+	if err != nil {
+		t.Fatalf("instructor applying a comment: %v", err)
+	}
+	if applied.Created || applied.Grade.Id != created.Grade.Id || applied.Grade.RubricScores["0"] != 35 {
+		t.Fatalf("comment created = %v on grade %d scoring %g; want the TA's grade %d down to 35",
+			applied.Created, applied.Grade.Id, applied.Grade.RubricScores["0"], created.Grade.Id)
+	}
+
+	csv := "student_id,question_id,score\ns1,Style,25\n"
+	if _, err := grades.ImportGradesCSV(instructor, course.assignmentID, strings.NewReader(csv), false); err != nil {
+		t.Fatalf("instructor import: %v", err)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grades WHERE submission_id = ?", submissionID); n != 1 {
+		t.Fatalf("submission has %d grades, want only the TA's", n)
+	}
+	var total float64
+	if err := course.db.DB.QueryRow("SELECT total_score FROM grades WHERE id = ?", created.Grade.Id).Scan(&total); err != nil {
+		t.Fatal(err)
+	}
+	if total != 70 {
+		t.Errorf("stored total = %v, want 70 after the comment and the import", total)
+	}
+}
+
+func TestGradeStatsCoverOneRubricVersion(t *testing.T) {
+	course := newTestCourse(t)
+	grades := NewGradeService(course.db)
+	ta := course.ctx(course.taID)
+	first := course.addSubmission(t, "s1")
+	second := course.addSubmission(t, "s2")
+	if _, err := grades.SubmitGrade(ta, gradeRequest(course, first, 0, 40, 20, 10)); err != nil {
+		t.Fatalf("grade on the first version: %v", err)
+	}
+	firstVersion, err := currentRubricVersionID(course.db, course.rubricID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tests moves to the front, so key 0 now means something else
+	_, err = NewRubricService(course.db).UpdateRubric(course.ctx(course.instructorID), &pb.UpdateRubricRequest{
+		Id:       course.rubricID,
+		Name:     "Rubric",
+		Criteria: []string{"Tests", "Correctness", "Style"},
+		Weights:  []float64{20, 50, 30},
+	})
+	if err != nil {
+		t.Fatalf("update rubric: %v", err)
+	}
+	if _, err := grades.SubmitGrade(ta, gradeRequest(course, second, 0, 15, 45, 25)); err != nil {
+		t.Fatalf("grade on the second version: %v", err)
+	}
+
+	stats, err := grades.GetGradeStats(ta, &pb.GetGradeStatsRequest{RubricId: course.rubricID})
+	if err != nil {
+		t.Fatalf("stats: %v", err)
+	}
+	if stats.ExcludedGrades != 1 || stats.Stats[0].Count != 1 || stats.Stats[0].AvgScore != 15 || stats.Stats[0].AvgPercentage != 75 {
+		t.Fatalf("current version stats: %d excluded, first stat %v; want the second grade only", stats.ExcludedGrades, stats.Stats[0])
+	}
+	distribution, err := grades.GetGradeDistribution(ta, &pb.GetGradeDistributionRequest{RubricId: course.rubricID, RubricVersionId: firstVersion})
+	if err != nil {
+		t.Fatalf("distribution: %v", err)
+	}
+	if scores := distribution.Distributions[0].TaDistributions[strconv.FormatInt(course.taID, 10)].Scores; distribution.ExcludedGrades != 1 || len(scores) != 1 || scores[0] != 40 {
+		t.Fatalf("first version distribution: %d excluded, scores %v; want the first grade only", distribution.ExcludedGrades, scores)
+	}
+
+	if _, err := course.db.DB.Exec("UPDATE grades SET rubric_scores = 'not json' WHERE submission_id = ?", second); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := grades.GetGradeStats(ta, &pb.GetGradeStatsRequest{RubricId: course.rubricID}); err == nil {
+		t.Fatal("stats skipped a grade with unreadable scores")
+	}
+}
@@ -0,0 +1,603 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+)
+
+// Gradebook export layouts
+const (
+	gradebookFormatCSV        = "csv"
+	gradebookFormatCanvas     = "canvas"
+	gradebookFormatGradescope = "gradescope"
+)
+
+// gradebookFlushRows is how many rows are written between flushes, so large
+// gradebooks reach the client while they are being written
+const gradebookFlushRows = 100
+
+// Submission status columns in the Gradescope layout
+const (
+	gradebookStatusGraded   = "Graded"
+	gradebookStatusUngraded = "Ungraded"
+	gradebookStatusMissing  = "Missing"
+)
+
+type GradebookService struct {
+	pb.UnimplementedGradebookServiceServer
+	db *database.Database
+}
+
+func NewGradebookService(db *database.Database) *GradebookService {
+	return &GradebookService{db: db}
+}
+
+// Gradebook is a course's grades loaded for export. Everything that can fail
+// is checked while it is prepared, so writing it only fails on the writer.
+type Gradebook struct {
+	format          string
+	fileName        string
+	section         string
+	includeCriteria bool
+	includeFeedback bool
+	anonymized      bool
+	assignments     []*gradebookAssignment
+	students        []*gradebookStudent
+}
+
+type gradebookAssignment struct {
+	id              int64
+	name            string
+	maxScore        float64
+	rubricVersionID int64
+	criteria        []string
+	weights         []float64
+}
+
+type gradebookStudent struct {
+	studentID string
+	name      string
+	email     string
+	entries   map[int64]*gradebookEntry
+}
+
+// gradebookEntry is one student's standing on one assignment: their latest
+// submission, and the grade on their latest graded submission
+type gradebookEntry struct {
+	submittedAt time.Time
+	graded      bool
+	scores      map[string]float64
+	totalScore  float64
+	feedback    string
+	// Feedback per criterion, kept with the scores it explains
+	criterionFeedback map[string]string
+}
+
+// FileName suggests a name for the exported file
+func (g *Gradebook) FileName() string {
+	return g.fileName
+}
+
+func (s *GradebookService) ExportGradebook(req *pb.ExportGradebookRequest, stream pb.GradebookService_ExportGradebookServer) error {
+	gradebook, err := s.PrepareGradebook(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriterSize(&chunkWriter{stream: stream, fileName: gradebook.FileName()}, 32*1024)
+	if err := gradebook.Write(writer); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// chunkWriter sends what is written to it as gradebook chunks
+type chunkWriter struct {
+	stream   pb.GradebookService_ExportGradebookServer
+	fileName string
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	chunk := &pb.GradebookChunk{Data: append([]byte(nil), p...), FileName: w.fileName}
+	if err := w.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+	w.fileName = ""
+	return len(p), nil
+}
+
+// PrepareGradebook checks an export request and loads the grades it covers
+func (s *GradebookService) PrepareGradebook(ctx context.Context, req *pb.ExportGradebookRequest) (*Gradebook, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format == "" {
+		format = gradebookFormatCSV
+	}
+
+	verr := &ValidationError{}
+	switch format {
+	case gradebookFormatCSV, gradebookFormatCanvas, gradebookFormatGradescope:
+	default:
+		verr.add("format", "must be one of csv, canvas or gradescope")
+	}
+	if req.IncludeFeedback && format != gradebookFormatCSV {
+		verr.add("include_feedback", "feedback can only be exported in the csv format")
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+
+	var courseCode string
+	err := s.db.DB.QueryRow("SELECT code FROM courses WHERE id = ?", req.CourseId).Scan(&courseCode)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("course not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, req.CourseId, userID); err != nil {
+		return nil, err
+	}
+	// Grades are only seen by the whole course staff once they are released
+	if req.IncludeUnreleased {
+		if err := checkCourseInstructor(s.db, req.CourseId, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	gradebook := &Gradebook{
+		format:          format,
+		section:         courseCode,
+		includeCriteria: req.IncludeCriteria,
+		includeFeedback: req.IncludeFeedback,
+	}
+
+	query := "SELECT a.id, a.name, a.max_score, COALESCE(a.rubric_id, 0), " + releaseStateColumn + " FROM assignments a WHERE a.course_id = ?"
+	args := []interface{}{req.CourseId}
+	if req.AssignmentId != 0 {
+		query += " AND a.id = ?"
+		args = append(args, req.AssignmentId)
+	}
+	query += " ORDER BY a.due_date IS NULL, a.due_date ASC, a.id ASC"
+
+	rows, err := s.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rubricIDs []int64
+	var releaseStates []string
+	for rows.Next() {
+		var assignment gradebookAssignment
+		var rubricID int64
+		var releaseState string
+		if err := rows.Scan(&assignment.id, &assignment.name, &assignment.maxScore, &rubricID, &releaseState); err != nil {
+			return nil, err
+		}
+		gradebook.assignments = append(gradebook.assignments, &assignment)
+		rubricIDs = append(rubricIDs, rubricID)
+		releaseStates = append(releaseStates, releaseState)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if req.AssignmentId != 0 && len(gradebook.assignments) == 0 {
+		return nil, errors.New("assignment not found in course")
+	}
+
+	// Unreleased assignments and those whose students are hidden from the
+	// caller are left out of course exports. A single assignment is exported
+	// as asked, with its students anonymized if need be.
+	mask := newBlindMask(s.db, userID)
+	var assignments []*gradebookAssignment
+	for i, assignment := range gradebook.assignments {
+		released := releaseStates[i] == releaseReleased
+		hidden, err := mask.hides(assignment.id)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.AssignmentId != 0 {
+			if !released && !req.IncludeUnreleased {
+				verr.add("include_unreleased", "grades for %s are not released", assignment.name)
+			}
+			if hidden && format != gradebookFormatCSV {
+				verr.add("format", "%s is blind graded; only the csv format can be exported until its grades are released", assignment.name)
+			}
+			gradebook.anonymized = hidden
+		} else if (!released && !req.IncludeUnreleased) || hidden {
+			continue
+		}
+
+		if rubricIDs[i] != 0 {
+			if assignment.rubricVersionID, err = currentRubricVersionID(s.db, rubricIDs[i]); err != nil {
+				return nil, err
+			}
+			if assignment.criteria, assignment.weights, err = getRubricVersionCriteria(s.db, assignment.rubricVersionID); err != nil {
+				return nil, err
+			}
+		}
+		assignments = append(assignments, assignment)
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	gradebook.assignments = assignments
+
+	if err := s.loadStudents(gradebook, req.CourseId); err != nil {
+		return nil, err
+	}
+
+	name := courseCode + "_gradebook"
+	if req.AssignmentId != 0 {
+		name = courseCode + "_" + gradebook.assignments[0].name
+	}
+	if format != gradebookFormatCSV {
+		name += "_" + format
+	}
+	gradebook.fileName = gradebookFileName(name) + ".csv"
+
+	return gradebook, nil
+}
+
+// loadStudents fills in the gradebook's rows: every student enrolled in the
+// course, and anyone else with a submission to an exported assignment. When
+// the gradebook is anonymized, rows are keyed by submission token instead.
+func (s *GradebookService) loadStudents(gradebook *Gradebook, courseID int64) error {
+	students := make(map[string]*gradebookStudent)
+
+	if !gradebook.anonymized {
+		rows, err := s.db.DB.Query(`
+			SELECT u.id, COALESCE(cr.student_id, ''), u.name, u.email
+			FROM course_members cm
+			JOIN users u ON cm.user_id = u.id
+			LEFT JOIN course_roster cr ON cr.course_id = cm.course_id AND cr.user_id = cm.user_id
+			WHERE cm.course_id = ? AND cm.role = 'student'
+		`, courseID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var userID int64
+			student := &gradebookStudent{entries: make(map[int64]*gradebookEntry)}
+			if err := rows.Scan(&userID, &student.studentID, &student.name, &student.email); err != nil {
+				return err
+			}
+			key := student.studentID
+			if key == "" {
+				key = "user:" + strconv.FormatInt(userID, 10)
+			}
+			students[key] = student
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+	}
+
+	for _, assignment := range gradebook.assignments {
+		if err := s.loadEntries(gradebook, assignment, students); err != nil {
+			return err
+		}
+	}
+
+	for _, student := range students {
+		gradebook.students = append(gradebook.students, student)
+	}
+	sort.Slice(gradebook.students, func(i, j int) bool {
+		a, b := gradebook.students[i], gradebook.students[j]
+		if !strings.EqualFold(a.name, b.name) {
+			return strings.ToLower(a.name) < strings.ToLower(b.name)
+		}
+		return a.studentID < b.studentID
+	})
+	return nil
+}
+
+// loadEntries records each student's latest submission to an assignment and
+// the grade on their latest graded submission. When several TAs graded it,
+// the first grade given is the one exported, as in the student portal.
+func (s *GradebookService) loadEntries(gradebook *Gradebook, assignment *gradebookAssignment, students map[string]*gradebookStudent) error {
+	rows, err := s.db.DB.Query(`
+		SELECT s.student_id, s.student_name, COALESCE(s.blind_token, ''), s.uploaded_at
+		FROM submissions s
+		WHERE s.assignment_id = ?
+		ORDER BY s.student_id, s.uploaded_at DESC, s.id DESC
+	`, assignment.id)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// Anonymized rows are keyed by the token of the student's latest
+	// submission, which is also what the row shows
+	keys := make(map[string]string)
+	for rows.Next() {
+		var studentID, studentName, token string
+		var uploadedAt time.Time
+		if err := rows.Scan(&studentID, &studentName, &token, &uploadedAt); err != nil {
+			return err
+		}
+		if _, seen := keys[studentID]; seen {
+			continue
+		}
+
+		key := studentID
+		if gradebook.anonymized {
+			key = token
+		}
+		keys[studentID] = key
+
+		student, ok := students[key]
+		if !ok {
+			student = &gradebookStudent{studentID: key, entries: make(map[int64]*gradebookEntry)}
+			if !gradebook.anonymized {
+				student.name = studentName
+			}
+			students[key] = student
+		}
+		student.entries[assignment.id] = &gradebookEntry{submittedAt: uploadedAt}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	rows, err = s.db.DB.Query(`
+		SELECT s.student_id, g.rubric_scores, g.criterion_feedback, g.total_score, g.feedback, COALESCE(g.rubric_version_id, 0)
+		FROM grades g
+		JOIN submissions s ON g.submission_id = s.id
+		WHERE g.assignment_id = ?
+		ORDER BY s.student_id, s.uploaded_at DESC, s.id DESC, g.graded_at ASC, g.id ASC
+	`, assignment.id)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var studentID, scoresJSON, feedbackJSON string
+		var totalScore float64
+		var feedback sql.NullString
+		var rubricVersionID int64
+		if err := rows.Scan(&studentID, &scoresJSON, &feedbackJSON, &totalScore, &feedback, &rubricVersionID); err != nil {
+			return err
+		}
+
+		entry := students[keys[studentID]].entries[assignment.id]
+		if entry.graded {
+			continue
+		}
+		// Criterion columns follow the current rubric; scores and feedback
+		// given on an earlier version are left out and only the total is exported
+		if rubricVersionID == 0 || rubricVersionID == assignment.rubricVersionID {
+			if err := json.Unmarshal([]byte(scoresJSON), &entry.scores); err != nil {
+				return fmt.Errorf("error parsing rubric scores: %v", err)
+			}
+			if err := json.Unmarshal([]byte(feedbackJSON), &entry.criterionFeedback); err != nil {
+				return fmt.Errorf("error parsing criterion feedback: %v", err)
+			}
+		}
+		entry.graded = true
+		entry.totalScore = totalScore
+		entry.feedback = feedback.String
+	}
+	return rows.Err()
+}
+
+// Write writes the gradebook as CSV in its format's layout
+func (g *Gradebook) Write(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(g.header()); err != nil {
+		return err
+	}
+	if g.format == gradebookFormatCanvas {
+		if err := writer.Write(g.canvasPointsPossible()); err != nil {
+			return err
+		}
+	}
+
+	for i, student := range g.students {
+		if err := writer.Write(g.row(student)); err != nil {
+			return err
+		}
+		if (i+1)%gradebookFlushRows == 0 {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (g *Gradebook) header() []string {
+	var header []string
+	switch g.format {
+	case gradebookFormatCanvas:
+		header = []string{"Student", "ID", "SIS User ID", "SIS Login ID", "Section"}
+	case gradebookFormatGradescope:
+		header = []string{"First Name", "Last Name", "SID", "Email"}
+	default:
+		header = []string{"student_id", "student_name", "email"}
+	}
+
+	for _, assignment := range g.assignments {
+		name := spreadsheetText(assignment.name)
+		header = append(header, name)
+		if g.format == gradebookFormatGradescope {
+			header = append(header, name+" - Max Points", name+" - Submission Time", name+" - Status")
+		}
+		if g.includeCriteria {
+			for i, criterion := range assignment.criteria {
+				column := fmt.Sprintf("%s - q%d", name, i+1)
+				if g.format == gradebookFormatGradescope {
+					column = fmt.Sprintf("%s - q%d: %s (%s pts)", name, i+1, criterion, formatScore(assignment.weights[i]))
+				}
+				header = append(header, column)
+				if g.includeFeedback {
+					header = append(header, fmt.Sprintf("%s - q%d Feedback", name, i+1))
+				}
+			}
+		}
+		if g.includeFeedback {
+			header = append(header, name+" - Feedback")
+		}
+	}
+
+	if g.format == gradebookFormatCSV {
+		header = append(header, "total", "points_possible")
+	}
+	return header
+}
+
+// canvasPointsPossible is the row Canvas expects under the header, giving
+// each column's maximum
+func (g *Gradebook) canvasPointsPossible() []string {
+	row := []string{"    Points Possible", "", "", "", ""}
+	for _, assignment := range g.assignments {
+		row = append(row, formatScore(assignment.maxScore))
+		if g.includeCriteria {
+			for _, weight := range assignment.weights {
+				row = append(row, formatScore(weight))
+			}
+		}
+	}
+	return row
+}
+
+func (g *Gradebook) row(student *gradebookStudent) []string {
+	var row []string
+	switch g.format {
+	case gradebookFormatCanvas:
+		first, last := splitStudentName(student.name)
+		name := student.name
+		if last != "" {
+			name = last + ", " + first
+		}
+		row = []string{spreadsheetText(name), "", spreadsheetText(student.studentID), spreadsheetText(student.email), spreadsheetText(g.section)}
+	case gradebookFormatGradescope:
+		first, last := splitStudentName(student.name)
+		row = []string{spreadsheetText(first), spreadsheetText(last), spreadsheetText(student.studentID), spreadsheetText(student.email)}
+	default:
+		row = []string{spreadsheetText(student.studentID), spreadsheetText(student.name), spreadsheetText(student.email)}
+	}
+
+	var total, possible float64
+	for _, assignment := range g.assignments {
+		entry := student.entries[assignment.id]
+		graded := entry != nil && entry.graded
+		possible += assignment.maxScore
+
+		score := ""
+		if graded {
+			score = formatScore(entry.totalScore)
+			total += entry.totalScore
+		}
+		row = append(row, score)
+
+		if g.format == gradebookFormatGradescope {
+			submittedAt, status := "", gradebookStatusMissing
+			if entry != nil {
+				submittedAt = entry.submittedAt.Format("2006-01-02 15:04:05 -0700")
+				status = gradebookStatusUngraded
+			}
+			if graded {
+				status = gradebookStatusGraded
+			}
+			row = append(row, formatScore(assignment.maxScore), submittedAt, status)
+		}
+
+		if g.includeCriteria {
+			for i := range assignment.criteria {
+				cell := ""
+				if graded {
+					if criterionScore, ok := entry.scores[strconv.Itoa(i)]; ok {
+						cell = formatScore(criterionScore)
+					}
+				}
+				row = append(row, cell)
+				if g.includeFeedback {
+					feedback := ""
+					if graded {
+						feedback = spreadsheetText(entry.criterionFeedback[strconv.Itoa(i)])
+					}
+					row = append(row, feedback)
+				}
+			}
+		}
+		if g.includeFeedback {
+			feedback := ""
+			if graded {
+				feedback = spreadsheetText(entry.feedback)
+			}
+			row = append(row, feedback)
+		}
+	}
+
+	if g.format == gradebookFormatCSV {
+		row = append(row, formatScore(total), formatScore(possible))
+	}
+	return row
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+// splitStudentName takes the last word of a name as the last name
+func splitStudentName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	i := strings.LastIndexAny(name, " \t")
+	if i < 0 {
+		return name, ""
+	}
+	return strings.TrimSpace(name[:i]), name[i+1:]
+}
+
+// spreadsheetText keeps spreadsheet programs from reading a text cell as a
+// formula
+func spreadsheetText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// gradebookFileName reduces a name to characters safe in a file name
+func gradebookFileName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "gradebook"
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestOnlyInstructorsExportUnreleasedGrades(t *testing.T) {
+	course := newTestCourse(t)
+	gradebooks := NewGradebookService(course.db)
+	request := &pb.ExportGradebookRequest{CourseId: course.courseID, IncludeUnreleased: true}
+
+	if _, err := gradebooks.PrepareGradebook(course.ctx(course.taID), request); !errors.Is(err, ErrNotCourseInstructor) {
+		t.Fatalf("TA exporting unreleased grades: err = %v, want ErrNotCourseInstructor", err)
+	}
+	request.AssignmentId = course.assignmentID
+	if _, err := gradebooks.PrepareGradebook(course.ctx(course.taID), request); !errors.Is(err, ErrNotCourseInstructor) {
+		t.Fatalf("TA exporting an unreleased assignment: err = %v, want ErrNotCourseInstructor", err)
+	}
+	if _, err := gradebooks.PrepareGradebook(course.ctx(course.instructorID), request); err != nil {
+		t.Fatalf("instructor exporting an unreleased assignment: %v", err)
+	}
+
+	gradebook, err := gradebooks.PrepareGradebook(course.ctx(course.taID), &pb.ExportGradebookRequest{CourseId: course.courseID})
+	if err != nil {
+		t.Fatalf("TA exporting released grades: %v", err)
+	}
+	if len(gradebook.assignments) != 0 {
+		t.Fatalf("TA export has %d assignments, want the unreleased one left out", len(gradebook.assignments))
+	}
+}
+
+func TestGradebookExportsCriterionFeedback(t *testing.T) {
+	course := newTestCourse(t)
+	submissionID := course.addSubmission(t, "s1")
+	grade := gradeRequest(course, submissionID, 0, 40, 20, 10)
+	grade.CriterionFeedback = map[string]string{"1": "Inconsistent naming"}
+	grade.Feedback = "Good work"
+	if _, err := NewGradeService(course.db).SubmitGrade(course.ctx(course.taID), grade); err != nil {
+		t.Fatalf("grade: %v", err)
+	}
+
+	gradebook, err := NewGradebookService(course.db).PrepareGradebook(course.ctx(course.instructorID), &pb.ExportGradebookRequest{
+		CourseId:          course.courseID,
+		IncludeCriteria:   true,
+		IncludeFeedback:   true,
+		IncludeUnreleased: true,
+	})
+	if err != nil {
+		t.Fatalf("prepare gradebook: %v", err)
+	}
+	var out bytes.Buffer
+	if err := gradebook.Write(&out); err != nil {
+		t.Fatalf("write gradebook: %v", err)
+	}
+	records, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	columns := make(map[string]string)
+	for i, name := range records[0] {
+		columns[name] = records[1][i]
+	}
+	want := map[string]string{
+		"Homework - q2":          "20",
+		"Homework - q2 Feedback": "Inconsistent naming",
+		"Homework - q1 Feedback": "",
+		"Homework - Feedback":    "Good work",
+	}
+	for name, value := range want {
+		got, ok := columns[name]
+		if !ok {
+			t.Fatalf("gradebook has no %q column: %v", name, records[0])
+		}
+		if got != value {
+			t.Fatalf("%s = %q, want %q", name, got, value)
+		}
+	}
+}
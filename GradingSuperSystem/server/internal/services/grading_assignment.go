@@ -0,0 +1,585 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrSubmissionNotAssigned is returned when a TA grades a submission outside their queue
+var ErrSubmissionNotAssigned = errors.New("access denied: this submission is not in your grading queue")
+
+const (
+	strategyRoundRobin     = "round_robin"
+	strategyBalanced       = "balanced"
+	strategyStudentIDRange = "student_id_range"
+	strategyRandom         = "random"
+	// Extra graders added to overlap submissions on top of the primary strategy
+	strategyOverlap = "overlap"
+
+	maxOverlapGraders = 5
+)
+
+type GradingAssignmentService struct {
+	pb.UnimplementedGradingAssignmentServiceServer
+	db *database.Database
+}
+
+func NewGradingAssignmentService(db *database.Database) *GradingAssignmentService {
+	return &GradingAssignmentService{db: db}
+}
+
+// submissionRef is a submission waiting to be assigned to a grader
+type submissionRef struct {
+	id        int64
+	studentID string
+}
+
+func (s *GradingAssignmentService) DistributeSubmissions(ctx context.Context, req *pb.DistributeSubmissionsRequest) (*pb.DistributeSubmissionsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	strategy := req.Strategy
+	if strategy == "" {
+		strategy = strategyRoundRobin
+	}
+	switch strategy {
+	case strategyRoundRobin, strategyBalanced, strategyStudentIDRange, strategyRandom:
+	default:
+		return nil, fmt.Errorf("unknown distribution strategy %q", strategy)
+	}
+
+	graderIDs, err := s.resolveGraders(courseID, req.GraderIds)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := req.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Graded submissions stay with their graders, who keep editing their grades
+	if req.Reassign {
+		_, err := tx.Exec(`
+			DELETE FROM grading_assignments
+			WHERE assignment_id = ?
+			  AND NOT EXISTS (SELECT 1 FROM grades g WHERE g.submission_id = grading_assignments.submission_id)
+		`, req.AssignmentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	submissions, err := unassignedSubmissions(tx, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Existing queue sizes so balanced distribution tops up the lightest queues
+	load := make(map[int64]int)
+	rows, err := tx.Query(`
+		SELECT grader_id, COUNT(*) FROM grading_assignments
+		WHERE assignment_id = ?
+		GROUP BY grader_id
+	`, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var graderID int64
+		var count int
+		if err := rows.Scan(&graderID, &count); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		load[graderID] = count
+	}
+	rows.Close()
+
+	var overlapPercent float64
+	var overlapGraders int
+	err = tx.QueryRow("SELECT overlap_percent, overlap_graders FROM assignments WHERE id = ?", req.AssignmentId).Scan(&overlapPercent, &overlapGraders)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := planDistribution(strategy, submissions, graderIDs, load, seed)
+	overlap := planOverlap(submissions, plan, graderIDs, load, overlapPercent, overlapGraders, seed)
+
+	var assignmentIDs []int64
+	insert := func(submissionID, graderID int64, strategy string) error {
+		result, err := tx.Exec(`
+			INSERT INTO grading_assignments (assignment_id, submission_id, grader_id, strategy, assigned_by, assigned_at)
+			VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+		`, req.AssignmentId, submissionID, graderID, strategy, userID)
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		assignmentIDs = append(assignmentIDs, id)
+		return nil
+	}
+
+	overlapCount := 0
+	for i, submission := range submissions {
+		if err := insert(submission.id, plan[i], strategy); err != nil {
+			return nil, err
+		}
+		if len(overlap[i]) > 0 {
+			overlapCount++
+		}
+		for _, graderID := range overlap[i] {
+			if err := insert(submission.id, graderID, strategyOverlap); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DistributeSubmissionsResponse{
+		Seed:    seed,
+		Message: fmt.Sprintf("Assigned %d submissions to %d graders (%d double graded)", len(submissions), len(graderIDs), overlapCount),
+	}
+	for _, id := range assignmentIDs {
+		assignment, err := s.getGradingAssignment(id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Assignments = append(resp.Assignments, assignment)
+	}
+
+	return resp, nil
+}
+
+func (s *GradingAssignmentService) ListGradingQueue(ctx context.Context, req *pb.ListGradingQueueRequest) (*pb.GradingQueueResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	// TAs only see their own queue
+	graderID := req.GraderId
+	if graderID == 0 {
+		graderID = userID
+	}
+	if graderID != userID {
+		if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+			return nil, err
+		}
+	} else if err := checkCalibration(s.db, courseID, req.AssignmentId, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT s.id, s.student_id, s.student_name, s.file_name, ga.assigned_at, g.id
+		FROM grading_assignments ga
+		JOIN submissions s ON ga.submission_id = s.id
+		LEFT JOIN grades g ON g.submission_id = ga.submission_id AND g.grader_id = ga.grader_id
+		WHERE ga.assignment_id = ? AND ga.grader_id = ?
+		ORDER BY s.student_name ASC
+	`, req.AssignmentId, graderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.GradingQueueResponse{
+		AssignmentId: req.AssignmentId,
+		GraderId:     graderID,
+	}
+	for rows.Next() {
+		var item pb.GradingQueueItem
+		var assignedAt time.Time
+		var gradeID sql.NullInt64
+
+		err := rows.Scan(&item.SubmissionId, &item.StudentId, &item.StudentName, &item.FileName, &assignedAt, &gradeID)
+		if err != nil {
+			return nil, err
+		}
+
+		item.AssignedAt = timestamppb.New(assignedAt)
+		item.Graded = gradeID.Valid
+		item.GradeId = gradeID.Int64
+		resp.Items = append(resp.Items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Blind queues are ordered by token, since name order gives identities away
+	mask := newBlindMask(s.db, userID)
+	for _, item := range resp.Items {
+		if _, err := mask.identity(item.SubmissionId, &item.StudentId, &item.StudentName, &item.FileName); err != nil {
+			return nil, err
+		}
+	}
+	if hidden, err := mask.hides(req.AssignmentId); err != nil {
+		return nil, err
+	} else if hidden {
+		sort.Slice(resp.Items, func(i, j int) bool { return resp.Items[i].StudentId < resp.Items[j].StudentId })
+	}
+
+	return resp, nil
+}
+
+func (s *GradingAssignmentService) GetGradingProgress(ctx context.Context, req *pb.GetGradingProgressRequest) (*pb.GradingProgressResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GradingProgressResponse{AssignmentId: req.AssignmentId}
+	err = s.db.DB.QueryRow("SELECT overlap_percent, overlap_graders FROM assignments WHERE id = ?", req.AssignmentId).Scan(&resp.OverlapPercent, &resp.OverlapGraders)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.DB.QueryRow(`
+		SELECT COUNT(*) FROM (
+			SELECT submission_id FROM grading_assignments
+			WHERE assignment_id = ?
+			GROUP BY submission_id
+			HAVING COUNT(*) > 1
+		)
+	`, req.AssignmentId).Scan(&resp.OverlapSubmissions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.DB.QueryRow(`
+		SELECT COUNT(*),
+		       COUNT(CASE WHEN id NOT IN (SELECT submission_id FROM grading_assignments WHERE assignment_id = ?) THEN 1 END)
+		FROM submissions
+		WHERE assignment_id = ?
+	`, req.AssignmentId, req.AssignmentId).Scan(&resp.TotalSubmissions, &resp.UnassignedSubmissions)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT ga.grader_id, COALESCE(u.name, ''), COUNT(*), COUNT(g.id)
+		FROM grading_assignments ga
+		LEFT JOIN users u ON ga.grader_id = u.id
+		LEFT JOIN grades g ON g.submission_id = ga.submission_id AND g.grader_id = ga.grader_id
+		WHERE ga.assignment_id = ?
+		GROUP BY ga.grader_id
+		ORDER BY u.name ASC
+	`, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var progress pb.GraderProgress
+		if err := rows.Scan(&progress.GraderId, &progress.GraderName, &progress.Assigned, &progress.Graded); err != nil {
+			return nil, err
+		}
+		progress.Remaining = progress.Assigned - progress.Graded
+		if progress.Assigned > 0 {
+			progress.PercentComplete = 100 * float64(progress.Graded) / float64(progress.Assigned)
+		}
+		resp.Graders = append(resp.Graders, &progress)
+	}
+
+	return resp, rows.Err()
+}
+
+func (s *GradingAssignmentService) ConfigureOverlap(ctx context.Context, req *pb.ConfigureOverlapRequest) (*pb.OverlapSettingsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	if req.OverlapPercent < 0 || req.OverlapPercent > 100 {
+		return nil, errors.New("overlap_percent must be between 0 and 100")
+	}
+	overlapGraders := req.OverlapGraders
+	if overlapGraders == 0 {
+		overlapGraders = 2
+	}
+	if overlapGraders < 2 || overlapGraders > maxOverlapGraders {
+		return nil, fmt.Errorf("overlap_graders must be between 2 and %d", maxOverlapGraders)
+	}
+
+	_, err = s.db.DB.Exec(`
+		UPDATE assignments SET overlap_percent = ?, overlap_graders = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, req.OverlapPercent, overlapGraders, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.OverlapSettingsResponse{
+		AssignmentId:   req.AssignmentId,
+		OverlapPercent: req.OverlapPercent,
+		OverlapGraders: overlapGraders,
+		Message:        "Overlap applies to submissions distributed from now on; redistribute with reassign to apply it to existing queues",
+	}, nil
+}
+
+// resolveGraders validates the requested graders, defaulting to every TA in the course
+func (s *GradingAssignmentService) resolveGraders(courseID int64, requested []int64) ([]int64, error) {
+	var graderIDs []int64
+	seen := make(map[int64]bool)
+	for _, graderID := range requested {
+		if seen[graderID] {
+			continue
+		}
+		seen[graderID] = true
+		if err := checkCourseMembership(s.db, courseID, graderID); err != nil {
+			return nil, fmt.Errorf("grader %d is not a member of this course", graderID)
+		}
+		graderIDs = append(graderIDs, graderID)
+	}
+	if len(graderIDs) > 0 {
+		return graderIDs, nil
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT user_id FROM course_members
+		WHERE course_id = ? AND role = 'ta'
+		ORDER BY user_id
+	`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var graderID int64
+		if err := rows.Scan(&graderID); err != nil {
+			return nil, err
+		}
+		graderIDs = append(graderIDs, graderID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(graderIDs) == 0 {
+		return nil, errors.New("no TAs in this course to assign submissions to")
+	}
+
+	return graderIDs, nil
+}
+
+func (s *GradingAssignmentService) getGradingAssignment(id int64) (*pb.GradingAssignment, error) {
+	var assignment pb.GradingAssignment
+	var graderName sql.NullString
+	var assignedAt time.Time
+
+	err := s.db.DB.QueryRow(`
+		SELECT ga.id, ga.assignment_id, ga.submission_id, s.student_id, ga.grader_id, u.name, ga.strategy, ga.assigned_at
+		FROM grading_assignments ga
+		JOIN submissions s ON ga.submission_id = s.id
+		LEFT JOIN users u ON ga.grader_id = u.id
+		WHERE ga.id = ?
+	`, id).Scan(&assignment.Id, &assignment.AssignmentId, &assignment.SubmissionId, &assignment.StudentId,
+		&assignment.GraderId, &graderName, &assignment.Strategy, &assignedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment.GraderName = graderName.String
+	assignment.AssignedAt = timestamppb.New(assignedAt)
+	return &assignment, nil
+}
+
+// unassignedSubmissions lists the assignment's submissions that no grader has been given yet
+func unassignedSubmissions(tx *sql.Tx, assignmentID int64) ([]submissionRef, error) {
+	rows, err := tx.Query(`
+		SELECT id, student_id FROM submissions
+		WHERE assignment_id = ?
+		  AND id NOT IN (SELECT submission_id FROM grading_assignments WHERE assignment_id = ?)
+		ORDER BY id
+	`, assignmentID, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []submissionRef
+	for rows.Next() {
+		var submission submissionRef
+		if err := rows.Scan(&submission.id, &submission.studentID); err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, rows.Err()
+}
+
+// planDistribution picks a grader for each submission. The returned slice is
+// parallel to submissions; submissions may be reordered in place.
+func planDistribution(strategy string, submissions []submissionRef, graderIDs []int64, load map[int64]int, seed int64) []int64 {
+	plan := make([]int64, len(submissions))
+
+	switch strategy {
+	case strategyBalanced:
+		// Give each submission to whoever currently has the fewest
+		counts := make(map[int64]int, len(graderIDs))
+		for _, graderID := range graderIDs {
+			counts[graderID] = load[graderID]
+		}
+		for i := range submissions {
+			best := graderIDs[0]
+			for _, graderID := range graderIDs[1:] {
+				if counts[graderID] < counts[best] {
+					best = graderID
+				}
+			}
+			plan[i] = best
+			counts[best]++
+		}
+
+	case strategyStudentIDRange:
+		// Contiguous blocks of student IDs of near-equal size
+		sort.SliceStable(submissions, func(i, j int) bool {
+			return studentIDLess(submissions[i].studentID, submissions[j].studentID)
+		})
+		blockSize := len(submissions) / len(graderIDs)
+		extra := len(submissions) % len(graderIDs)
+		i := 0
+		for g, graderID := range graderIDs {
+			size := blockSize
+			if g < extra {
+				size++
+			}
+			for end := i + size; i < end; i++ {
+				plan[i] = graderID
+			}
+		}
+
+	case strategyRandom:
+		rng := rand.New(rand.NewSource(seed))
+		rng.Shuffle(len(submissions), func(i, j int) {
+			submissions[i], submissions[j] = submissions[j], submissions[i]
+		})
+		fallthrough
+
+	default:
+		for i := range submissions {
+			plan[i] = graderIDs[i%len(graderIDs)]
+		}
+	}
+
+	return plan
+}
+
+// planOverlap picks a random overlapPercent of the submissions and, for each,
+// the additional graders that will grade it independently. Extra graders are
+// the least loaded graders other than the primary one. The result is parallel
+// to submissions.
+func planOverlap(submissions []submissionRef, plan []int64, graderIDs []int64, load map[int64]int, overlapPercent float64, overlapGraders int, seed int64) [][]int64 {
+	overlap := make([][]int64, len(submissions))
+	count := int(math.Round(overlapPercent / 100 * float64(len(submissions))))
+	if count == 0 || len(graderIDs) < 2 {
+		return overlap
+	}
+
+	counts := make(map[int64]int, len(graderIDs))
+	for _, graderID := range graderIDs {
+		counts[graderID] = load[graderID]
+	}
+	for _, graderID := range plan {
+		counts[graderID]++
+	}
+
+	// Offset the seed so overlap picks are independent of a random primary split
+	rng := rand.New(rand.NewSource(seed + 1))
+	for _, i := range rng.Perm(len(submissions))[:count] {
+		assigned := map[int64]bool{plan[i]: true}
+		for len(assigned) < overlapGraders && len(assigned) < len(graderIDs) {
+			var best int64
+			for _, graderID := range graderIDs {
+				if !assigned[graderID] && (best == 0 || counts[graderID] < counts[best]) {
+					best = graderID
+				}
+			}
+			assigned[best] = true
+			counts[best]++
+			overlap[i] = append(overlap[i], best)
+		}
+	}
+
+	return overlap
+}
+
+// studentIDLess orders numeric student IDs numerically and everything else lexically
+func studentIDLess(a, b string) bool {
+	ai, errA := strconv.ParseInt(a, 10, 64)
+	bi, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		return ai < bi
+	}
+	return a < b
+}
+
+// checkGradingAssignment allows a grade once submissions have been distributed
+// only if the grader is assigned the submission. Instructors can grade anything,
+// and assignments that were never distributed stay open to every course member.
+func checkGradingAssignment(db *database.Database, courseID, assignmentID, submissionID, userID int64) error {
+	if checkCourseInstructor(db, courseID, userID) == nil {
+		return nil
+	}
+
+	var distributed, assigned int
+	err := db.DB.QueryRow(`
+		SELECT COUNT(*), COUNT(CASE WHEN submission_id = ? AND grader_id = ? THEN 1 END)
+		FROM grading_assignments
+		WHERE assignment_id = ?
+	`, submissionID, userID, assignmentID).Scan(&distributed, &assigned)
+	if err != nil {
+		return err
+	}
+	if distributed > 0 && assigned == 0 {
+		return ErrSubmissionNotAssigned
+	}
+	return nil
+}
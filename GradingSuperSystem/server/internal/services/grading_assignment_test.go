@@ -0,0 +1,79 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestReassignKeepsGradedSubmissionsWithTheirGraders(t *testing.T) {
+	course := newTestCourse(t)
+	otherTA := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('ta2@example.edu', 'TA 2', '', 'ta')")
+	course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'ta')", course.courseID, otherTA)
+	graded := course.addSubmission(t, "s1")
+	course.addSubmission(t, "s2")
+	distribution := NewGradingAssignmentService(course.db)
+	grades := NewGradeService(course.db)
+	instructor := course.ctx(course.instructorID)
+
+	_, err := distribution.DistributeSubmissions(instructor, &pb.DistributeSubmissionsRequest{
+		AssignmentId: course.assignmentID,
+		GraderIds:    []int64{course.taID},
+	})
+	if err != nil {
+		t.Fatalf("distribute: %v", err)
+	}
+	created, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, graded, 0, 40, 20, 10))
+	if err != nil {
+		t.Fatalf("grade: %v", err)
+	}
+
+	_, err = distribution.DistributeSubmissions(instructor, &pb.DistributeSubmissionsRequest{
+		AssignmentId: course.assignmentID,
+		GraderIds:    []int64{otherTA},
+		Reassign:     true,
+	})
+	if err != nil {
+		t.Fatalf("reassign: %v", err)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grading_assignments WHERE submission_id = ? AND grader_id = ?", graded, course.taID); n != 1 {
+		t.Fatalf("graded submission has %d assignments to its grader, want 1", n)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM grading_assignments WHERE grader_id = ?", otherTA); n != 1 {
+		t.Fatalf("other TA was assigned %d submissions, want only the ungraded one", n)
+	}
+
+	if _, err := grades.SubmitGrade(course.ctx(course.taID), gradeRequest(course, graded, created.Grade.Version, 45, 20, 10)); err != nil {
+		t.Fatalf("grader editing their grade after reassign: %v", err)
+	}
+	if _, err := grades.SubmitGrade(course.ctx(otherTA), gradeRequest(course, graded, 0, 30, 20, 10)); err == nil {
+		t.Fatal("other TA graded a submission that stayed with its grader")
+	}
+}
+
+func TestTAsOpenOnlySubmissionsInTheirQueue(t *testing.T) {
+	course := newTestCourse(t)
+	otherTA := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('ta2@example.edu', 'TA 2', '', 'ta')")
+	course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'ta')", course.courseID, otherTA)
+	submissionID := course.addSubmission(t, "s1")
+	_, err := NewGradingAssignmentService(course.db).DistributeSubmissions(course.ctx(course.instructorID), &pb.DistributeSubmissionsRequest{
+		AssignmentId: course.assignmentID,
+		GraderIds:    []int64{course.taID},
+	})
+	if err != nil {
+		t.Fatalf("distribute: %v", err)
+	}
+
+	submissions := NewSubmissionService(course.db)
+	request := &pb.GetSubmissionRequest{Id: submissionID}
+	if _, err := submissions.GetSubmission(course.ctx(course.taID), request); err != nil {
+		t.Fatalf("assigned TA opening the submission: %v", err)
+	}
+	if _, err := submissions.GetSubmission(course.ctx(otherTA), request); !errors.Is(err, ErrSubmissionNotAssigned) {
+		t.Fatalf("other TA opening the submission: err = %v, want ErrSubmissionNotAssigned", err)
+	}
+	if _, err := submissions.GetSubmissionFile(course.ctx(otherTA), request); !errors.Is(err, ErrSubmissionNotAssigned) {
+		t.Fatalf("other TA opening the submission file: err = %v, want ErrSubmissionNotAssigned", err)
+	}
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/talytics/server/internal/statistics"
+	pb "github.com/talytics/server/proto"
+)
+
+// Confidence level of the intervals around bias estimates and adjusted scores
+const leniencyConfidenceLevel = 0.95
+
+// graderBias is a grader's estimated leniency on one score key
+type graderBias struct {
+	bias     float64
+	variance float64
+}
+
+func (s *AnalysisService) EstimateLeniency(ctx context.Context, req *pb.EstimateLeniencyRequest) (*pb.LeniencyResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	scope, err := s.resolveScope(req.AssignmentId, req.RubricId, req.RubricVersionId)
+	if err != nil {
+		return nil, err
+	}
+	// Adjusted scores for every student are a preview for the course's instructors
+	if err := checkCourseInstructor(s.db, scope.courseID, userID); err != nil {
+		return nil, err
+	}
+
+	records, excluded, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).records(records); err != nil {
+		return nil, err
+	}
+
+	criteria, err := scopeCriteria(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.LeniencyResponse{
+		AssignmentId:    scope.assignmentID,
+		RubricId:        scope.rubricID,
+		ConfidenceLevel: leniencyConfidenceLevel,
+		RubricVersionId: scope.rubricVersionID,
+		ExcludedGrades:  excluded,
+	}
+	z := statistics.NormalQuantile(1 - (1-leniencyConfidenceLevel)/2)
+
+	// Fit each criterion separately; the total is adjusted by the sum of
+	// its criteria so the pieces stay consistent
+	biases := make(map[string]map[int64]graderBias)
+	for _, key := range scoreKeys(records) {
+		if key == totalScoreKey {
+			continue
+		}
+		leniency, keyBiases := estimateCriterionLeniency(records, key, z)
+		leniency.Label = criterionLabel(criteria, key)
+		resp.Criteria = append(resp.Criteria, leniency)
+		biases[key] = keyBiases
+	}
+
+	for _, record := range records {
+		grade := &pb.AdjustedGrade{
+			GradeId:        record.id,
+			SubmissionId:   record.submissionID,
+			StudentId:      record.studentID,
+			GraderId:       record.graderID,
+			RubricScores:   record.rubricScores,
+			AdjustedScores: make(map[string]float64),
+			AdjustedLower:  make(map[string]float64),
+			AdjustedUpper:  make(map[string]float64),
+			TotalScore:     record.totalScore,
+			AdjustedTotal:  record.totalScore,
+		}
+
+		var totalVariance float64
+		for key, score := range record.rubricScores {
+			estimate := biases[key][record.graderID]
+			margin := z * math.Sqrt(estimate.variance)
+			adjusted := score - estimate.bias
+
+			grade.AdjustedScores[key] = adjusted
+			grade.AdjustedLower[key] = adjusted - margin
+			grade.AdjustedUpper[key] = adjusted + margin
+			grade.AdjustedTotal -= estimate.bias
+			totalVariance += estimate.variance
+		}
+
+		margin := z * math.Sqrt(totalVariance)
+		grade.AdjustedTotalLower = grade.AdjustedTotal - margin
+		grade.AdjustedTotalUpper = grade.AdjustedTotal + margin
+
+		resp.Grades = append(resp.Grades, grade)
+	}
+
+	return resp, nil
+}
+
+// estimateCriterionLeniency fits the grader random effects model for one
+// criterion. Graders left out of the model (too few grades, or too little
+// data overall) get zero bias so their scores pass through unadjusted.
+func estimateCriterionLeniency(records []gradeRecord, key string, z float64) (*pb.CriterionLeniency, map[int64]graderBias) {
+	byGrader := make(map[int64][]float64)
+	graderNames := make(map[int64]string)
+	for _, record := range records {
+		if value, ok := scoreFor(record, key); ok {
+			byGrader[record.graderID] = append(byGrader[record.graderID], value)
+			graderNames[record.graderID] = record.graderName
+		}
+	}
+
+	var graderIDs []int64
+	var groups [][]float64
+	for _, graderID := range sortedGraderIDs(byGrader) {
+		if len(byGrader[graderID]) >= minGroupSize {
+			graderIDs = append(graderIDs, graderID)
+			groups = append(groups, byGrader[graderID])
+		}
+	}
+
+	leniency := &pb.CriterionLeniency{Key: key}
+	biases := make(map[int64]graderBias)
+
+	model, err := statistics.RandomEffects(groups)
+	if err != nil {
+		leniency.GrandMean = statistics.Mean(scoreValues(records, key))
+		return leniency, biases
+	}
+
+	leniency.GrandMean = model.GrandMean
+	leniency.BetweenGraderVariance = model.BetweenVariance
+	leniency.WithinGraderVariance = model.WithinVariance
+	leniency.SufficientData = true
+
+	for i, effect := range model.Effects {
+		graderID := graderIDs[i]
+		margin := z * math.Sqrt(effect.Variance)
+		biases[graderID] = graderBias{bias: effect.Effect, variance: effect.Variance}
+		leniency.Graders = append(leniency.Graders, &pb.GraderLeniency{
+			GraderId:      graderID,
+			GraderName:    graderNames[graderID],
+			Count:         int32(effect.Count),
+			Mean:          effect.Mean,
+			RawDifference: effect.RawDiff,
+			Bias:          effect.Effect,
+			BiasLower:     effect.Effect - margin,
+			BiasUpper:     effect.Effect + margin,
+			Shrinkage:     effect.Shrinkage,
+		})
+	}
+
+	// Most lenient graders first
+	sort.SliceStable(leniency.Graders, func(i, j int) bool {
+		return leniency.Graders[i].Bias > leniency.Graders[j].Bias
+	})
+
+	return leniency, biases
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LtiService makes TAlytics an LTI 1.3 tool. Platforms are registered by
+// administrators; a launch from one provisions its course and user, and grades
+// of the assignments its resource links stand for can be passed back.
+type LtiService struct {
+	pb.UnimplementedLtiServiceServer
+	db          *database.Database
+	userService *UserService
+	client      *http.Client
+
+	// toolURL is where platforms reach the tool, and appURL where launched
+	// users are sent to sign in to the web client
+	toolURL string
+	appURL  string
+
+	mu           sync.Mutex
+	toolKey      *ltiToolKey
+	platformKeys map[string]*ltiKeySet
+	accessTokens map[string]ltiAccessToken
+}
+
+type ltiPlatform struct {
+	id           int64
+	name         string
+	issuer       string
+	clientID     string
+	deploymentID string
+	authLoginURL string
+	authTokenURL string
+	jwksURL      string
+	trustEmail   bool
+	createdAt    time.Time
+}
+
+func NewLtiService(db *database.Database, userService *UserService) *LtiService {
+	return &LtiService{
+		db:           db,
+		userService:  userService,
+		client:       &http.Client{Timeout: 15 * time.Second},
+		toolURL:      strings.TrimSuffix(envOrDefault("LTI_TOOL_URL", "http://localhost:5000"), "/"),
+		appURL:       strings.TrimSuffix(envOrDefault("LTI_APP_URL", "http://localhost:3000"), "/"),
+		platformKeys: make(map[string]*ltiKeySet),
+		accessTokens: make(map[string]ltiAccessToken),
+	}
+}
+
+func envOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// checkAdmin returns an error unless the user is a TAlytics administrator.
+// A platform decides who its launches sign in as, so only administrators can
+// register one. Accounts are made administrators by the server's operator
+// with cmd/grantadmin, never by anything a user can claim about themselves.
+func (s *LtiService) checkAdmin(userID int64) error {
+	var admin bool
+	err := s.db.DB.QueryRow("SELECT is_admin FROM users WHERE id = ?", userID).Scan(&admin)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if !admin {
+		return errors.New("access denied: only administrators can register LTI platforms")
+	}
+	return nil
+}
+
+func (s *LtiService) RegisterPlatform(ctx context.Context, req *pb.RegisterLtiPlatformRequest) (*pb.LtiPlatformResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := s.checkAdmin(userID); err != nil {
+		return nil, err
+	}
+
+	platform := ltiPlatform{
+		name:         strings.TrimSpace(req.Name),
+		issuer:       strings.TrimSpace(req.Issuer),
+		clientID:     strings.TrimSpace(req.ClientId),
+		deploymentID: strings.TrimSpace(req.DeploymentId),
+		authLoginURL: strings.TrimSpace(req.AuthLoginUrl),
+		authTokenURL: strings.TrimSpace(req.AuthTokenUrl),
+		jwksURL:      strings.TrimSpace(req.JwksUrl),
+		trustEmail:   req.TrustEmail,
+	}
+
+	verr := &ValidationError{}
+	if platform.issuer == "" {
+		verr.add("issuer", "is required")
+	}
+	if platform.clientID == "" {
+		verr.add("client_id", "is required")
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"auth_login_url", platform.authLoginURL},
+		{"auth_token_url", platform.authTokenURL},
+		{"jwks_url", platform.jwksURL},
+	} {
+		if u, err := url.Parse(field.value); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			verr.add(field.name, "must be an absolute http or https URL")
+		}
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	if platform.name == "" {
+		platform.name = platform.issuer
+	}
+
+	var count int
+	err := s.db.DB.QueryRow("SELECT COUNT(*) FROM lti_platforms WHERE issuer = ? AND client_id = ?", platform.issuer, platform.clientID).Scan(&count)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errors.New("a platform with this issuer and client ID is already registered")
+	}
+
+	result, err := s.db.DB.Exec(`
+		INSERT INTO lti_platforms (name, issuer, client_id, deployment_id, auth_login_url, auth_token_url, jwks_url, trust_email, created_by, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, platform.name, platform.issuer, platform.clientID, platform.deploymentID, platform.authLoginURL, platform.authTokenURL, platform.jwksURL, platform.trustEmail, userID)
+	if err != nil {
+		return nil, err
+	}
+	platformID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := s.getPlatform(platformID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LtiPlatformResponse{
+		Platform: registered.toProto(),
+		Message:  "LTI platform registered successfully",
+	}, nil
+}
+
+func (s *LtiService) ListPlatforms(ctx context.Context, req *pb.ListLtiPlatformsRequest) (*pb.ListLtiPlatformsResponse, error) {
+	userRole := ctx.Value("user_role").(string)
+	if userRole != "instructor" {
+		return nil, errors.New("access denied: only instructors can view LTI platforms")
+	}
+
+	rows, err := s.db.DB.Query("SELECT " + ltiPlatformColumns + " FROM lti_platforms ORDER BY name, id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var platforms []*pb.LtiPlatform
+	for rows.Next() {
+		platform, err := scanLtiPlatform(rows)
+		if err != nil {
+			return nil, err
+		}
+		platforms = append(platforms, platform.toProto())
+	}
+	return &pb.ListLtiPlatformsResponse{Platforms: platforms}, rows.Err()
+}
+
+func (s *LtiService) ListResourceLinks(ctx context.Context, req *pb.ListLtiResourceLinksRequest) (*pb.ListLtiResourceLinksResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := checkCourseInstructor(s.db, req.CourseId, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query("SELECT "+ltiResourceLinkColumns+" FROM lti_resource_links WHERE course_id = ? ORDER BY title, id", req.CourseId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*pb.LtiResourceLink
+	for rows.Next() {
+		link, err := scanLtiResourceLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return &pb.ListLtiResourceLinksResponse{Links: links}, rows.Err()
+}
+
+func (s *LtiService) LinkResource(ctx context.Context, req *pb.LinkLtiResourceRequest) (*pb.LtiResourceLinkResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	link, err := s.getResourceLink(req.LinkId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, link.CourseId, userID); err != nil {
+		return nil, err
+	}
+
+	var assignmentID sql.NullInt64
+	if req.AssignmentId != 0 {
+		courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+		if err != nil {
+			return nil, err
+		}
+		if courseID != link.CourseId {
+			return nil, errors.New("assignment is not in the resource link's course")
+		}
+		assignmentID = sql.NullInt64{Int64: req.AssignmentId, Valid: true}
+	}
+
+	_, err = s.db.DB.Exec("UPDATE lti_resource_links SET assignment_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", assignmentID, req.LinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err = s.getResourceLink(req.LinkId)
+	if err != nil {
+		return nil, err
+	}
+	message := "Resource link unlinked"
+	if req.AssignmentId != 0 {
+		message = "Resource link linked to assignment"
+	}
+	return &pb.LtiResourceLinkResponse{Link: link, Message: message}, nil
+}
+
+const ltiPlatformColumns = "id, name, issuer, client_id, COALESCE(deployment_id, ''), auth_login_url, auth_token_url, jwks_url, trust_email, created_at"
+
+func scanLtiPlatform(row rowScanner) (*ltiPlatform, error) {
+	var platform ltiPlatform
+	err := row.Scan(&platform.id, &platform.name, &platform.issuer, &platform.clientID, &platform.deploymentID,
+		&platform.authLoginURL, &platform.authTokenURL, &platform.jwksURL, &platform.trustEmail, &platform.createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return &platform, nil
+}
+
+func (s *LtiService) getPlatform(platformID int64) (*ltiPlatform, error) {
+	platform, err := scanLtiPlatform(s.db.DB.QueryRow("SELECT "+ltiPlatformColumns+" FROM lti_platforms WHERE id = ?", platformID))
+	if err == sql.ErrNoRows {
+		return nil, errors.New("LTI platform not found")
+	}
+	return platform, err
+}
+
+func (p *ltiPlatform) toProto() *pb.LtiPlatform {
+	return &pb.LtiPlatform{
+		Id:           p.id,
+		Name:         p.name,
+		Issuer:       p.issuer,
+		ClientId:     p.clientID,
+		DeploymentId: p.deploymentID,
+		AuthLoginUrl: p.authLoginURL,
+		AuthTokenUrl: p.authTokenURL,
+		JwksUrl:      p.jwksURL,
+		TrustEmail:   p.trustEmail,
+		CreatedAt:    timestamppb.New(p.createdAt),
+	}
+}
+
+const ltiResourceLinkColumns = "id, platform_id, resource_link_id, course_id, COALESCE(assignment_id, 0), COALESCE(title, ''), COALESCE(lineitem_url, ''), ags_scopes"
+
+func scanLtiResourceLink(row rowScanner) (*pb.LtiResourceLink, error) {
+	var link pb.LtiResourceLink
+	var scopes string
+	err := row.Scan(&link.Id, &link.PlatformId, &link.ResourceLinkId, &link.CourseId, &link.AssignmentId, &link.Title, &link.LineitemUrl, &scopes)
+	if err != nil {
+		return nil, err
+	}
+	link.CanPassBack = hasScope(scopes, agsScopeScore)
+	return &link, nil
+}
+
+func (s *LtiService) getResourceLink(linkID int64) (*pb.LtiResourceLink, error) {
+	link, err := scanLtiResourceLink(s.db.DB.QueryRow("SELECT "+ltiResourceLinkColumns+" FROM lti_resource_links WHERE id = ?", linkID))
+	if err == sql.ErrNoRows {
+		return nil, errors.New("LTI resource link not found")
+	}
+	return link, err
+}
+
+// hasScope reports whether a space-separated scope list grants scope
+func hasScope(scopes, scope string) bool {
+	for _, granted := range strings.Fields(scopes) {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,552 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	pb "github.com/talytics/server/proto"
+)
+
+// Assignment and Grade Services scopes
+const (
+	agsScopeLineItem = "https://purl.imsglobal.org/spec/lti-ags/scope/lineitem"
+	agsScopeScore    = "https://purl.imsglobal.org/spec/lti-ags/scope/score"
+)
+
+// Outcomes of passing back one student's score
+const (
+	ltiScoreSent    = "sent"
+	ltiScoreSkipped = "skipped"
+	ltiScoreFailed  = "failed"
+)
+
+// platformKeysLifetime is how long a platform's JWKS is trusted before it is
+// fetched again; unknown key IDs also trigger a fetch
+const platformKeysLifetime = 10 * time.Minute
+
+// ltiToolKey is the key pair the tool signs its client assertions with
+type ltiToolKey struct {
+	kid string
+	key *rsa.PrivateKey
+}
+
+type ltiKeySet struct {
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+type ltiAccessToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// jsonWebKey is the subset of an RSA JWK the tool reads and publishes
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// ToolJWKS returns the public keys platforms verify the tool's client
+// assertions with
+func (s *LtiService) ToolJWKS() (map[string]interface{}, error) {
+	toolKey, err := s.signingKey()
+	if err != nil {
+		return nil, err
+	}
+	public := toolKey.key.PublicKey
+	key := jsonWebKey{
+		Kty: "RSA",
+		Kid: toolKey.kid,
+		Alg: "RS256",
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+	}
+	return map[string]interface{}{"keys": []jsonWebKey{key}}, nil
+}
+
+// signingKey loads the tool's key pair, generating it the first time
+func (s *LtiService) signingKey() (*ltiToolKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.toolKey != nil {
+		return s.toolKey, nil
+	}
+
+	load := func() (*ltiToolKey, error) {
+		var kid, privateKey string
+		err := s.db.DB.QueryRow("SELECT kid, private_key FROM lti_tool_keys ORDER BY id LIMIT 1").Scan(&kid, &privateKey)
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode([]byte(privateKey))
+		if block == nil {
+			return nil, errors.New("stored LTI tool key is not PEM encoded")
+		}
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return &ltiToolKey{kid: kid, key: key}, nil
+	}
+
+	toolKey, err := load()
+	if err == sql.ErrNoRows {
+		var key *rsa.PrivateKey
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			return nil, err
+		}
+		der := x509.MarshalPKCS1PrivateKey(key)
+		sum := sha256.Sum256(x509.MarshalPKCS1PublicKey(&key.PublicKey))
+		privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: der})
+		_, err = s.db.DB.Exec("INSERT INTO lti_tool_keys (kid, private_key, created_at) VALUES (?, ?, CURRENT_TIMESTAMP)",
+			hex.EncodeToString(sum[:8]), string(privateKey))
+		if err != nil {
+			return nil, err
+		}
+		// The gRPC and HTTP servers may both have generated a key; the first
+		// one stored is used
+		toolKey, err = load()
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.toolKey = toolKey
+	return toolKey, nil
+}
+
+// platformKey finds the key a platform signed an id_token with
+func (s *LtiService) platformKey(jwksURL, kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	keySet := s.platformKeys[jwksURL]
+	s.mu.Unlock()
+
+	if keySet == nil || time.Since(keySet.fetchedAt) > platformKeysLifetime || (kid != "" && keySet.keys[kid] == nil) {
+		fetched, err := s.fetchPlatformKeys(jwksURL)
+		if err != nil {
+			return nil, err
+		}
+		keySet = fetched
+		s.mu.Lock()
+		s.platformKeys[jwksURL] = keySet
+		s.mu.Unlock()
+	}
+
+	if kid == "" {
+		if len(keySet.keys) == 1 {
+			for _, key := range keySet.keys {
+				return key, nil
+			}
+		}
+		return nil, errors.New("token has no kid and the platform publishes several keys")
+	}
+	key, ok := keySet.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("platform has no key %s", kid)
+	}
+	return key, nil
+}
+
+func (s *LtiService) fetchPlatformKeys(jwksURL string) (*ltiKeySet, error) {
+	resp, err := s.client.Get(jwksURL)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching platform keys: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching platform keys: %s", resp.Status)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("error parsing platform keys: %v", err)
+	}
+
+	keySet := &ltiKeySet{keys: make(map[string]*rsa.PublicKey), fetchedAt: time.Now()}
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			continue
+		}
+		keySet.keys[jwk.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	return keySet, nil
+}
+
+// accessToken gets an AGS access token from the platform using a client
+// assertion signed with the tool's key, reusing it until it expires
+func (s *LtiService) accessToken(platform *ltiPlatform, scopes []string) (string, error) {
+	scope := strings.Join(scopes, " ")
+	cacheKey := fmt.Sprintf("%d %s", platform.id, scope)
+	s.mu.Lock()
+	cached, ok := s.accessTokens[cacheKey]
+	s.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.token, nil
+	}
+
+	toolKey, err := s.signingKey()
+	if err != nil {
+		return "", err
+	}
+	jti, err := randomHex(16)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Issuer:    platform.clientID,
+		Subject:   platform.clientID,
+		Audience:  jwt.ClaimStrings{platform.authTokenURL},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+		ID:        jti,
+	})
+	assertion.Header["kid"] = toolKey.kid
+	signed, err := assertion.SignedString(toolKey.key)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.PostForm(platform.authTokenURL, url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {signed},
+		"scope":                 {scope},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error requesting access token: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error requesting access token: %s", responseSummary(resp))
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return "", fmt.Errorf("error parsing access token: %v", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("platform returned no access token")
+	}
+	if token.ExpiresIn <= 0 {
+		token.ExpiresIn = 3600
+	}
+
+	s.mu.Lock()
+	s.accessTokens[cacheKey] = ltiAccessToken{
+		token:     token.AccessToken,
+		expiresAt: now.Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute),
+	}
+	s.mu.Unlock()
+	return token.AccessToken, nil
+}
+
+// responseSummary describes a failed response from a platform
+func responseSummary(resp *http.Response) string {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if message := strings.TrimSpace(string(body)); message != "" {
+		return resp.Status + ": " + message
+	}
+	return resp.Status
+}
+
+// PassbackGrades sends the released grades of an assignment to the line item
+// of every resource link that stands for it. Each student launched from the
+// link's platform gets the grade on their latest graded submission, the one
+// the student portal shows them.
+func (s *LtiService) PassbackGrades(ctx context.Context, req *pb.PassbackGradesRequest) (*pb.PassbackGradesResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseInstructor(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+	released, err := assignmentReleased(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if !released {
+		return nil, errors.New("grades for this assignment are not released")
+	}
+
+	var name string
+	var maxScore float64
+	if err := s.db.DB.QueryRow("SELECT name, max_score FROM assignments WHERE id = ?", req.AssignmentId).Scan(&name, &maxScore); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT id, platform_id, resource_link_id, COALESCE(lineitems_url, ''), COALESCE(lineitem_url, ''), ags_scopes
+		FROM lti_resource_links WHERE assignment_id = ? ORDER BY id
+	`, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type resourceLink struct {
+		id             int64
+		platformID     int64
+		resourceLinkID string
+		lineItemsURL   string
+		lineItemURL    string
+		scopes         string
+	}
+	var links []resourceLink
+	for rows.Next() {
+		var link resourceLink
+		if err := rows.Scan(&link.id, &link.platformID, &link.resourceLinkID, &link.lineItemsURL, &link.lineItemURL, &link.scopes); err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	if len(links) == 0 {
+		return nil, errors.New("assignment is not linked to an LTI resource link")
+	}
+
+	resp := &pb.PassbackGradesResponse{AssignmentId: req.AssignmentId}
+	for _, link := range links {
+		platform, err := s.getPlatform(link.platformID)
+		if err != nil {
+			return nil, err
+		}
+		students, err := s.passbackStudents(courseID, req.AssignmentId, link.id, platform.id, maxScore)
+		if err != nil {
+			return nil, err
+		}
+
+		// A link whose platform cannot take scores fails as a whole
+		var linkErr error
+		lineItemURL := link.lineItemURL
+		if !hasScope(link.scopes, agsScopeScore) {
+			linkErr = errors.New("the platform did not grant the score scope")
+		} else if lineItemURL == "" {
+			if link.lineItemsURL == "" || !hasScope(link.scopes, agsScopeLineItem) {
+				linkErr = errors.New("the platform gave the resource link no line item")
+			} else if lineItemURL, linkErr = s.createLineItem(platform, link.lineItemsURL, link.resourceLinkID, name, maxScore); linkErr == nil {
+				if _, err := s.db.DB.Exec("UPDATE lti_resource_links SET lineitem_url = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", lineItemURL, link.id); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		var token string
+		if linkErr == nil {
+			token, linkErr = s.accessToken(platform, []string{agsScopeScore})
+		}
+
+		for _, student := range students {
+			result := student.result
+			switch {
+			case result.Status == ltiScoreSkipped:
+			case linkErr != nil:
+				result.Status = ltiScoreFailed
+				result.Message = linkErr.Error()
+			default:
+				if err := s.sendScore(lineItemURL, token, student); err != nil {
+					result.Status = ltiScoreFailed
+					result.Message = err.Error()
+				} else {
+					result.Status = ltiScoreSent
+				}
+			}
+
+			switch result.Status {
+			case ltiScoreSent:
+				resp.Sent++
+			case ltiScoreSkipped:
+				resp.Skipped++
+			default:
+				resp.Failed++
+			}
+			resp.Results = append(resp.Results, result)
+		}
+	}
+
+	resp.Message = fmt.Sprintf("Passed back %d grades; %d skipped, %d failed", resp.Sent, resp.Skipped, resp.Failed)
+	return resp, nil
+}
+
+// passbackStudent is a student's score ready to send to a line item
+type passbackStudent struct {
+	result   *pb.LtiScoreResult
+	subject  string
+	feedback string
+}
+
+// passbackStudents loads the students of a course who launched from a
+// platform, with their grades on an assignment
+func (s *LtiService) passbackStudents(courseID, assignmentID, linkID, platformID int64, maxScore float64) ([]passbackStudent, error) {
+	rows, err := s.db.DB.Query(`
+		SELECT u.id, lu.subject, u.name, COALESCE(cr.student_id, '')
+		FROM lti_users lu
+		JOIN users u ON lu.user_id = u.id
+		JOIN course_members cm ON cm.user_id = u.id AND cm.course_id = ? AND cm.role = 'student'
+		LEFT JOIN course_roster cr ON cr.course_id = cm.course_id AND cr.user_id = u.id
+		WHERE lu.platform_id = ?
+		ORDER BY u.name, u.id
+	`, courseID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var students []passbackStudent
+	for rows.Next() {
+		student := passbackStudent{result: &pb.LtiScoreResult{LinkId: linkID, MaxScore: maxScore}}
+		if err := rows.Scan(&student.result.UserId, &student.subject, &student.result.StudentName, &student.result.StudentId); err != nil {
+			return nil, err
+		}
+		students = append(students, student)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range students {
+		result := students[i].result
+		var feedback sql.NullString
+		err := s.db.DB.QueryRow(`
+			SELECT g.total_score, g.feedback
+			FROM grades g
+			JOIN submissions s ON g.submission_id = s.id
+			WHERE g.assignment_id = ? AND s.student_id = ? AND s.student_id != ''
+			ORDER BY s.uploaded_at DESC, s.id DESC, g.graded_at ASC, g.id ASC
+			LIMIT 1
+		`, assignmentID, result.StudentId).Scan(&result.Score, &feedback)
+		if err == sql.ErrNoRows {
+			result.Status = ltiScoreSkipped
+			result.Message = "no grade for this student"
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		students[i].feedback = feedback.String
+	}
+	return students, nil
+}
+
+// createLineItem adds a line item for a resource link to the platform's
+// gradebook, returning its URL
+func (s *LtiService) createLineItem(platform *ltiPlatform, lineItemsURL, resourceLinkID, label string, maxScore float64) (string, error) {
+	token, err := s.accessToken(platform, []string{agsScopeLineItem})
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"label":          label,
+		"scoreMaximum":   maxScore,
+		"resourceLinkId": resourceLinkID,
+	})
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequest(http.MethodPost, lineItemsURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", "application/vnd.ims.lis.v2.lineitem+json")
+	request.Header.Set("Accept", "application/vnd.ims.lis.v2.lineitem+json")
+
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("error creating line item: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creating line item: %s", responseSummary(resp))
+	}
+
+	var lineItem struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&lineItem); err != nil || lineItem.ID == "" {
+		return "", errors.New("platform returned no line item ID")
+	}
+	return lineItem.ID, nil
+}
+
+// sendScore posts a student's score to a line item's scores endpoint
+func (s *LtiService) sendScore(lineItemURL, token string, student passbackStudent) error {
+	scoresURL, err := url.Parse(lineItemURL)
+	if err != nil {
+		return fmt.Errorf("invalid line item URL: %v", err)
+	}
+	scoresURL.Path = strings.TrimSuffix(scoresURL.Path, "/") + "/scores"
+
+	score := map[string]interface{}{
+		"userId":           student.subject,
+		"scoreGiven":       student.result.Score,
+		"scoreMaximum":     student.result.MaxScore,
+		"activityProgress": "Completed",
+		"gradingProgress":  "FullyGraded",
+		"timestamp":        time.Now().UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+	}
+	if student.feedback != "" {
+		score["comment"] = student.feedback
+	}
+	body, err := json.Marshal(score)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, scoresURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", "application/vnd.ims.lis.v1.score+json")
+
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("error sending score: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("error sending score: %s", responseSummary(resp))
+	}
+	return nil
+}
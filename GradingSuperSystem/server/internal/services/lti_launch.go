@@ -0,0 +1,689 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	pb "github.com/talytics/server/proto"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ltiStateLifetime bounds how long a login may take to come back as a launch
+const ltiStateLifetime = "-10 minutes"
+
+// ltiLoginCodeLifetime bounds how long the web client has to exchange the
+// code a launch redirects it with
+const ltiLoginCodeLifetime = "-2 minutes"
+
+// ErrLtiLoginCodeInvalid is returned for sign-in codes that are unknown,
+// expired or already used
+var ErrLtiLoginCodeInvalid = errors.New("invalid or expired LTI sign-in code")
+
+// The only launch message supported, and its LTI version
+const (
+	ltiMessageTypeLaunch = "LtiResourceLinkRequest"
+	ltiVersion           = "1.3.0"
+)
+
+// ltiClaims is the payload of a launch's id_token
+type ltiClaims struct {
+	jwt.RegisteredClaims
+	Nonce           string `json:"nonce"`
+	AuthorizedParty string `json:"azp"`
+	Name            string `json:"name"`
+	GivenName       string `json:"given_name"`
+	FamilyName      string `json:"family_name"`
+	Email           string `json:"email"`
+	EmailVerified   bool   `json:"email_verified"`
+
+	MessageType  string                 `json:"https://purl.imsglobal.org/spec/lti/claim/message_type"`
+	Version      string                 `json:"https://purl.imsglobal.org/spec/lti/claim/version"`
+	DeploymentID string                 `json:"https://purl.imsglobal.org/spec/lti/claim/deployment_id"`
+	Roles        []string               `json:"https://purl.imsglobal.org/spec/lti/claim/roles"`
+	Custom       map[string]interface{} `json:"https://purl.imsglobal.org/spec/lti/claim/custom"`
+	Context      struct {
+		ID    string `json:"id"`
+		Label string `json:"label"`
+		Title string `json:"title"`
+	} `json:"https://purl.imsglobal.org/spec/lti/claim/context"`
+	ResourceLink struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	} `json:"https://purl.imsglobal.org/spec/lti/claim/resource_link"`
+	LIS struct {
+		PersonSourcedID string `json:"person_sourcedid"`
+	} `json:"https://purl.imsglobal.org/spec/lti/claim/lis"`
+	AGS *struct {
+		Scope     []string `json:"scope"`
+		LineItems string   `json:"lineitems"`
+		LineItem  string   `json:"lineitem"`
+	} `json:"https://purl.imsglobal.org/spec/lti-ags/claim/endpoint"`
+}
+
+// custom returns a custom parameter of the launch. Platforms send them as
+// strings, though some send numbers as JSON numbers.
+func (c *ltiClaims) custom(name string) string {
+	value, ok := c.Custom[name]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// LtiLogin holds the parameters of a third-party initiated login
+type LtiLogin struct {
+	Issuer        string
+	LoginHint     string
+	TargetLinkURI string
+	MessageHint   string
+	ClientID      string
+	DeploymentID  string
+}
+
+// LtiLaunch is the outcome of a successful launch
+type LtiLaunch struct {
+	UserID       int64
+	CourseID     int64
+	AssignmentID int64
+	// RedirectURL takes the user to the web client with a one-time code it
+	// exchanges for a session, so no session token is ever put in a URL
+	RedirectURL string
+}
+
+func ltiLaunchError(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid LTI launch: "+format, args...)
+}
+
+// LoginRedirect starts an OIDC login initiated by a platform, returning the
+// platform authorization URL to send the browser to and the login's state.
+// The state must also be kept in the browser, so that a launch is only
+// accepted from the browser that started its login.
+func (s *LtiService) LoginRedirect(login LtiLogin) (string, string, error) {
+	if login.Issuer == "" || login.LoginHint == "" || login.TargetLinkURI == "" {
+		return "", "", ltiLaunchError("iss, login_hint and target_link_uri are required")
+	}
+
+	query := "SELECT " + ltiPlatformColumns + " FROM lti_platforms WHERE issuer = ?"
+	args := []interface{}{login.Issuer}
+	if login.ClientID != "" {
+		query += " AND client_id = ?"
+		args = append(args, login.ClientID)
+	}
+	rows, err := s.db.DB.Query(query, args...)
+	if err != nil {
+		return "", "", err
+	}
+	defer rows.Close()
+
+	var platforms []*ltiPlatform
+	for rows.Next() {
+		platform, err := scanLtiPlatform(rows)
+		if err != nil {
+			return "", "", err
+		}
+		platforms = append(platforms, platform)
+	}
+	if err := rows.Err(); err != nil {
+		return "", "", err
+	}
+	rows.Close()
+
+	if len(platforms) == 0 {
+		return "", "", ltiLaunchError("platform %s is not registered", login.Issuer)
+	}
+	if len(platforms) > 1 {
+		return "", "", ltiLaunchError("platform %s has several registrations; client_id is required", login.Issuer)
+	}
+	platform := platforms[0]
+	if platform.deploymentID != "" && login.DeploymentID != "" && login.DeploymentID != platform.deploymentID {
+		return "", "", ltiLaunchError("deployment %s is not registered", login.DeploymentID)
+	}
+
+	state, err := randomHex(16)
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomHex(16)
+	if err != nil {
+		return "", "", err
+	}
+
+	if _, err := s.db.DB.Exec("DELETE FROM lti_launch_states WHERE created_at < datetime('now', ?)", ltiStateLifetime); err != nil {
+		return "", "", err
+	}
+	_, err = s.db.DB.Exec(`
+		INSERT INTO lti_launch_states (state, nonce, platform_id, created_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+	`, state, nonce, platform.id)
+	if err != nil {
+		return "", "", err
+	}
+
+	authURL, err := url.Parse(platform.authLoginURL)
+	if err != nil {
+		return "", "", err
+	}
+	params := authURL.Query()
+	params.Set("scope", "openid")
+	params.Set("response_type", "id_token")
+	params.Set("response_mode", "form_post")
+	params.Set("prompt", "none")
+	params.Set("client_id", platform.clientID)
+	params.Set("redirect_uri", s.toolURL+"/lti/launch")
+	params.Set("login_hint", login.LoginHint)
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	if login.MessageHint != "" {
+		params.Set("lti_message_hint", login.MessageHint)
+	}
+	authURL.RawQuery = params.Encode()
+	return authURL.String(), state, nil
+}
+
+// Launch validates the id_token a platform posted back for a login state,
+// provisions the launching user and their course, and signs the user in.
+// browserState is the state the browser kept when the login started.
+func (s *LtiService) Launch(idToken, state, browserState string) (*LtiLaunch, error) {
+	if idToken == "" || state == "" {
+		return nil, ltiLaunchError("id_token and state are required")
+	}
+	// A launch posted from another browser could sign this one in to
+	// someone else's account
+	if subtle.ConstantTimeCompare([]byte(state), []byte(browserState)) != 1 {
+		return nil, ltiLaunchError("state does not match the browser that started the login")
+	}
+
+	// Each state is good for one launch
+	var nonce string
+	var platformID int64
+	err := s.db.DB.QueryRow(`
+		SELECT nonce, platform_id FROM lti_launch_states
+		WHERE state = ? AND created_at >= datetime('now', ?)
+	`, state, ltiStateLifetime).Scan(&nonce, &platformID)
+	if err == sql.ErrNoRows {
+		return nil, ltiLaunchError("unknown or expired state")
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.db.DB.Exec("DELETE FROM lti_launch_states WHERE state = ?", state)
+	if err != nil {
+		return nil, err
+	}
+	if deleted, err := result.RowsAffected(); err != nil || deleted == 0 {
+		return nil, ltiLaunchError("state has already been used")
+	}
+
+	platform, err := s.getPlatform(platformID)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := s.validateIDToken(platform, idToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	role := ltiRole(claims.Roles)
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	userID, _, accountRole, err := s.provisionUser(tx, platform, claims, role)
+	if err != nil {
+		return nil, err
+	}
+	if err := raiseAccountRole(tx, userID, accountRole, role); err != nil {
+		return nil, err
+	}
+	courseID, err := s.provisionCourse(tx, platform, claims, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(`
+		INSERT OR IGNORE INTO course_members (course_id, user_id, role, joined_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+	`, courseID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if role == "student" {
+		if err := linkLtiStudentID(tx, courseID, userID, ltiStudentID(claims)); err != nil {
+			return nil, err
+		}
+	}
+	assignmentID, err := s.saveResourceLink(tx, platform, claims, courseID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec("DELETE FROM lti_login_codes WHERE created_at < datetime('now', ?)", ltiLoginCodeLifetime); err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(`
+		INSERT INTO lti_login_codes (code, user_id, created_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+	`, code, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &LtiLaunch{
+		UserID:       userID,
+		CourseID:     courseID,
+		AssignmentID: assignmentID,
+		RedirectURL:  s.appURL + "/#lti_code=" + code,
+	}, nil
+}
+
+// ExchangeLoginCode signs in the user a launch redirected to the web client
+// with a one-time code
+func (s *LtiService) ExchangeLoginCode(code string) (*pb.AuthResponse, error) {
+	if code == "" {
+		return nil, ErrLtiLoginCodeInvalid
+	}
+
+	var userID int64
+	err := s.db.DB.QueryRow(`
+		SELECT user_id FROM lti_login_codes
+		WHERE code = ? AND created_at >= datetime('now', ?)
+	`, code, ltiLoginCodeLifetime).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return nil, ErrLtiLoginCodeInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.db.DB.Exec("DELETE FROM lti_login_codes WHERE code = ?", code)
+	if err != nil {
+		return nil, err
+	}
+	if deleted, err := result.RowsAffected(); err != nil || deleted == 0 {
+		return nil, ErrLtiLoginCodeInvalid
+	}
+
+	var user pb.User
+	var createdAt, updatedAt time.Time
+	err = s.db.DB.QueryRow(`
+		SELECT id, email, name, role, created_at, updated_at
+		FROM users WHERE id = ?
+	`, userID).Scan(&user.Id, &user.Email, &user.Name, &user.Role, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	user.CreatedAt = timestamppb.New(createdAt)
+	user.UpdatedAt = timestamppb.New(updatedAt)
+
+	token, err := s.userService.generateToken(user.Id, user.Email, user.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuthResponse{
+		User:    &user,
+		Token:   token,
+		Message: "Login successful",
+	}, nil
+}
+
+// validateIDToken checks a launch's id_token against the platform's keys and
+// registration and the nonce sent with the login
+func (s *LtiService) validateIDToken(platform *ltiPlatform, idToken, nonce string) (*ltiClaims, error) {
+	claims := &ltiClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256"}))
+	_, err := parser.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return s.platformKey(platform.jwksURL, kid)
+	})
+	if err != nil {
+		return nil, ltiLaunchError("id_token: %v", err)
+	}
+
+	switch {
+	case claims.ExpiresAt == nil || claims.IssuedAt == nil:
+		return nil, ltiLaunchError("id_token must have exp and iat claims")
+	case !claims.VerifyIssuer(platform.issuer, true):
+		return nil, ltiLaunchError("id_token was not issued by %s", platform.issuer)
+	case !claims.VerifyAudience(platform.clientID, true):
+		return nil, ltiLaunchError("id_token is not meant for client %s", platform.clientID)
+	case (len(claims.Audience) > 1 || claims.AuthorizedParty != "") && claims.AuthorizedParty != platform.clientID:
+		return nil, ltiLaunchError("id_token azp does not match client %s", platform.clientID)
+	case claims.Nonce != nonce:
+		return nil, ltiLaunchError("nonce does not match the login")
+	case claims.Subject == "":
+		return nil, ltiLaunchError("anonymous launches are not supported")
+	case claims.MessageType != ltiMessageTypeLaunch:
+		return nil, ltiLaunchError("message type %q is not supported", claims.MessageType)
+	case claims.Version != ltiVersion:
+		return nil, ltiLaunchError("LTI version %q is not supported", claims.Version)
+	case claims.DeploymentID == "":
+		return nil, ltiLaunchError("deployment_id is required")
+	case platform.deploymentID != "" && claims.DeploymentID != platform.deploymentID:
+		return nil, ltiLaunchError("deployment %s is not registered", claims.DeploymentID)
+	case claims.Context.ID == "":
+		return nil, ltiLaunchError("a context is required")
+	case claims.ResourceLink.ID == "":
+		return nil, ltiLaunchError("a resource link is required")
+	}
+	return claims, nil
+}
+
+const (
+	ltiMembershipRole        = "http://purl.imsglobal.org/vocab/lis/v2/membership#"
+	ltiTeachingAssistantRole = "http://purl.imsglobal.org/vocab/lis/v2/membership/Instructor#TeachingAssistant"
+)
+
+// ltiRole maps a launch's context roles to a course role. Institution and
+// system roles say nothing about this course, so they are ignored, and
+// anyone without an instructor role in the context is a student. Teaching
+// assistants also carry the Instructor role, so they are checked first.
+func ltiRole(roles []string) string {
+	var instructor, ta bool
+	for _, role := range roles {
+		switch role {
+		case ltiTeachingAssistantRole:
+			ta = true
+		case ltiMembershipRole + "Instructor", "Instructor":
+			instructor = true
+		}
+	}
+
+	switch {
+	case ta:
+		return "ta"
+	case instructor:
+		return "instructor"
+	}
+	return "student"
+}
+
+// provisionUser finds the account of the launching user or creates one, and
+// returns the account's ID, email and role. A user's first launch only signs
+// in to an existing account when an administrator registered the platform as
+// trusted for emails, the platform verified the account's email, and the
+// account is a student's; anyone else gets a new account, since a platform
+// can claim any email it likes. Staff and administrator accounts are never
+// taken over this way, as they can see other students' work.
+func (s *LtiService) provisionUser(tx *sql.Tx, platform *ltiPlatform, claims *ltiClaims, role string) (int64, string, string, error) {
+	var userID int64
+	var email, userRole string
+	err := tx.QueryRow(`
+		SELECT u.id, u.email, u.role FROM lti_users lu
+		JOIN users u ON lu.user_id = u.id
+		WHERE lu.platform_id = ? AND lu.subject = ?
+	`, platform.id, claims.Subject).Scan(&userID, &email, &userRole)
+	if err == nil {
+		return userID, email, userRole, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, "", "", err
+	}
+
+	email = strings.TrimSpace(claims.Email)
+	if platform.trustEmail && claims.EmailVerified && email != "" {
+		err = tx.QueryRow(`
+			SELECT id, email, role FROM users
+			WHERE email = ? COLLATE NOCASE AND role = 'student' AND is_admin = 0
+		`, email).Scan(&userID, &email, &userRole)
+	}
+	if err == sql.ErrNoRows {
+		userID, email, userRole, err = s.createLtiUser(tx, platform, claims, role)
+	}
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO lti_users (platform_id, subject, user_id, created_at)
+		VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+	`, platform.id, claims.Subject, userID)
+	if err != nil {
+		return 0, "", "", err
+	}
+	return userID, email, userRole, nil
+}
+
+// accountRoleRank orders account roles by the pages they reach
+var accountRoleRank = map[string]int{"student": 0, "ta": 1, "instructor": 2}
+
+// raiseAccountRole gives an account the role of a launch when it reaches more
+// than the account's role. LTI accounts are created with the role of their
+// first launch, and a student in one course can be on the staff of another;
+// their access to each course still follows their course membership.
+func raiseAccountRole(tx *sql.Tx, userID int64, accountRole, launchRole string) error {
+	if accountRoleRank[launchRole] <= accountRoleRank[accountRole] {
+		return nil
+	}
+	_, err := tx.Exec("UPDATE users SET role = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", launchRole, userID)
+	return err
+}
+
+// ltiStudentID is the ID the platform enrolled a student under: their LIS
+// sourcedId, or their subject when the platform sends none
+func ltiStudentID(claims *ltiClaims) string {
+	if studentID := strings.TrimSpace(claims.LIS.PersonSourcedID); studentID != "" {
+		return studentID
+	}
+	return claims.Subject
+}
+
+var ltiSubjectCleaner = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func (s *LtiService) createLtiUser(tx *sql.Tx, platform *ltiPlatform, claims *ltiClaims, role string) (int64, string, string, error) {
+	name := strings.TrimSpace(claims.Name)
+	if name == "" {
+		name = strings.TrimSpace(claims.GivenName + " " + claims.FamilyName)
+	}
+	if name == "" {
+		name = "LTI user " + claims.Subject
+	}
+
+	// Accounts without an email, or whose email another account has, get a
+	// placeholder one; they sign in through launches, never with a password
+	email := strings.TrimSpace(claims.Email)
+	var taken int
+	if err := tx.QueryRow("SELECT COUNT(*) FROM users WHERE email = ? COLLATE NOCASE", email).Scan(&taken); err != nil {
+		return 0, "", "", err
+	}
+	if email == "" || taken > 0 {
+		email = fmt.Sprintf("lti-%d-%s@lti.invalid", platform.id, ltiSubjectCleaner.ReplaceAllString(claims.Subject, "_"))
+	}
+	password, err := randomHex(32)
+	if err != nil {
+		return 0, "", "", err
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	result, err := tx.Exec(`
+		INSERT INTO users (email, name, password_hash, role, created_at, updated_at)
+		VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, email, name, string(passwordHash), role)
+	if err != nil {
+		return 0, "", "", err
+	}
+	userID, err := result.LastInsertId()
+	if err != nil {
+		return 0, "", "", err
+	}
+	return userID, email, role, nil
+}
+
+// provisionCourse finds the course a launch's context was provisioned into.
+// The first instructor to launch from a context sets it up: the custom
+// parameter talytics_course_code links a course they already teach, and
+// otherwise a new course is created for them.
+func (s *LtiService) provisionCourse(tx *sql.Tx, platform *ltiPlatform, claims *ltiClaims, userID int64, role string) (int64, error) {
+	var courseID int64
+	err := tx.QueryRow("SELECT course_id FROM lti_contexts WHERE platform_id = ? AND context_id = ?", platform.id, claims.Context.ID).Scan(&courseID)
+	if err == nil {
+		return courseID, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+	if role != "instructor" {
+		return 0, errors.New("access denied: this course has not been set up in TAlytics yet; an instructor must launch it first")
+	}
+
+	title := strings.TrimSpace(claims.Context.Title)
+	if code := strings.TrimSpace(claims.custom("talytics_course_code")); code != "" {
+		err := tx.QueryRow(`
+			SELECT c.id FROM courses c
+			JOIN course_members cm ON cm.course_id = c.id AND cm.user_id = ? AND cm.role = 'instructor'
+			WHERE c.code = ?
+		`, userID, code).Scan(&courseID)
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("access denied: you do not teach a course with code %s", code)
+		}
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		if courseID, err = createLtiCourse(tx, claims, userID); err != nil {
+			return 0, err
+		}
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO lti_contexts (platform_id, context_id, course_id, title, created_at)
+		VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, platform.id, claims.Context.ID, courseID, title)
+	if err != nil {
+		return 0, err
+	}
+	return courseID, nil
+}
+
+func createLtiCourse(tx *sql.Tx, claims *ltiClaims, userID int64) (int64, error) {
+	name := strings.TrimSpace(claims.Context.Title)
+	code := strings.TrimSpace(claims.Context.Label)
+	if code == "" {
+		code = "LTI-" + claims.Context.ID
+	}
+	if name == "" {
+		name = code
+	}
+
+	// Course codes are unique, so a taken code gets a numeric suffix
+	base := code
+	for n := 2; ; n++ {
+		var count int
+		if err := tx.QueryRow("SELECT COUNT(*) FROM courses WHERE code = ?", code).Scan(&count); err != nil {
+			return 0, err
+		}
+		if count == 0 {
+			break
+		}
+		code = base + "-" + strconv.Itoa(n)
+	}
+
+	joinCode, err := generateCourseJoinCode()
+	if err != nil {
+		return 0, err
+	}
+	studentJoinCode, err := generateCourseJoinCode()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(`
+		INSERT INTO courses (name, code, join_code, student_join_code, instructor_id, description, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, name, code, joinCode, studentJoinCode, userID, "Provisioned from an LTI launch")
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// saveResourceLink records the launched resource link and its AGS endpoint.
+// An instructor launch with the custom parameter talytics_assignment_id links
+// it to that assignment. It returns the link's assignment, if any.
+func (s *LtiService) saveResourceLink(tx *sql.Tx, platform *ltiPlatform, claims *ltiClaims, courseID int64, role string) (int64, error) {
+	var linkID int64
+	var assignmentID sql.NullInt64
+	err := tx.QueryRow("SELECT id, assignment_id FROM lti_resource_links WHERE platform_id = ? AND resource_link_id = ?",
+		platform.id, claims.ResourceLink.ID).Scan(&linkID, &assignmentID)
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`
+			INSERT INTO lti_resource_links (platform_id, resource_link_id, course_id, title, created_at, updated_at)
+			VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`, platform.id, claims.ResourceLink.ID, courseID, claims.ResourceLink.Title)
+		if err != nil {
+			return 0, err
+		}
+		if linkID, err = result.LastInsertId(); err != nil {
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, err
+	} else {
+		_, err = tx.Exec("UPDATE lti_resource_links SET title = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?", claims.ResourceLink.Title, linkID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if claims.AGS != nil {
+		_, err = tx.Exec(`
+			UPDATE lti_resource_links
+			SET lineitems_url = NULLIF(?, ''), lineitem_url = COALESCE(NULLIF(?, ''), lineitem_url), ags_scopes = ?
+			WHERE id = ?
+		`, claims.AGS.LineItems, claims.AGS.LineItem, strings.Join(claims.AGS.Scope, " "), linkID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if value := strings.TrimSpace(claims.custom("talytics_assignment_id")); value != "" && role == "instructor" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, ltiLaunchError("talytics_assignment_id must be a number")
+		}
+		var assignmentCourseID int64
+		err = tx.QueryRow("SELECT course_id FROM assignments WHERE id = ?", id).Scan(&assignmentCourseID)
+		if err == sql.ErrNoRows || (err == nil && assignmentCourseID != courseID) {
+			return 0, errors.New("assignment not found in course")
+		}
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tx.Exec("UPDATE lti_resource_links SET assignment_id = ? WHERE id = ?", id, linkID); err != nil {
+			return 0, err
+		}
+		assignmentID = sql.NullInt64{Int64: id, Valid: true}
+	}
+
+	return assignmentID.Int64, nil
+}
+
+func randomHex(n int) (string, error) {
+	bytes := make([]byte, n)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestProvisionUserLinksOnlyVerifiedEmailsOfTrustedPlatforms(t *testing.T) {
+	tests := []struct {
+		name       string
+		email      string
+		trustEmail bool
+		verified   bool
+		wantLinked bool
+	}{
+		{"untrusted platform", "alice@example.edu", false, true, false},
+		{"unverified email", "alice@example.edu", true, false, false},
+		{"verified email on a trusted platform", "alice@example.edu", true, true, true},
+		{"email in another case", "Alice@Example.edu", true, true, true},
+		{"instructor account", "prof@example.edu", true, true, false},
+		{"administrator account", "admin@example.edu", true, true, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			course := newTestCourse(t)
+			alice := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('alice@example.edu', 'Alice', '', 'student')")
+			admin := course.insert(t, "INSERT INTO users (email, name, password_hash, role, is_admin) VALUES ('admin@example.edu', 'Admin', '', 'student', 1)")
+			accounts := map[string]int64{"alice@example.edu": alice, "prof@example.edu": course.instructorID, "admin@example.edu": admin}
+			lti := NewLtiService(course.db, NewUserService(course.db))
+			platform := &ltiPlatform{trustEmail: test.trustEmail}
+			platform.id = course.insert(t, `INSERT INTO lti_platforms (name, issuer, client_id, auth_login_url, auth_token_url, jwks_url, trust_email, created_by)
+				VALUES ('LMS', 'https://lms.example.edu', 'talytics', 'https://lms/auth', 'https://lms/token', 'https://lms/jwks', ?, ?)`,
+				test.trustEmail, course.instructorID)
+
+			claims := &ltiClaims{Email: test.email, EmailVerified: test.verified}
+			claims.Subject = "lms-user"
+			tx, err := course.db.DB.Begin()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tx.Rollback()
+			userID, email, role, err := lti.provisionUser(tx, platform, claims, "student")
+			if err != nil {
+				t.Fatalf("provision user: %v", err)
+			}
+
+			if linked := userID == accounts[strings.ToLower(test.email)]; linked != test.wantLinked {
+				t.Fatalf("signed in as user %d (%s, %s); linked = %v, want %v", userID, email, role, linked, test.wantLinked)
+			}
+			if !test.wantLinked && (strings.EqualFold(email, claims.Email) || role != "student") {
+				t.Fatalf("new account has email %s and role %s; want a placeholder email and the student role", email, role)
+			}
+		})
+	}
+}
+
+func TestLtiRoleUsesOnlyContextRoles(t *testing.T) {
+	const institution = "http://purl.imsglobal.org/vocab/lis/v2/institution/person#"
+	tests := []struct {
+		name  string
+		roles []string
+		want  string
+	}{
+		{"context instructor", []string{ltiMembershipRole + "Instructor"}, "instructor"},
+		{"short instructor", []string{"Instructor"}, "instructor"},
+		{"teaching assistant", []string{ltiMembershipRole + "Instructor", ltiTeachingAssistantRole}, "ta"},
+		{"context learner", []string{ltiMembershipRole + "Learner"}, "student"},
+		{"institution instructor learning here", []string{institution + "Instructor", ltiMembershipRole + "Learner"}, "student"},
+		{"institution administrator", []string{institution + "Administrator"}, "student"},
+		{"system administrator", []string{"http://purl.imsglobal.org/vocab/lis/v2/system/person#Administrator"}, "student"},
+		{"no roles", nil, "student"},
+	}
+	for _, test := range tests {
+		if got := ltiRole(test.roles); got != test.want {
+			t.Errorf("%s: ltiRole(%v) = %s, want %s", test.name, test.roles, got, test.want)
+		}
+	}
+}
+
+func TestOnlyAdministratorAccountsRegisterPlatforms(t *testing.T) {
+	course := newTestCourse(t)
+	lti := NewLtiService(course.db, NewUserService(course.db))
+	admin := course.insert(t, "INSERT INTO users (email, name, password_hash, role, is_admin) VALUES ('admin@example.edu', 'Admin', '', 'instructor', 1)")
+
+	if err := lti.checkAdmin(admin); err != nil {
+		t.Fatalf("administrator: %v", err)
+	}
+	if err := lti.checkAdmin(course.instructorID); err == nil {
+		t.Fatal("instructor without the administrator flag may register platforms")
+	}
+}
+
+func TestLaunchRequiresTheStateTheBrowserKept(t *testing.T) {
+	course := newTestCourse(t)
+	lti := NewLtiService(course.db, NewUserService(course.db))
+
+	for _, browserState := range []string{"", "other-state"} {
+		_, err := lti.Launch("id-token", "login-state", browserState)
+		if err == nil || !strings.Contains(err.Error(), "state does not match") {
+			t.Fatalf("launch with browser state %q: err = %v, want a state mismatch", browserState, err)
+		}
+	}
+}
+
+func TestLoginCodesAreExchangedOnce(t *testing.T) {
+	course := newTestCourse(t)
+	lti := NewLtiService(course.db, NewUserService(course.db))
+	course.insert(t, "INSERT INTO lti_login_codes (code, user_id) VALUES ('fresh', ?)", course.taID)
+	course.insert(t, "INSERT INTO lti_login_codes (code, user_id, created_at) VALUES ('stale', ?, datetime('now', '-1 hour'))", course.taID)
+
+	resp, err := lti.ExchangeLoginCode("fresh")
+	if err != nil {
+		t.Fatalf("exchange code: %v", err)
+	}
+	if resp.User.Id != course.taID || resp.Token == "" {
+		t.Fatalf("signed in as user %d with token %q, want user %d with a token", resp.User.Id, resp.Token, course.taID)
+	}
+	for _, code := range []string{"fresh", "stale", ""} {
+		if _, err := lti.ExchangeLoginCode(code); !errors.Is(err, ErrLtiLoginCodeInvalid) {
+			t.Fatalf("exchange code %q: err = %v, want ErrLtiLoginCodeInvalid", code, err)
+		}
+	}
+}
+
+func TestStaffLaunchesRaiseTheAccountRole(t *testing.T) {
+	course := newTestCourse(t)
+	student := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('alice@example.edu', 'Alice', '', 'student')")
+	launches := []struct {
+		role, want string
+	}{
+		{"student", "student"},
+		{"ta", "ta"},
+		{"student", "ta"},
+		{"instructor", "instructor"},
+		{"ta", "instructor"},
+	}
+	for _, launch := range launches {
+		var accountRole string
+		if err := course.db.DB.QueryRow("SELECT role FROM users WHERE id = ?", student).Scan(&accountRole); err != nil {
+			t.Fatal(err)
+		}
+		tx, err := course.db.DB.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := raiseAccountRole(tx, student, accountRole, launch.role); err != nil {
+			t.Fatalf("raise role: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if err := course.db.DB.QueryRow("SELECT role FROM users WHERE id = ?", student).Scan(&accountRole); err != nil {
+			t.Fatal(err)
+		}
+		if accountRole != launch.want {
+			t.Fatalf("account role after a %s launch is %s, want %s", launch.role, accountRole, launch.want)
+		}
+	}
+}
@@ -0,0 +1,736 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrRegradeRequestNotFound is returned when a regrade request does not exist
+// or the caller cannot see it
+var ErrRegradeRequestNotFound = errors.New("regrade request not found")
+
+// ErrRegradeRequestExists is returned when a grade already has a request awaiting a decision
+var ErrRegradeRequestExists = errors.New("grade already has an open regrade request")
+
+// ErrRegradeRequestStatus is returned when a request's status does not allow the action
+var ErrRegradeRequestStatus = errors.New("regrade request status does not allow this action")
+
+// ErrRegradeAccessDenied is returned when the caller may see a request but not act on it
+var ErrRegradeAccessDenied = errors.New("access denied: you cannot act on this regrade request")
+
+const (
+	regradeOpen     = "open"
+	regradeAssigned = "assigned"
+	regradeAccepted = "accepted"
+	regradeRejected = "rejected"
+	regradeResolved = "resolved"
+)
+
+type RegradeService struct {
+	pb.UnimplementedRegradeServiceServer
+	db *database.Database
+}
+
+func NewRegradeService(db *database.Database) *RegradeService {
+	return &RegradeService{db: db}
+}
+
+const regradeRequestSelectQuery = `
+	SELECT r.id, r.grade_id, r.assignment_id, g.submission_id, g.student_id, g.grader_id, grader.name,
+	       r.criteria, r.justification, r.status, r.requested_by, requester.name,
+	       r.assigned_to, assignee.name, r.response, r.comment_id, r.revision_id,
+	       r.created_at, r.updated_at, r.resolved_at
+	FROM regrade_requests r
+	JOIN grades g ON r.grade_id = g.id
+	LEFT JOIN users grader ON g.grader_id = grader.id
+	LEFT JOIN users requester ON r.requested_by = requester.id
+	LEFT JOIN users assignee ON r.assigned_to = assignee.id
+`
+
+func (s *RegradeService) CreateRegradeRequest(ctx context.Context, req *pb.CreateRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	if _, err := checkGradeAccess(s.db, req.GradeId, userID); err != nil {
+		return nil, err
+	}
+
+	var assignmentID int64
+	if err := s.db.DB.QueryRow("SELECT assignment_id FROM grades WHERE id = ?", req.GradeId).Scan(&assignmentID); err != nil {
+		return nil, err
+	}
+	request, err := fileRegradeRequest(s.db, req.GradeId, assignmentID, userID, req.Criteria, req.Justification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegradeRequestResponse{
+		Request: request,
+		Message: "Regrade request submitted",
+	}, nil
+}
+
+// fileRegradeRequest opens a request against criteria of a grade the caller
+// has already been checked against, starting its comment thread
+func fileRegradeRequest(db *database.Database, gradeID, assignmentID, userID int64, requested []string, justification string) (*pb.RegradeRequest, error) {
+	validator, err := newRubricScoreValidator(db, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+	justification = strings.TrimSpace(justification)
+	if justification == "" {
+		verr.add("justification", "explain why the grade should change")
+	}
+	if len(requested) == 0 {
+		verr.add("criteria", "name at least one rubric criterion to regrade")
+	}
+	seen := make(map[string]bool)
+	var criteria []string
+	for _, key := range requested {
+		if _, ok := validator.criterionIndex(key); !ok {
+			verr.add("criteria."+key, "unknown criterion; expected an index from 0 to %d", len(validator.criteria)-1)
+			continue
+		}
+		if !seen[key] {
+			seen[key] = true
+			criteria = append(criteria, key)
+		}
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	sort.Slice(criteria, func(i, j int) bool { return criterionLess(criteria[i], criteria[j]) })
+
+	criteriaJSON, err := json.Marshal(criteria)
+	if err != nil {
+		return nil, err
+	}
+	// Criteria are numbered from 1 in comments, as they appear on the rubric
+	numbers := make([]string, len(criteria))
+	for i, key := range criteria {
+		index, _ := validator.criterionIndex(key)
+		numbers[i] = "#" + strconv.Itoa(index+1)
+	}
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var active int
+	err = tx.QueryRow(`
+		SELECT COUNT(*) FROM regrade_requests
+		WHERE grade_id = ? AND status IN ('open', 'assigned')
+	`, gradeID).Scan(&active)
+	if err != nil {
+		return nil, err
+	}
+	if active > 0 {
+		return nil, ErrRegradeRequestExists
+	}
+
+	// The request starts a comment thread on the grade that every later
+	// step replies to, so the discussion stays next to the scores
+	result, err := tx.Exec(`
+		INSERT INTO grade_comments (grade_id, user_id, message)
+		VALUES (?, ?, ?)
+	`, gradeID, userID, fmt.Sprintf("Regrade requested for criteria %s: %s", strings.Join(numbers, ", "), justification))
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = tx.Exec(`
+		INSERT INTO regrade_requests (grade_id, assignment_id, criteria, justification, status, requested_by, comment_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, 'open', ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+	`, gradeID, assignmentID, string(criteriaJSON), justification, userID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	requestID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return NewRegradeService(db).getRegradeRequest(requestID)
+}
+
+func (s *RegradeService) ListRegradeRequests(ctx context.Context, req *pb.ListRegradeRequestsRequest) (*pb.ListRegradeRequestsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	query := regradeRequestSelectQuery + " WHERE r.assignment_id = ?"
+	args := []interface{}{req.AssignmentId}
+	if checkCourseInstructor(s.db, courseID, userID) != nil {
+		query += " AND (g.grader_id = ? OR r.requested_by = ? OR r.assigned_to = ?)"
+		args = append(args, userID, userID, userID)
+	}
+	if req.Status != "" {
+		switch req.Status {
+		case regradeOpen, regradeAssigned, regradeAccepted, regradeRejected, regradeResolved:
+		default:
+			return nil, fmt.Errorf("unknown status %q", req.Status)
+		}
+		query += " AND r.status = ?"
+		args = append(args, req.Status)
+	}
+	if req.AssignedToMe {
+		query += " AND r.assigned_to = ?"
+		args = append(args, userID)
+	}
+	query += " ORDER BY r.created_at ASC, r.id ASC"
+
+	rows, err := s.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.ListRegradeRequestsResponse{}
+	for rows.Next() {
+		request, err := scanRegradeRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		resp.Requests = append(resp.Requests, request)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.labelCriteria(resp.Requests); err != nil {
+		return nil, err
+	}
+	mask := newBlindMask(s.db, userID)
+	for _, request := range resp.Requests {
+		if request.StudentId, _, err = mask.studentID(request.SubmissionId, request.StudentId); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *RegradeService) GetRegradeRequest(ctx context.Context, req *pb.GetRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	request, _, err := s.loadForUser(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegradeRequestResponse{Request: request}, nil
+}
+
+func (s *RegradeService) AssignRegradeRequest(ctx context.Context, req *pb.AssignRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	request, isInstructor, err := s.loadForUser(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isInstructor {
+		return nil, ErrRegradeAccessDenied
+	}
+
+	courseID, _, err := getAssignmentCourse(s.db, request.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	var assigneeName string
+	err = s.db.DB.QueryRow(`
+		SELECT u.name FROM course_members cm
+		JOIN users u ON cm.user_id = u.id
+		WHERE cm.course_id = ? AND cm.user_id = ? AND cm.role IN ('instructor', 'ta')
+	`, courseID, req.AssigneeId).Scan(&assigneeName)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("assignee must be an instructor or TA of this course")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE regrade_requests SET status = 'assigned', assigned_to = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status IN ('open', 'assigned')
+	`, req.AssigneeId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRegradeTransition(result); err != nil {
+		return nil, err
+	}
+	if err := postRegradeComment(tx, request, userID, "Regrade request assigned to "+assigneeName); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.regradeResponse(req.Id, userID, "Regrade request assigned")
+}
+
+func (s *RegradeService) AcceptRegradeRequest(ctx context.Context, req *pb.AcceptRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	request, isInstructor, err := s.loadForUser(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !canDecideRegrade(request, userID, isInstructor) {
+		return nil, ErrRegradeAccessDenied
+	}
+	if request.Status != regradeOpen && request.Status != regradeAssigned {
+		return nil, ErrRegradeRequestStatus
+	}
+
+	// Accepting a request may only change the criteria it disputes
+	requested := make(map[string]bool)
+	for _, key := range request.Criteria {
+		requested[key] = true
+	}
+	verr := &ValidationError{}
+	for key := range req.RubricScores {
+		if !requested[key] {
+			verr.add("rubric_scores."+key, "criterion is not part of this regrade request")
+		}
+	}
+	if len(verr.Fields) > 0 {
+		sort.Slice(verr.Fields, func(i, j int) bool { return verr.Fields[i].Field < verr.Fields[j].Field })
+		return nil, verr
+	}
+
+	validator, err := newRubricScoreValidator(s.db, request.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var existingJSON string
+	var version int32
+	previous := &gradeSnapshot{}
+	err = tx.QueryRow("SELECT rubric_scores, total_score, version FROM grades WHERE id = ?", request.GradeId).Scan(&existingJSON, &previous.totalScore, &version)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != version {
+		return nil, gradeConflict(s.db, request.GradeId, userID)
+	}
+	// The disputed criteria are keyed by the rubric version the grade was given on
+	if err := checkGradeRubricVersion(tx, request.GradeId, validator.versionID); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(existingJSON), &previous.rubricScores); err != nil {
+		return nil, err
+	}
+
+	rubricScores := make(map[string]float64, len(previous.rubricScores))
+	for key, score := range previous.rubricScores {
+		rubricScores[key] = score
+	}
+	for key, score := range req.RubricScores {
+		rubricScores[key] = score
+	}
+	totalScore, err := validator.validate(rubricScores)
+	if err != nil {
+		return nil, err
+	}
+	rubricScoresJSON, err := marshalRubricScores(rubricScores)
+	if err != nil {
+		return nil, err
+	}
+
+	current := gradeSnapshot{rubricScores: rubricScores, totalScore: totalScore}
+	var revisionID int64
+	if !previous.equal(current) {
+		result, err := tx.Exec(`
+			UPDATE grades SET rubric_scores = ?, total_score = ?, version = version + 1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND version = ?
+		`, rubricScoresJSON, totalScore, request.GradeId, version)
+		if err != nil {
+			return nil, err
+		}
+		if updated, err := result.RowsAffected(); err != nil {
+			return nil, err
+		} else if updated == 0 {
+			return nil, gradeConflict(s.db, request.GradeId, userID)
+		}
+
+		reason := fmt.Sprintf("Regrade request %d accepted", request.Id)
+		if response := strings.TrimSpace(req.Response); response != "" {
+			reason += ": " + response
+		}
+		if revisionID, err = recordGradeRevision(tx, request.GradeId, userID, revisionSourceRegrade, previous, current, reason); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := tx.Exec(`
+		UPDATE regrade_requests SET status = 'accepted', response = ?, revision_id = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status IN ('open', 'assigned')
+	`, strings.TrimSpace(req.Response), sql.NullInt64{Int64: revisionID, Valid: revisionID != 0}, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRegradeTransition(result); err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Regrade accepted; total score %g -> %g", previous.totalScore, totalScore)
+	if revisionID == 0 {
+		message = "Regrade accepted with no change to the scores"
+	}
+	if err := postRegradeComment(tx, request, userID, withResponse(message, req.Response)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.regradeResponse(req.Id, userID, "Regrade request accepted")
+}
+
+func (s *RegradeService) RejectRegradeRequest(ctx context.Context, req *pb.RejectRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	request, isInstructor, err := s.loadForUser(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !canDecideRegrade(request, userID, isInstructor) {
+		return nil, ErrRegradeAccessDenied
+	}
+	response := strings.TrimSpace(req.Response)
+	if response == "" {
+		verr := &ValidationError{}
+		verr.add("response", "explain why the request is rejected")
+		return nil, verr
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE regrade_requests SET status = 'rejected', response = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status IN ('open', 'assigned')
+	`, response, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRegradeTransition(result); err != nil {
+		return nil, err
+	}
+	if err := postRegradeComment(tx, request, userID, withResponse("Regrade rejected", response)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.regradeResponse(req.Id, userID, "Regrade request rejected")
+}
+
+func (s *RegradeService) ResolveRegradeRequest(ctx context.Context, req *pb.ResolveRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	request, isInstructor, err := s.loadForUser(req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isInstructor && request.RequestedBy != userID {
+		return nil, ErrRegradeAccessDenied
+	}
+
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		UPDATE regrade_requests SET status = 'resolved', updated_at = CURRENT_TIMESTAMP, resolved_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status IN ('accepted', 'rejected')
+	`, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRegradeTransition(result); err != nil {
+		return nil, err
+	}
+	if err := postRegradeComment(tx, request, userID, withResponse("Regrade request resolved", req.Response)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.regradeResponse(req.Id, userID, "Regrade request resolved")
+}
+
+func (s *RegradeService) GetRegradeStats(ctx context.Context, req *pb.GetRegradeStatsRequest) (*pb.RegradeStatsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	courseID, _, err := getAssignmentCourse(s.db, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT g.grader_id, u.name, r.status, COUNT(*),
+		       COALESCE(SUM(rev.new_total_score - COALESCE(rev.old_total_score, 0)), 0)
+		FROM regrade_requests r
+		JOIN grades g ON r.grade_id = g.id
+		LEFT JOIN users u ON g.grader_id = u.id
+		LEFT JOIN grade_revisions rev ON r.revision_id = rev.id
+		WHERE r.assignment_id = ?
+		GROUP BY g.grader_id, r.status
+	`, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.RegradeStatsResponse{AssignmentId: req.AssignmentId}
+	byGrader := make(map[int64]*pb.GraderRegradeStats)
+	for rows.Next() {
+		var graderID int64
+		var graderName sql.NullString
+		var status string
+		var count int32
+		var pointsChanged float64
+		if err := rows.Scan(&graderID, &graderName, &status, &count, &pointsChanged); err != nil {
+			return nil, err
+		}
+
+		stats, ok := byGrader[graderID]
+		if !ok {
+			stats = &pb.GraderRegradeStats{GraderId: graderID, GraderName: graderName.String}
+			byGrader[graderID] = stats
+			resp.Graders = append(resp.Graders, stats)
+		}
+		switch status {
+		case regradeOpen:
+			stats.Open += count
+		case regradeAssigned:
+			stats.Assigned += count
+		case regradeAccepted:
+			stats.Accepted += count
+		case regradeRejected:
+			stats.Rejected += count
+		case regradeResolved:
+			stats.Resolved += count
+		}
+		stats.Total += count
+		stats.PointsChanged += pointsChanged
+		resp.Total += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(resp.Graders, func(i, j int) bool { return resp.Graders[i].GraderId < resp.Graders[j].GraderId })
+	return resp, nil
+}
+
+// loadForUser returns a request the caller can see and whether they teach
+// the course. TAs see requests on their own grades and requests they filed
+// or were assigned; anything else is reported as not found.
+func (s *RegradeService) loadForUser(id, userID int64) (*pb.RegradeRequest, bool, error) {
+	request, err := s.getRegradeRequest(id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	courseID, _, err := getAssignmentCourse(s.db, request.AssignmentId)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return nil, false, err
+	}
+	if checkCourseInstructor(s.db, courseID, userID) == nil {
+		return request, true, nil
+	}
+	if request.GraderId != userID && request.RequestedBy != userID && request.AssignedTo != userID {
+		return nil, false, ErrRegradeRequestNotFound
+	}
+	if request.StudentId, _, err = newBlindMask(s.db, userID).studentID(request.SubmissionId, request.StudentId); err != nil {
+		return nil, false, err
+	}
+	return request, false, nil
+}
+
+func (s *RegradeService) getRegradeRequest(id int64) (*pb.RegradeRequest, error) {
+	request, err := scanRegradeRequest(s.db.DB.QueryRow(regradeRequestSelectQuery+" WHERE r.id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, ErrRegradeRequestNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.labelCriteria([]*pb.RegradeRequest{request}); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *RegradeService) regradeResponse(id, userID int64, message string) (*pb.RegradeRequestResponse, error) {
+	request, err := s.getRegradeRequest(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.StudentId, _, err = newBlindMask(s.db, userID).studentID(request.SubmissionId, request.StudentId); err != nil {
+		return nil, err
+	}
+	return &pb.RegradeRequestResponse{Request: request, Message: message}, nil
+}
+
+// labelCriteria fills in the rubric names of each request's criteria
+func (s *RegradeService) labelCriteria(requests []*pb.RegradeRequest) error {
+	criteriaByAssignment := make(map[int64][]string)
+	for _, request := range requests {
+		criteria, ok := criteriaByAssignment[request.AssignmentId]
+		if !ok {
+			_, rubricID, err := getAssignmentCourse(s.db, request.AssignmentId)
+			if err != nil {
+				return err
+			}
+			if rubricID != 0 {
+				if criteria, _, err = getRubricCriteria(s.db, rubricID); err != nil {
+					return err
+				}
+			}
+			criteriaByAssignment[request.AssignmentId] = criteria
+		}
+
+		request.CriterionLabels = make([]string, len(request.Criteria))
+		for i, key := range request.Criteria {
+			request.CriterionLabels[i] = criterionLabel(criteria, key)
+		}
+	}
+	return nil
+}
+
+func scanRegradeRequest(row rowScanner) (*pb.RegradeRequest, error) {
+	var request pb.RegradeRequest
+	var criteriaJSON string
+	var graderName, requestedByName, assignedToName, response sql.NullString
+	var assignedTo, commentID, revisionID sql.NullInt64
+	var createdAt, updatedAt time.Time
+	var resolvedAt sql.NullTime
+
+	err := row.Scan(&request.Id, &request.GradeId, &request.AssignmentId, &request.SubmissionId, &request.StudentId,
+		&request.GraderId, &graderName, &criteriaJSON, &request.Justification, &request.Status,
+		&request.RequestedBy, &requestedByName, &assignedTo, &assignedToName, &response, &commentID, &revisionID,
+		&createdAt, &updatedAt, &resolvedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(criteriaJSON), &request.Criteria); err != nil {
+		return nil, fmt.Errorf("error parsing regrade criteria: %v", err)
+	}
+
+	request.GraderName = graderName.String
+	request.RequestedByName = requestedByName.String
+	request.AssignedTo = assignedTo.Int64
+	request.AssignedToName = assignedToName.String
+	request.Response = response.String
+	request.CommentId = commentID.Int64
+	request.RevisionId = revisionID.Int64
+	request.CreatedAt = timestamppb.New(createdAt)
+	request.UpdatedAt = timestamppb.New(updatedAt)
+	if resolvedAt.Valid {
+		request.ResolvedAt = timestamppb.New(resolvedAt.Time)
+	}
+
+	return &request, nil
+}
+
+// canDecideRegrade reports whether the caller may accept or reject a request:
+// instructors, the assigned grader, or the original grader while it is unassigned
+func canDecideRegrade(request *pb.RegradeRequest, userID int64, isInstructor bool) bool {
+	if isInstructor {
+		return true
+	}
+	if request.AssignedTo != 0 {
+		return request.AssignedTo == userID
+	}
+	return request.GraderId == userID
+}
+
+// checkRegradeTransition fails when a status update matched no row because
+// the request moved on since it was loaded
+func checkRegradeTransition(result sql.Result) error {
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrRegradeRequestStatus
+	}
+	return nil
+}
+
+// postRegradeComment replies to a request's comment thread
+func postRegradeComment(tx *sql.Tx, request *pb.RegradeRequest, userID int64, message string) error {
+	_, err := tx.Exec(`
+		INSERT INTO grade_comments (grade_id, user_id, message, parent_id)
+		VALUES (?, ?, ?, ?)
+	`, request.GradeId, userID, message, sql.NullInt64{Int64: request.CommentId, Valid: request.CommentId != 0})
+	return err
+}
+
+func withResponse(message, response string) string {
+	if response = strings.TrimSpace(response); response != "" {
+		return message + ": " + response
+	}
+	return message
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestStudentsFileRegradeRequestsOnlyOnTheirReleasedGrades(t *testing.T) {
+	course := newTestCourse(t)
+	alice := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('alice@example.edu', 'Alice', '', 'student')")
+	bob := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('bob@example.edu', 'Bob', '', 'student')")
+	for userID, studentID := range map[int64]string{alice: "s-alice", bob: "s-bob"} {
+		course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'student')", course.courseID, userID)
+		course.insert(t, "INSERT INTO course_roster (course_id, user_id, student_id, source) VALUES (?, ?, ?, 'roster')", course.courseID, userID, studentID)
+	}
+	submissionID := course.addSubmission(t, "s-alice")
+	graded, err := NewGradeService(course.db).SubmitGrade(course.ctx(course.instructorID), gradeRequest(course, submissionID, 0, 40, 20, 10))
+	if err != nil {
+		t.Fatalf("grade: %v", err)
+	}
+	students := NewStudentService(course.db)
+	request := &pb.CreateStudentRegradeRequestRequest{
+		AssignmentId:  course.assignmentID,
+		Criteria:      []string{"0"},
+		Justification: "My solution handles the empty input",
+	}
+
+	if _, err := students.CreateStudentRegradeRequest(course.ctx(alice), request); !errors.Is(err, ErrGradeNotReleased) {
+		t.Fatalf("filing before release: err = %v, want ErrGradeNotReleased", err)
+	}
+	if _, err := course.db.DB.Exec("UPDATE assignments SET release_state = 'released' WHERE id = ?", course.assignmentID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := students.CreateStudentRegradeRequest(course.ctx(bob), request); !errors.Is(err, ErrGradeNotFound) {
+		t.Fatalf("student without a grade filing: err = %v, want ErrGradeNotFound", err)
+	}
+	if _, err := students.CreateStudentRegradeRequest(course.ctx(course.taID), request); err == nil {
+		t.Fatal("TA filed a regrade request through the student portal")
+	}
+
+	resp, err := students.CreateStudentRegradeRequest(course.ctx(alice), request)
+	if err != nil {
+		t.Fatalf("student filing on their released grade: %v", err)
+	}
+	if resp.Request.GradeId != graded.Grade.Id || resp.Request.RequestedBy != alice {
+		t.Fatalf("request on grade %d by %d, want grade %d by %d", resp.Request.GradeId, resp.Request.RequestedBy, graded.Grade.Id, alice)
+	}
+	if resp.Request.GraderName != "" {
+		t.Fatalf("request shows grader %q to the student, want it hidden", resp.Request.GraderName)
+	}
+	if _, err := students.CreateStudentRegradeRequest(course.ctx(alice), request); !errors.Is(err, ErrRegradeRequestExists) {
+		t.Fatalf("filing a second request: err = %v, want ErrRegradeRequestExists", err)
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrStudentIDNotLinked is returned to students whose account has not been
+// linked to a student ID in the course yet
+var ErrStudentIDNotLinked = errors.New("access denied: your instructor has not linked your account to a student ID in this course")
+
+// SetStudentId links a student member of the course to the submissions filed
+// under a student ID. Students never choose their own ID; it comes from the
+// instructor's roster or, for LTI courses, the platform's enrollment.
+func (s *CourseService) SetStudentId(ctx context.Context, req *pb.SetStudentIdRequest) (*pb.SetStudentIdResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := checkCourseInstructor(s.db, req.CourseId, userID); err != nil {
+		return nil, err
+	}
+
+	studentID := strings.TrimSpace(req.StudentId)
+	verr := &ValidationError{}
+	if checkCourseStudent(s.db, req.CourseId, req.UserId) != nil {
+		verr.add("user_id", "user %d is not a student in this course", req.UserId)
+	}
+	if studentID != "" {
+		var owner int64
+		err := s.db.DB.QueryRow(`
+			SELECT user_id FROM course_roster WHERE course_id = ? AND student_id = ?
+		`, req.CourseId, studentID).Scan(&owner)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if err == nil && owner != req.UserId {
+			verr.add("student_id", "student ID %s is already linked to another student in this course", studentID)
+		}
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+
+	message := "Student ID unlinked"
+	if studentID == "" {
+		_, err := s.db.DB.Exec("DELETE FROM course_roster WHERE course_id = ? AND user_id = ?", req.CourseId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		_, err := s.db.DB.Exec(`
+			INSERT INTO course_roster (course_id, user_id, student_id, source, bound_by, bound_at)
+			VALUES (?, ?, ?, 'roster', ?, CURRENT_TIMESTAMP)
+			ON CONFLICT (course_id, user_id) DO UPDATE SET
+				student_id = excluded.student_id, source = excluded.source,
+				bound_by = excluded.bound_by, bound_at = excluded.bound_at
+		`, req.CourseId, req.UserId, studentID, userID)
+		if err != nil {
+			return nil, err
+		}
+		message = "Student ID linked"
+	}
+
+	member, err := s.getCourseMember(req.CourseId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetStudentIdResponse{Member: member, Message: message}, nil
+}
+
+func (s *CourseService) getCourseMember(courseID, userID int64) (*pb.CourseMember, error) {
+	var member pb.CourseMember
+	var joinedAt time.Time
+	err := s.db.DB.QueryRow(`
+		SELECT u.id, u.name, u.email, cm.role, cm.joined_at, COALESCE(cr.student_id, '')
+		FROM course_members cm
+		JOIN users u ON cm.user_id = u.id
+		LEFT JOIN course_roster cr ON cr.course_id = cm.course_id AND cr.user_id = cm.user_id
+		WHERE cm.course_id = ? AND cm.user_id = ?
+	`, courseID, userID).Scan(&member.UserId, &member.Name, &member.Email, &member.Role, &joinedAt, &member.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	member.JoinedAt = timestamppb.New(joinedAt)
+	return &member, nil
+}
+
+// courseStudentID returns the student ID the user is linked to in the course
+func courseStudentID(db *database.Database, courseID, userID int64) (string, error) {
+	var studentID string
+	err := db.DB.QueryRow(`
+		SELECT student_id FROM course_roster WHERE course_id = ? AND user_id = ?
+	`, courseID, userID).Scan(&studentID)
+	if err == sql.ErrNoRows {
+		return "", ErrStudentIDNotLinked
+	}
+	if err != nil {
+		return "", err
+	}
+	return studentID, nil
+}
+
+// linkLtiStudentID links a launching student to the ID the platform enrolled
+// them under, unless the instructor already linked them or another student
+// holds that ID in the course
+func linkLtiStudentID(tx *sql.Tx, courseID, userID int64, studentID string) error {
+	if studentID == "" {
+		return nil
+	}
+	_, err := tx.Exec(`
+		INSERT OR IGNORE INTO course_roster (course_id, user_id, student_id, source, bound_at)
+		VALUES (?, ?, ?, 'lti', CURRENT_TIMESTAMP)
+	`, courseID, userID, studentID)
+	return err
+}
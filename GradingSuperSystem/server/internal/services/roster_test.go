@@ -0,0 +1,61 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestSetStudentIdLinksStudentsToTheirSubmissions(t *testing.T) {
+	course := newTestCourse(t)
+	alice := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('alice@example.edu', 'Alice', '', 'student')")
+	mallory := course.insert(t, "INSERT INTO users (email, name, password_hash, role) VALUES ('mallory@example.edu', 'Mallory', '', 'student')")
+	for _, userID := range []int64{alice, mallory} {
+		course.insert(t, "INSERT INTO course_members (course_id, user_id, role) VALUES (?, ?, 'student')", course.courseID, userID)
+	}
+	course.addSubmission(t, "s-alice")
+	courses := NewCourseService(course.db, NewUserService(course.db))
+	students := NewStudentService(course.db)
+
+	// Joining the course alone gives a student no submissions
+	_, err := students.ListStudentAssignments(course.ctx(mallory), &pb.ListStudentAssignmentsRequest{CourseId: course.courseID})
+	if !errors.Is(err, ErrStudentIDNotLinked) {
+		t.Fatalf("unlinked student listing assignments: err = %v, want ErrStudentIDNotLinked", err)
+	}
+
+	link := func(callerID, userID int64, studentID string) error {
+		_, err := courses.SetStudentId(course.ctx(callerID), &pb.SetStudentIdRequest{
+			CourseId: course.courseID, UserId: userID, StudentId: studentID,
+		})
+		return err
+	}
+	if err := link(course.taID, alice, "s-alice"); !errors.Is(err, ErrNotCourseInstructor) {
+		t.Fatalf("TA linking a student: err = %v, want ErrNotCourseInstructor", err)
+	}
+	if err := link(course.instructorID, alice, "s-alice"); err != nil {
+		t.Fatalf("instructor linking a student: %v", err)
+	}
+	var verr *ValidationError
+	if err := link(course.instructorID, mallory, "s-alice"); !errors.As(err, &verr) {
+		t.Fatalf("linking a taken student ID: err = %v, want a validation error", err)
+	}
+	if err := link(course.instructorID, course.taID, "s-ta"); !errors.As(err, &verr) {
+		t.Fatalf("linking a TA: err = %v, want a validation error", err)
+	}
+
+	submissions, err := students.ListStudentSubmissions(course.ctx(alice), &pb.ListStudentSubmissionsRequest{})
+	if err != nil {
+		t.Fatalf("linked student listing submissions: %v", err)
+	}
+	if len(submissions.Submissions) != 1 {
+		t.Fatalf("linked student sees %d submissions, want 1", len(submissions.Submissions))
+	}
+	submissions, err = students.ListStudentSubmissions(course.ctx(mallory), &pb.ListStudentSubmissionsRequest{})
+	if err != nil {
+		t.Fatalf("unlinked student listing submissions: %v", err)
+	}
+	if len(submissions.Submissions) != 0 {
+		t.Fatalf("unlinked student sees %d submissions, want none", len(submissions.Submissions))
+	}
+}
@@ -45,6 +45,13 @@ func (s *RubricService) CreateRubric(ctx context.Context, req *pb.CreateRubricRe
 		return nil, errors.New("only the course instructor can create rubrics for this course")
 	}
 
+	// A criterion tree replaces the flat criteria and weights, which are
+	// derived from its scored criteria
+	req.Criteria, req.Weights, err = criteriaFromTree(req.CriterionTree, req.Criteria, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate weights sum to 100
 	var totalWeight float64
 	for _, weight := range req.Weights {
@@ -78,8 +85,14 @@ func (s *RubricService) CreateRubric(ctx context.Context, req *pb.CreateRubricRe
 		return nil, err
 	}
 
+	tx, err := s.db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	query := `INSERT INTO rubrics (name, course_id, criteria, weights, created_by, created_at, updated_at) VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`
-	result, err := s.db.DB.Exec(query, req.Name, req.CourseId, string(criteriaJSON), string(weightsJSON), userID)
+	result, err := tx.Exec(query, req.Name, req.CourseId, string(criteriaJSON), string(weightsJSON), userID)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +102,16 @@ func (s *RubricService) CreateRubric(ctx context.Context, req *pb.CreateRubricRe
 		return nil, err
 	}
 
+	// The criteria and weights as created are the rubric's first version
+	tree := savedCriterionTree(req.CriterionTree, req.Criteria, req.Weights)
+	if _, err := createRubricVersion(tx, id, string(criteriaJSON), string(weightsJSON), tree, userID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	// Get created rubric
 	rubric, err := s.getRubricByID(id)
 	if err != nil {
@@ -155,9 +178,11 @@ func (s *RubricService) ListRubrics(ctx context.Context, req *pb.ListRubricsRequ
 	}
 
 	rows, err := s.db.DB.Query(`
-		SELECT r.id, r.name, r.course_id, r.criteria, r.weights, r.created_by, u.name, r.created_at, r.updated_at 
+		SELECT r.id, r.name, r.course_id, r.criteria, r.weights, r.created_by, u.name, r.created_at, r.updated_at,
+		       COALESCE(r.current_version_id, 0), COALESCE(v.version, 0)
 		FROM rubrics r
 		LEFT JOIN users u ON r.created_by = u.id
+		LEFT JOIN rubric_versions v ON r.current_version_id = v.id
 		WHERE r.course_id = ?
 		ORDER BY r.created_at DESC
 	`, req.CourseId)
@@ -174,7 +199,7 @@ func (s *RubricService) ListRubrics(ctx context.Context, req *pb.ListRubricsRequ
 		var creatorName sql.NullString
 
 		err := rows.Scan(&rubric.Id, &rubric.Name, &rubric.CourseId, &criteriaJSON, &weightsJSON, 
-			&rubric.CreatedBy, &creatorName, &createdAt, &updatedAt)
+			&rubric.CreatedBy, &creatorName, &createdAt, &updatedAt, &rubric.VersionId, &rubric.Version)
 		if err != nil {
 			return nil, err
 		}
@@ -199,6 +224,16 @@ func (s *RubricService) ListRubrics(ctx context.Context, req *pb.ListRubricsRequ
 
 		rubrics = append(rubrics, &rubric)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for _, rubric := range rubrics {
+		if rubric.CriterionTree, err = loadCriterionTree(s.db, rubric.VersionId); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pb.ListRubricsResponse{
 		Rubrics: rubrics,
@@ -235,6 +270,13 @@ func (s *RubricService) UpdateRubric(ctx context.Context, req *pb.UpdateRubricRe
 		return nil, errors.New("only the course instructor can update this rubric")
 	}
 
+	// A criterion tree replaces the flat criteria and weights, which are
+	// derived from its scored criteria
+	req.Criteria, req.Weights, err = criteriaFromTree(req.CriterionTree, req.Criteria, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate weights sum to 100
 	var totalWeight float64
 	for _, weight := range req.Weights {
@@ -275,6 +317,23 @@ func (s *RubricService) UpdateRubric(ctx context.Context, req *pb.UpdateRubricRe
 	}
 	defer tx.Rollback()
 
+	// Grades keep the version they were scored against, so changed criteria,
+	// weights or structure become a new version instead of rewriting the
+	// current one
+	tree, err := s.updatedCriterionTree(req.Id, req.CriterionTree, req.Criteria, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+	versioned, err := s.criteriaChanged(req.Id, tree)
+	if err != nil {
+		return nil, err
+	}
+	if versioned {
+		if _, err := createRubricVersion(tx, req.Id, string(criteriaJSON), string(weightsJSON), tree, userID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update rubric
 	_, err = tx.Exec(`
 		UPDATE rubrics 
@@ -299,9 +358,14 @@ func (s *RubricService) UpdateRubric(ctx context.Context, req *pb.UpdateRubricRe
 		return nil, err
 	}
 
+	message := "Rubric updated successfully"
+	if versioned {
+		message = fmt.Sprintf("Rubric updated to version %d", rubric.Version)
+	}
+
 	return &pb.RubricResponse{
 		Rubric:  rubric,
-		Message: "Rubric updated successfully",
+		Message: message,
 	}, nil
 }
 
@@ -378,10 +442,41 @@ func (s *RubricService) DeleteRubric(ctx context.Context, req *pb.DeleteRubricRe
 		return nil, errors.New("cannot delete rubric: it is being used by one or more assignments")
 	}
 
-	_, err = s.db.DB.Exec("DELETE FROM rubrics WHERE id = ?", req.Id)
+	// Grades from assignments that moved to another rubric still point at its versions
+	var gradeCount int
+	err = s.db.DB.QueryRow(`
+		SELECT COUNT(*) FROM grades
+		WHERE rubric_version_id IN (SELECT id FROM rubric_versions WHERE rubric_id = ?)
+	`, req.Id).Scan(&gradeCount)
+	if err != nil {
+		return nil, err
+	}
+	if gradeCount > 0 {
+		return nil, errors.New("cannot delete rubric: grades were scored against it")
+	}
+
+	tx, err := s.db.DB.Begin()
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback()
+
+	statements := []string{
+		"DELETE FROM rubric_levels WHERE criterion_id IN (SELECT c.id FROM rubric_criteria c JOIN rubric_versions v ON c.rubric_version_id = v.id WHERE v.rubric_id = ?)",
+		"DELETE FROM rubric_criteria WHERE rubric_version_id IN (SELECT id FROM rubric_versions WHERE rubric_id = ?)",
+		"DELETE FROM rubric_versions WHERE rubric_id = ?",
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement, req.Id); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.Exec("DELETE FROM rubrics WHERE id = ?", req.Id); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	return &pb.DeleteRubricResponse{
 		Message: "Rubric deleted successfully",
@@ -395,12 +490,14 @@ func (s *RubricService) getRubricByID(rubricID int64) (*pb.Rubric, error) {
 	var creatorName sql.NullString
 
 	err := s.db.DB.QueryRow(`
-		SELECT r.id, r.name, r.course_id, r.criteria, r.weights, r.created_by, u.name, r.created_at, r.updated_at
+		SELECT r.id, r.name, r.course_id, r.criteria, r.weights, r.created_by, u.name, r.created_at, r.updated_at,
+		       COALESCE(r.current_version_id, 0), COALESCE(v.version, 0)
 		FROM rubrics r
 		LEFT JOIN users u ON r.created_by = u.id
+		LEFT JOIN rubric_versions v ON r.current_version_id = v.id
 		WHERE r.id = ?
 	`, rubricID).Scan(&rubric.Id, &rubric.Name, &rubric.CourseId, &criteriaJSON, &weightsJSON,
-		&rubric.CreatedBy, &creatorName, &createdAt, &updatedAt)
+		&rubric.CreatedBy, &creatorName, &createdAt, &updatedAt, &rubric.VersionId, &rubric.Version)
 	if err != nil {
 		return nil, err
 	}
@@ -423,5 +520,9 @@ func (s *RubricService) getRubricByID(rubricID int64) (*pb.Rubric, error) {
 		rubric.CreatorName = creatorName.String
 	}
 
+	if rubric.CriterionTree, err = loadCriterionTree(s.db, rubric.VersionId); err != nil {
+		return nil, err
+	}
+
 	return &rubric, nil
 }
@@ -0,0 +1,422 @@
+package services
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+)
+
+// maxCriterionDepth limits how deeply sub-criteria can be nested
+const maxCriterionDepth = 3
+
+// criterionPathSeparator joins a scored criterion's title to its parents'
+// in the flat criteria list
+const criterionPathSeparator = " / "
+
+// flattenCriterionTree validates a criterion tree and returns its scored
+// leaves, depth first, as the flat criteria and weights grades are keyed by.
+// It normalizes the tree in place: titles are trimmed, leaves get their
+// rubric_scores key and groups the sum of their points.
+func flattenCriterionTree(tree []*pb.RubricCriterion) ([]string, []float64, error) {
+	flat := &criterionFlattener{verr: &ValidationError{}}
+	flat.walk(tree, "criterion_tree", nil, 1)
+	if len(flat.verr.Fields) > 0 {
+		return nil, nil, flat.verr
+	}
+	return flat.criteria, flat.weights, nil
+}
+
+type criterionFlattener struct {
+	verr     *ValidationError
+	criteria []string
+	weights  []float64
+}
+
+func (f *criterionFlattener) walk(criteria []*pb.RubricCriterion, field string, path []string, depth int) float64 {
+	var total float64
+	for i, criterion := range criteria {
+		prefix := fmt.Sprintf("%s[%d]", field, i)
+		criterion.Id = 0
+		criterion.Key = ""
+		criterion.Title = strings.TrimSpace(criterion.Title)
+		criterion.Description = strings.TrimSpace(criterion.Description)
+		if criterion.Title == "" {
+			f.verr.add(prefix+".title", "is required")
+		}
+
+		if len(criterion.SubCriteria) > 0 {
+			if depth >= maxCriterionDepth {
+				f.verr.add(prefix+".sub_criteria", "criteria can be nested at most %d deep", maxCriterionDepth)
+				continue
+			}
+			if len(criterion.Levels) > 0 {
+				f.verr.add(prefix+".levels", "only criteria without sub-criteria have performance levels")
+			}
+			groupPath := append(append([]string{}, path...), criterion.Title)
+			points := f.walk(criterion.SubCriteria, prefix+".sub_criteria", groupPath, depth+1)
+			if criterion.Points != 0 && math.Abs(criterion.Points-points) > 1e-9 {
+				f.verr.add(prefix+".points", "must equal the sum of its sub-criteria, %g", points)
+			}
+			criterion.Points = points
+			total += points
+			continue
+		}
+
+		f.checkLevels(criterion, prefix)
+		if math.IsNaN(criterion.Points) || math.IsInf(criterion.Points, 0) || criterion.Points <= 0 {
+			f.verr.add(prefix+".points", "must be greater than 0")
+		}
+		criterion.Key = strconv.Itoa(len(f.criteria))
+		f.criteria = append(f.criteria, strings.Join(append(append([]string{}, path...), criterion.Title), criterionPathSeparator))
+		f.weights = append(f.weights, criterion.Points)
+		total += criterion.Points
+	}
+	return total
+}
+
+// checkLevels validates a scored criterion's performance levels, which run
+// from best to worst without overlapping, and takes its points from the best
+func (f *criterionFlattener) checkLevels(criterion *pb.RubricCriterion, prefix string) {
+	if len(criterion.Levels) == 0 {
+		return
+	}
+	if criterion.Points == 0 {
+		criterion.Points = criterion.Levels[0].Points
+	} else if criterion.Points != criterion.Levels[0].Points {
+		f.verr.add(prefix+".points", "must equal the points of its first performance level, %g", criterion.Levels[0].Points)
+	}
+
+	floor := math.Inf(1)
+	for i, level := range criterion.Levels {
+		field := fmt.Sprintf("%s.levels[%d]", prefix, i)
+		level.Id = 0
+		level.Label = strings.TrimSpace(level.Label)
+		level.Description = strings.TrimSpace(level.Description)
+		if level.Label == "" {
+			f.verr.add(field+".label", "is required")
+		}
+		if math.IsNaN(level.Points) || math.IsInf(level.Points, 0) || level.Points < 0 {
+			f.verr.add(field+".points", "must be 0 or more")
+			continue
+		}
+		if !level.PointRange {
+			level.MinPoints = 0
+		} else if math.IsNaN(level.MinPoints) || level.MinPoints < 0 || level.MinPoints >= level.Points {
+			f.verr.add(field+".min_points", "must be from 0 up to the level's points")
+			continue
+		}
+		if level.Points >= floor {
+			f.verr.add(field+".points", "levels must go from most to fewest points without overlapping")
+		}
+		floor = level.Points
+		if level.PointRange {
+			floor = level.MinPoints
+		}
+	}
+}
+
+// flatCriterionTree builds the structure of a rubric given only flat criteria
+// and weights: one scored criterion per entry
+func flatCriterionTree(criteria []string, weights []float64) []*pb.RubricCriterion {
+	tree := make([]*pb.RubricCriterion, len(criteria))
+	for i, title := range criteria {
+		tree[i] = &pb.RubricCriterion{Key: strconv.Itoa(i), Title: title}
+		if i < len(weights) {
+			tree[i].Points = weights[i]
+		}
+	}
+	return tree
+}
+
+// criterionTreeJSON renders a tree without its row IDs, so two trees with the
+// same content compare equal
+func criterionTreeJSON(tree []*pb.RubricCriterion) (string, error) {
+	type level struct {
+		Label       string  `json:"label"`
+		Description string  `json:"description"`
+		Points      float64 `json:"points"`
+		PointRange  bool    `json:"point_range"`
+		MinPoints   float64 `json:"min_points"`
+	}
+	type criterion struct {
+		Title       string      `json:"title"`
+		Description string      `json:"description"`
+		Points      float64     `json:"points"`
+		Levels      []level     `json:"levels"`
+		SubCriteria []criterion `json:"sub_criteria"`
+	}
+	var convert func([]*pb.RubricCriterion) []criterion
+	convert = func(criteria []*pb.RubricCriterion) []criterion {
+		out := make([]criterion, len(criteria))
+		for i, c := range criteria {
+			out[i] = criterion{Title: c.Title, Description: c.Description, Points: c.Points, SubCriteria: convert(c.SubCriteria)}
+			for _, l := range c.Levels {
+				out[i].Levels = append(out[i].Levels, level{l.Label, l.Description, l.Points, l.PointRange, l.MinPoints})
+			}
+		}
+		return out
+	}
+	data, err := json.Marshal(convert(tree))
+	return string(data), err
+}
+
+// saveCriterionTree stores a normalized tree as a rubric version's structure
+func saveCriterionTree(tx *sql.Tx, versionID int64, parentID sql.NullInt64, criteria []*pb.RubricCriterion) error {
+	for position, criterion := range criteria {
+		key := sql.NullString{String: criterion.Key, Valid: len(criterion.SubCriteria) == 0}
+		result, err := tx.Exec(`
+			INSERT INTO rubric_criteria (rubric_version_id, parent_id, position, criterion_key, title, description, points)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, versionID, parentID, position, key, criterion.Title, criterion.Description, criterion.Points)
+		if err != nil {
+			return err
+		}
+		criterionID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for levelPosition, level := range criterion.Levels {
+			minPoints := sql.NullFloat64{Float64: level.MinPoints, Valid: level.PointRange}
+			_, err := tx.Exec(`
+				INSERT INTO rubric_levels (criterion_id, position, label, description, points, min_points)
+				VALUES (?, ?, ?, ?, ?, ?)
+			`, criterionID, levelPosition, level.Label, level.Description, level.Points, minPoints)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := saveCriterionTree(tx, versionID, sql.NullInt64{Int64: criterionID, Valid: true}, criterion.SubCriteria); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadCriterionTree reads the structure of a rubric version
+func loadCriterionTree(db *database.Database, versionID int64) ([]*pb.RubricCriterion, error) {
+	rows, err := db.DB.Query(`
+		SELECT id, COALESCE(parent_id, 0), COALESCE(criterion_key, ''), title, description, points
+		FROM rubric_criteria WHERE rubric_version_id = ?
+		ORDER BY position, id
+	`, versionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byID := make(map[int64]*pb.RubricCriterion)
+	children := make(map[int64][]*pb.RubricCriterion)
+	for rows.Next() {
+		var criterion pb.RubricCriterion
+		var parentID int64
+		if err := rows.Scan(&criterion.Id, &parentID, &criterion.Key, &criterion.Title, &criterion.Description, &criterion.Points); err != nil {
+			return nil, err
+		}
+		byID[criterion.Id] = &criterion
+		children[parentID] = append(children[parentID], &criterion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	for id, criterion := range byID {
+		criterion.SubCriteria = children[id]
+	}
+
+	levelRows, err := db.DB.Query(`
+		SELECT l.id, l.criterion_id, l.label, l.description, l.points, l.min_points
+		FROM rubric_levels l
+		JOIN rubric_criteria c ON l.criterion_id = c.id
+		WHERE c.rubric_version_id = ?
+		ORDER BY l.position, l.id
+	`, versionID)
+	if err != nil {
+		return nil, err
+	}
+	defer levelRows.Close()
+
+	for levelRows.Next() {
+		var level pb.RubricLevel
+		var criterionID int64
+		var minPoints sql.NullFloat64
+		if err := levelRows.Scan(&level.Id, &criterionID, &level.Label, &level.Description, &level.Points, &minPoints); err != nil {
+			return nil, err
+		}
+		level.PointRange = minPoints.Valid
+		level.MinPoints = minPoints.Float64
+		if criterion, ok := byID[criterionID]; ok {
+			criterion.Levels = append(criterion.Levels, &level)
+		}
+	}
+	return children[0], levelRows.Err()
+}
+
+// scoredCriteria lists a tree's scored criteria in key order
+func scoredCriteria(tree []*pb.RubricCriterion) []*pb.RubricCriterion {
+	var leaves []*pb.RubricCriterion
+	for _, criterion := range tree {
+		if len(criterion.SubCriteria) > 0 {
+			leaves = append(leaves, scoredCriteria(criterion.SubCriteria)...)
+			continue
+		}
+		leaves = append(leaves, criterion)
+	}
+	return leaves
+}
+
+// criterionLevels returns the performance levels of each scored criterion of
+// a rubric version, indexed like its criteria
+func criterionLevels(db *database.Database, versionID int64) ([][]*pb.RubricLevel, error) {
+	tree, err := loadCriterionTree(db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	leaves := scoredCriteria(tree)
+	levels := make([][]*pb.RubricLevel, len(leaves))
+	for i, leaf := range leaves {
+		levels[i] = leaf.Levels
+	}
+	return levels, nil
+}
+
+// matchesLevel reports whether a score is allowed by a criterion's
+// performance levels; criteria without levels accept any score
+func matchesLevel(levels []*pb.RubricLevel, score float64) bool {
+	if len(levels) == 0 {
+		return true
+	}
+	for _, level := range levels {
+		if score == level.Points || (level.PointRange && score >= level.MinPoints && score <= level.Points) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeLevels lists the scores a criterion's levels allow, e.g. "10, 6 to 8 or 0"
+func describeLevels(levels []*pb.RubricLevel) string {
+	parts := make([]string, len(levels))
+	for i, level := range levels {
+		parts[i] = formatScore(level.Points)
+		if level.PointRange {
+			parts[i] = formatScore(level.MinPoints) + " to " + formatScore(level.Points)
+		}
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " or " + parts[len(parts)-1]
+}
+
+// criteriaFromTree validates a rubric's criterion tree, when one is given,
+// and returns the flat criteria and weights of its scored criteria. Flat
+// criteria sent alongside a tree must match it.
+func criteriaFromTree(tree []*pb.RubricCriterion, criteria []string, weights []float64) ([]string, []float64, error) {
+	if len(tree) == 0 {
+		return criteria, weights, nil
+	}
+	treeCriteria, treeWeights, err := flattenCriterionTree(tree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(criteria) > 0 || len(weights) > 0 {
+		matches := len(criteria) == len(treeCriteria) && len(weights) == len(treeWeights)
+		for i := 0; matches && i < len(criteria); i++ {
+			matches = criteria[i] == treeCriteria[i] && weights[i] == treeWeights[i]
+		}
+		if !matches {
+			verr := &ValidationError{}
+			verr.add("criteria", "must match the scored criteria of criterion_tree; send one or the other")
+			return nil, nil, verr
+		}
+	}
+	return treeCriteria, treeWeights, nil
+}
+
+// savedCriterionTree is the structure a rubric is saved with: its criterion
+// tree, or one scored criterion per flat criterion when it has none
+func savedCriterionTree(tree []*pb.RubricCriterion, criteria []string, weights []float64) []*pb.RubricCriterion {
+	if len(tree) > 0 {
+		return tree
+	}
+	return flatCriterionTree(criteria, weights)
+}
+
+// updatedCriterionTree is the structure a rubric update saves. Updates with
+// only flat criteria and weights keep the current structure when those match
+// its scored criteria, and cannot change a rubric whose structure the flat
+// fields would lose.
+func (s *RubricService) updatedCriterionTree(rubricID int64, tree []*pb.RubricCriterion, criteria []string, weights []float64) ([]*pb.RubricCriterion, error) {
+	if len(tree) > 0 {
+		return tree, nil
+	}
+	versionID, err := currentRubricVersionID(s.db, rubricID)
+	if err != nil {
+		return nil, err
+	}
+	currentCriteria, currentWeights, err := getRubricVersionCriteria(s.db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := loadCriterionTree(s.db, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := len(criteria) == len(currentCriteria) && len(weights) == len(currentWeights)
+	for i := 0; matches && i < len(criteria); i++ {
+		matches = criteria[i] == currentCriteria[i] && weights[i] == currentWeights[i]
+	}
+	if matches {
+		return current, nil
+	}
+	if structuredCriteria(current) {
+		verr := &ValidationError{}
+		verr.add("criteria", "this rubric has sub-criteria, performance levels or descriptions; send criterion_tree to change it")
+		return nil, verr
+	}
+	return flatCriterionTree(criteria, weights), nil
+}
+
+// structuredCriteria reports whether a tree has anything beyond titles and
+// points, which flat criteria and weights cannot express
+func structuredCriteria(tree []*pb.RubricCriterion) bool {
+	for _, criterion := range tree {
+		if len(criterion.SubCriteria) > 0 || len(criterion.Levels) > 0 || criterion.Description != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// criteriaChanged reports whether a tree differs from the structure of a
+// rubric's current version
+func (s *RubricService) criteriaChanged(rubricID int64, tree []*pb.RubricCriterion) (bool, error) {
+	versionID, err := currentRubricVersionID(s.db, rubricID)
+	if err != nil {
+		return false, err
+	}
+	current, err := loadCriterionTree(s.db, versionID)
+	if err != nil {
+		return false, err
+	}
+
+	currentJSON, err := criterionTreeJSON(current)
+	if err != nil {
+		return false, err
+	}
+	treeJSON, err := criterionTreeJSON(tree)
+	if err != nil {
+		return false, err
+	}
+	return currentJSON != treeJSON, nil
+}
@@ -0,0 +1,458 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/talytics/server/internal/statistics"
+	pb "github.com/talytics/server/proto"
+)
+
+const (
+	// Number of equal-width bins the before and after distributions are
+	// compared over, as fractions of the maximum score
+	impactHistogramBins = 10
+	// Score changes smaller than this are rounding, not a change
+	impactTolerance = 1e-9
+)
+
+// proposedCriterion is one scored criterion of a proposed rubric and the
+// current criteria its replayed score is built from
+type proposedCriterion struct {
+	label   string
+	weight  float64
+	sources []*pb.CriterionSource
+	assumed float64
+}
+
+// rubricReplay rescores grades given on the current version of a rubric
+// against a proposed set of criteria
+type rubricReplay struct {
+	currentWeights map[string]float64
+	proposed       []proposedCriterion
+}
+
+// score returns a grade's total under the proposed rubric. Each proposed
+// criterion earns its points in proportion to the share-weighted fraction its
+// sources earned; anything in the total outside the criterion scores is kept.
+func (r *rubricReplay) score(record gradeRecord) (float64, []float64) {
+	total := record.totalScore
+	for key := range r.currentWeights {
+		total -= record.rubricScores[key]
+	}
+
+	scores := make([]float64, len(r.proposed))
+	for i, criterion := range r.proposed {
+		if len(criterion.sources) == 0 {
+			scores[i] = criterion.assumed * criterion.weight
+		} else {
+			var earned, possible float64
+			for _, source := range criterion.sources {
+				earned += source.Share * record.rubricScores[source.Key]
+				possible += source.Share * r.currentWeights[source.Key]
+			}
+			if possible > 0 {
+				scores[i] = criterion.weight * earned / possible
+			}
+		}
+		total += scores[i]
+	}
+	return total, scores
+}
+
+// SimulateRubricChange replays the grades scored on a rubric's current version
+// against proposed criteria and weights, reporting how the score distribution,
+// each student's reported grade and the spread between graders would change.
+// Nothing is saved.
+func (s *RubricService) SimulateRubricChange(ctx context.Context, req *pb.SimulateRubricChangeRequest) (*pb.RubricImpactResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := s.checkRubricAccess(req.RubricId, userID); err != nil {
+		return nil, err
+	}
+
+	current, err := s.getVersion(req.RubricId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if req.AssignmentId != 0 {
+		_, rubricID, err := getAssignmentCourse(s.db, req.AssignmentId)
+		if err != nil {
+			return nil, err
+		}
+		if rubricID != req.RubricId {
+			verr := &ValidationError{}
+			verr.add("assignment_id", "assignment is not graded with this rubric")
+			return nil, verr
+		}
+	}
+
+	replay, err := newRubricReplay(current, req)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &analysisScope{assignmentID: req.AssignmentId, rubricID: req.RubricId, rubricVersionID: current.id}
+	records, _, err := loadGradeRecords(s.db, scope)
+	if err != nil {
+		return nil, err
+	}
+	reported, outdated, err := s.reportedGradeIDs(scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := newBlindMask(s.db, userID).records(records); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RubricImpactResponse{
+		RubricId:        req.RubricId,
+		AssignmentId:    req.AssignmentId,
+		CurrentVersion:  current.version,
+		ReplayedGrades:  int32(len(records)),
+		OutdatedGrades:  int32(outdated),
+		DroppedCriteria: replay.droppedCriteria(),
+	}
+
+	var currentMax, proposedMax float64
+	for _, weight := range current.weights {
+		currentMax += weight
+	}
+	for _, criterion := range replay.proposed {
+		proposedMax += criterion.weight
+	}
+
+	criterionTotals := make([]scoreSum, len(replay.proposed))
+	byGrader := make(map[int64]*gradeShift)
+	var graderOrder []int64
+	var before, after []float64
+	for _, record := range records {
+		total, scores := replay.score(record)
+		for i, score := range scores {
+			criterionTotals[i].total += score
+			criterionTotals[i].count++
+		}
+
+		shift, ok := byGrader[record.graderID]
+		if !ok {
+			shift = &gradeShift{name: record.graderName}
+			byGrader[record.graderID] = shift
+			graderOrder = append(graderOrder, record.graderID)
+		}
+		shift.before = append(shift.before, record.totalScore)
+		shift.after = append(shift.after, total)
+
+		if !reported[record.id] {
+			continue
+		}
+		before = append(before, record.totalScore)
+		after = append(after, total)
+
+		delta := &pb.StudentScoreDelta{
+			AssignmentId:   record.assignmentID,
+			StudentId:      record.studentID,
+			SubmissionId:   record.submissionID,
+			GradeId:        record.id,
+			Before:         record.totalScore,
+			After:          total,
+			Delta:          total - record.totalScore,
+			BeforeFraction: scoreFraction(record.totalScore, currentMax),
+			AfterFraction:  scoreFraction(total, proposedMax),
+		}
+		switch {
+		case delta.Delta > impactTolerance:
+			resp.StudentsUp++
+		case delta.Delta < -impactTolerance:
+			resp.StudentsDown++
+		default:
+			resp.StudentsUnchanged++
+		}
+		resp.Students = append(resp.Students, delta)
+	}
+	sort.SliceStable(resp.Students, func(i, j int) bool {
+		return math.Abs(resp.Students[i].Delta) > math.Abs(resp.Students[j].Delta)
+	})
+
+	for i, criterion := range replay.proposed {
+		resp.Criteria = append(resp.Criteria, &pb.ProposedCriterionImpact{
+			Index:           int32(i),
+			Label:           criterion.label,
+			Weight:          criterion.weight,
+			Sources:         criterion.sources,
+			AssumedFraction: criterion.assumed,
+			MeanAfter:       criterionTotals[i].mean(),
+		})
+	}
+
+	resp.Before = scoreDistribution(before, currentMax)
+	resp.After = scoreDistribution(after, proposedMax)
+	resp.Histogram = distributionShift(before, currentMax, after, proposedMax)
+
+	sort.Slice(graderOrder, func(i, j int) bool { return graderOrder[i] < graderOrder[j] })
+	var beforeGroups, afterGroups [][]float64
+	for _, graderID := range graderOrder {
+		shift := byGrader[graderID]
+		resp.Graders = append(resp.Graders, &pb.GraderScoreShift{
+			GraderId:   graderID,
+			GraderName: shift.name,
+			Count:      int32(len(shift.before)),
+			MeanBefore: statistics.Mean(shift.before),
+			MeanAfter:  statistics.Mean(shift.after),
+		})
+		if len(shift.before) >= minGroupSize {
+			beforeGroups = append(beforeGroups, shift.before)
+			afterGroups = append(afterGroups, shift.after)
+		}
+	}
+	resp.GraderVarianceBefore = graderVariance(beforeGroups)
+	resp.GraderVarianceAfter = graderVariance(afterGroups)
+
+	return resp, nil
+}
+
+// gradeShift collects one grader's totals before and after a replay
+type gradeShift struct {
+	name   string
+	before []float64
+	after  []float64
+}
+
+// newRubricReplay validates a proposed rubric and resolves where each of its
+// scored criteria takes its score from
+func newRubricReplay(current *rubricVersion, req *pb.SimulateRubricChangeRequest) (*rubricReplay, error) {
+	criteria, weights, err := criteriaFromTree(req.CriterionTree, req.Criteria, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+
+	verr := &ValidationError{}
+	var totalWeight float64
+	for i, weight := range weights {
+		if weight < 0 {
+			verr.add("weights["+strconv.Itoa(i)+"]", "must not be negative")
+		}
+		totalWeight += weight
+	}
+	switch {
+	case len(criteria) == 0 || len(weights) == 0:
+		verr.add("criteria", "criteria and weights are required")
+	case len(criteria) != len(weights):
+		verr.add("weights", "must have one weight per criterion")
+	case totalWeight < 99.9 || totalWeight > 100.1:
+		verr.add("weights", "must sum to 100")
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+
+	replay := &rubricReplay{currentWeights: make(map[string]float64)}
+	for i, weight := range current.weights {
+		replay.currentWeights[strconv.Itoa(i)] = weight
+	}
+	for i, label := range criteria {
+		criterion := proposedCriterion{label: label, weight: weights[i]}
+		// Unmapped criteria carry over the current criterion at their position
+		if _, ok := replay.currentWeights[strconv.Itoa(i)]; ok {
+			criterion.sources = []*pb.CriterionSource{{Key: strconv.Itoa(i), Share: 1}}
+		}
+		replay.proposed = append(replay.proposed, criterion)
+	}
+
+	mapped := make(map[int32]bool)
+	for i, mapping := range req.Mappings {
+		field := "mappings[" + strconv.Itoa(i) + "]"
+		if mapping.Index < 0 || int(mapping.Index) >= len(criteria) {
+			verr.add(field+".index", "must be between 0 and %d", len(criteria)-1)
+			continue
+		}
+		if mapped[mapping.Index] {
+			verr.add(field+".index", "criterion %d is mapped more than once", mapping.Index)
+			continue
+		}
+		mapped[mapping.Index] = true
+
+		if mapping.AssumedFraction < 0 || mapping.AssumedFraction > 1 {
+			verr.add(field+".assumed_fraction", "must be between 0 and 1")
+		}
+		if len(mapping.Sources) > 0 && mapping.AssumedFraction != 0 {
+			verr.add(field+".assumed_fraction", "only applies to criteria without sources")
+		}
+
+		var sources []*pb.CriterionSource
+		seen := make(map[string]bool)
+		for j, source := range mapping.Sources {
+			sourceField := field + ".sources[" + strconv.Itoa(j) + "]"
+			if _, ok := replay.currentWeights[source.Key]; !ok {
+				verr.add(sourceField+".key", "%q is not a criterion of the current rubric", source.Key)
+				continue
+			}
+			if seen[source.Key] {
+				verr.add(sourceField+".key", "%q is listed more than once", source.Key)
+				continue
+			}
+			seen[source.Key] = true
+			share := source.Share
+			if share == 0 {
+				share = 1
+			}
+			if share < 0 || share > 1 {
+				verr.add(sourceField+".share", "must be between 0 and 1")
+				continue
+			}
+			sources = append(sources, &pb.CriterionSource{Key: source.Key, Share: share})
+		}
+
+		replay.proposed[mapping.Index].sources = sources
+		replay.proposed[mapping.Index].assumed = mapping.AssumedFraction
+	}
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	return replay, nil
+}
+
+// droppedCriteria lists the current criteria no proposed criterion draws on
+func (r *rubricReplay) droppedCriteria() []string {
+	used := make(map[string]bool)
+	for _, criterion := range r.proposed {
+		for _, source := range criterion.sources {
+			used[source.Key] = true
+		}
+	}
+
+	var dropped []string
+	for key := range r.currentWeights {
+		if !used[key] {
+			dropped = append(dropped, key)
+		}
+	}
+	sort.Slice(dropped, func(i, j int) bool {
+		return criterionLess(dropped[i], dropped[j])
+	})
+	return dropped
+}
+
+// reportedGradeIDs finds the grade each student in scope is reported, the
+// first grade on their latest graded submission, and counts those scored on
+// an earlier rubric version
+func (s *RubricService) reportedGradeIDs(scope *analysisScope) (map[int64]bool, int, error) {
+	filter := "a.rubric_id = ?"
+	filterID := scope.rubricID
+	if scope.assignmentID != 0 {
+		filter = "g.assignment_id = ?"
+		filterID = scope.assignmentID
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT g.id, g.assignment_id, s.student_id, COALESCE(g.rubric_version_id, 0)
+		FROM grades g
+		JOIN submissions s ON g.submission_id = s.id
+		JOIN assignments a ON g.assignment_id = a.id
+		WHERE `+filter+`
+		ORDER BY g.assignment_id, s.student_id, s.uploaded_at DESC, s.id DESC, g.graded_at ASC, g.id ASC
+	`, filterID)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	type student struct {
+		assignmentID int64
+		studentID    string
+	}
+	seen := make(map[student]bool)
+	reported := make(map[int64]bool)
+	outdated := 0
+	for rows.Next() {
+		var gradeID, versionID int64
+		var key student
+		if err := rows.Scan(&gradeID, &key.assignmentID, &key.studentID, &versionID); err != nil {
+			return nil, 0, err
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if versionID != scope.rubricVersionID {
+			outdated++
+			continue
+		}
+		reported[gradeID] = true
+	}
+	return reported, outdated, rows.Err()
+}
+
+func scoreFraction(score, maxPoints float64) float64 {
+	if maxPoints <= 0 {
+		return 0
+	}
+	return score / maxPoints
+}
+
+func scoreDistribution(scores []float64, maxPoints float64) *pb.ScoreDistribution {
+	distribution := &pb.ScoreDistribution{Count: int32(len(scores)), MaxPoints: maxPoints}
+	if len(scores) == 0 {
+		return distribution
+	}
+	distribution.Mean = statistics.Mean(scores)
+	distribution.StdDev = statistics.StdDev(scores)
+	distribution.Median = statistics.Median(scores)
+	distribution.Min = statistics.Min(scores)
+	distribution.Max = statistics.Max(scores)
+	distribution.MeanFraction = scoreFraction(distribution.Mean, maxPoints)
+	return distribution
+}
+
+// distributionShift bins scores before and after by their fraction of each
+// rubric's maximum. Scores at or above the maximum fall in the top bin.
+func distributionShift(before []float64, beforeMax float64, after []float64, afterMax float64) []*pb.DistributionBin {
+	bins := make([]*pb.DistributionBin, impactHistogramBins)
+	for i := range bins {
+		bins[i] = &pb.DistributionBin{
+			LowerFraction: float64(i) / impactHistogramBins,
+			UpperFraction: float64(i+1) / impactHistogramBins,
+		}
+	}
+	bin := func(score, maxPoints float64) int {
+		index := int(scoreFraction(score, maxPoints) * impactHistogramBins)
+		if index < 0 {
+			return 0
+		}
+		if index >= impactHistogramBins {
+			return impactHistogramBins - 1
+		}
+		return index
+	}
+
+	for _, score := range before {
+		bins[bin(score, beforeMax)].BeforeCount++
+	}
+	for _, score := range after {
+		bins[bin(score, afterMax)].AfterCount++
+	}
+	return bins
+}
+
+// graderVariance splits the variance of total scores into the part between
+// graders and the part within each grader's grades
+func graderVariance(groups [][]float64) *pb.GraderVariance {
+	variance := &pb.GraderVariance{}
+	fit, err := statistics.RandomEffects(groups)
+	if err != nil {
+		return variance
+	}
+	anova, err := statistics.OneWayANOVA(groups)
+	if err != nil {
+		return variance
+	}
+
+	variance.SufficientData = true
+	variance.BetweenGraderVariance = fit.BetweenVariance
+	variance.WithinGraderVariance = fit.WithinVariance
+	if total := fit.BetweenVariance + fit.WithinVariance; total > 0 {
+		variance.GraderShare = fit.BetweenVariance / total
+	}
+	variance.AnovaPValue = anova.PValue
+	return variance
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestUpdateRubricWithFlatCriteriaKeepsTheCriterionTree(t *testing.T) {
+	course := newTestCourse(t)
+	rubrics := NewRubricService(course.db)
+	ctx := course.ctx(course.instructorID)
+
+	_, err := rubrics.UpdateRubric(ctx, &pb.UpdateRubricRequest{
+		Id:   course.rubricID,
+		Name: "Rubric",
+		CriterionTree: []*pb.RubricCriterion{
+			{Title: "Code", SubCriteria: []*pb.RubricCriterion{
+				{Title: "Correctness", Points: 50, Description: "Passes the hidden tests"},
+				{Title: "Style", Points: 30},
+			}},
+			{Title: "Tests", Levels: []*pb.RubricLevel{
+				{Label: "Thorough", Points: 20},
+				{Label: "Missing", Points: 0},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("save criterion tree: %v", err)
+	}
+	saved, err := rubrics.getRubricByID(course.rubricID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The flat fields a legacy client sends back rename the rubric only
+	resp, err := rubrics.UpdateRubric(ctx, &pb.UpdateRubricRequest{
+		Id:       course.rubricID,
+		Name:     "Renamed",
+		Criteria: saved.Criteria,
+		Weights:  saved.Weights,
+	})
+	if err != nil {
+		t.Fatalf("update with the current flat criteria: %v", err)
+	}
+	if resp.Rubric.Version != saved.Version || resp.Rubric.Name != "Renamed" {
+		t.Fatalf("update with the current flat criteria: version %d named %q, want version %d named Renamed",
+			resp.Rubric.Version, resp.Rubric.Name, saved.Version)
+	}
+	versionID, err := currentRubricVersionID(course.db, course.rubricID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := loadCriterionTree(course.db, versionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !structuredCriteria(tree) || len(tree[0].SubCriteria) != 2 || len(tree[1].Levels) != 2 {
+		t.Fatalf("criterion tree lost its structure: %v", tree)
+	}
+
+	// Changed flat criteria would drop the sub-criteria and levels
+	_, err = rubrics.UpdateRubric(ctx, &pb.UpdateRubricRequest{
+		Id:       course.rubricID,
+		Name:     "Renamed",
+		Criteria: saved.Criteria,
+		Weights:  []float64{40, 40, 20},
+	})
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("update with changed flat criteria: err = %v, want a validation error", err)
+	}
+	if n := course.count(t, "SELECT COUNT(*) FROM rubric_versions WHERE rubric_id = ?", course.rubricID); n != 2 {
+		t.Fatalf("rubric has %d versions, want 2", n)
+	}
+}
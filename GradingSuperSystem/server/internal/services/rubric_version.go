@@ -0,0 +1,378 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrGradeRubricOutdated is returned when a partial change is made to a grade
+// scored against an earlier rubric version, whose criterion keys may no
+// longer mean the same criteria
+var ErrGradeRubricOutdated = errors.New("grade was scored against an earlier version of the rubric; regrade it against the current rubric first")
+
+// ErrRubricNotFound is returned when a request names a rubric that does not exist
+var ErrRubricNotFound = errors.New("rubric not found")
+
+// ErrRubricVersionNotFound is returned when a rubric version does not exist
+// or belongs to another rubric
+var ErrRubricVersionNotFound = errors.New("rubric version not found")
+
+// Criterion changes between two rubric versions
+const (
+	criterionUnchanged         = "unchanged"
+	criterionRenamed           = "renamed"
+	criterionReweighted        = "reweighted"
+	criterionRenamedReweighted = "renamed_reweighted"
+	criterionAdded             = "added"
+	criterionRemoved           = "removed"
+)
+
+// rubricVersion is one immutable snapshot of a rubric's criteria and weights
+type rubricVersion struct {
+	id          int64
+	rubricID    int64
+	version     int32
+	criteria    []string
+	weights     []float64
+	createdBy   int64
+	creatorName string
+	createdAt   time.Time
+	current     bool
+	gradeCount  int32
+}
+
+const rubricVersionSelectQuery = `
+	SELECT v.id, v.rubric_id, v.version, v.criteria, v.weights, v.created_by, COALESCE(u.name, ''), v.created_at,
+	       COALESCE(v.id = r.current_version_id, 0),
+	       (SELECT COUNT(*) FROM grades g WHERE g.rubric_version_id = v.id)
+	FROM rubric_versions v
+	JOIN rubrics r ON v.rubric_id = r.id
+	LEFT JOIN users u ON v.created_by = u.id
+`
+
+func scanRubricVersion(row rowScanner) (*rubricVersion, error) {
+	var version rubricVersion
+	var criteriaJSON, weightsJSON string
+	err := row.Scan(&version.id, &version.rubricID, &version.version, &criteriaJSON, &weightsJSON, &version.createdBy,
+		&version.creatorName, &version.createdAt, &version.current, &version.gradeCount)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(criteriaJSON), &version.criteria); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(weightsJSON), &version.weights); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func (v *rubricVersion) toProto() *pb.RubricVersion {
+	return &pb.RubricVersion{
+		Id:          v.id,
+		RubricId:    v.rubricID,
+		Version:     v.version,
+		Criteria:    v.criteria,
+		Weights:     v.weights,
+		CreatedBy:   v.createdBy,
+		CreatorName: v.creatorName,
+		CreatedAt:   timestamppb.New(v.createdAt),
+		Current:     v.current,
+		GradeCount:  v.gradeCount,
+	}
+}
+
+// createRubricVersion snapshots a rubric's new criteria, weights and
+// criterion structure as its next version and makes it the one new grades
+// are scored against
+func createRubricVersion(tx *sql.Tx, rubricID int64, criteriaJSON, weightsJSON string, tree []*pb.RubricCriterion, userID int64) (int64, error) {
+	var version int32
+	err := tx.QueryRow("SELECT COALESCE(MAX(version), 0) + 1 FROM rubric_versions WHERE rubric_id = ?", rubricID).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(`
+		INSERT INTO rubric_versions (rubric_id, version, criteria, weights, created_by, created_at)
+		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+	`, rubricID, version, criteriaJSON, weightsJSON, userID)
+	if err != nil {
+		return 0, err
+	}
+	versionID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	if err := saveCriterionTree(tx, versionID, sql.NullInt64{}, tree); err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec("UPDATE rubrics SET current_version_id = ? WHERE id = ?", versionID, rubricID)
+	return versionID, err
+}
+
+// currentRubricVersionID returns the version grades of a rubric are scored against
+func currentRubricVersionID(db *database.Database, rubricID int64) (int64, error) {
+	var versionID sql.NullInt64
+	err := db.DB.QueryRow("SELECT current_version_id FROM rubrics WHERE id = ?", rubricID).Scan(&versionID)
+	if err == sql.ErrNoRows {
+		return 0, ErrRubricNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !versionID.Valid {
+		return 0, errors.New("rubric has no versions")
+	}
+	return versionID.Int64, nil
+}
+
+// getRubricVersionCriteria returns the criteria and weights of one rubric version
+func getRubricVersionCriteria(db *database.Database, versionID int64) ([]string, []float64, error) {
+	version, err := scanRubricVersion(db.DB.QueryRow(rubricVersionSelectQuery+" WHERE v.id = ?", versionID))
+	if err == sql.ErrNoRows {
+		return nil, nil, ErrRubricVersionNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return version.criteria, version.weights, nil
+}
+
+// checkGradeRubricVersion rejects partial changes to a grade pinned to a
+// rubric version other than the one the change is validated against
+func checkGradeRubricVersion(tx *sql.Tx, gradeID, versionID int64) error {
+	var pinned sql.NullInt64
+	if err := tx.QueryRow("SELECT rubric_version_id FROM grades WHERE id = ?", gradeID).Scan(&pinned); err != nil {
+		return err
+	}
+	if pinned.Valid && pinned.Int64 != versionID {
+		return ErrGradeRubricOutdated
+	}
+	return nil
+}
+
+// checkRubricAccess finds a rubric's course and checks the user is on its staff
+func (s *RubricService) checkRubricAccess(rubricID, userID int64) error {
+	var courseID int64
+	err := s.db.DB.QueryRow("SELECT course_id FROM rubrics WHERE id = ?", rubricID).Scan(&courseID)
+	if err == sql.ErrNoRows {
+		return ErrRubricNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return checkCourseMembership(s.db, courseID, userID)
+}
+
+func (s *RubricService) ListRubricVersions(ctx context.Context, req *pb.ListRubricVersionsRequest) (*pb.ListRubricVersionsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := s.checkRubricAccess(req.RubricId, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(rubricVersionSelectQuery+" WHERE v.rubric_id = ? ORDER BY v.version DESC", req.RubricId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.ListRubricVersionsResponse{RubricId: req.RubricId}
+	for rows.Next() {
+		version, err := scanRubricVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		if version.current {
+			resp.CurrentVersion = version.version
+		}
+		resp.Versions = append(resp.Versions, version.toProto())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for _, version := range resp.Versions {
+		if version.CriterionTree, err = loadCriterionTree(s.db, version.Id); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// CompareRubricVersions lines up the criteria of two versions of a rubric by
+// key and summarizes the grades scored against each
+func (s *RubricService) CompareRubricVersions(ctx context.Context, req *pb.CompareRubricVersionsRequest) (*pb.RubricVersionComparison, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := s.checkRubricAccess(req.RubricId, userID); err != nil {
+		return nil, err
+	}
+
+	if req.FromVersion <= 0 {
+		verr := &ValidationError{}
+		verr.add("from_version", "is required")
+		return nil, verr
+	}
+	from, err := s.getVersion(req.RubricId, req.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getVersion(req.RubricId, req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	fromScores, fromCriteria, err := s.versionScores(from)
+	if err != nil {
+		return nil, err
+	}
+	toScores, toCriteria, err := s.versionScores(to)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RubricVersionComparison{
+		RubricId:   req.RubricId,
+		From:       from.toProto(),
+		To:         to.toProto(),
+		FromScores: fromScores,
+		ToScores:   toScores,
+	}
+	if resp.From.CriterionTree, err = loadCriterionTree(s.db, from.id); err != nil {
+		return nil, err
+	}
+	if resp.To.CriterionTree, err = loadCriterionTree(s.db, to.id); err != nil {
+		return nil, err
+	}
+
+	count := len(from.criteria)
+	if len(to.criteria) > count {
+		count = len(to.criteria)
+	}
+	for i := 0; i < count; i++ {
+		key := strconv.Itoa(i)
+		change := &pb.RubricCriterionChange{Key: key}
+		inFrom, inTo := i < len(from.criteria), i < len(to.criteria)
+		if inFrom {
+			change.FromLabel = from.criteria[i]
+			change.FromWeight = versionWeight(from, i)
+			change.FromMean, change.FromGraded = fromCriteria[key].mean(), int32(fromCriteria[key].count)
+		}
+		if inTo {
+			change.ToLabel = to.criteria[i]
+			change.ToWeight = versionWeight(to, i)
+			change.ToMean, change.ToGraded = toCriteria[key].mean(), int32(toCriteria[key].count)
+		}
+
+		switch {
+		case !inFrom:
+			change.Change = criterionAdded
+		case !inTo:
+			change.Change = criterionRemoved
+		case change.FromLabel != change.ToLabel && change.FromWeight != change.ToWeight:
+			change.Change = criterionRenamedReweighted
+		case change.FromLabel != change.ToLabel:
+			change.Change = criterionRenamed
+		case change.FromWeight != change.ToWeight:
+			change.Change = criterionReweighted
+		default:
+			change.Change = criterionUnchanged
+		}
+		resp.Criteria = append(resp.Criteria, change)
+	}
+
+	return resp, nil
+}
+
+// getVersion loads a rubric version by number, or the current one for 0
+func (s *RubricService) getVersion(rubricID int64, number int32) (*rubricVersion, error) {
+	query := rubricVersionSelectQuery + " WHERE v.rubric_id = ? AND v.version = ?"
+	args := []interface{}{rubricID, number}
+	if number == 0 {
+		query = rubricVersionSelectQuery + " WHERE v.rubric_id = ? AND v.id = r.current_version_id"
+		args = args[:1]
+	}
+
+	version, err := scanRubricVersion(s.db.DB.QueryRow(query, args...))
+	if err == sql.ErrNoRows {
+		return nil, ErrRubricVersionNotFound
+	}
+	return version, err
+}
+
+func versionWeight(version *rubricVersion, index int) float64 {
+	if index < len(version.weights) {
+		return version.weights[index]
+	}
+	return 0
+}
+
+// scoreSum accumulates scores for a mean
+type scoreSum struct {
+	total float64
+	count int
+}
+
+func (s scoreSum) mean() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.total / float64(s.count)
+}
+
+// versionScores summarizes the grades pinned to a rubric version, overall and
+// per criterion key
+func (s *RubricService) versionScores(version *rubricVersion) (*pb.RubricVersionScores, map[string]scoreSum, error) {
+	rows, err := s.db.DB.Query("SELECT rubric_scores, total_score FROM grades WHERE rubric_version_id = ?", version.id)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var totals scoreSum
+	criteria := make(map[string]scoreSum)
+	for rows.Next() {
+		var scoresJSON string
+		var totalScore float64
+		if err := rows.Scan(&scoresJSON, &totalScore); err != nil {
+			return nil, nil, err
+		}
+		var rubricScores map[string]float64
+		if err := json.Unmarshal([]byte(scoresJSON), &rubricScores); err != nil {
+			return nil, nil, err
+		}
+
+		totals.total += totalScore
+		totals.count++
+		for key, score := range rubricScores {
+			sum := criteria[key]
+			sum.total += score
+			sum.count++
+			criteria[key] = sum
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	scores := &pb.RubricVersionScores{
+		GradeCount: int32(totals.count),
+		MeanTotal:  totals.mean(),
+	}
+	for _, weight := range version.weights {
+		scores.MaxTotal += weight
+	}
+	if scores.MaxTotal > 0 {
+		scores.MeanFraction = scores.MeanTotal / scores.MaxTotal
+	}
+	return scores, criteria, nil
+}
@@ -0,0 +1,424 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrGradeNotReleased is returned when a student asks for grades the
+// instructor has not released yet
+var ErrGradeNotReleased = errors.New("grades for this assignment have not been released")
+
+// ErrStudentSubmissionNotFound is returned for submissions that do not exist
+// or belong to another student
+var ErrStudentSubmissionNotFound = errors.New("submission not found")
+
+// StudentService is the read-only portal students use to see their own
+// submissions and released grades. Students are matched to submissions by
+// the student ID they are linked to in each course.
+type StudentService struct {
+	pb.UnimplementedStudentServiceServer
+	db *database.Database
+}
+
+func NewStudentService(db *database.Database) *StudentService {
+	return &StudentService{db: db}
+}
+
+func (s *StudentService) ListStudentCourses(ctx context.Context, req *pb.ListStudentCoursesRequest) (*pb.StudentCoursesResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	rows, err := s.db.DB.Query(`
+		SELECT c.id, c.name, c.code, c.description, c.semester, c.year, u.name
+		FROM courses c
+		JOIN course_members cm ON c.id = cm.course_id
+		LEFT JOIN users u ON c.instructor_id = u.id
+		WHERE cm.user_id = ? AND cm.role = 'student'
+		ORDER BY c.year DESC, c.semester, c.name
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var courses []*pb.StudentCourse
+	for rows.Next() {
+		var course pb.StudentCourse
+		var description, semester, instructorName sql.NullString
+		var year sql.NullInt64
+		if err := rows.Scan(&course.Id, &course.Name, &course.Code, &description, &semester, &year, &instructorName); err != nil {
+			return nil, err
+		}
+		course.Description = description.String
+		course.Semester = semester.String
+		course.Year = int32(year.Int64)
+		course.InstructorName = instructorName.String
+		courses = append(courses, &course)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.StudentCoursesResponse{Courses: courses}, nil
+}
+
+func (s *StudentService) ListStudentAssignments(ctx context.Context, req *pb.ListStudentAssignmentsRequest) (*pb.StudentAssignmentsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	if err := checkCourseStudent(s.db, req.CourseId, userID); err != nil {
+		return nil, err
+	}
+	studentID, err := courseStudentID(s.db, req.CourseId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.DB.Query(`
+		SELECT a.id, a.course_id, a.name, a.description, a.due_date, a.max_score, `+releaseStateColumn+`,
+		       (SELECT COUNT(*) FROM submissions s WHERE s.assignment_id = a.id AND s.student_id = ?)
+		FROM assignments a
+		WHERE a.course_id = ?
+		ORDER BY a.due_date IS NULL, a.due_date, a.id
+	`, studentID, req.CourseId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*pb.StudentAssignment
+	for rows.Next() {
+		var assignment pb.StudentAssignment
+		var description sql.NullString
+		var dueDate sql.NullTime
+		var releaseState string
+		err := rows.Scan(&assignment.Id, &assignment.CourseId, &assignment.Name, &description, &dueDate,
+			&assignment.MaxScore, &releaseState, &assignment.SubmissionCount)
+		if err != nil {
+			return nil, err
+		}
+		assignment.Description = description.String
+		if dueDate.Valid {
+			assignment.DueDate = timestamppb.New(dueDate.Time)
+		}
+		assignment.Released = releaseState == releaseReleased
+		assignments = append(assignments, &assignment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// Scores only show once the instructor releases them
+	for _, assignment := range assignments {
+		if !assignment.Released || assignment.SubmissionCount == 0 {
+			continue
+		}
+		grade, err := s.loadStudentGrade(assignment.Id, studentID)
+		if err == ErrGradeNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		assignment.Graded = true
+		assignment.TotalScore = grade.totalScore
+	}
+
+	return &pb.StudentAssignmentsResponse{Assignments: assignments}, nil
+}
+
+func (s *StudentService) ListStudentSubmissions(ctx context.Context, req *pb.ListStudentSubmissionsRequest) (*pb.StudentSubmissionsResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	query := `
+		SELECT s.id, s.assignment_id, a.name, s.file_name, s.uploaded_at
+		FROM submissions s
+		JOIN assignments a ON s.assignment_id = a.id
+		JOIN course_roster cr ON cr.course_id = a.course_id AND cr.student_id = s.student_id
+		JOIN course_members cm ON cm.course_id = a.course_id AND cm.user_id = cr.user_id
+		WHERE cr.user_id = ? AND cm.role = 'student'`
+	args := []interface{}{userID}
+	if req.CourseId != 0 {
+		query += " AND a.course_id = ?"
+		args = append(args, req.CourseId)
+	}
+	if req.AssignmentId != 0 {
+		query += " AND s.assignment_id = ?"
+		args = append(args, req.AssignmentId)
+	}
+	query += " ORDER BY s.uploaded_at DESC, s.id DESC"
+
+	rows, err := s.db.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var submissions []*pb.StudentSubmission
+	for rows.Next() {
+		var submission pb.StudentSubmission
+		var uploadedAt time.Time
+		if err := rows.Scan(&submission.Id, &submission.AssignmentId, &submission.AssignmentName, &submission.FileName, &uploadedAt); err != nil {
+			return nil, err
+		}
+		submission.UploadedAt = timestamppb.New(uploadedAt)
+		submissions = append(submissions, &submission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.StudentSubmissionsResponse{Submissions: submissions}, nil
+}
+
+func (s *StudentService) GetStudentSubmissionFile(ctx context.Context, req *pb.GetSubmissionRequest) (*pb.SubmissionFileResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+
+	var courseID int64
+	var owner, filePath, fileName string
+	err := s.db.DB.QueryRow(`
+		SELECT a.course_id, s.student_id, s.file_path, s.file_name
+		FROM submissions s
+		JOIN assignments a ON s.assignment_id = a.id
+		WHERE s.id = ?
+	`, req.Id).Scan(&courseID, &owner, &filePath, &fileName)
+	if err == sql.ErrNoRows {
+		return nil, ErrStudentSubmissionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Other students' submissions look the same as missing ones
+	studentID, err := courseStudentID(s.db, courseID, userID)
+	if err != nil && err != ErrStudentIDNotLinked {
+		return nil, err
+	}
+	if err != nil || owner != studentID || checkCourseStudent(s.db, courseID, userID) != nil {
+		return nil, ErrStudentSubmissionNotFound
+	}
+
+	fileData, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.New("failed to read submission file")
+	}
+
+	return &pb.SubmissionFileResponse{
+		FileData: fileData,
+		FileName: fileName,
+	}, nil
+}
+
+func (s *StudentService) GetStudentGrade(ctx context.Context, req *pb.GetStudentGradeRequest) (*pb.StudentGradeResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	courseID, rubricID, err := s.checkReleased(req.AssignmentId, userID)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := courseStudentID(s.db, courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	grade, err := s.loadStudentGrade(req.AssignmentId, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StudentGrade{
+		AssignmentId:   req.AssignmentId,
+		AssignmentName: grade.assignmentName,
+		SubmissionId:   grade.submissionID,
+		TotalScore:     grade.totalScore,
+		MaxScore:       grade.maxScore,
+		Feedback:       grade.feedback,
+		GradedAt:       timestamppb.New(grade.gradedAt),
+		UpdatedAt:      timestamppb.New(grade.updatedAt),
+	}
+	if grade.showGrader {
+		resp.GraderName = grade.graderName
+	}
+
+	if rubricID != 0 {
+		// Scores are reported against the rubric version they were given on
+		criteria, weights, err := getRubricCriteria(s.db, rubricID)
+		if grade.rubricVersionID != 0 {
+			criteria, weights, err = getRubricVersionCriteria(s.db, grade.rubricVersionID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, label := range criteria {
+			key := strconv.Itoa(i)
+			score, graded := grade.rubricScores[key]
+			criterion := &pb.StudentCriterionScore{
+				Key:      key,
+				Label:    label,
+				Score:    score,
+				Graded:   graded,
+				Feedback: grade.criterionFeedback[key],
+			}
+			if i < len(weights) {
+				criterion.MaxScore = weights[i]
+			}
+			resp.Criteria = append(resp.Criteria, criterion)
+		}
+	}
+
+	return &pb.StudentGradeResponse{Grade: resp}, nil
+}
+
+func (s *StudentService) GetStudentRubric(ctx context.Context, req *pb.GetStudentRubricRequest) (*pb.StudentRubricResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	_, rubricID, err := s.checkReleased(req.AssignmentId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StudentRubricResponse{AssignmentId: req.AssignmentId}
+	if rubricID == 0 {
+		return resp, nil
+	}
+
+	if err := s.db.DB.QueryRow("SELECT name FROM rubrics WHERE id = ?", rubricID).Scan(&resp.RubricName); err != nil {
+		return nil, err
+	}
+	versionID, err := currentRubricVersionID(s.db, rubricID)
+	if err != nil {
+		return nil, err
+	}
+	criteria, weights, err := getRubricVersionCriteria(s.db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.CriterionTree, err = loadCriterionTree(s.db, versionID); err != nil {
+		return nil, err
+	}
+	for i, label := range criteria {
+		criterion := &pb.StudentRubricCriterion{Key: strconv.Itoa(i), Label: label}
+		if i < len(weights) {
+			criterion.MaxScore = weights[i]
+		}
+		resp.Criteria = append(resp.Criteria, criterion)
+	}
+
+	return resp, nil
+}
+
+// CreateStudentRegradeRequest files a regrade request against the released
+// grade the student sees for an assignment. Who graded it stays hidden unless
+// the course shows graders to students.
+func (s *StudentService) CreateStudentRegradeRequest(ctx context.Context, req *pb.CreateStudentRegradeRequestRequest) (*pb.RegradeRequestResponse, error) {
+	userID := ctx.Value("user_id").(int64)
+	courseID, _, err := s.checkReleased(req.AssignmentId, userID)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := courseStudentID(s.db, courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	grade, err := s.loadStudentGrade(req.AssignmentId, studentID)
+	if err != nil {
+		return nil, err
+	}
+	request, err := fileRegradeRequest(s.db, grade.id, req.AssignmentId, userID, req.Criteria, req.Justification)
+	if err != nil {
+		return nil, err
+	}
+	if !grade.showGrader {
+		request.GraderId = 0
+		request.GraderName = ""
+	}
+
+	return &pb.RegradeRequestResponse{
+		Request: request,
+		Message: "Regrade request submitted",
+	}, nil
+}
+
+// checkReleased checks the student is enrolled in the assignment's course and
+// its grades have been released, returning the course and the assignment's rubric
+func (s *StudentService) checkReleased(assignmentID, userID int64) (int64, int64, error) {
+	courseID, rubricID, err := getAssignmentCourse(s.db, assignmentID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := checkCourseStudent(s.db, courseID, userID); err != nil {
+		return 0, 0, err
+	}
+
+	released, err := assignmentReleased(s.db, assignmentID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !released {
+		return 0, 0, ErrGradeNotReleased
+	}
+	return courseID, rubricID, nil
+}
+
+// studentGrade is the grade a student sees for an assignment
+type studentGrade struct {
+	id                int64
+	submissionID      int64
+	assignmentName    string
+	rubricScores      map[string]float64
+	criterionFeedback map[string]string
+	feedback          string
+	totalScore        float64
+	maxScore          float64
+	rubricVersionID   int64
+	graderName        string
+	showGrader        bool
+	gradedAt          time.Time
+	updatedAt         time.Time
+}
+
+// loadStudentGrade finds the grade on the student's latest graded submission.
+// When several TAs graded it, the first grade given is the one reported.
+func (s *StudentService) loadStudentGrade(assignmentID int64, studentID string) (*studentGrade, error) {
+	var grade studentGrade
+	var scoresJSON, feedbackJSON string
+	var graderName, feedback sql.NullString
+	var showGrader int
+	err := s.db.DB.QueryRow(`
+		SELECT g.id, g.submission_id, a.name, g.rubric_scores, g.criterion_feedback, g.feedback, g.total_score, a.max_score,
+		       COALESCE(g.rubric_version_id, 0), u.name, c.show_grader_to_students, g.graded_at, g.updated_at
+		FROM grades g
+		JOIN submissions s ON g.submission_id = s.id
+		JOIN assignments a ON g.assignment_id = a.id
+		JOIN courses c ON a.course_id = c.id
+		LEFT JOIN users u ON g.grader_id = u.id
+		WHERE g.assignment_id = ? AND s.student_id = ?
+		ORDER BY s.uploaded_at DESC, s.id DESC, g.graded_at ASC, g.id ASC
+		LIMIT 1
+	`, assignmentID, studentID).Scan(&grade.id, &grade.submissionID, &grade.assignmentName, &scoresJSON, &feedbackJSON, &feedback, &grade.totalScore, &grade.maxScore,
+		&grade.rubricVersionID, &graderName, &showGrader, &grade.gradedAt, &grade.updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrGradeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(scoresJSON), &grade.rubricScores); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(feedbackJSON), &grade.criterionFeedback); err != nil {
+		return nil, err
+	}
+	grade.feedback = feedback.String
+	grade.graderName = graderName.String
+	grade.showGrader = showGrader != 0
+	return &grade, nil
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/talytics/server/internal/database"
@@ -72,8 +73,8 @@ func (s *SubmissionService) UploadSubmission(ctx context.Context, req *pb.Upload
 
 	// Insert submission record
 	result, err := s.db.DB.Exec(`
-		INSERT INTO submissions (assignment_id, student_id, student_name, file_path, file_name, uploaded_at)
-		VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+		INSERT INTO submissions (assignment_id, student_id, student_name, file_path, file_name, blind_token, uploaded_at)
+		VALUES (?, ?, ?, ?, ?, lower(hex(randomblob(6))), CURRENT_TIMESTAMP)
 	`, req.AssignmentId, req.StudentId, req.StudentName, filePath, fileName)
 	if err != nil {
 		os.Remove(filePath) // Clean up file on error
@@ -125,13 +126,22 @@ func (s *SubmissionService) ListSubmissions(ctx context.Context, req *pb.ListSub
 		return nil, errors.New("access denied: you are not a member of this course")
 	}
 
+	// Once submissions are distributed, TAs only see the ones in their queue
+	var distributed int
+	err = s.db.DB.QueryRow("SELECT COUNT(*) FROM grading_assignments WHERE assignment_id = ?", req.AssignmentId).Scan(&distributed)
+	if err != nil {
+		return nil, err
+	}
+	queueOnly := distributed > 0 && checkCourseInstructor(s.db, courseID, userID) != nil
+
 	// Get submissions for this assignment
 	rows, err := s.db.DB.Query(`
-		SELECT id, assignment_id, student_id, student_name, file_path, file_name, uploaded_at
+		SELECT id, assignment_id, student_id, student_name, file_path, file_name, COALESCE(blind_token, ''), uploaded_at
 		FROM submissions
 		WHERE assignment_id = ?
+		  AND (? = 0 OR id IN (SELECT submission_id FROM grading_assignments WHERE grader_id = ?))
 		ORDER BY student_name ASC
-	`, req.AssignmentId)
+	`, req.AssignmentId, queueOnly, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +153,7 @@ func (s *SubmissionService) ListSubmissions(ctx context.Context, req *pb.ListSub
 		var uploadedAt time.Time
 
 		err := rows.Scan(&submission.Id, &submission.AssignmentId, &submission.StudentId,
-			&submission.StudentName, &submission.FilePath, &submission.FileName, &uploadedAt)
+			&submission.StudentName, &submission.FilePath, &submission.FileName, &submission.BlindToken, &uploadedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -152,6 +162,20 @@ func (s *SubmissionService) ListSubmissions(ctx context.Context, req *pb.ListSub
 		submissions = append(submissions, &submission)
 	}
 
+	// Ordering by name would give away identities, so blind lists are ordered
+	// by token instead
+	mask := newBlindMask(s.db, userID)
+	for _, submission := range submissions {
+		if err := mask.submission(submission); err != nil {
+			return nil, err
+		}
+	}
+	if hidden, err := mask.hides(req.AssignmentId); err != nil {
+		return nil, err
+	} else if hidden {
+		sort.Slice(submissions, func(i, j int) bool { return submissions[i].BlindToken < submissions[j].BlindToken })
+	}
+
 	return &pb.ListSubmissionsResponse{
 		Submissions: submissions,
 	}, nil
@@ -165,28 +189,13 @@ func (s *SubmissionService) GetSubmission(ctx context.Context, req *pb.GetSubmis
 		return nil, err
 	}
 
-	// Check if user has access to this submission's assignment
-	var courseID int64
-	err = s.db.DB.QueryRow(`
-		SELECT a.course_id FROM assignments a
-		WHERE a.id = ?
-	`, submission.AssignmentId).Scan(&courseID)
-	if err != nil {
+	if err := s.checkSubmissionAccess(submission, userID); err != nil {
 		return nil, err
 	}
 
-	// Check course membership
-	var memberCount int
-	err = s.db.DB.QueryRow(`
-		SELECT COUNT(*) FROM course_members 
-		WHERE course_id = ? AND user_id = ?
-	`, courseID, userID).Scan(&memberCount)
-	if err != nil {
+	if err := newBlindMask(s.db, userID).submission(submission); err != nil {
 		return nil, err
 	}
-	if memberCount == 0 {
-		return nil, errors.New("access denied: you are not a member of this course")
-	}
 
 	return &pb.SubmissionResponse{
 		Submission: submission,
@@ -202,34 +211,20 @@ func (s *SubmissionService) GetSubmissionFile(ctx context.Context, req *pb.GetSu
 		return nil, err
 	}
 
-	// Check if user has access
-	var courseID int64
-	err = s.db.DB.QueryRow(`
-		SELECT a.course_id FROM assignments a
-		WHERE a.id = ?
-	`, submission.AssignmentId).Scan(&courseID)
-	if err != nil {
+	if err := s.checkSubmissionAccess(submission, userID); err != nil {
 		return nil, err
 	}
 
-	var memberCount int
-	err = s.db.DB.QueryRow(`
-		SELECT COUNT(*) FROM course_members 
-		WHERE course_id = ? AND user_id = ?
-	`, courseID, userID).Scan(&memberCount)
-	if err != nil {
-		return nil, err
-	}
-	if memberCount == 0 {
-		return nil, errors.New("access denied")
-	}
-
 	// Read file
 	fileData, err := os.ReadFile(submission.FilePath)
 	if err != nil {
 		return nil, errors.New("failed to read submission file")
 	}
 
+	if err := newBlindMask(s.db, userID).submission(submission); err != nil {
+		return nil, err
+	}
+
 	return &pb.SubmissionFileResponse{
 		FileData: fileData,
 		FileName: submission.FileName,
@@ -280,16 +275,47 @@ func (s *SubmissionService) DeleteSubmission(ctx context.Context, req *pb.Delete
 	}, nil
 }
 
+// checkSubmissionAccess checks the user is on the course staff and, once
+// the assignment's submissions are distributed, that a TA has this one in
+// their queue or is reviewing a regrade request on it
+func (s *SubmissionService) checkSubmissionAccess(submission *pb.Submission, userID int64) error {
+	courseID, _, err := getAssignmentCourse(s.db, submission.AssignmentId)
+	if err != nil {
+		return err
+	}
+	if err := checkCourseMembership(s.db, courseID, userID); err != nil {
+		return err
+	}
+	err = checkGradingAssignment(s.db, courseID, submission.AssignmentId, submission.Id, userID)
+	if !errors.Is(err, ErrSubmissionNotAssigned) {
+		return err
+	}
+
+	var regrades int
+	err = s.db.DB.QueryRow(`
+		SELECT COUNT(*) FROM regrade_requests r
+		JOIN grades g ON r.grade_id = g.id
+		WHERE g.submission_id = ? AND r.assigned_to = ? AND r.status = 'assigned'
+	`, submission.Id, userID).Scan(&regrades)
+	if err != nil {
+		return err
+	}
+	if regrades == 0 {
+		return ErrSubmissionNotAssigned
+	}
+	return nil
+}
+
 func (s *SubmissionService) getSubmissionByID(submissionID int64) (*pb.Submission, error) {
 	var submission pb.Submission
 	var uploadedAt time.Time
 
 	err := s.db.DB.QueryRow(`
-		SELECT id, assignment_id, student_id, student_name, file_path, file_name, uploaded_at
+		SELECT id, assignment_id, student_id, student_name, file_path, file_name, COALESCE(blind_token, ''), uploaded_at
 		FROM submissions
 		WHERE id = ?
 	`, submissionID).Scan(&submission.Id, &submission.AssignmentId, &submission.StudentId,
-		&submission.StudentName, &submission.FilePath, &submission.FileName, &uploadedAt)
+		&submission.StudentName, &submission.FilePath, &submission.FileName, &submission.BlindToken, &uploadedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -41,13 +41,13 @@ func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		return nil, errors.New("all fields are required")
 	}
 
-	if req.Role != "instructor" && req.Role != "ta" {
-		return nil, errors.New("role must be either 'instructor' or 'ta'")
+	if req.Role != "instructor" && req.Role != "ta" && req.Role != "student" {
+		return nil, errors.New("role must be 'instructor', 'ta' or 'student'")
 	}
 
 	// Check if user already exists
 	var count int
-	err := s.db.DB.QueryRow("SELECT COUNT(*) FROM users WHERE email = ?", req.Email).Scan(&count)
+	err := s.db.DB.QueryRow("SELECT COUNT(*) FROM users WHERE email = ? COLLATE NOCASE", req.Email).Scan(&count)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +110,7 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Auth
 
 	err := s.db.DB.QueryRow(`
 		SELECT id, email, name, password_hash, role, created_at, updated_at
-		FROM users WHERE email = ?
+		FROM users WHERE email = ? COLLATE NOCASE
 	`, req.Email).Scan(&user.Id, &user.Email, &user.Name, &passwordHash, &user.Role, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
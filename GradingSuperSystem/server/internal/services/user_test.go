@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/talytics/server/proto"
+)
+
+func TestEmailsAreUniqueRegardlessOfCase(t *testing.T) {
+	course := newTestCourse(t)
+	users := NewUserService(course.db)
+
+	_, err := users.Register(context.Background(), &pb.RegisterRequest{
+		Email: "PROF@example.edu", Name: "Impostor", Password: "secret", Role: "instructor",
+	})
+	if err == nil {
+		t.Fatal("registered an email that differs from an existing one only in case")
+	}
+	if _, err := course.db.DB.Exec("INSERT INTO users (email, name, password_hash, role) VALUES ('Prof@Example.edu', 'Impostor', '', 'ta')"); err == nil {
+		t.Fatal("database accepted an email that differs from an existing one only in case")
+	}
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/talytics/server/internal/database"
+	pb "github.com/talytics/server/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldError describes one invalid field of a request
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when a request fails validation. It carries
+// every problem found rather than just the first.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Field + ": " + field.Message
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// GRPCStatus reports validation failures to gRPC clients as InvalidArgument
+func (e *ValidationError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// rubricScoreValidator checks rubric_scores against the criteria and weights
+// of the current version of an assignment's rubric
+type rubricScoreValidator struct {
+	versionID int64
+	criteria  []string
+	weights   []float64
+	// Performance levels of each criterion; scores must match one when set
+	levels [][]*pb.RubricLevel
+}
+
+// newRubricScoreValidator resolves the rubric version an assignment is graded with
+func newRubricScoreValidator(db *database.Database, assignmentID int64) (*rubricScoreValidator, error) {
+	_, rubricID, err := getAssignmentCourse(db, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if rubricID == 0 {
+		verr := &ValidationError{}
+		verr.add("assignment_id", "assignment does not have a rubric to grade against")
+		return nil, verr
+	}
+
+	versionID, err := currentRubricVersionID(db, rubricID)
+	if err != nil {
+		return nil, err
+	}
+	criteria, weights, err := getRubricVersionCriteria(db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := criterionLevels(db, versionID)
+	if err != nil {
+		return nil, err
+	}
+	return &rubricScoreValidator{versionID: versionID, criteria: criteria, weights: weights, levels: levels}, nil
+}
+
+// validate rejects unknown criteria and scores outside 0..weight, returning
+// the total computed from the scores. Criteria left out count as not graded.
+func (v *rubricScoreValidator) validate(rubricScores map[string]float64) (float64, error) {
+	keys := make([]string, 0, len(rubricScores))
+	for key := range rubricScores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return criterionLess(keys[i], keys[j]) })
+
+	verr := &ValidationError{}
+	var total float64
+	for _, key := range keys {
+		field := "rubric_scores." + key
+		score := rubricScores[key]
+
+		index, ok := v.criterionIndex(key)
+		if !ok {
+			verr.add(field, "unknown criterion; expected an index from 0 to %d", len(v.criteria)-1)
+			continue
+		}
+
+		weight := 0.0
+		if index < len(v.weights) {
+			weight = v.weights[index]
+		}
+		if math.IsNaN(score) || math.IsInf(score, 0) || score < 0 || score > weight {
+			verr.add(field, "score %g is outside 0 to %g", score, weight)
+			continue
+		}
+		if index < len(v.levels) && !matchesLevel(v.levels[index], score) {
+			verr.add(field, "score %g does not match a performance level; expected %s", score, describeLevels(v.levels[index]))
+			continue
+		}
+		total += score
+	}
+
+	if len(verr.Fields) > 0 {
+		return 0, verr
+	}
+	return total, nil
+}
+
+// maxFeedbackLength caps each feedback string, in characters
+const maxFeedbackLength = 5000
+
+// validateFeedback rejects feedback for unknown criteria and overly long
+// comments, returning the criterion feedback trimmed with blank entries dropped
+func (v *rubricScoreValidator) validateFeedback(criterionFeedback map[string]string, feedback string) (map[string]string, error) {
+	keys := make([]string, 0, len(criterionFeedback))
+	for key := range criterionFeedback {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return criterionLess(keys[i], keys[j]) })
+
+	verr := &ValidationError{}
+	cleaned := make(map[string]string)
+	for _, key := range keys {
+		field := "criterion_feedback." + key
+		if _, ok := v.criterionIndex(key); !ok {
+			verr.add(field, "unknown criterion; expected an index from 0 to %d", len(v.criteria)-1)
+			continue
+		}
+		text := strings.TrimSpace(criterionFeedback[key])
+		if utf8.RuneCountInString(text) > maxFeedbackLength {
+			verr.add(field, "feedback is longer than %d characters", maxFeedbackLength)
+			continue
+		}
+		if text != "" {
+			cleaned[key] = text
+		}
+	}
+	if utf8.RuneCountInString(feedback) > maxFeedbackLength {
+		verr.add("feedback", "feedback is longer than %d characters", maxFeedbackLength)
+	}
+
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	return cleaned, nil
+}
+
+// criterionIndex parses a rubric_scores key, reporting whether it names one
+// of the rubric's criteria
+func (v *rubricScoreValidator) criterionIndex(key string) (int, bool) {
+	index, err := strconv.Atoi(key)
+	if err != nil || index < 0 || index >= len(v.criteria) || strconv.Itoa(index) != key {
+		return 0, false
+	}
+	return index, true
+}
@@ -0,0 +1,115 @@
+package statistics
+
+import (
+	"math"
+	"sort"
+)
+
+// ANOVAResult is the outcome of a one-way analysis of variance.
+type ANOVAResult struct {
+	F          float64
+	DFBetween  int
+	DFWithin   int
+	SSBetween  float64
+	SSWithin   float64
+	MSWithin   float64
+	PValue     float64
+	EtaSquared float64
+}
+
+// PairwiseComparison compares two groups in a post-hoc test. A and B are
+// indices into the groups passed to the test and PValue is already adjusted
+// for multiple comparisons.
+type PairwiseComparison struct {
+	A          int
+	B          int
+	Difference float64
+	Statistic  float64
+	PValue     float64
+}
+
+// OneWayANOVA tests whether the group means differ. It needs at least two
+// non-empty groups, more observations than groups and some variation within
+// the groups.
+func OneWayANOVA(groups [][]float64) (ANOVAResult, error) {
+	var all []float64
+	for _, group := range groups {
+		if len(group) == 0 {
+			return ANOVAResult{}, ErrInsufficientData
+		}
+		all = append(all, group...)
+	}
+	k, n := len(groups), len(all)
+	if k < 2 || n <= k {
+		return ANOVAResult{}, ErrInsufficientData
+	}
+
+	grandMean := Mean(all)
+	result := ANOVAResult{DFBetween: k - 1, DFWithin: n - k}
+	for _, group := range groups {
+		groupMean := Mean(group)
+		result.SSBetween += float64(len(group)) * (groupMean - grandMean) * (groupMean - grandMean)
+		for _, value := range group {
+			result.SSWithin += (value - groupMean) * (value - groupMean)
+		}
+	}
+
+	// F is undefined when every group is internally constant
+	if result.SSWithin == 0 {
+		return ANOVAResult{}, ErrInsufficientData
+	}
+
+	result.MSWithin = result.SSWithin / float64(result.DFWithin)
+	result.EtaSquared = result.SSBetween / (result.SSBetween + result.SSWithin)
+	result.F = (result.SSBetween / float64(result.DFBetween)) / result.MSWithin
+	result.PValue = FSurvival(result.F, float64(result.DFBetween), float64(result.DFWithin))
+	return result, nil
+}
+
+// TukeyHSD runs Tukey's honestly significant difference test on every pair of
+// groups, using the Tukey-Kramer adjustment for unequal group sizes. The
+// statistic is the studentized range q and p-values come from its distribution.
+func TukeyHSD(groups [][]float64) ([]PairwiseComparison, error) {
+	anova, err := OneWayANOVA(groups)
+	if err != nil {
+		return nil, err
+	}
+	if anova.DFWithin < 2 {
+		return nil, ErrInsufficientData
+	}
+
+	k := float64(len(groups))
+	var comparisons []PairwiseComparison
+	for a := 0; a < len(groups); a++ {
+		for b := a + 1; b < len(groups); b++ {
+			diff := Mean(groups[a]) - Mean(groups[b])
+			se := math.Sqrt(anova.MSWithin / 2 * (1/float64(len(groups[a])) + 1/float64(len(groups[b]))))
+
+			comparison := PairwiseComparison{A: a, B: b, Difference: diff, Statistic: math.Abs(diff) / se}
+			comparison.PValue = 1 - StudentizedRangeCDF(comparison.Statistic, k, float64(anova.DFWithin))
+			comparisons = append(comparisons, comparison)
+		}
+	}
+	return comparisons, nil
+}
+
+// HolmAdjust applies the Holm-Bonferroni step-down correction to p-values,
+// returning adjusted values in the original order.
+func HolmAdjust(pValues []float64) []float64 {
+	m := len(pValues)
+	order := make([]int, m)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return pValues[order[i]] < pValues[order[j]] })
+
+	adjusted := make([]float64, m)
+	var running float64
+	for rank, index := range order {
+		p := math.Min(1, float64(m-rank)*pValues[index])
+		// Adjusted p-values must not decrease as raw p-values grow
+		running = math.Max(running, p)
+		adjusted[index] = running
+	}
+	return adjusted
+}
@@ -0,0 +1,124 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+// With three groups the F distribution has two numerator degrees of freedom,
+// whose survival function is (1 + 2F/d2)^(-d2/2)
+var separatedGroups = [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+func TestOneWayANOVA(t *testing.T) {
+	result, err := OneWayANOVA(separatedGroups)
+	if err != nil {
+		t.Fatalf("OneWayANOVA: %v", err)
+	}
+
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"SSBetween", result.SSBetween, 54},
+		{"SSWithin", result.SSWithin, 6},
+		{"MSWithin", result.MSWithin, 1},
+		{"F", result.F, 27},
+		{"PValue", result.PValue, math.Pow(1+2*27.0/6, -3)},
+		{"EtaSquared", result.EtaSquared, 0.9},
+	}
+	for _, check := range checks {
+		if math.Abs(check.got-check.want) > 1e-9 {
+			t.Errorf("%s = %v, want %v", check.name, check.got, check.want)
+		}
+	}
+	if result.DFBetween != 2 || result.DFWithin != 6 {
+		t.Errorf("df = (%d, %d), want (2, 6)", result.DFBetween, result.DFWithin)
+	}
+}
+
+func TestOneWayANOVAInsufficientData(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups [][]float64
+	}{
+		{"one group", [][]float64{{1, 2, 3}}},
+		{"empty group", [][]float64{{1, 2}, {}}},
+		{"one value per group", [][]float64{{1}, {2}}},
+		{"no variation within groups", [][]float64{{1, 1}, {2, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := OneWayANOVA(tt.groups); err != ErrInsufficientData {
+				t.Errorf("err = %v, want ErrInsufficientData", err)
+			}
+		})
+	}
+}
+
+// Critical values of the studentized range from Tukey's tables
+func TestStudentizedRangeCDF(t *testing.T) {
+	tests := []struct {
+		q, k, df float64
+		want     float64
+	}{
+		{3.151, 2, 10, 0.95},
+		{3.877, 3, 10, 0.95},
+		{5.270, 3, 10, 0.99},
+		{3.958, 4, 20, 0.95},
+		{4.102, 5, 30, 0.95},
+	}
+	for _, tt := range tests {
+		if got := StudentizedRangeCDF(tt.q, tt.k, tt.df); math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("StudentizedRangeCDF(%v, %v, %v) = %.4f, want %.2f", tt.q, tt.k, tt.df, got, tt.want)
+		}
+	}
+}
+
+func TestTukeyHSD(t *testing.T) {
+	comparisons, err := TukeyHSD(separatedGroups)
+	if err != nil {
+		t.Fatalf("TukeyHSD: %v", err)
+	}
+	if len(comparisons) != 3 {
+		t.Fatalf("got %d comparisons, want 3", len(comparisons))
+	}
+
+	// The standard error of each difference is sqrt(MSWithin/2 * (1/3 + 1/3))
+	se := math.Sqrt(1.0 / 3)
+	tests := []struct {
+		a, b       int
+		difference float64
+		// p-value bounds from the 0.05 and 0.01 critical values 4.339 and
+		// 6.331 for three groups and six degrees of freedom
+		minP, maxP float64
+	}{
+		{0, 1, -3, 0.01, 0.05},
+		{0, 2, -6, 0, 0.01},
+		{1, 2, -3, 0.01, 0.05},
+	}
+	for i, tt := range tests {
+		got := comparisons[i]
+		if got.A != tt.a || got.B != tt.b {
+			t.Fatalf("comparison %d is (%d, %d), want (%d, %d)", i, got.A, got.B, tt.a, tt.b)
+		}
+		if math.Abs(got.Difference-tt.difference) > 1e-9 {
+			t.Errorf("(%d, %d) difference = %v, want %v", tt.a, tt.b, got.Difference, tt.difference)
+		}
+		if want := math.Abs(tt.difference) / se; math.Abs(got.Statistic-want) > 1e-9 {
+			t.Errorf("(%d, %d) q = %v, want %v", tt.a, tt.b, got.Statistic, want)
+		}
+		if got.PValue <= tt.minP || got.PValue >= tt.maxP {
+			t.Errorf("(%d, %d) p = %.4f, want between %v and %v", tt.a, tt.b, got.PValue, tt.minP, tt.maxP)
+		}
+	}
+}
+
+func TestHolmAdjust(t *testing.T) {
+	got := HolmAdjust([]float64{0.01, 0.04, 0.03, 0.005})
+	want := []float64{0.03, 0.06, 0.06, 0.02}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("adjusted[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package statistics
+
+import "math"
+
+// EWMAPoint is one observation on an exponentially weighted moving average chart.
+type EWMAPoint struct {
+	Value        float64
+	Lower        float64
+	Upper        float64
+	OutOfControl bool
+}
+
+// EWMAChart smooths values with z_t = lambda*x_t + (1-lambda)*z_{t-1},
+// starting from target, and flags points outside the time-varying limits
+//
+//	target ± width * sigma * sqrt(lambda/(2-lambda) * (1-(1-lambda)^(2t)))
+//
+// Small lambda (0.05-0.25) makes the chart sensitive to small sustained shifts.
+func EWMAChart(values []float64, target, sigma, lambda, width float64) []EWMAPoint {
+	points := make([]EWMAPoint, len(values))
+	z := target
+	for i, value := range values {
+		z = lambda*value + (1-lambda)*z
+		t := float64(i + 1)
+		spread := width * sigma * math.Sqrt(lambda/(2-lambda)*(1-math.Pow(1-lambda, 2*t)))
+		points[i] = EWMAPoint{
+			Value:        z,
+			Lower:        target - spread,
+			Upper:        target + spread,
+			OutOfControl: z < target-spread || z > target+spread,
+		}
+	}
+	return points
+}
+
+// CUSUMPoint is one observation on a tabular CUSUM chart. Upper and Lower are
+// the cumulative sums in units of sigma.
+type CUSUMPoint struct {
+	Upper        float64
+	Lower        float64
+	OutOfControl bool
+}
+
+// CUSUMChart accumulates standardized deviations from target above the
+// allowance k, flagging points where either sum exceeds the decision interval
+// h. The usual choice of k = 0.5 and h = 4 or 5 detects one-sigma shifts.
+func CUSUMChart(values []float64, target, sigma, k, h float64) []CUSUMPoint {
+	points := make([]CUSUMPoint, len(values))
+	var upper, lower float64
+	for i, value := range values {
+		z := (value - target) / sigma
+		upper = math.Max(0, upper+z-k)
+		lower = math.Max(0, lower-z-k)
+		points[i] = CUSUMPoint{
+			Upper:        upper,
+			Lower:        lower,
+			OutOfControl: upper > h || lower > h,
+		}
+	}
+	return points
+}
@@ -0,0 +1,70 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEWMAChart(t *testing.T) {
+	// lambda = 0.5 gives limits of width * sigma * sqrt((1 - 0.25^t) / 3)
+	spread := func(step float64) float64 { return 3 * 2 * math.Sqrt((1-math.Pow(0.25, step))/3) }
+	want := []EWMAPoint{
+		{Value: 10, Lower: 10 - spread(1), Upper: 10 + spread(1)},
+		{Value: 10, Lower: 10 - spread(2), Upper: 10 + spread(2)},
+		{Value: 12, Lower: 10 - spread(3), Upper: 10 + spread(3)},
+		{Value: 14, Lower: 10 - spread(4), Upper: 10 + spread(4), OutOfControl: true},
+	}
+
+	got := EWMAChart([]float64{10, 10, 14, 16}, 10, 2, 0.5, 3)
+	if len(got) != len(want) {
+		t.Fatalf("got %d points, want %d", len(got), len(want))
+	}
+	if math.Abs(got[0].Upper-13) > 1e-9 {
+		t.Errorf("first upper limit = %v, want 13", got[0].Upper)
+	}
+	for i := range want {
+		if math.Abs(got[i].Value-want[i].Value) > 1e-9 || math.Abs(got[i].Lower-want[i].Lower) > 1e-9 ||
+			math.Abs(got[i].Upper-want[i].Upper) > 1e-9 || got[i].OutOfControl != want[i].OutOfControl {
+			t.Errorf("point %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCUSUMChart(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   []CUSUMPoint
+	}{
+		{
+			name:   "upward shift then a drop",
+			values: []float64{1, 1, 1, 2, -3},
+			want: []CUSUMPoint{
+				{Upper: 0.5},
+				{Upper: 1},
+				{Upper: 1.5},
+				{Upper: 3, OutOfControl: true},
+				{Lower: 2.5, OutOfControl: true},
+			},
+		},
+		{
+			name:   "deviations within the allowance",
+			values: []float64{0.5, -0.5, 0.4, -0.4},
+			want:   []CUSUMPoint{{}, {}, {}, {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CUSUMChart(tt.values, 0, 1, 0.5, 2)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d points, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if math.Abs(got[i].Upper-tt.want[i].Upper) > 1e-9 || math.Abs(got[i].Lower-tt.want[i].Lower) > 1e-9 ||
+					got[i].OutOfControl != tt.want[i].OutOfControl {
+					t.Errorf("point %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Package statistics implements the statistical measures used by grading analytics.
+package statistics
+
+import (
+	"math"
+	"sort"
+)
+
+// Mean returns the arithmetic mean of values, or NaN when values is empty.
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+// Variance returns the sample variance (n-1 denominator), or 0 for fewer than two values.
+func Variance(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	m := Mean(values)
+	var sum float64
+	for _, value := range values {
+		sum += (value - m) * (value - m)
+	}
+	return sum / float64(len(values)-1)
+}
+
+// StdDev returns the sample standard deviation.
+func StdDev(values []float64) float64 {
+	return math.Sqrt(Variance(values))
+}
+
+// Min returns the smallest value, or NaN when values is empty.
+func Min(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	min := values[0]
+	for _, value := range values[1:] {
+		if value < min {
+			min = value
+		}
+	}
+	return min
+}
+
+// Max returns the largest value, or NaN when values is empty.
+func Max(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	max := values[0]
+	for _, value := range values[1:] {
+		if value > max {
+			max = value
+		}
+	}
+	return max
+}
+
+// CohensD returns the standardized mean difference between a and b using the
+// pooled standard deviation. It is NaN when either group has fewer than two
+// values or there is no variation.
+func CohensD(a, b []float64) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return math.NaN()
+	}
+	pooled := ((float64(len(a))-1)*Variance(a) + (float64(len(b))-1)*Variance(b)) /
+		float64(len(a)+len(b)-2)
+	if pooled == 0 {
+		return math.NaN()
+	}
+	return (Mean(a) - Mean(b)) / math.Sqrt(pooled)
+}
+
+// Median returns the middle value of values, or NaN when values is empty.
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sorted := sortedCopy(values)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// MedianAbsoluteDeviation returns the median of the absolute deviations from the median.
+func MedianAbsoluteDeviation(values []float64) float64 {
+	median := Median(values)
+	deviations := make([]float64, len(values))
+	for i, value := range values {
+		deviations[i] = math.Abs(value - median)
+	}
+	return Median(deviations)
+}
+
+// sortedCopy returns values in ascending order without modifying the input.
+func sortedCopy(values []float64) []float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	return sorted
+}
@@ -0,0 +1,107 @@
+package statistics
+
+import "math"
+
+// GroupEffect is the estimated random effect of one group, such as a
+// grader's leniency relative to the average grader.
+type GroupEffect struct {
+	Count    int
+	Mean     float64
+	RawDiff  float64
+	Effect   float64
+	Variance float64
+	// Shrinkage is the weight given to the group's own data, from 0 (effect
+	// pulled entirely to zero) to 1 (raw difference kept as is)
+	Shrinkage float64
+}
+
+// RandomEffectsResult holds the fit of a one-way random effects model.
+type RandomEffectsResult struct {
+	GrandMean       float64
+	BetweenVariance float64
+	WithinVariance  float64
+	Effects         []GroupEffect
+}
+
+// RandomEffects fits y_ij = mu + b_j + e_ij with b_j ~ N(0, tau^2) and
+// e_ij ~ N(0, sigma^2). Variance components come from the unbalanced ANOVA
+// method of moments, and each b_j is the empirical Bayes estimate
+//
+//	b_j = B_j * (mean_j - mu),  B_j = tau^2 / (tau^2 + sigma^2/n_j)
+//
+// with posterior variance B_j * sigma^2 / n_j. Small groups are shrunk
+// towards zero, and when tau^2 is estimated as zero every effect is zero.
+func RandomEffects(groups [][]float64) (RandomEffectsResult, error) {
+	anova, err := OneWayANOVA(groups)
+	if err != nil {
+		return RandomEffectsResult{}, err
+	}
+
+	var n, sumSquaredSizes float64
+	for _, group := range groups {
+		size := float64(len(group))
+		n += size
+		sumSquaredSizes += size * size
+	}
+	k := float64(len(groups))
+	n0 := (n - sumSquaredSizes/n) / (k - 1)
+
+	msBetween := anova.SSBetween / float64(anova.DFBetween)
+	sigma2 := anova.MSWithin
+	tau2 := math.Max(0, (msBetween-sigma2)/n0)
+
+	// Precision-weighted grand mean; with no between-group variance every
+	// observation counts equally
+	var weightedSum, totalWeight float64
+	for _, group := range groups {
+		size := float64(len(group))
+		weight := size
+		if tau2 > 0 {
+			weight = 1 / (tau2 + sigma2/size)
+		}
+		weightedSum += weight * Mean(group)
+		totalWeight += weight
+	}
+
+	result := RandomEffectsResult{
+		GrandMean:       weightedSum / totalWeight,
+		BetweenVariance: tau2,
+		WithinVariance:  sigma2,
+		Effects:         make([]GroupEffect, len(groups)),
+	}
+	for i, group := range groups {
+		size := float64(len(group))
+		shrinkage := tau2 / (tau2 + sigma2/size)
+		mean := Mean(group)
+		result.Effects[i] = GroupEffect{
+			Count:     len(group),
+			Mean:      mean,
+			RawDiff:   mean - result.GrandMean,
+			Effect:    shrinkage * (mean - result.GrandMean),
+			Variance:  shrinkage * sigma2 / size,
+			Shrinkage: shrinkage,
+		}
+	}
+
+	return result, nil
+}
+
+// NormalQuantile returns z such that NormalCDF(z) = p, found by bisection.
+func NormalQuantile(p float64) float64 {
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	lo, hi := -40.0, 40.0
+	for i := 0; i < 200 && hi-lo > 1e-12; i++ {
+		mid := (lo + hi) / 2
+		if NormalCDF(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
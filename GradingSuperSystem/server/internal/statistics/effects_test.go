@@ -0,0 +1,75 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRandomEffects(t *testing.T) {
+	// MS between 27 and MS within 1 over groups of three give
+	// tau^2 = (27 - 1) / 3 and shrinkage tau^2 / (tau^2 + 1/3) = 26/27
+	result, err := RandomEffects(separatedGroups)
+	if err != nil {
+		t.Fatalf("RandomEffects: %v", err)
+	}
+	if math.Abs(result.GrandMean-5) > 1e-9 {
+		t.Errorf("grand mean = %v, want 5", result.GrandMean)
+	}
+	if math.Abs(result.BetweenVariance-26.0/3) > 1e-9 {
+		t.Errorf("between variance = %v, want %v", result.BetweenVariance, 26.0/3)
+	}
+	if math.Abs(result.WithinVariance-1) > 1e-9 {
+		t.Errorf("within variance = %v, want 1", result.WithinVariance)
+	}
+
+	for i, rawDiff := range []float64{-3, 0, 3} {
+		effect := result.Effects[i]
+		if effect.Count != 3 || math.Abs(effect.RawDiff-rawDiff) > 1e-9 {
+			t.Errorf("group %d: count %d, raw difference %v, want 3 and %v", i, effect.Count, effect.RawDiff, rawDiff)
+		}
+		if math.Abs(effect.Shrinkage-26.0/27) > 1e-9 {
+			t.Errorf("group %d shrinkage = %v, want %v", i, effect.Shrinkage, 26.0/27)
+		}
+		if math.Abs(effect.Effect-rawDiff*26/27) > 1e-9 {
+			t.Errorf("group %d effect = %v, want %v", i, effect.Effect, rawDiff*26/27)
+		}
+		if math.Abs(effect.Variance-26.0/81) > 1e-9 {
+			t.Errorf("group %d variance = %v, want %v", i, effect.Variance, 26.0/81)
+		}
+	}
+}
+
+func TestRandomEffectsShrinksNoiseToZero(t *testing.T) {
+	// MS between 1 is below MS within 8, so tau^2 is estimated as zero
+	result, err := RandomEffects([][]float64{{0, 4}, {1, 5}})
+	if err != nil {
+		t.Fatalf("RandomEffects: %v", err)
+	}
+	if result.BetweenVariance != 0 {
+		t.Errorf("between variance = %v, want 0", result.BetweenVariance)
+	}
+	if math.Abs(result.GrandMean-2.5) > 1e-9 {
+		t.Errorf("grand mean = %v, want 2.5", result.GrandMean)
+	}
+	for i, effect := range result.Effects {
+		if effect.Effect != 0 || effect.Shrinkage != 0 {
+			t.Errorf("group %d effect = %v with shrinkage %v, want 0", i, effect.Effect, effect.Shrinkage)
+		}
+	}
+}
+
+func TestNormalQuantile(t *testing.T) {
+	tests := []struct {
+		p, want float64
+	}{
+		{0.5, 0},
+		{0.975, 1.959964},
+		{0.95, 1.644854},
+		{0.01, -2.326348},
+	}
+	for _, tt := range tests {
+		if got := NormalQuantile(tt.p); math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("NormalQuantile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package statistics
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Level is the level of measurement used to compare values in Krippendorff's alpha.
+type Level int
+
+const (
+	Nominal Level = iota
+	Ordinal
+	Interval
+)
+
+func (l Level) String() string {
+	switch l {
+	case Nominal:
+		return "nominal"
+	case Ordinal:
+		return "ordinal"
+	case Interval:
+		return "interval"
+	default:
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+}
+
+// ErrInsufficientData is returned when alpha is undefined, either because
+// fewer than two pairable values exist or because all values are identical.
+var ErrInsufficientData = errors.New("insufficient data to compute reliability")
+
+// AlphaResult holds Krippendorff's alpha along with the data it was computed from.
+type AlphaResult struct {
+	Alpha float64
+	// Units that had at least two values and so contributed to the estimate
+	Units int
+	// Total number of pairable values across those units
+	PairableValues int
+}
+
+// KrippendorffAlpha computes Krippendorff's alpha for a reliability data set.
+// Each unit holds the values assigned to one item (e.g. a submission) by the
+// different coders that rated it; missing ratings are simply omitted. Units
+// with fewer than two values are not pairable and are ignored.
+//
+// Alpha is computed from the coincidence matrix as
+//
+//	alpha = 1 - (n-1) * sum(o_ck * d_ck) / sum(n_c * n_k * d_ck)
+//
+// where d_ck is the squared difference function for the level of measurement.
+func KrippendorffAlpha(units [][]float64, level Level) (AlphaResult, error) {
+	// Distinct values in ascending order index the coincidence matrix
+	index := make(map[float64]int)
+	var values []float64
+	result := AlphaResult{}
+	for _, unit := range units {
+		if len(unit) < 2 {
+			continue
+		}
+		result.Units++
+		result.PairableValues += len(unit)
+		for _, value := range unit {
+			if _, ok := index[value]; !ok {
+				index[value] = 0
+				values = append(values, value)
+			}
+		}
+	}
+	if result.PairableValues < 2 {
+		return result, ErrInsufficientData
+	}
+
+	values = sortedCopy(values)
+	for i, value := range values {
+		index[value] = i
+	}
+
+	// Each ordered pair of values within a unit contributes 1/(m_u - 1)
+	size := len(values)
+	coincidences := make([][]float64, size)
+	for i := range coincidences {
+		coincidences[i] = make([]float64, size)
+	}
+	for _, unit := range units {
+		m := len(unit)
+		if m < 2 {
+			continue
+		}
+		weight := 1 / float64(m-1)
+		for i := 0; i < m; i++ {
+			for j := 0; j < m; j++ {
+				if i != j {
+					coincidences[index[unit[i]]][index[unit[j]]] += weight
+				}
+			}
+		}
+	}
+
+	marginals := make([]float64, size)
+	var n float64
+	for c := range coincidences {
+		for k := range coincidences[c] {
+			marginals[c] += coincidences[c][k]
+		}
+		n += marginals[c]
+	}
+
+	delta := differenceFunc(level, values, marginals)
+
+	var observed, expected float64
+	for c := 0; c < size; c++ {
+		for k := 0; k < size; k++ {
+			d := delta(c, k)
+			observed += coincidences[c][k] * d
+			expected += marginals[c] * marginals[k] * d
+		}
+	}
+	if expected == 0 {
+		return result, ErrInsufficientData
+	}
+
+	result.Alpha = 1 - (n-1)*observed/expected
+	return result, nil
+}
+
+// differenceFunc returns the squared metric difference between the values at
+// two coincidence matrix indices for the given level of measurement.
+func differenceFunc(level Level, values, marginals []float64) func(c, k int) float64 {
+	switch level {
+	case Interval:
+		return func(c, k int) float64 {
+			diff := values[c] - values[k]
+			return diff * diff
+		}
+	case Ordinal:
+		// Ranks are spaced by how many values fall between them, so the
+		// difference only depends on the order of the values
+		cumulative := make([]float64, len(marginals)+1)
+		for i, marginal := range marginals {
+			cumulative[i+1] = cumulative[i] + marginal
+		}
+		return func(c, k int) float64 {
+			if c > k {
+				c, k = k, c
+			}
+			diff := cumulative[k+1] - cumulative[c] - (marginals[c]+marginals[k])/2
+			return diff * diff
+		}
+	default:
+		return func(c, k int) float64 {
+			if c == k {
+				return 0
+			}
+			return 1
+		}
+	}
+}
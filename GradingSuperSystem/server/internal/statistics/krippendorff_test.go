@@ -0,0 +1,69 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+// krippendorffExample is the reliability data of Krippendorff (2011),
+// "Computing Krippendorff's Alpha-Reliability": four observers rating twelve
+// units on a 1-5 scale with missing values.
+var krippendorffExample = [][]float64{
+	{1, 1, 1},
+	{2, 2, 3, 2},
+	{3, 3, 3, 3},
+	{3, 3, 3, 3},
+	{2, 2, 2, 2},
+	{1, 2, 3, 4},
+	{4, 4, 4, 4},
+	{1, 1, 2, 1},
+	{2, 2, 2, 2},
+	{5, 5, 5},
+	{1, 1},
+	{3},
+}
+
+func TestKrippendorffAlphaReferenceValues(t *testing.T) {
+	tests := []struct {
+		level Level
+		want  float64
+	}{
+		{Nominal, 0.743},
+		{Ordinal, 0.815},
+		{Interval, 0.849},
+	}
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			result, err := KrippendorffAlpha(krippendorffExample, tt.level)
+			if err != nil {
+				t.Fatalf("KrippendorffAlpha: %v", err)
+			}
+			if math.Abs(result.Alpha-tt.want) > 0.0005 {
+				t.Errorf("alpha = %.4f, want %.3f", result.Alpha, tt.want)
+			}
+			if result.Units != 11 {
+				t.Errorf("units = %d, want 11", result.Units)
+			}
+			if result.PairableValues != 40 {
+				t.Errorf("pairable values = %d, want 40", result.PairableValues)
+			}
+		})
+	}
+}
+
+func TestKrippendorffAlphaInsufficientData(t *testing.T) {
+	tests := []struct {
+		name  string
+		units [][]float64
+	}{
+		{"no pairable units", [][]float64{{1}, {2}, {3}}},
+		{"identical values", [][]float64{{2, 2}, {2, 2, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := KrippendorffAlpha(tt.units, Interval); err != ErrInsufficientData {
+				t.Errorf("err = %v, want ErrInsufficientData", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package statistics
+
+import (
+	"math"
+	"sort"
+)
+
+// KruskalWallisResult is the outcome of a Kruskal-Wallis H test.
+type KruskalWallisResult struct {
+	H          float64
+	DF         int
+	PValue     float64
+	EtaSquared float64
+	// Average rank of each group, in the order the groups were given
+	MeanRanks []float64
+}
+
+// KruskalWallis tests whether the groups come from the same distribution
+// using ranks, so it does not assume normally distributed scores. H is
+// corrected for ties and eta-squared is estimated as (H - k + 1) / (n - k).
+func KruskalWallis(groups [][]float64) (KruskalWallisResult, error) {
+	ranked, n, tieCorrection, err := rankGroups(groups)
+	if err != nil {
+		return KruskalWallisResult{}, err
+	}
+	k := len(groups)
+	if tieCorrection == 0 {
+		// Every value is tied, so there is nothing to rank
+		return KruskalWallisResult{}, ErrInsufficientData
+	}
+
+	result := KruskalWallisResult{DF: k - 1, MeanRanks: make([]float64, k)}
+	var sum float64
+	for i, ranks := range ranked {
+		var rankSum float64
+		for _, rank := range ranks {
+			rankSum += rank
+		}
+		result.MeanRanks[i] = rankSum / float64(len(ranks))
+		sum += rankSum * rankSum / float64(len(ranks))
+	}
+
+	nf := float64(n)
+	result.H = (12/(nf*(nf+1))*sum - 3*(nf+1)) / tieCorrection
+	result.PValue = ChiSquareSurvival(result.H, float64(result.DF))
+	result.EtaSquared = math.Max(0, (result.H-float64(k)+1)/(nf-float64(k)))
+	return result, nil
+}
+
+// DunnTest compares the mean ranks of every pair of groups after a
+// Kruskal-Wallis test. The statistic is a z-score and p-values are two-sided
+// with Holm correction applied across all pairs.
+func DunnTest(groups [][]float64) ([]PairwiseComparison, error) {
+	ranked, n, _, err := rankGroups(groups)
+	if err != nil {
+		return nil, err
+	}
+
+	meanRanks := make([]float64, len(ranked))
+	for i, ranks := range ranked {
+		meanRanks[i] = Mean(ranks)
+	}
+
+	nf := float64(n)
+	variance := nf * (nf + 1) / 12
+	if n > 1 {
+		variance -= tieSum(groups) / (12 * (nf - 1))
+	}
+
+	var comparisons []PairwiseComparison
+	var pValues []float64
+	for a := 0; a < len(groups); a++ {
+		for b := a + 1; b < len(groups); b++ {
+			diff := meanRanks[a] - meanRanks[b]
+			se := math.Sqrt(variance * (1/float64(len(groups[a])) + 1/float64(len(groups[b]))))
+
+			comparison := PairwiseComparison{A: a, B: b, Difference: diff}
+			p := 1.0
+			if se > 0 {
+				comparison.Statistic = diff / se
+				p = 2 * (1 - NormalCDF(math.Abs(comparison.Statistic)))
+			}
+			comparisons = append(comparisons, comparison)
+			pValues = append(pValues, p)
+		}
+	}
+
+	for i, p := range HolmAdjust(pValues) {
+		comparisons[i].PValue = p
+	}
+	return comparisons, nil
+}
+
+// rankGroups ranks all values together, averaging ranks across ties, and
+// returns the ranks split back into their groups along with the total count
+// and the tie correction factor 1 - sum(t^3 - t) / (n^3 - n).
+func rankGroups(groups [][]float64) ([][]float64, int, float64, error) {
+	type entry struct {
+		value float64
+		group int
+		index int
+	}
+
+	var entries []entry
+	for g, group := range groups {
+		if len(group) == 0 {
+			return nil, 0, 0, ErrInsufficientData
+		}
+		for i, value := range group {
+			entries = append(entries, entry{value: value, group: g, index: i})
+		}
+	}
+	n := len(entries)
+	if len(groups) < 2 || n <= len(groups) {
+		return nil, 0, 0, ErrInsufficientData
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].value < entries[j].value })
+
+	ranked := make([][]float64, len(groups))
+	for g, group := range groups {
+		ranked[g] = make([]float64, len(group))
+	}
+	for start := 0; start < n; {
+		end := start
+		for end < n && entries[end].value == entries[start].value {
+			end++
+		}
+		// Ranks are 1-based, so tied positions start..end-1 share the average rank
+		rank := float64(start+end+1) / 2
+		for _, e := range entries[start:end] {
+			ranked[e.group][e.index] = rank
+		}
+		start = end
+	}
+
+	nf := float64(n)
+	return ranked, n, 1 - tieSum(groups)/(nf*nf*nf-nf), nil
+}
+
+// tieSum returns sum(t^3 - t) over every group of tied values.
+func tieSum(groups [][]float64) float64 {
+	counts := make(map[float64]int)
+	for _, group := range groups {
+		for _, value := range group {
+			counts[value]++
+		}
+	}
+	var sum float64
+	for _, count := range counts {
+		t := float64(count)
+		sum += t*t*t - t
+	}
+	return sum
+}
@@ -0,0 +1,104 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+// With three groups H has two degrees of freedom, whose chi-square survival
+// function is exp(-H/2); with two groups it is erfc(sqrt(H/2))
+func TestKruskalWallis(t *testing.T) {
+	tests := []struct {
+		name       string
+		groups     [][]float64
+		h          float64
+		pValue     float64
+		etaSquared float64
+		meanRanks  []float64
+	}{
+		{
+			name:       "no ties",
+			groups:     separatedGroups,
+			h:          7.2,
+			pValue:     math.Exp(-3.6),
+			etaSquared: 5.2 / 6,
+			meanRanks:  []float64{2, 5, 8},
+		},
+		{
+			// Uncorrected H of 64/21 divided by the tie correction 1 - 18/210
+			name:       "tie corrected",
+			groups:     [][]float64{{1, 1, 2}, {2, 3, 3}},
+			h:          10.0 / 3,
+			pValue:     math.Erfc(math.Sqrt(10.0 / 6)),
+			etaSquared: (10.0/3 - 1) / 4,
+			meanRanks:  []float64{6.5 / 3, 14.5 / 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := KruskalWallis(tt.groups)
+			if err != nil {
+				t.Fatalf("KruskalWallis: %v", err)
+			}
+			if result.DF != len(tt.groups)-1 {
+				t.Errorf("df = %d, want %d", result.DF, len(tt.groups)-1)
+			}
+			if math.Abs(result.H-tt.h) > 1e-9 {
+				t.Errorf("H = %v, want %v", result.H, tt.h)
+			}
+			if math.Abs(result.PValue-tt.pValue) > 1e-9 {
+				t.Errorf("p = %v, want %v", result.PValue, tt.pValue)
+			}
+			if math.Abs(result.EtaSquared-tt.etaSquared) > 1e-9 {
+				t.Errorf("eta squared = %v, want %v", result.EtaSquared, tt.etaSquared)
+			}
+			for i, want := range tt.meanRanks {
+				if math.Abs(result.MeanRanks[i]-want) > 1e-9 {
+					t.Errorf("mean rank %d = %v, want %v", i, result.MeanRanks[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestKruskalWallisAllTied(t *testing.T) {
+	if _, err := KruskalWallis([][]float64{{4, 4}, {4, 4}}); err != ErrInsufficientData {
+		t.Errorf("err = %v, want ErrInsufficientData", err)
+	}
+}
+
+func TestDunnTest(t *testing.T) {
+	comparisons, err := DunnTest(separatedGroups)
+	if err != nil {
+		t.Fatalf("DunnTest: %v", err)
+	}
+
+	// Rank variance n(n+1)/12 = 7.5, so each difference has standard error sqrt(5)
+	z := func(diff float64) float64 { return diff / math.Sqrt(5) }
+	rawP := func(diff float64) float64 { return math.Erfc(math.Abs(z(diff)) / math.Sqrt2) }
+	tests := []struct {
+		a, b       int
+		difference float64
+		pValue     float64
+	}{
+		// Holm: the smallest p-value is tripled, the two tied ones doubled
+		{0, 1, -3, 2 * rawP(-3)},
+		{0, 2, -6, 3 * rawP(-6)},
+		{1, 2, -3, 2 * rawP(-3)},
+	}
+	for i, tt := range tests {
+		got := comparisons[i]
+		if got.A != tt.a || got.B != tt.b {
+			t.Fatalf("comparison %d is (%d, %d), want (%d, %d)", i, got.A, got.B, tt.a, tt.b)
+		}
+		if math.Abs(got.Difference-tt.difference) > 1e-9 {
+			t.Errorf("(%d, %d) difference = %v, want %v", tt.a, tt.b, got.Difference, tt.difference)
+		}
+		if math.Abs(got.Statistic-z(tt.difference)) > 1e-9 {
+			t.Errorf("(%d, %d) z = %v, want %v", tt.a, tt.b, got.Statistic, z(tt.difference))
+		}
+		if math.Abs(got.PValue-tt.pValue) > 1e-9 {
+			t.Errorf("(%d, %d) p = %v, want %v", tt.a, tt.b, got.PValue, tt.pValue)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package statistics
+
+import "math"
+
+// ModifiedZThreshold is the cutoff recommended by Iglewicz and Hoaglin above
+// which a modified Z-score marks a potential outlier.
+const ModifiedZThreshold = 3.5
+
+// ModifiedZScores returns the modified Z-score of every value,
+//
+//	M_i = 0.6745 * (x_i - median) / MAD
+//
+// which is robust to the outliers it is looking for. When more than half the
+// values are identical the MAD is zero, so the mean absolute deviation is used
+// instead (scaled by 1.253314 to estimate the standard deviation). If every
+// value is identical all scores are zero.
+func ModifiedZScores(values []float64) []float64 {
+	scores := make([]float64, len(values))
+	if len(values) == 0 {
+		return scores
+	}
+
+	median := Median(values)
+	if mad := MedianAbsoluteDeviation(values); mad != 0 {
+		for i, value := range values {
+			scores[i] = 0.6745 * (value - median) / mad
+		}
+		return scores
+	}
+
+	var meanAbsDev float64
+	for _, value := range values {
+		meanAbsDev += math.Abs(value - median)
+	}
+	meanAbsDev /= float64(len(values))
+	if meanAbsDev == 0 {
+		return scores
+	}
+
+	for i, value := range values {
+		scores[i] = (value - median) / (1.253314 * meanAbsDev)
+	}
+	return scores
+}
@@ -0,0 +1,58 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestModifiedZScores(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   []float64
+	}{
+		{
+			name:   "median absolute deviation",
+			values: []float64{1, 2, 3, 4, 100},
+			want:   []float64{-1.349, -0.6745, 0, 0.6745, 65.4265},
+		},
+		{
+			name:   "mean absolute deviation when the MAD is zero",
+			values: []float64{5, 5, 5, 5, 9},
+			want:   []float64{0, 0, 0, 0, 4 / (1.253314 * 0.8)},
+		},
+		{
+			name:   "identical values",
+			values: []float64{7, 7, 7},
+			want:   []float64{0, 0, 0},
+		},
+		{
+			name:   "no values",
+			values: nil,
+			want:   []float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ModifiedZScores(tt.values)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d scores, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("score %d = %.6f, want %.6f", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestModifiedZScoresFlagsOutlier(t *testing.T) {
+	scores := ModifiedZScores([]float64{88, 90, 91, 89, 92, 90, 40})
+	for i, score := range scores {
+		flagged := math.Abs(score) > ModifiedZThreshold
+		if flagged != (i == 6) {
+			t.Errorf("value %d: score %.3f, flagged = %v", i, score, flagged)
+		}
+	}
+}
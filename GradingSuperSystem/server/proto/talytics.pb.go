@@ -30,7 +30,7 @@ type User struct {
 	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Email     string               `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Name      string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Role      string               `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "instructor" or "ta"
+	Role      string               `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "instructor", "ta" or "student"
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
@@ -117,7 +117,7 @@ type RegisterRequest struct {
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Role     string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "instructor" or "ta"
+	Role     string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "instructor", "ta" or "student"
 }
 
 func (x *RegisterRequest) Reset() {
@@ -558,6 +558,10 @@ type Course struct {
 	Members      []*CourseMember      `protobuf:"bytes,9,rep,name=members,proto3" json:"members,omitempty"`
 	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt    *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Students join with this code; join_code is for course staff only
+	StudentJoinCode string `protobuf:"bytes,12,opt,name=student_join_code,json=studentJoinCode,proto3" json:"student_join_code,omitempty"`
+	// Whether students see who graded their work
+	ShowGraderToStudents bool `protobuf:"varint,13,opt,name=show_grader_to_students,json=showGraderToStudents,proto3" json:"show_grader_to_students,omitempty"`
 }
 
 func (x *Course) Reset() {
@@ -669,6 +673,20 @@ func (x *Course) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *Course) GetStudentJoinCode() string {
+	if x != nil {
+		return x.StudentJoinCode
+	}
+	return ""
+}
+
+func (x *Course) GetShowGraderToStudents() bool {
+	if x != nil {
+		return x.ShowGraderToStudents
+	}
+	return false
+}
+
 type CourseMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -679,6 +697,8 @@ type CourseMember struct {
 	Email    string               `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	Role     string               `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	JoinedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// Student ID the member is linked to in this course, empty if not linked
+	StudentId string `protobuf:"bytes,6,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 }
 
 func (x *CourseMember) Reset() {
@@ -748,6 +768,13 @@ func (x *CourseMember) GetJoinedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *CourseMember) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
 type CreateCourseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -917,12 +944,13 @@ type UpdateCourseRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code        string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Semester    string `protobuf:"bytes,5,opt,name=semester,proto3" json:"semester,omitempty"`
-	Year        int32  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	Id                   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code                 string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Description          string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Semester             string `protobuf:"bytes,5,opt,name=semester,proto3" json:"semester,omitempty"`
+	Year                 int32  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	ShowGraderToStudents bool   `protobuf:"varint,7,opt,name=show_grader_to_students,json=showGraderToStudents,proto3" json:"show_grader_to_students,omitempty"`
 }
 
 func (x *UpdateCourseRequest) Reset() {
@@ -999,6 +1027,13 @@ func (x *UpdateCourseRequest) GetYear() int32 {
 	return 0
 }
 
+func (x *UpdateCourseRequest) GetShowGraderToStudents() bool {
+	if x != nil {
+		return x.ShowGraderToStudents
+	}
+	return false
+}
+
 type JoinCourseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1140,6 +1175,71 @@ func (x *DeleteCourseRequest) GetId() int64 {
 	return 0
 }
 
+// Links a student member to the submissions filed under student_id in the
+// course. An empty student_id removes the link.
+type SetStudentIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId  int64  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId    int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StudentId string `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *SetStudentIdRequest) Reset() {
+	*x = SetStudentIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStudentIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStudentIdRequest) ProtoMessage() {}
+
+func (x *SetStudentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStudentIdRequest.ProtoReflect.Descriptor instead.
+func (*SetStudentIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetStudentIdRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SetStudentIdRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetStudentIdRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
 type CourseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1152,7 +1252,7 @@ type CourseResponse struct {
 func (x *CourseResponse) Reset() {
 	*x = CourseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[18]
+		mi := &file_proto_talytics_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1165,7 +1265,7 @@ func (x *CourseResponse) String() string {
 func (*CourseResponse) ProtoMessage() {}
 
 func (x *CourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[18]
+	mi := &file_proto_talytics_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,7 +1278,7 @@ func (x *CourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CourseResponse.ProtoReflect.Descriptor instead.
 func (*CourseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{18}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CourseResponse) GetCourse() *Course {
@@ -1206,7 +1306,7 @@ type ListCoursesResponse struct {
 func (x *ListCoursesResponse) Reset() {
 	*x = ListCoursesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[19]
+		mi := &file_proto_talytics_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1219,7 +1319,7 @@ func (x *ListCoursesResponse) String() string {
 func (*ListCoursesResponse) ProtoMessage() {}
 
 func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[19]
+	mi := &file_proto_talytics_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,7 +1332,7 @@ func (x *ListCoursesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCoursesResponse.ProtoReflect.Descriptor instead.
 func (*ListCoursesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{19}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListCoursesResponse) GetCourses() []*Course {
@@ -1253,7 +1353,7 @@ type LeaveCourseResponse struct {
 func (x *LeaveCourseResponse) Reset() {
 	*x = LeaveCourseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[20]
+		mi := &file_proto_talytics_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1266,7 +1366,7 @@ func (x *LeaveCourseResponse) String() string {
 func (*LeaveCourseResponse) ProtoMessage() {}
 
 func (x *LeaveCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[20]
+	mi := &file_proto_talytics_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,7 +1379,7 @@ func (x *LeaveCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveCourseResponse.ProtoReflect.Descriptor instead.
 func (*LeaveCourseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{20}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *LeaveCourseResponse) GetMessage() string {
@@ -1300,7 +1400,7 @@ type DeleteCourseResponse struct {
 func (x *DeleteCourseResponse) Reset() {
 	*x = DeleteCourseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[21]
+		mi := &file_proto_talytics_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1313,7 +1413,7 @@ func (x *DeleteCourseResponse) String() string {
 func (*DeleteCourseResponse) ProtoMessage() {}
 
 func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[21]
+	mi := &file_proto_talytics_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,7 +1426,7 @@ func (x *DeleteCourseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCourseResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCourseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{21}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteCourseResponse) GetMessage() string {
@@ -1336,6 +1436,61 @@ func (x *DeleteCourseResponse) GetMessage() string {
 	return ""
 }
 
+type SetStudentIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member  *CourseMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetStudentIdResponse) Reset() {
+	*x = SetStudentIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStudentIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStudentIdResponse) ProtoMessage() {}
+
+func (x *SetStudentIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStudentIdResponse.ProtoReflect.Descriptor instead.
+func (*SetStudentIdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetStudentIdResponse) GetMember() *CourseMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *SetStudentIdResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // Messages for Assignment service
 type Assignment struct {
 	state         protoimpl.MessageState
@@ -1354,12 +1509,18 @@ type Assignment struct {
 	CreatorName string               `protobuf:"bytes,10,opt,name=creator_name,json=creatorName,proto3" json:"creator_name,omitempty"`
 	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   *timestamp.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// "draft", "in_review" or "released"; students only see released grades
+	ReleaseState string `protobuf:"bytes,13,opt,name=release_state,json=releaseState,proto3" json:"release_state,omitempty"`
+	// When grades were released, or are scheduled to be
+	ReleaseAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// Hides student identities from TAs until grades are released
+	BlindGrading bool `protobuf:"varint,15,opt,name=blind_grading,json=blindGrading,proto3" json:"blind_grading,omitempty"`
 }
 
 func (x *Assignment) Reset() {
 	*x = Assignment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[22]
+		mi := &file_proto_talytics_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1372,7 +1533,7 @@ func (x *Assignment) String() string {
 func (*Assignment) ProtoMessage() {}
 
 func (x *Assignment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[22]
+	mi := &file_proto_talytics_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1546,7 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
 func (*Assignment) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{22}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Assignment) GetId() int64 {
@@ -1472,23 +1633,45 @@ func (x *Assignment) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *Assignment) GetReleaseState() string {
+	if x != nil {
+		return x.ReleaseState
+	}
+	return ""
+}
+
+func (x *Assignment) GetReleaseAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetBlindGrading() bool {
+	if x != nil {
+		return x.BlindGrading
+	}
+	return false
+}
+
 type CreateAssignmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseId    int64                `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	DueDate     *timestamp.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	MaxScore    float64              `protobuf:"fixed64,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	RubricId    int64                `protobuf:"varint,6,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	CourseId     int64                `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Name         string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate      *timestamp.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	MaxScore     float64              `protobuf:"fixed64,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	RubricId     int64                `protobuf:"varint,6,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	BlindGrading bool                 `protobuf:"varint,7,opt,name=blind_grading,json=blindGrading,proto3" json:"blind_grading,omitempty"`
 }
 
 func (x *CreateAssignmentRequest) Reset() {
 	*x = CreateAssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[23]
+		mi := &file_proto_talytics_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1501,7 +1684,7 @@ func (x *CreateAssignmentRequest) String() string {
 func (*CreateAssignmentRequest) ProtoMessage() {}
 
 func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[23]
+	mi := &file_proto_talytics_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +1697,7 @@ func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
 func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{23}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateAssignmentRequest) GetCourseId() int64 {
@@ -1559,6 +1742,13 @@ func (x *CreateAssignmentRequest) GetRubricId() int64 {
 	return 0
 }
 
+func (x *CreateAssignmentRequest) GetBlindGrading() bool {
+	if x != nil {
+		return x.BlindGrading
+	}
+	return false
+}
+
 type GetAssignmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1570,7 +1760,7 @@ type GetAssignmentRequest struct {
 func (x *GetAssignmentRequest) Reset() {
 	*x = GetAssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[24]
+		mi := &file_proto_talytics_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1583,7 +1773,7 @@ func (x *GetAssignmentRequest) String() string {
 func (*GetAssignmentRequest) ProtoMessage() {}
 
 func (x *GetAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[24]
+	mi := &file_proto_talytics_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1596,7 +1786,7 @@ func (x *GetAssignmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssignmentRequest.ProtoReflect.Descriptor instead.
 func (*GetAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{24}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetAssignmentRequest) GetId() int64 {
@@ -1617,7 +1807,7 @@ type ListAssignmentsRequest struct {
 func (x *ListAssignmentsRequest) Reset() {
 	*x = ListAssignmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[25]
+		mi := &file_proto_talytics_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1630,7 +1820,7 @@ func (x *ListAssignmentsRequest) String() string {
 func (*ListAssignmentsRequest) ProtoMessage() {}
 
 func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[25]
+	mi := &file_proto_talytics_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,7 +1833,7 @@ func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssignmentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{25}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListAssignmentsRequest) GetCourseId() int64 {
@@ -1669,7 +1859,7 @@ type UpdateAssignmentRequest struct {
 func (x *UpdateAssignmentRequest) Reset() {
 	*x = UpdateAssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[26]
+		mi := &file_proto_talytics_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1682,7 +1872,7 @@ func (x *UpdateAssignmentRequest) String() string {
 func (*UpdateAssignmentRequest) ProtoMessage() {}
 
 func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[26]
+	mi := &file_proto_talytics_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +1885,7 @@ func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{26}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateAssignmentRequest) GetId() int64 {
@@ -1751,7 +1941,7 @@ type DeleteAssignmentRequest struct {
 func (x *DeleteAssignmentRequest) Reset() {
 	*x = DeleteAssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[27]
+		mi := &file_proto_talytics_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1764,7 +1954,7 @@ func (x *DeleteAssignmentRequest) String() string {
 func (*DeleteAssignmentRequest) ProtoMessage() {}
 
 func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[27]
+	mi := &file_proto_talytics_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +1967,7 @@ func (x *DeleteAssignmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAssignmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{27}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteAssignmentRequest) GetId() int64 {
@@ -1799,7 +1989,7 @@ type AssignmentResponse struct {
 func (x *AssignmentResponse) Reset() {
 	*x = AssignmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[28]
+		mi := &file_proto_talytics_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1812,7 +2002,7 @@ func (x *AssignmentResponse) String() string {
 func (*AssignmentResponse) ProtoMessage() {}
 
 func (x *AssignmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[28]
+	mi := &file_proto_talytics_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1825,7 +2015,7 @@ func (x *AssignmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignmentResponse.ProtoReflect.Descriptor instead.
 func (*AssignmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{28}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AssignmentResponse) GetAssignment() *Assignment {
@@ -1853,7 +2043,7 @@ type ListAssignmentsResponse struct {
 func (x *ListAssignmentsResponse) Reset() {
 	*x = ListAssignmentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[29]
+		mi := &file_proto_talytics_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1866,7 +2056,7 @@ func (x *ListAssignmentsResponse) String() string {
 func (*ListAssignmentsResponse) ProtoMessage() {}
 
 func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[29]
+	mi := &file_proto_talytics_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,7 +2069,7 @@ func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{29}
+	return file_proto_talytics_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListAssignmentsResponse) GetAssignments() []*Assignment {
@@ -1889,31 +2079,34 @@ func (x *ListAssignmentsResponse) GetAssignments() []*Assignment {
 	return nil
 }
 
-type DeleteAssignmentResponse struct {
+type SetReleaseStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	AssignmentId int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	State        string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Schedules the release for a draft or in_review assignment
+	ReleaseAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
 }
 
-func (x *DeleteAssignmentResponse) Reset() {
-	*x = DeleteAssignmentResponse{}
+func (x *SetReleaseStateRequest) Reset() {
+	*x = SetReleaseStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[30]
+		mi := &file_proto_talytics_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteAssignmentResponse) String() string {
+func (x *SetReleaseStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAssignmentResponse) ProtoMessage() {}
+func (*SetReleaseStateRequest) ProtoMessage() {}
 
-func (x *DeleteAssignmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[30]
+func (x *SetReleaseStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,52 +2117,58 @@ func (x *DeleteAssignmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAssignmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAssignmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SetReleaseStateRequest.ProtoReflect.Descriptor instead.
+func (*SetReleaseStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteAssignmentResponse) GetMessage() string {
+func (x *SetReleaseStateRequest) GetAssignmentId() int64 {
 	if x != nil {
-		return x.Message
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SetReleaseStateRequest) GetState() string {
+	if x != nil {
+		return x.State
 	}
 	return ""
 }
 
-// Messages for Rubric service
-type Rubric struct {
+func (x *SetReleaseStateRequest) GetReleaseAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+type SetBlindGradingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId    int64                `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Criteria    []string             `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
-	Weights     []float64            `protobuf:"fixed64,5,rep,packed,name=weights,proto3" json:"weights,omitempty"`
-	CreatedBy   int64                `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatorName string               `protobuf:"bytes,7,opt,name=creator_name,json=creatorName,proto3" json:"creator_name,omitempty"`
-	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Enabled      bool  `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *Rubric) Reset() {
-	*x = Rubric{}
+func (x *SetBlindGradingRequest) Reset() {
+	*x = SetBlindGradingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[31]
+		mi := &file_proto_talytics_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rubric) String() string {
+func (x *SetBlindGradingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rubric) ProtoMessage() {}
+func (*SetBlindGradingRequest) ProtoMessage() {}
 
-func (x *Rubric) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[31]
+func (x *SetBlindGradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1980,102 +2179,108 @@ func (x *Rubric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rubric.ProtoReflect.Descriptor instead.
-func (*Rubric) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SetBlindGradingRequest.ProtoReflect.Descriptor instead.
+func (*SetBlindGradingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Rubric) GetId() int64 {
+func (x *SetBlindGradingRequest) GetAssignmentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.AssignmentId
 	}
 	return 0
 }
 
-func (x *Rubric) GetName() string {
+func (x *SetBlindGradingRequest) GetEnabled() bool {
 	if x != nil {
-		return x.Name
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Rubric) GetCourseId() int64 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
-}
+type DeleteAssignmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Rubric) GetCriteria() []string {
-	if x != nil {
-		return x.Criteria
-	}
-	return nil
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Rubric) GetWeights() []float64 {
-	if x != nil {
-		return x.Weights
+func (x *DeleteAssignmentResponse) Reset() {
+	*x = DeleteAssignmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Rubric) GetCreatedBy() int64 {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return 0
+func (x *DeleteAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Rubric) GetCreatorName() string {
-	if x != nil {
-		return x.CreatorName
+func (*DeleteAssignmentResponse) ProtoMessage() {}
+
+func (x *DeleteAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Rubric) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use DeleteAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Rubric) GetUpdatedAt() *timestamp.Timestamp {
+func (x *DeleteAssignmentResponse) GetMessage() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type CreateRubricRequest struct {
+// Messages for Submission service
+type Submission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId int64     `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	Criteria []string  `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
-	Weights  []float64 `protobuf:"fixed64,4,rep,packed,name=weights,proto3" json:"weights,omitempty"`
-}
-
-func (x *CreateRubricRequest) Reset() {
-	*x = CreateRubricRequest{}
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId    string               `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName  string               `protobuf:"bytes,4,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	FilePath     string               `protobuf:"bytes,5,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileName     string               `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	UploadedAt   *timestamp.Timestamp `protobuf:"bytes,7,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	// Under blind grading, TAs get the token in place of student_id, an empty
+	// student_name and a file name derived from the token
+	BlindToken string `protobuf:"bytes,8,opt,name=blind_token,json=blindToken,proto3" json:"blind_token,omitempty"`
+	Anonymized bool   `protobuf:"varint,9,opt,name=anonymized,proto3" json:"anonymized,omitempty"`
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[32]
+		mi := &file_proto_talytics_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRubricRequest) String() string {
+func (x *Submission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRubricRequest) ProtoMessage() {}
+func (*Submission) ProtoMessage() {}
 
-func (x *CreateRubricRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[32]
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2086,64 +2291,102 @@ func (x *CreateRubricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRubricRequest.ProtoReflect.Descriptor instead.
-func (*CreateRubricRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateRubricRequest) GetName() string {
+func (x *Submission) GetId() int64 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateRubricRequest) GetCourseId() int64 {
+func (x *Submission) GetAssignmentId() int64 {
 	if x != nil {
-		return x.CourseId
+		return x.AssignmentId
 	}
 	return 0
 }
 
-func (x *CreateRubricRequest) GetCriteria() []string {
+func (x *Submission) GetStudentId() string {
 	if x != nil {
-		return x.Criteria
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateRubricRequest) GetWeights() []float64 {
+func (x *Submission) GetStudentName() string {
 	if x != nil {
-		return x.Weights
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *Submission) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *Submission) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Submission) GetUploadedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UploadedAt
 	}
 	return nil
 }
 
-type GetRubricRequest struct {
+func (x *Submission) GetBlindToken() string {
+	if x != nil {
+		return x.BlindToken
+	}
+	return ""
+}
+
+func (x *Submission) GetAnonymized() bool {
+	if x != nil {
+		return x.Anonymized
+	}
+	return false
+}
+
+type UploadSubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId    string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName  string `protobuf:"bytes,3,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	FileData     []byte `protobuf:"bytes,4,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
 }
 
-func (x *GetRubricRequest) Reset() {
-	*x = GetRubricRequest{}
+func (x *UploadSubmissionRequest) Reset() {
+	*x = UploadSubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[33]
+		mi := &file_proto_talytics_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRubricRequest) String() string {
+func (x *UploadSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRubricRequest) ProtoMessage() {}
+func (*UploadSubmissionRequest) ProtoMessage() {}
 
-func (x *GetRubricRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[33]
+func (x *UploadSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,43 +2397,64 @@ func (x *GetRubricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRubricRequest.ProtoReflect.Descriptor instead.
-func (*GetRubricRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UploadSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*UploadSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetRubricRequest) GetId() int64 {
+func (x *UploadSubmissionRequest) GetAssignmentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.AssignmentId
 	}
 	return 0
 }
 
-type ListRubricsRequest struct {
+func (x *UploadSubmissionRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *UploadSubmissionRequest) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *UploadSubmissionRequest) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+type GetSubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseId int64 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListRubricsRequest) Reset() {
-	*x = ListRubricsRequest{}
+func (x *GetSubmissionRequest) Reset() {
+	*x = GetSubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[34]
+		mi := &file_proto_talytics_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRubricsRequest) String() string {
+func (x *GetSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRubricsRequest) ProtoMessage() {}
+func (*GetSubmissionRequest) ProtoMessage() {}
 
-func (x *ListRubricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[34]
+func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,46 +2465,43 @@ func (x *ListRubricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRubricsRequest.ProtoReflect.Descriptor instead.
-func (*ListRubricsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListRubricsRequest) GetCourseId() int64 {
+func (x *GetSubmissionRequest) GetId() int64 {
 	if x != nil {
-		return x.CourseId
+		return x.Id
 	}
 	return 0
 }
 
-type UpdateRubricRequest struct {
+type ListSubmissionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Criteria []string  `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
-	Weights  []float64 `protobuf:"fixed64,4,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
 }
 
-func (x *UpdateRubricRequest) Reset() {
-	*x = UpdateRubricRequest{}
+func (x *ListSubmissionsRequest) Reset() {
+	*x = ListSubmissionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[35]
+		mi := &file_proto_talytics_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateRubricRequest) String() string {
+func (x *ListSubmissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRubricRequest) ProtoMessage() {}
+func (*ListSubmissionsRequest) ProtoMessage() {}
 
-func (x *UpdateRubricRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[35]
+func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2251,40 +2512,19 @@ func (x *UpdateRubricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRubricRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRubricRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpdateRubricRequest) GetId() int64 {
+func (x *ListSubmissionsRequest) GetAssignmentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.AssignmentId
 	}
 	return 0
 }
 
-func (x *UpdateRubricRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateRubricRequest) GetCriteria() []string {
-	if x != nil {
-		return x.Criteria
-	}
-	return nil
-}
-
-func (x *UpdateRubricRequest) GetWeights() []float64 {
-	if x != nil {
-		return x.Weights
-	}
-	return nil
-}
-
-type DeleteRubricRequest struct {
+type DeleteSubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2292,23 +2532,23 @@ type DeleteRubricRequest struct {
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteRubricRequest) Reset() {
-	*x = DeleteRubricRequest{}
+func (x *DeleteSubmissionRequest) Reset() {
+	*x = DeleteSubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[36]
+		mi := &file_proto_talytics_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRubricRequest) String() string {
+func (x *DeleteSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRubricRequest) ProtoMessage() {}
+func (*DeleteSubmissionRequest) ProtoMessage() {}
 
-func (x *DeleteRubricRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[36]
+func (x *DeleteSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,44 +2559,44 @@ func (x *DeleteRubricRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRubricRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRubricRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DeleteSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DeleteRubricRequest) GetId() int64 {
+func (x *DeleteSubmissionRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type RubricResponse struct {
+type SubmissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rubric  *Rubric `protobuf:"bytes,1,opt,name=rubric,proto3" json:"rubric,omitempty"`
-	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Message    string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RubricResponse) Reset() {
-	*x = RubricResponse{}
+func (x *SubmissionResponse) Reset() {
+	*x = SubmissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[37]
+		mi := &file_proto_talytics_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RubricResponse) String() string {
+func (x *SubmissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RubricResponse) ProtoMessage() {}
+func (*SubmissionResponse) ProtoMessage() {}
 
-func (x *RubricResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[37]
+func (x *SubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,50 +2607,50 @@ func (x *RubricResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RubricResponse.ProtoReflect.Descriptor instead.
-func (*RubricResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SubmissionResponse.ProtoReflect.Descriptor instead.
+func (*SubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RubricResponse) GetRubric() *Rubric {
+func (x *SubmissionResponse) GetSubmission() *Submission {
 	if x != nil {
-		return x.Rubric
+		return x.Submission
 	}
 	return nil
 }
 
-func (x *RubricResponse) GetMessage() string {
+func (x *SubmissionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ListRubricsResponse struct {
+type ListSubmissionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rubrics []*Rubric `protobuf:"bytes,1,rep,name=rubrics,proto3" json:"rubrics,omitempty"`
+	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
 }
 
-func (x *ListRubricsResponse) Reset() {
-	*x = ListRubricsResponse{}
+func (x *ListSubmissionsResponse) Reset() {
+	*x = ListSubmissionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[38]
+		mi := &file_proto_talytics_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRubricsResponse) String() string {
+func (x *ListSubmissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRubricsResponse) ProtoMessage() {}
+func (*ListSubmissionsResponse) ProtoMessage() {}
 
-func (x *ListRubricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[38]
+func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2421,19 +2661,74 @@ func (x *ListRubricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRubricsResponse.ProtoReflect.Descriptor instead.
-func (*ListRubricsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListRubricsResponse) GetRubrics() []*Rubric {
+func (x *ListSubmissionsResponse) GetSubmissions() []*Submission {
 	if x != nil {
-		return x.Rubrics
+		return x.Submissions
 	}
 	return nil
 }
 
-type DeleteRubricResponse struct {
+type SubmissionFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileData []byte `protobuf:"bytes,1,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *SubmissionFileResponse) Reset() {
+	*x = SubmissionFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionFileResponse) ProtoMessage() {}
+
+func (x *SubmissionFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionFileResponse.ProtoReflect.Descriptor instead.
+func (*SubmissionFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SubmissionFileResponse) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+func (x *SubmissionFileResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type DeleteSubmissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2441,23 +2736,23 @@ type DeleteRubricResponse struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *DeleteRubricResponse) Reset() {
-	*x = DeleteRubricResponse{}
+func (x *DeleteSubmissionResponse) Reset() {
+	*x = DeleteSubmissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[39]
+		mi := &file_proto_talytics_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRubricResponse) String() string {
+func (x *DeleteSubmissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRubricResponse) ProtoMessage() {}
+func (*DeleteSubmissionResponse) ProtoMessage() {}
 
-func (x *DeleteRubricResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[39]
+func (x *DeleteSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,54 +2763,58 @@ func (x *DeleteRubricResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRubricResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRubricResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use DeleteSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeleteRubricResponse) GetMessage() string {
+func (x *DeleteSubmissionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// Messages for Grade service
-type Grade struct {
+// Messages for Rubric service
+type Rubric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AssignmentId int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	RubricId     int64                `protobuf:"varint,3,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
-	StudentId    string               `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	GraderId     int64                `protobuf:"varint,5,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
-	GraderName   string               `protobuf:"bytes,6,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
-	QuestionId   string               `protobuf:"bytes,7,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Score        float64              `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
-	MaxScore     float64              `protobuf:"fixed64,9,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	Feedback     string               `protobuf:"bytes,10,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	GradedAt     *timestamp.Timestamp `protobuf:"bytes,11,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId    int64                `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Criteria    []string             `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Weights     []float64            `protobuf:"fixed64,5,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	CreatedBy   int64                `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatorName string               `protobuf:"bytes,7,opt,name=creator_name,json=creatorName,proto3" json:"creator_name,omitempty"`
+	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The version criteria and weights belong to; grades scored now pin it
+	Version   int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	VersionId int64 `protobuf:"varint,11,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// The rubric's full structure. criteria and weights list its scored leaf
+	// criteria in order, which is what rubric_scores keys index.
+	CriterionTree []*RubricCriterion `protobuf:"bytes,12,rep,name=criterion_tree,json=criterionTree,proto3" json:"criterion_tree,omitempty"`
 }
 
-func (x *Grade) Reset() {
-	*x = Grade{}
+func (x *Rubric) Reset() {
+	*x = Rubric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[40]
+		mi := &file_proto_talytics_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Grade) String() string {
+func (x *Rubric) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Grade) ProtoMessage() {}
+func (*Rubric) ProtoMessage() {}
 
-func (x *Grade) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[40]
+func (x *Rubric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2526,117 +2825,130 @@ func (x *Grade) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
-func (*Grade) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Rubric.ProtoReflect.Descriptor instead.
+func (*Rubric) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Grade) GetId() int64 {
+func (x *Rubric) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Grade) GetAssignmentId() int64 {
+func (x *Rubric) GetName() string {
 	if x != nil {
-		return x.AssignmentId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Grade) GetRubricId() int64 {
+func (x *Rubric) GetCourseId() int64 {
 	if x != nil {
-		return x.RubricId
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Grade) GetStudentId() string {
+func (x *Rubric) GetCriteria() []string {
 	if x != nil {
-		return x.StudentId
+		return x.Criteria
 	}
-	return ""
+	return nil
 }
 
-func (x *Grade) GetGraderId() int64 {
+func (x *Rubric) GetWeights() []float64 {
 	if x != nil {
-		return x.GraderId
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Rubric) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return 0
 }
 
-func (x *Grade) GetGraderName() string {
+func (x *Rubric) GetCreatorName() string {
 	if x != nil {
-		return x.GraderName
+		return x.CreatorName
 	}
 	return ""
 }
 
-func (x *Grade) GetQuestionId() string {
+func (x *Rubric) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.QuestionId
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Grade) GetScore() float64 {
+func (x *Rubric) GetUpdatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Score
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Grade) GetMaxScore() float64 {
+func (x *Rubric) GetVersion() int32 {
 	if x != nil {
-		return x.MaxScore
+		return x.Version
 	}
 	return 0
 }
 
-func (x *Grade) GetFeedback() string {
+func (x *Rubric) GetVersionId() int64 {
 	if x != nil {
-		return x.Feedback
+		return x.VersionId
 	}
-	return ""
+	return 0
 }
 
-func (x *Grade) GetGradedAt() *timestamp.Timestamp {
+func (x *Rubric) GetCriterionTree() []*RubricCriterion {
 	if x != nil {
-		return x.GradedAt
+		return x.CriterionTree
 	}
 	return nil
 }
 
-type GradeData struct {
+// A rubric criterion. Criteria with sub_criteria group them and are worth
+// the sum of their points; the others are scored, and their key is the
+// rubric_scores key they are graded under.
+type RubricCriterion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StudentId  string  `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	QuestionId string  `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Score      float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
-	MaxScore   float64 `protobuf:"fixed64,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	Feedback   string  `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Id          int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key         string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Title       string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Points      float64 `protobuf:"fixed64,5,opt,name=points,proto3" json:"points,omitempty"`
+	// Best first. When set, a score must match one of the levels.
+	Levels      []*RubricLevel     `protobuf:"bytes,6,rep,name=levels,proto3" json:"levels,omitempty"`
+	SubCriteria []*RubricCriterion `protobuf:"bytes,7,rep,name=sub_criteria,json=subCriteria,proto3" json:"sub_criteria,omitempty"`
 }
 
-func (x *GradeData) Reset() {
-	*x = GradeData{}
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[41]
+		mi := &file_proto_talytics_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradeData) String() string {
+func (x *RubricCriterion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradeData) ProtoMessage() {}
+func (*RubricCriterion) ProtoMessage() {}
 
-func (x *GradeData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[41]
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2647,73 +2959,93 @@ func (x *GradeData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradeData.ProtoReflect.Descriptor instead.
-func (*GradeData) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GradeData) GetStudentId() string {
+func (x *RubricCriterion) GetId() int64 {
 	if x != nil {
-		return x.StudentId
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricCriterion) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GradeData) GetQuestionId() string {
+func (x *RubricCriterion) GetTitle() string {
 	if x != nil {
-		return x.QuestionId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GradeData) GetScore() float64 {
+func (x *RubricCriterion) GetDescription() string {
 	if x != nil {
-		return x.Score
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *GradeData) GetMaxScore() float64 {
+func (x *RubricCriterion) GetPoints() float64 {
 	if x != nil {
-		return x.MaxScore
+		return x.Points
 	}
 	return 0
 }
 
-func (x *GradeData) GetFeedback() string {
+func (x *RubricCriterion) GetLevels() []*RubricLevel {
 	if x != nil {
-		return x.Feedback
+		return x.Levels
 	}
-	return ""
+	return nil
 }
 
-type UploadGradesRequest struct {
+func (x *RubricCriterion) GetSubCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.SubCriteria
+	}
+	return nil
+}
+
+// A performance level of a scored criterion, e.g. Excellent, Good or Poor
+type RubricLevel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssignmentId int64        `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	RubricId     int64        `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
-	Grades       []*GradeData `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// What work at this level looks like
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Points      float64 `protobuf:"fixed64,4,opt,name=points,proto3" json:"points,omitempty"`
+	// A ranged level accepts any score from min_points to points
+	PointRange bool    `protobuf:"varint,5,opt,name=point_range,json=pointRange,proto3" json:"point_range,omitempty"`
+	MinPoints  float64 `protobuf:"fixed64,6,opt,name=min_points,json=minPoints,proto3" json:"min_points,omitempty"`
 }
 
-func (x *UploadGradesRequest) Reset() {
-	*x = UploadGradesRequest{}
+func (x *RubricLevel) Reset() {
+	*x = RubricLevel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[42]
+		mi := &file_proto_talytics_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadGradesRequest) String() string {
+func (x *RubricLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGradesRequest) ProtoMessage() {}
+func (*RubricLevel) ProtoMessage() {}
 
-func (x *UploadGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[42]
+func (x *RubricLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2724,59 +3056,83 @@ func (x *UploadGradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGradesRequest.ProtoReflect.Descriptor instead.
-func (*UploadGradesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use RubricLevel.ProtoReflect.Descriptor instead.
+func (*RubricLevel) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UploadGradesRequest) GetAssignmentId() int64 {
+func (x *RubricLevel) GetId() int64 {
 	if x != nil {
-		return x.AssignmentId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UploadGradesRequest) GetRubricId() int64 {
+func (x *RubricLevel) GetLabel() string {
 	if x != nil {
-		return x.RubricId
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *UploadGradesRequest) GetGrades() []*GradeData {
+func (x *RubricLevel) GetDescription() string {
 	if x != nil {
-		return x.Grades
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-type UploadGradesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Message       string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	TotalUploaded int32    `protobuf:"varint,2,opt,name=total_uploaded,json=totalUploaded,proto3" json:"total_uploaded,omitempty"`
-	Errors        []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+func (x *RubricLevel) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
 }
 
-func (x *UploadGradesResponse) Reset() {
-	*x = UploadGradesResponse{}
+func (x *RubricLevel) GetPointRange() bool {
+	if x != nil {
+		return x.PointRange
+	}
+	return false
+}
+
+func (x *RubricLevel) GetMinPoints() float64 {
+	if x != nil {
+		return x.MinPoints
+	}
+	return 0
+}
+
+type CreateRubricRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId int64     `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Criteria []string  `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Weights  []float64 `protobuf:"fixed64,4,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	// Replaces criteria and weights, which are derived from its leaves
+	CriterionTree []*RubricCriterion `protobuf:"bytes,5,rep,name=criterion_tree,json=criterionTree,proto3" json:"criterion_tree,omitempty"`
+}
+
+func (x *CreateRubricRequest) Reset() {
+	*x = CreateRubricRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[43]
+		mi := &file_proto_talytics_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadGradesResponse) String() string {
+func (x *CreateRubricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadGradesResponse) ProtoMessage() {}
+func (*CreateRubricRequest) ProtoMessage() {}
 
-func (x *UploadGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[43]
+func (x *CreateRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2787,57 +3143,71 @@ func (x *UploadGradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadGradesResponse.ProtoReflect.Descriptor instead.
-func (*UploadGradesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CreateRubricRequest.ProtoReflect.Descriptor instead.
+func (*CreateRubricRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UploadGradesResponse) GetMessage() string {
+func (x *CreateRubricRequest) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UploadGradesResponse) GetTotalUploaded() int32 {
+func (x *CreateRubricRequest) GetCourseId() int64 {
 	if x != nil {
-		return x.TotalUploaded
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *UploadGradesResponse) GetErrors() []string {
+func (x *CreateRubricRequest) GetCriteria() []string {
 	if x != nil {
-		return x.Errors
+		return x.Criteria
 	}
 	return nil
 }
 
-type GetGradeStatsRequest struct {
+func (x *CreateRubricRequest) GetWeights() []float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *CreateRubricRequest) GetCriterionTree() []*RubricCriterion {
+	if x != nil {
+		return x.CriterionTree
+	}
+	return nil
+}
+
+type GetRubricRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RubricId int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetGradeStatsRequest) Reset() {
-	*x = GetGradeStatsRequest{}
+func (x *GetRubricRequest) Reset() {
+	*x = GetRubricRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[44]
+		mi := &file_proto_talytics_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGradeStatsRequest) String() string {
+func (x *GetRubricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeStatsRequest) ProtoMessage() {}
+func (*GetRubricRequest) ProtoMessage() {}
 
-func (x *GetGradeStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[44]
+func (x *GetRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2848,49 +3218,43 @@ func (x *GetGradeStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetGradeStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetRubricRequest.ProtoReflect.Descriptor instead.
+func (*GetRubricRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetGradeStatsRequest) GetRubricId() int64 {
+func (x *GetRubricRequest) GetId() int64 {
 	if x != nil {
-		return x.RubricId
+		return x.Id
 	}
 	return 0
 }
 
-type GradeStat struct {
+type ListRubricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaId          string  `protobuf:"bytes,1,opt,name=ta_id,json=taId,proto3" json:"ta_id,omitempty"`
-	QuestionId    string  `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Count         int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	AvgScore      float64 `protobuf:"fixed64,4,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
-	MinScore      float64 `protobuf:"fixed64,5,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
-	MaxScore      float64 `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	AvgPercentage float64 `protobuf:"fixed64,7,opt,name=avg_percentage,json=avgPercentage,proto3" json:"avg_percentage,omitempty"`
+	CourseId int64 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 }
 
-func (x *GradeStat) Reset() {
-	*x = GradeStat{}
+func (x *ListRubricsRequest) Reset() {
+	*x = ListRubricsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[45]
+		mi := &file_proto_talytics_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradeStat) String() string {
+func (x *ListRubricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradeStat) ProtoMessage() {}
+func (*ListRubricsRequest) ProtoMessage() {}
 
-func (x *GradeStat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[45]
+func (x *ListRubricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2901,85 +3265,126 @@ func (x *GradeStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradeStat.ProtoReflect.Descriptor instead.
-func (*GradeStat) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ListRubricsRequest.ProtoReflect.Descriptor instead.
+func (*ListRubricsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GradeStat) GetTaId() string {
+func (x *ListRubricsRequest) GetCourseId() int64 {
 	if x != nil {
-		return x.TaId
+		return x.CourseId
 	}
-	return ""
+	return 0
 }
 
-func (x *GradeStat) GetQuestionId() string {
-	if x != nil {
-		return x.QuestionId
+type UpdateRubricRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Without criterion_tree, a rubric with sub-criteria, performance levels
+	// or descriptions keeps its structure only if these match its current
+	// scored criteria, and otherwise cannot be changed through them
+	Criteria []string  `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Weights  []float64 `protobuf:"fixed64,4,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	// Replaces criteria and weights, which are derived from its leaves
+	CriterionTree []*RubricCriterion `protobuf:"bytes,5,rep,name=criterion_tree,json=criterionTree,proto3" json:"criterion_tree,omitempty"`
+}
+
+func (x *UpdateRubricRequest) Reset() {
+	*x = UpdateRubricRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GradeStat) GetCount() int32 {
+func (x *UpdateRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRubricRequest) ProtoMessage() {}
+
+func (x *UpdateRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRubricRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRubricRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateRubricRequest) GetId() int64 {
 	if x != nil {
-		return x.Count
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GradeStat) GetAvgScore() float64 {
+func (x *UpdateRubricRequest) GetName() string {
 	if x != nil {
-		return x.AvgScore
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GradeStat) GetMinScore() float64 {
+func (x *UpdateRubricRequest) GetCriteria() []string {
 	if x != nil {
-		return x.MinScore
+		return x.Criteria
 	}
-	return 0
+	return nil
 }
 
-func (x *GradeStat) GetMaxScore() float64 {
+func (x *UpdateRubricRequest) GetWeights() []float64 {
 	if x != nil {
-		return x.MaxScore
+		return x.Weights
 	}
-	return 0
+	return nil
 }
 
-func (x *GradeStat) GetAvgPercentage() float64 {
+func (x *UpdateRubricRequest) GetCriterionTree() []*RubricCriterion {
 	if x != nil {
-		return x.AvgPercentage
+		return x.CriterionTree
 	}
-	return 0
+	return nil
 }
 
-type GetGradeStatsResponse struct {
+type DeleteRubricRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stats []*GradeStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetGradeStatsResponse) Reset() {
-	*x = GetGradeStatsResponse{}
+func (x *DeleteRubricRequest) Reset() {
+	*x = DeleteRubricRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[46]
+		mi := &file_proto_talytics_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGradeStatsResponse) String() string {
+func (x *DeleteRubricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeStatsResponse) ProtoMessage() {}
+func (*DeleteRubricRequest) ProtoMessage() {}
 
-func (x *GetGradeStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[46]
+func (x *DeleteRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2990,43 +3395,44 @@ func (x *GetGradeStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetGradeStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use DeleteRubricRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRubricRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetGradeStatsResponse) GetStats() []*GradeStat {
+func (x *DeleteRubricRequest) GetId() int64 {
 	if x != nil {
-		return x.Stats
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetGradeDistributionRequest struct {
+type RubricResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RubricId int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Rubric  *Rubric `protobuf:"bytes,1,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetGradeDistributionRequest) Reset() {
-	*x = GetGradeDistributionRequest{}
+func (x *RubricResponse) Reset() {
+	*x = RubricResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[47]
+		mi := &file_proto_talytics_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGradeDistributionRequest) String() string {
+func (x *RubricResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeDistributionRequest) ProtoMessage() {}
+func (*RubricResponse) ProtoMessage() {}
 
-func (x *GetGradeDistributionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[47]
+func (x *RubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3037,44 +3443,50 @@ func (x *GetGradeDistributionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeDistributionRequest.ProtoReflect.Descriptor instead.
-func (*GetGradeDistributionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use RubricResponse.ProtoReflect.Descriptor instead.
+func (*RubricResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetGradeDistributionRequest) GetRubricId() int64 {
+func (x *RubricResponse) GetRubric() *Rubric {
 	if x != nil {
-		return x.RubricId
+		return x.Rubric
 	}
-	return 0
+	return nil
 }
 
-type QuestionDistribution struct {
+func (x *RubricResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListRubricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId      string                     `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	TaDistributions map[string]*TADistribution `protobuf:"bytes,2,rep,name=ta_distributions,json=taDistributions,proto3" json:"ta_distributions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Rubrics []*Rubric `protobuf:"bytes,1,rep,name=rubrics,proto3" json:"rubrics,omitempty"`
 }
 
-func (x *QuestionDistribution) Reset() {
-	*x = QuestionDistribution{}
+func (x *ListRubricsResponse) Reset() {
+	*x = ListRubricsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[48]
+		mi := &file_proto_talytics_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuestionDistribution) String() string {
+func (x *ListRubricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionDistribution) ProtoMessage() {}
+func (*ListRubricsResponse) ProtoMessage() {}
 
-func (x *QuestionDistribution) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[48]
+func (x *ListRubricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3085,50 +3497,43 @@ func (x *QuestionDistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionDistribution.ProtoReflect.Descriptor instead.
-func (*QuestionDistribution) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *QuestionDistribution) GetQuestionId() string {
-	if x != nil {
-		return x.QuestionId
-	}
-	return ""
+// Deprecated: Use ListRubricsResponse.ProtoReflect.Descriptor instead.
+func (*ListRubricsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *QuestionDistribution) GetTaDistributions() map[string]*TADistribution {
+func (x *ListRubricsResponse) GetRubrics() []*Rubric {
 	if x != nil {
-		return x.TaDistributions
+		return x.Rubrics
 	}
 	return nil
 }
 
-type TADistribution struct {
+type DeleteRubricResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scores []float64 `protobuf:"fixed64,1,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *TADistribution) Reset() {
-	*x = TADistribution{}
+func (x *DeleteRubricResponse) Reset() {
+	*x = DeleteRubricResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[49]
+		mi := &file_proto_talytics_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TADistribution) String() string {
+func (x *DeleteRubricResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TADistribution) ProtoMessage() {}
+func (*DeleteRubricResponse) ProtoMessage() {}
 
-func (x *TADistribution) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[49]
+func (x *DeleteRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3139,43 +3544,56 @@ func (x *TADistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TADistribution.ProtoReflect.Descriptor instead.
-func (*TADistribution) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DeleteRubricResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRubricResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *TADistribution) GetScores() []float64 {
+func (x *DeleteRubricResponse) GetMessage() string {
 	if x != nil {
-		return x.Scores
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type GetGradeDistributionResponse struct {
+// An immutable snapshot of a rubric's criteria and weights. Changing either
+// creates a new version; grades keep the version they were scored against.
+type RubricVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Distributions []*QuestionDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RubricId    int64                `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Version     int32                `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Criteria    []string             `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Weights     []float64            `protobuf:"fixed64,5,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	CreatedBy   int64                `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatorName string               `protobuf:"bytes,7,opt,name=creator_name,json=creatorName,proto3" json:"creator_name,omitempty"`
+	CreatedAt   *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Current     bool                 `protobuf:"varint,9,opt,name=current,proto3" json:"current,omitempty"`
+	// Grades scored against this version
+	GradeCount    int32              `protobuf:"varint,10,opt,name=grade_count,json=gradeCount,proto3" json:"grade_count,omitempty"`
+	CriterionTree []*RubricCriterion `protobuf:"bytes,11,rep,name=criterion_tree,json=criterionTree,proto3" json:"criterion_tree,omitempty"`
 }
 
-func (x *GetGradeDistributionResponse) Reset() {
-	*x = GetGradeDistributionResponse{}
+func (x *RubricVersion) Reset() {
+	*x = RubricVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[50]
+		mi := &file_proto_talytics_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGradeDistributionResponse) String() string {
+func (x *RubricVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGradeDistributionResponse) ProtoMessage() {}
+func (*RubricVersion) ProtoMessage() {}
 
-func (x *GetGradeDistributionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[50]
+func (x *RubricVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,20 +3604,89 @@ func (x *GetGradeDistributionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGradeDistributionResponse.ProtoReflect.Descriptor instead.
-func (*GetGradeDistributionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use RubricVersion.ProtoReflect.Descriptor instead.
+func (*RubricVersion) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetGradeDistributionResponse) GetDistributions() []*QuestionDistribution {
+func (x *RubricVersion) GetId() int64 {
 	if x != nil {
-		return x.Distributions
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricVersion) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *RubricVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RubricVersion) GetCriteria() []string {
+	if x != nil {
+		return x.Criteria
 	}
 	return nil
 }
 
-// Messages for Analysis service
-type RunAnomalyAnalysisRequest struct {
+func (x *RubricVersion) GetWeights() []float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *RubricVersion) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *RubricVersion) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *RubricVersion) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RubricVersion) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *RubricVersion) GetGradeCount() int32 {
+	if x != nil {
+		return x.GradeCount
+	}
+	return 0
+}
+
+func (x *RubricVersion) GetCriterionTree() []*RubricCriterion {
+	if x != nil {
+		return x.CriterionTree
+	}
+	return nil
+}
+
+type ListRubricVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3207,23 +3694,23 @@ type RunAnomalyAnalysisRequest struct {
 	RubricId int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
 }
 
-func (x *RunAnomalyAnalysisRequest) Reset() {
-	*x = RunAnomalyAnalysisRequest{}
+func (x *ListRubricVersionsRequest) Reset() {
+	*x = ListRubricVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[51]
+		mi := &file_proto_talytics_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RunAnomalyAnalysisRequest) String() string {
+func (x *ListRubricVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunAnomalyAnalysisRequest) ProtoMessage() {}
+func (*ListRubricVersionsRequest) ProtoMessage() {}
 
-func (x *RunAnomalyAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[51]
+func (x *ListRubricVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3234,46 +3721,46 @@ func (x *RunAnomalyAnalysisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunAnomalyAnalysisRequest.ProtoReflect.Descriptor instead.
-func (*RunAnomalyAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ListRubricVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRubricVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RunAnomalyAnalysisRequest) GetRubricId() int64 {
+func (x *ListRubricVersionsRequest) GetRubricId() int64 {
 	if x != nil {
 		return x.RubricId
 	}
 	return 0
 }
 
-type Anomaly struct {
+type ListRubricVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type       string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	QuestionId string            `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Severity   string            `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
-	Details    map[string]string `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RubricId       int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	CurrentVersion int32 `protobuf:"varint,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Newest first
+	Versions []*RubricVersion `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *Anomaly) Reset() {
-	*x = Anomaly{}
+func (x *ListRubricVersionsResponse) Reset() {
+	*x = ListRubricVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[52]
+		mi := &file_proto_talytics_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Anomaly) String() string {
+func (x *ListRubricVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Anomaly) ProtoMessage() {}
+func (*ListRubricVersionsResponse) ProtoMessage() {}
 
-func (x *Anomaly) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[52]
+func (x *ListRubricVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3284,68 +3771,60 @@ func (x *Anomaly) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
-func (*Anomaly) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *Anomaly) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use ListRubricVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRubricVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Anomaly) GetQuestionId() string {
+func (x *ListRubricVersionsResponse) GetRubricId() int64 {
 	if x != nil {
-		return x.QuestionId
+		return x.RubricId
 	}
-	return ""
+	return 0
 }
 
-func (x *Anomaly) GetSeverity() string {
+func (x *ListRubricVersionsResponse) GetCurrentVersion() int32 {
 	if x != nil {
-		return x.Severity
+		return x.CurrentVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *Anomaly) GetDetails() map[string]string {
+func (x *ListRubricVersionsResponse) GetVersions() []*RubricVersion {
 	if x != nil {
-		return x.Details
+		return x.Versions
 	}
 	return nil
 }
 
-type Statistics struct {
+type CompareRubricVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mean        float64 `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
-	Variance    float64 `protobuf:"fixed64,2,opt,name=variance,proto3" json:"variance,omitempty"`
-	StdDev      float64 `protobuf:"fixed64,3,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
-	Count       int32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Reliability float64 `protobuf:"fixed64,5,opt,name=reliability,proto3" json:"reliability,omitempty"`
+	RubricId    int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	FromVersion int32 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// Defaults to the current version
+	ToVersion int32 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
 }
 
-func (x *Statistics) Reset() {
-	*x = Statistics{}
+func (x *CompareRubricVersionsRequest) Reset() {
+	*x = CompareRubricVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[53]
+		mi := &file_proto_talytics_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Statistics) String() string {
+func (x *CompareRubricVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Statistics) ProtoMessage() {}
+func (*CompareRubricVersionsRequest) ProtoMessage() {}
 
-func (x *Statistics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[53]
+func (x *CompareRubricVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3356,74 +3835,70 @@ func (x *Statistics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Statistics.ProtoReflect.Descriptor instead.
-func (*Statistics) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *Statistics) GetMean() float64 {
-	if x != nil {
-		return x.Mean
-	}
-	return 0
-}
-
-func (x *Statistics) GetVariance() float64 {
-	if x != nil {
-		return x.Variance
-	}
-	return 0
+// Deprecated: Use CompareRubricVersionsRequest.ProtoReflect.Descriptor instead.
+func (*CompareRubricVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Statistics) GetStdDev() float64 {
+func (x *CompareRubricVersionsRequest) GetRubricId() int64 {
 	if x != nil {
-		return x.StdDev
+		return x.RubricId
 	}
 	return 0
 }
 
-func (x *Statistics) GetCount() int32 {
+func (x *CompareRubricVersionsRequest) GetFromVersion() int32 {
 	if x != nil {
-		return x.Count
+		return x.FromVersion
 	}
 	return 0
 }
 
-func (x *Statistics) GetReliability() float64 {
+func (x *CompareRubricVersionsRequest) GetToVersion() int32 {
 	if x != nil {
-		return x.Reliability
+		return x.ToVersion
 	}
 	return 0
 }
 
-type AnomalyAnalysisResponse struct {
+// How one criterion key reads under two rubric versions. Grades key scores by
+// criterion index, so criteria are lined up by key.
+type RubricCriterionChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Anomalies         []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
-	Statistics        map[string]*Statistics `protobuf:"bytes,2,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TotalGrades       int32                  `protobuf:"varint,3,opt,name=total_grades,json=totalGrades,proto3" json:"total_grades,omitempty"`
-	AnalysisTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=analysis_timestamp,json=analysisTimestamp,proto3" json:"analysis_timestamp,omitempty"`
-}
-
-func (x *AnomalyAnalysisResponse) Reset() {
-	*x = AnomalyAnalysisResponse{}
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// unchanged, renamed, reweighted, renamed_reweighted, added or removed
+	Change     string  `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	FromLabel  string  `protobuf:"bytes,3,opt,name=from_label,json=fromLabel,proto3" json:"from_label,omitempty"`
+	ToLabel    string  `protobuf:"bytes,4,opt,name=to_label,json=toLabel,proto3" json:"to_label,omitempty"`
+	FromWeight float64 `protobuf:"fixed64,5,opt,name=from_weight,json=fromWeight,proto3" json:"from_weight,omitempty"`
+	ToWeight   float64 `protobuf:"fixed64,6,opt,name=to_weight,json=toWeight,proto3" json:"to_weight,omitempty"`
+	// Mean score and number of grades scoring the criterion under each version
+	FromMean   float64 `protobuf:"fixed64,7,opt,name=from_mean,json=fromMean,proto3" json:"from_mean,omitempty"`
+	ToMean     float64 `protobuf:"fixed64,8,opt,name=to_mean,json=toMean,proto3" json:"to_mean,omitempty"`
+	FromGraded int32   `protobuf:"varint,9,opt,name=from_graded,json=fromGraded,proto3" json:"from_graded,omitempty"`
+	ToGraded   int32   `protobuf:"varint,10,opt,name=to_graded,json=toGraded,proto3" json:"to_graded,omitempty"`
+}
+
+func (x *RubricCriterionChange) Reset() {
+	*x = RubricCriterionChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[54]
+		mi := &file_proto_talytics_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnomalyAnalysisResponse) String() string {
+func (x *RubricCriterionChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnomalyAnalysisResponse) ProtoMessage() {}
+func (*RubricCriterionChange) ProtoMessage() {}
 
-func (x *AnomalyAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[54]
+func (x *RubricCriterionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3434,64 +3909,111 @@ func (x *AnomalyAnalysisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnomalyAnalysisResponse.ProtoReflect.Descriptor instead.
-func (*AnomalyAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use RubricCriterionChange.ProtoReflect.Descriptor instead.
+func (*RubricCriterionChange) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AnomalyAnalysisResponse) GetAnomalies() []*Anomaly {
+func (x *RubricCriterionChange) GetKey() string {
 	if x != nil {
-		return x.Anomalies
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *AnomalyAnalysisResponse) GetStatistics() map[string]*Statistics {
+func (x *RubricCriterionChange) GetChange() string {
 	if x != nil {
-		return x.Statistics
+		return x.Change
 	}
-	return nil
+	return ""
 }
 
-func (x *AnomalyAnalysisResponse) GetTotalGrades() int32 {
+func (x *RubricCriterionChange) GetFromLabel() string {
 	if x != nil {
-		return x.TotalGrades
+		return x.FromLabel
+	}
+	return ""
+}
+
+func (x *RubricCriterionChange) GetToLabel() string {
+	if x != nil {
+		return x.ToLabel
+	}
+	return ""
+}
+
+func (x *RubricCriterionChange) GetFromWeight() float64 {
+	if x != nil {
+		return x.FromWeight
 	}
 	return 0
 }
 
-func (x *AnomalyAnalysisResponse) GetAnalysisTimestamp() *timestamp.Timestamp {
+func (x *RubricCriterionChange) GetToWeight() float64 {
 	if x != nil {
-		return x.AnalysisTimestamp
+		return x.ToWeight
 	}
-	return nil
+	return 0
 }
 
-type GetAnalysisHistoryRequest struct {
+func (x *RubricCriterionChange) GetFromMean() float64 {
+	if x != nil {
+		return x.FromMean
+	}
+	return 0
+}
+
+func (x *RubricCriterionChange) GetToMean() float64 {
+	if x != nil {
+		return x.ToMean
+	}
+	return 0
+}
+
+func (x *RubricCriterionChange) GetFromGraded() int32 {
+	if x != nil {
+		return x.FromGraded
+	}
+	return 0
+}
+
+func (x *RubricCriterionChange) GetToGraded() int32 {
+	if x != nil {
+		return x.ToGraded
+	}
+	return 0
+}
+
+// Scores of the grades pinned to one rubric version
+type RubricVersionScores struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RubricId int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	GradeCount int32   `protobuf:"varint,1,opt,name=grade_count,json=gradeCount,proto3" json:"grade_count,omitempty"`
+	MeanTotal  float64 `protobuf:"fixed64,2,opt,name=mean_total,json=meanTotal,proto3" json:"mean_total,omitempty"`
+	MaxTotal   float64 `protobuf:"fixed64,3,opt,name=max_total,json=maxTotal,proto3" json:"max_total,omitempty"`
+	// mean_total as a fraction of max_total
+	MeanFraction float64 `protobuf:"fixed64,4,opt,name=mean_fraction,json=meanFraction,proto3" json:"mean_fraction,omitempty"`
 }
 
-func (x *GetAnalysisHistoryRequest) Reset() {
-	*x = GetAnalysisHistoryRequest{}
+func (x *RubricVersionScores) Reset() {
+	*x = RubricVersionScores{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[55]
+		mi := &file_proto_talytics_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAnalysisHistoryRequest) String() string {
+func (x *RubricVersionScores) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnalysisHistoryRequest) ProtoMessage() {}
+func (*RubricVersionScores) ProtoMessage() {}
 
-func (x *GetAnalysisHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[55]
+func (x *RubricVersionScores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3502,47 +4024,69 @@ func (x *GetAnalysisHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnalysisHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetAnalysisHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RubricVersionScores.ProtoReflect.Descriptor instead.
+func (*RubricVersionScores) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetAnalysisHistoryRequest) GetRubricId() int64 {
+func (x *RubricVersionScores) GetGradeCount() int32 {
 	if x != nil {
-		return x.RubricId
+		return x.GradeCount
 	}
 	return 0
 }
 
-type AnalysisResult struct {
+func (x *RubricVersionScores) GetMeanTotal() float64 {
+	if x != nil {
+		return x.MeanTotal
+	}
+	return 0
+}
+
+func (x *RubricVersionScores) GetMaxTotal() float64 {
+	if x != nil {
+		return x.MaxTotal
+	}
+	return 0
+}
+
+func (x *RubricVersionScores) GetMeanFraction() float64 {
+	if x != nil {
+		return x.MeanFraction
+	}
+	return 0
+}
+
+type RubricVersionComparison struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	RubricId     int64                `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
-	AnalysisType string               `protobuf:"bytes,3,opt,name=analysis_type,json=analysisType,proto3" json:"analysis_type,omitempty"`
-	ResultsJson  string               `protobuf:"bytes,4,opt,name=results_json,json=resultsJson,proto3" json:"results_json,omitempty"`
-	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RubricId   int64                    `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	From       *RubricVersion           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         *RubricVersion           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Criteria   []*RubricCriterionChange `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	FromScores *RubricVersionScores     `protobuf:"bytes,5,opt,name=from_scores,json=fromScores,proto3" json:"from_scores,omitempty"`
+	ToScores   *RubricVersionScores     `protobuf:"bytes,6,opt,name=to_scores,json=toScores,proto3" json:"to_scores,omitempty"`
 }
 
-func (x *AnalysisResult) Reset() {
-	*x = AnalysisResult{}
+func (x *RubricVersionComparison) Reset() {
+	*x = RubricVersionComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[56]
+		mi := &file_proto_talytics_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnalysisResult) String() string {
+func (x *RubricVersionComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnalysisResult) ProtoMessage() {}
+func (*RubricVersionComparison) ProtoMessage() {}
 
-func (x *AnalysisResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[56]
+func (x *RubricVersionComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3553,71 +4097,177 @@ func (x *AnalysisResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnalysisResult.ProtoReflect.Descriptor instead.
-func (*AnalysisResult) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use RubricVersionComparison.ProtoReflect.Descriptor instead.
+func (*RubricVersionComparison) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AnalysisResult) GetId() int64 {
+func (x *RubricVersionComparison) GetRubricId() int64 {
 	if x != nil {
-		return x.Id
+		return x.RubricId
 	}
 	return 0
 }
 
-func (x *AnalysisResult) GetRubricId() int64 {
+func (x *RubricVersionComparison) GetFrom() *RubricVersion {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RubricVersionComparison) GetTo() *RubricVersion {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RubricVersionComparison) GetCriteria() []*RubricCriterionChange {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RubricVersionComparison) GetFromScores() *RubricVersionScores {
+	if x != nil {
+		return x.FromScores
+	}
+	return nil
+}
+
+func (x *RubricVersionComparison) GetToScores() *RubricVersionScores {
+	if x != nil {
+		return x.ToScores
+	}
+	return nil
+}
+
+// A proposed rubric replayed against the grades scored on the current version
+// before it is saved with UpdateRubric
+type SimulateRubricChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	// Limits the replay to one assignment using the rubric
+	AssignmentId  int64              `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Criteria      []string           `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Weights       []float64          `protobuf:"fixed64,4,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	CriterionTree []*RubricCriterion `protobuf:"bytes,5,rep,name=criterion_tree,json=criterionTree,proto3" json:"criterion_tree,omitempty"`
+	// Proposed criteria without a mapping carry over the current criterion at
+	// the same position
+	Mappings []*CriterionMapping `protobuf:"bytes,6,rep,name=mappings,proto3" json:"mappings,omitempty"`
+}
+
+func (x *SimulateRubricChangeRequest) Reset() {
+	*x = SimulateRubricChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateRubricChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRubricChangeRequest) ProtoMessage() {}
+
+func (x *SimulateRubricChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRubricChangeRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRubricChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SimulateRubricChangeRequest) GetRubricId() int64 {
 	if x != nil {
 		return x.RubricId
 	}
 	return 0
 }
 
-func (x *AnalysisResult) GetAnalysisType() string {
+func (x *SimulateRubricChangeRequest) GetAssignmentId() int64 {
 	if x != nil {
-		return x.AnalysisType
+		return x.AssignmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *AnalysisResult) GetResultsJson() string {
+func (x *SimulateRubricChangeRequest) GetCriteria() []string {
 	if x != nil {
-		return x.ResultsJson
+		return x.Criteria
 	}
-	return ""
+	return nil
 }
 
-func (x *AnalysisResult) GetCreatedAt() *timestamp.Timestamp {
+func (x *SimulateRubricChangeRequest) GetWeights() []float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Weights
 	}
 	return nil
 }
 
-type GetAnalysisHistoryResponse struct {
+func (x *SimulateRubricChangeRequest) GetCriterionTree() []*RubricCriterion {
+	if x != nil {
+		return x.CriterionTree
+	}
+	return nil
+}
+
+func (x *SimulateRubricChangeRequest) GetMappings() []*CriterionMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// Where a proposed criterion's score comes from. Several sources merge
+// current criteria; a source shared between proposed criteria splits it.
+type CriterionMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*AnalysisResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Position of the proposed scored criterion
+	Index   int32              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Sources []*CriterionSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	// Fraction of the criterion's points awarded to every grade when it has no
+	// sources, i.e. a new criterion
+	AssumedFraction float64 `protobuf:"fixed64,3,opt,name=assumed_fraction,json=assumedFraction,proto3" json:"assumed_fraction,omitempty"`
 }
 
-func (x *GetAnalysisHistoryResponse) Reset() {
-	*x = GetAnalysisHistoryResponse{}
+func (x *CriterionMapping) Reset() {
+	*x = CriterionMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[57]
+		mi := &file_proto_talytics_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAnalysisHistoryResponse) String() string {
+func (x *CriterionMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnalysisHistoryResponse) ProtoMessage() {}
+func (*CriterionMapping) ProtoMessage() {}
 
-func (x *GetAnalysisHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[57]
+func (x *CriterionMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3628,42 +4278,60 @@ func (x *GetAnalysisHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnalysisHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetAnalysisHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CriterionMapping.ProtoReflect.Descriptor instead.
+func (*CriterionMapping) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetAnalysisHistoryResponse) GetResults() []*AnalysisResult {
+func (x *CriterionMapping) GetIndex() int32 {
 	if x != nil {
-		return x.Results
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CriterionMapping) GetSources() []*CriterionSource {
+	if x != nil {
+		return x.Sources
 	}
 	return nil
 }
 
-// Health check messages
-type HealthCheckRequest struct {
+func (x *CriterionMapping) GetAssumedFraction() float64 {
+	if x != nil {
+		return x.AssumedFraction
+	}
+	return 0
+}
+
+type CriterionSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Key of the current criterion
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Share of the current criterion carried into the proposed one, 1 when unset
+	Share float64 `protobuf:"fixed64,2,opt,name=share,proto3" json:"share,omitempty"`
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
+func (x *CriterionSource) Reset() {
+	*x = CriterionSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[58]
+		mi := &file_proto_talytics_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *CriterionSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*CriterionSource) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[58]
+func (x *CriterionSource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3674,37 +4342,57 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CriterionSource.ProtoReflect.Descriptor instead.
+func (*CriterionSource) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{64}
 }
 
-type HealthCheckResponse struct {
+func (x *CriterionSource) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CriterionSource) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type ScoreDistribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status    string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Count        int32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MaxPoints    float64 `protobuf:"fixed64,2,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	Mean         float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdDev       float64 `protobuf:"fixed64,4,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Median       float64 `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	Min          float64 `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max          float64 `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	MeanFraction float64 `protobuf:"fixed64,8,opt,name=mean_fraction,json=meanFraction,proto3" json:"mean_fraction,omitempty"`
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+func (x *ScoreDistribution) Reset() {
+	*x = ScoreDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_talytics_proto_msgTypes[59]
+		mi := &file_proto_talytics_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *ScoreDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*ScoreDistribution) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_talytics_proto_msgTypes[59]
+func (x *ScoreDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,801 +4403,17640 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_talytics_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ScoreDistribution.ProtoReflect.Descriptor instead.
+func (*ScoreDistribution) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *HealthCheckResponse) GetStatus() string {
+func (x *ScoreDistribution) GetCount() int32 {
 	if x != nil {
-		return x.Status
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *HealthCheckResponse) GetTimestamp() *timestamp.Timestamp {
+func (x *ScoreDistribution) GetMaxPoints() float64 {
 	if x != nil {
-		return x.Timestamp
+		return x.MaxPoints
 	}
-	return nil
+	return 0
 }
 
-var File_proto_talytics_proto protoreflect.FileDescriptor
+func (x *ScoreDistribution) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
 
-var file_proto_talytics_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xca, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6b,
-	0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x62, 0x0a,
-	0x0c, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x25, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x2a, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x0c, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfc, 0x02, 0x0a, 0x06, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x79, 0x65, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
-	0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x22, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x30, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x12, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x13,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x13,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a,
-	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0xc2, 0x03, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
-	0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75,
-	0x62, 0x72, 0x69, 0x63, 0x52, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
-	0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
-	0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72,
-	0x69, 0x63, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62,
-	0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75,
-	0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x64, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
-	0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0xb7, 0x02, 0x0a, 0x06, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7c, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18,
-	0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52,
-	0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52,
-	0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x12,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22,
-	0x6f, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
-	0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x0e, 0x52, 0x75, 0x62, 0x72, 0x69,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x75, 0x62,
-	0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x06, 0x72, 0x75, 0x62,
-	0x72, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x07, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73,
-	0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x09, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x47, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0xd5,
-	0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x13, 0x0a, 0x05,
-	0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65,
-	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62,
-	0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75,
-	0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x5e, 0x0a, 0x10, 0x74, 0x61, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x44, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0f, 0x74, 0x61, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x1a, 0x5c, 0x0a, 0x14, 0x54, 0x61, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x41, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x28,
-	0x0a, 0x0e, 0x54, 0x41, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01,
-	0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x47,
-	0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x38,
-	0x0a, 0x19, 0x52, 0x75, 0x6e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c,
-	0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x07, 0x41, 0x6e, 0x6f,
-	0x6d, 0x61, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76,
-	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76,
-	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a,
-	0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x01, 0x0a, 0x0a,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
-	0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74,
-	0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64,
-	0x44, 0x65, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c,
-	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
-	0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xe0, 0x02, 0x0a, 0x17,
-	0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61,
-	0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x09, 0x61,
-	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41,
-	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x49,
-	0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x53, 0x0a, 0x0f, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x61,
-	0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x50, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x14, 0x0a,
-	0x12, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x32, 0xca, 0x02, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x08,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x17,
-	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
-	0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x90, 0x04, 0x0a, 0x0d, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a,
-	0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
-	0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1d, 0x2e,
-	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbf, 0x03, 0x0a,
-	0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
-	0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xff,
-	0x02, 0x0a, 0x0d, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x47, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
-	0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75,
-	0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69,
-	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
-	0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69,
-	0x63, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x96, 0x02, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd0, 0x01, 0x0a, 0x0f, 0x41, 0x6e,
-	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a,
-	0x12, 0x52, 0x75, 0x6e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x73, 0x69, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52,
-	0x75, 0x6e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x55, 0x0a, 0x0d,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a,
-	0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ScoreDistribution) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
 }
 
-var (
-	file_proto_talytics_proto_rawDescOnce sync.Once
-	file_proto_talytics_proto_rawDescData = file_proto_talytics_proto_rawDesc
-)
+func (x *ScoreDistribution) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
 
-func file_proto_talytics_proto_rawDescGZIP() []byte {
-	file_proto_talytics_proto_rawDescOnce.Do(func() {
-		file_proto_talytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_talytics_proto_rawDescData)
-	})
-	return file_proto_talytics_proto_rawDescData
+func (x *ScoreDistribution) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
 }
 
-var file_proto_talytics_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
-var file_proto_talytics_proto_goTypes = []interface{}{
-	(*User)(nil),                         // 0: talytics.User
-	(*RegisterRequest)(nil),              // 1: talytics.RegisterRequest
-	(*LoginRequest)(nil),                 // 2: talytics.LoginRequest
-	(*AuthResponse)(nil),                 // 3: talytics.AuthResponse
-	(*LogoutRequest)(nil),                // 4: talytics.LogoutRequest
-	(*LogoutResponse)(nil),               // 5: talytics.LogoutResponse
-	(*GetProfileRequest)(nil),            // 6: talytics.GetProfileRequest
-	(*VerifyTokenRequest)(nil),           // 7: talytics.VerifyTokenRequest
-	(*UserResponse)(nil),                 // 8: talytics.UserResponse
-	(*Course)(nil),                       // 9: talytics.Course
-	(*CourseMember)(nil),                 // 10: talytics.CourseMember
-	(*CreateCourseRequest)(nil),          // 11: talytics.CreateCourseRequest
-	(*GetCourseRequest)(nil),             // 12: talytics.GetCourseRequest
-	(*ListCoursesRequest)(nil),           // 13: talytics.ListCoursesRequest
-	(*UpdateCourseRequest)(nil),          // 14: talytics.UpdateCourseRequest
-	(*JoinCourseRequest)(nil),            // 15: talytics.JoinCourseRequest
-	(*LeaveCourseRequest)(nil),           // 16: talytics.LeaveCourseRequest
-	(*DeleteCourseRequest)(nil),          // 17: talytics.DeleteCourseRequest
-	(*CourseResponse)(nil),               // 18: talytics.CourseResponse
-	(*ListCoursesResponse)(nil),          // 19: talytics.ListCoursesResponse
-	(*LeaveCourseResponse)(nil),          // 20: talytics.LeaveCourseResponse
-	(*DeleteCourseResponse)(nil),         // 21: talytics.DeleteCourseResponse
-	(*Assignment)(nil),                   // 22: talytics.Assignment
-	(*CreateAssignmentRequest)(nil),      // 23: talytics.CreateAssignmentRequest
-	(*GetAssignmentRequest)(nil),         // 24: talytics.GetAssignmentRequest
-	(*ListAssignmentsRequest)(nil),       // 25: talytics.ListAssignmentsRequest
-	(*UpdateAssignmentRequest)(nil),      // 26: talytics.UpdateAssignmentRequest
-	(*DeleteAssignmentRequest)(nil),      // 27: talytics.DeleteAssignmentRequest
-	(*AssignmentResponse)(nil),           // 28: talytics.AssignmentResponse
-	(*ListAssignmentsResponse)(nil),      // 29: talytics.ListAssignmentsResponse
-	(*DeleteAssignmentResponse)(nil),     // 30: talytics.DeleteAssignmentResponse
-	(*Rubric)(nil),                       // 31: talytics.Rubric
-	(*CreateRubricRequest)(nil),          // 32: talytics.CreateRubricRequest
-	(*GetRubricRequest)(nil),             // 33: talytics.GetRubricRequest
-	(*ListRubricsRequest)(nil),           // 34: talytics.ListRubricsRequest
-	(*UpdateRubricRequest)(nil),          // 35: talytics.UpdateRubricRequest
-	(*DeleteRubricRequest)(nil),          // 36: talytics.DeleteRubricRequest
-	(*RubricResponse)(nil),               // 37: talytics.RubricResponse
-	(*ListRubricsResponse)(nil),          // 38: talytics.ListRubricsResponse
-	(*DeleteRubricResponse)(nil),         // 39: talytics.DeleteRubricResponse
-	(*Grade)(nil),                        // 40: talytics.Grade
-	(*GradeData)(nil),                    // 41: talytics.GradeData
-	(*UploadGradesRequest)(nil),          // 42: talytics.UploadGradesRequest
-	(*UploadGradesResponse)(nil),         // 43: talytics.UploadGradesResponse
-	(*GetGradeStatsRequest)(nil),         // 44: talytics.GetGradeStatsRequest
-	(*GradeStat)(nil),                    // 45: talytics.GradeStat
-	(*GetGradeStatsResponse)(nil),        // 46: talytics.GetGradeStatsResponse
-	(*GetGradeDistributionRequest)(nil),  // 47: talytics.GetGradeDistributionRequest
-	(*QuestionDistribution)(nil),         // 48: talytics.QuestionDistribution
-	(*TADistribution)(nil),               // 49: talytics.TADistribution
-	(*GetGradeDistributionResponse)(nil), // 50: talytics.GetGradeDistributionResponse
-	(*RunAnomalyAnalysisRequest)(nil),    // 51: talytics.RunAnomalyAnalysisRequest
-	(*Anomaly)(nil),                      // 52: talytics.Anomaly
-	(*Statistics)(nil),                   // 53: talytics.Statistics
-	(*AnomalyAnalysisResponse)(nil),      // 54: talytics.AnomalyAnalysisResponse
-	(*GetAnalysisHistoryRequest)(nil),    // 55: talytics.GetAnalysisHistoryRequest
-	(*AnalysisResult)(nil),               // 56: talytics.AnalysisResult
-	(*GetAnalysisHistoryResponse)(nil),   // 57: talytics.GetAnalysisHistoryResponse
-	(*HealthCheckRequest)(nil),           // 58: talytics.HealthCheckRequest
-	(*HealthCheckResponse)(nil),          // 59: talytics.HealthCheckResponse
-	nil,                                  // 60: talytics.QuestionDistribution.TaDistributionsEntry
-	nil,                                  // 61: talytics.Anomaly.DetailsEntry
-	nil,                                  // 62: talytics.AnomalyAnalysisResponse.StatisticsEntry
-	(*timestamp.Timestamp)(nil),          // 63: google.protobuf.Timestamp
+func (x *ScoreDistribution) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
 }
-var file_proto_talytics_proto_depIdxs = []int32{
-	63, // 0: talytics.User.created_at:type_name -> google.protobuf.Timestamp
-	63, // 1: talytics.User.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: talytics.AuthResponse.user:type_name -> talytics.User
-	0,  // 3: talytics.UserResponse.user:type_name -> talytics.User
-	10, // 4: talytics.Course.members:type_name -> talytics.CourseMember
-	63, // 5: talytics.Course.created_at:type_name -> google.protobuf.Timestamp
-	63, // 6: talytics.Course.updated_at:type_name -> google.protobuf.Timestamp
-	63, // 7: talytics.CourseMember.joined_at:type_name -> google.protobuf.Timestamp
-	9,  // 8: talytics.CourseResponse.course:type_name -> talytics.Course
-	9,  // 9: talytics.ListCoursesResponse.courses:type_name -> talytics.Course
-	63, // 10: talytics.Assignment.due_date:type_name -> google.protobuf.Timestamp
-	31, // 11: talytics.Assignment.rubric:type_name -> talytics.Rubric
-	63, // 12: talytics.Assignment.created_at:type_name -> google.protobuf.Timestamp
-	63, // 13: talytics.Assignment.updated_at:type_name -> google.protobuf.Timestamp
-	63, // 14: talytics.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
-	63, // 15: talytics.UpdateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
-	22, // 16: talytics.AssignmentResponse.assignment:type_name -> talytics.Assignment
-	22, // 17: talytics.ListAssignmentsResponse.assignments:type_name -> talytics.Assignment
-	63, // 18: talytics.Rubric.created_at:type_name -> google.protobuf.Timestamp
-	63, // 19: talytics.Rubric.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 20: talytics.RubricResponse.rubric:type_name -> talytics.Rubric
-	31, // 21: talytics.ListRubricsResponse.rubrics:type_name -> talytics.Rubric
-	63, // 22: talytics.Grade.graded_at:type_name -> google.protobuf.Timestamp
-	41, // 23: talytics.UploadGradesRequest.grades:type_name -> talytics.GradeData
-	45, // 24: talytics.GetGradeStatsResponse.stats:type_name -> talytics.GradeStat
-	60, // 25: talytics.QuestionDistribution.ta_distributions:type_name -> talytics.QuestionDistribution.TaDistributionsEntry
-	48, // 26: talytics.GetGradeDistributionResponse.distributions:type_name -> talytics.QuestionDistribution
-	61, // 27: talytics.Anomaly.details:type_name -> talytics.Anomaly.DetailsEntry
-	52, // 28: talytics.AnomalyAnalysisResponse.anomalies:type_name -> talytics.Anomaly
-	62, // 29: talytics.AnomalyAnalysisResponse.statistics:type_name -> talytics.AnomalyAnalysisResponse.StatisticsEntry
-	63, // 30: talytics.AnomalyAnalysisResponse.analysis_timestamp:type_name -> google.protobuf.Timestamp
-	63, // 31: talytics.AnalysisResult.created_at:type_name -> google.protobuf.Timestamp
-	56, // 32: talytics.GetAnalysisHistoryResponse.results:type_name -> talytics.AnalysisResult
-	63, // 33: talytics.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	49, // 34: talytics.QuestionDistribution.TaDistributionsEntry.value:type_name -> talytics.TADistribution
-	53, // 35: talytics.AnomalyAnalysisResponse.StatisticsEntry.value:type_name -> talytics.Statistics
-	1,  // 36: talytics.UserService.Register:input_type -> talytics.RegisterRequest
-	2,  // 37: talytics.UserService.Login:input_type -> talytics.LoginRequest
-	4,  // 38: talytics.UserService.Logout:input_type -> talytics.LogoutRequest
-	6,  // 39: talytics.UserService.GetProfile:input_type -> talytics.GetProfileRequest
-	7,  // 40: talytics.UserService.VerifyToken:input_type -> talytics.VerifyTokenRequest
-	11, // 41: talytics.CourseService.CreateCourse:input_type -> talytics.CreateCourseRequest
-	12, // 42: talytics.CourseService.GetCourse:input_type -> talytics.GetCourseRequest
-	13, // 43: talytics.CourseService.ListCourses:input_type -> talytics.ListCoursesRequest
-	14, // 44: talytics.CourseService.UpdateCourse:input_type -> talytics.UpdateCourseRequest
-	15, // 45: talytics.CourseService.JoinCourse:input_type -> talytics.JoinCourseRequest
-	16, // 46: talytics.CourseService.LeaveCourse:input_type -> talytics.LeaveCourseRequest
-	17, // 47: talytics.CourseService.DeleteCourse:input_type -> talytics.DeleteCourseRequest
-	23, // 48: talytics.AssignmentService.CreateAssignment:input_type -> talytics.CreateAssignmentRequest
-	24, // 49: talytics.AssignmentService.GetAssignment:input_type -> talytics.GetAssignmentRequest
-	25, // 50: talytics.AssignmentService.ListAssignments:input_type -> talytics.ListAssignmentsRequest
-	26, // 51: talytics.AssignmentService.UpdateAssignment:input_type -> talytics.UpdateAssignmentRequest
-	27, // 52: talytics.AssignmentService.DeleteAssignment:input_type -> talytics.DeleteAssignmentRequest
-	32, // 53: talytics.RubricService.CreateRubric:input_type -> talytics.CreateRubricRequest
-	33, // 54: talytics.RubricService.GetRubric:input_type -> talytics.GetRubricRequest
-	34, // 55: talytics.RubricService.ListRubrics:input_type -> talytics.ListRubricsRequest
-	35, // 56: talytics.RubricService.UpdateRubric:input_type -> talytics.UpdateRubricRequest
-	36, // 57: talytics.RubricService.DeleteRubric:input_type -> talytics.DeleteRubricRequest
-	42, // 58: talytics.GradeService.UploadGrades:input_type -> talytics.UploadGradesRequest
-	44, // 59: talytics.GradeService.GetGradeStats:input_type -> talytics.GetGradeStatsRequest
-	47, // 60: talytics.GradeService.GetGradeDistribution:input_type -> talytics.GetGradeDistributionRequest
-	51, // 61: talytics.AnalysisService.RunAnomalyAnalysis:input_type -> talytics.RunAnomalyAnalysisRequest
-	55, // 62: talytics.AnalysisService.GetAnalysisHistory:input_type -> talytics.GetAnalysisHistoryRequest
-	58, // 63: talytics.HealthService.Check:input_type -> talytics.HealthCheckRequest
-	3,  // 64: talytics.UserService.Register:output_type -> talytics.AuthResponse
-	3,  // 65: talytics.UserService.Login:output_type -> talytics.AuthResponse
-	5,  // 66: talytics.UserService.Logout:output_type -> talytics.LogoutResponse
-	8,  // 67: talytics.UserService.GetProfile:output_type -> talytics.UserResponse
-	8,  // 68: talytics.UserService.VerifyToken:output_type -> talytics.UserResponse
-	18, // 69: talytics.CourseService.CreateCourse:output_type -> talytics.CourseResponse
-	18, // 70: talytics.CourseService.GetCourse:output_type -> talytics.CourseResponse
-	19, // 71: talytics.CourseService.ListCourses:output_type -> talytics.ListCoursesResponse
-	18, // 72: talytics.CourseService.UpdateCourse:output_type -> talytics.CourseResponse
-	18, // 73: talytics.CourseService.JoinCourse:output_type -> talytics.CourseResponse
-	20, // 74: talytics.CourseService.LeaveCourse:output_type -> talytics.LeaveCourseResponse
-	21, // 75: talytics.CourseService.DeleteCourse:output_type -> talytics.DeleteCourseResponse
-	28, // 76: talytics.AssignmentService.CreateAssignment:output_type -> talytics.AssignmentResponse
-	28, // 77: talytics.AssignmentService.GetAssignment:output_type -> talytics.AssignmentResponse
-	29, // 78: talytics.AssignmentService.ListAssignments:output_type -> talytics.ListAssignmentsResponse
-	28, // 79: talytics.AssignmentService.UpdateAssignment:output_type -> talytics.AssignmentResponse
-	30, // 80: talytics.AssignmentService.DeleteAssignment:output_type -> talytics.DeleteAssignmentResponse
-	37, // 81: talytics.RubricService.CreateRubric:output_type -> talytics.RubricResponse
-	37, // 82: talytics.RubricService.GetRubric:output_type -> talytics.RubricResponse
-	38, // 83: talytics.RubricService.ListRubrics:output_type -> talytics.ListRubricsResponse
-	37, // 84: talytics.RubricService.UpdateRubric:output_type -> talytics.RubricResponse
-	39, // 85: talytics.RubricService.DeleteRubric:output_type -> talytics.DeleteRubricResponse
-	43, // 86: talytics.GradeService.UploadGrades:output_type -> talytics.UploadGradesResponse
-	46, // 87: talytics.GradeService.GetGradeStats:output_type -> talytics.GetGradeStatsResponse
-	50, // 88: talytics.GradeService.GetGradeDistribution:output_type -> talytics.GetGradeDistributionResponse
-	54, // 89: talytics.AnalysisService.RunAnomalyAnalysis:output_type -> talytics.AnomalyAnalysisResponse
-	57, // 90: talytics.AnalysisService.GetAnalysisHistory:output_type -> talytics.GetAnalysisHistoryResponse
-	59, // 91: talytics.HealthService.Check:output_type -> talytics.HealthCheckResponse
-	64, // [64:92] is the sub-list for method output_type
-	36, // [36:64] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+
+func (x *ScoreDistribution) GetMeanFraction() float64 {
+	if x != nil {
+		return x.MeanFraction
+	}
+	return 0
 }
 
-func init() { file_proto_talytics_proto_init() }
-func file_proto_talytics_proto_init() {
-	if File_proto_talytics_proto != nil {
-		return
+// Reported grades per tenth of the maximum score, before and after
+type DistributionBin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LowerFraction float64 `protobuf:"fixed64,1,opt,name=lower_fraction,json=lowerFraction,proto3" json:"lower_fraction,omitempty"`
+	UpperFraction float64 `protobuf:"fixed64,2,opt,name=upper_fraction,json=upperFraction,proto3" json:"upper_fraction,omitempty"`
+	BeforeCount   int32   `protobuf:"varint,3,opt,name=before_count,json=beforeCount,proto3" json:"before_count,omitempty"`
+	AfterCount    int32   `protobuf:"varint,4,opt,name=after_count,json=afterCount,proto3" json:"after_count,omitempty"`
+}
+
+func (x *DistributionBin) Reset() {
+	*x = DistributionBin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_talytics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+}
+
+func (x *DistributionBin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionBin) ProtoMessage() {}
+
+func (x *DistributionBin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionBin.ProtoReflect.Descriptor instead.
+func (*DistributionBin) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DistributionBin) GetLowerFraction() float64 {
+	if x != nil {
+		return x.LowerFraction
+	}
+	return 0
+}
+
+func (x *DistributionBin) GetUpperFraction() float64 {
+	if x != nil {
+		return x.UpperFraction
+	}
+	return 0
+}
+
+func (x *DistributionBin) GetBeforeCount() int32 {
+	if x != nil {
+		return x.BeforeCount
+	}
+	return 0
+}
+
+func (x *DistributionBin) GetAfterCount() int32 {
+	if x != nil {
+		return x.AfterCount
+	}
+	return 0
+}
+
+type StudentScoreDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId   int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId      string  `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	SubmissionId   int64   `protobuf:"varint,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	GradeId        int64   `protobuf:"varint,4,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	Before         float64 `protobuf:"fixed64,5,opt,name=before,proto3" json:"before,omitempty"`
+	After          float64 `protobuf:"fixed64,6,opt,name=after,proto3" json:"after,omitempty"`
+	Delta          float64 `protobuf:"fixed64,7,opt,name=delta,proto3" json:"delta,omitempty"`
+	BeforeFraction float64 `protobuf:"fixed64,8,opt,name=before_fraction,json=beforeFraction,proto3" json:"before_fraction,omitempty"`
+	AfterFraction  float64 `protobuf:"fixed64,9,opt,name=after_fraction,json=afterFraction,proto3" json:"after_fraction,omitempty"`
+}
+
+func (x *StudentScoreDelta) Reset() {
+	*x = StudentScoreDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentScoreDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentScoreDelta) ProtoMessage() {}
+
+func (x *StudentScoreDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentScoreDelta.ProtoReflect.Descriptor instead.
+func (*StudentScoreDelta) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StudentScoreDelta) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentScoreDelta) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetBefore() float64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetAfter() float64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetBeforeFraction() float64 {
+	if x != nil {
+		return x.BeforeFraction
+	}
+	return 0
+}
+
+func (x *StudentScoreDelta) GetAfterFraction() float64 {
+	if x != nil {
+		return x.AfterFraction
+	}
+	return 0
+}
+
+// How far graders' totals differ from each other, as a one-way random
+// effects fit with graders as groups
+type GraderVariance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SufficientData        bool    `protobuf:"varint,1,opt,name=sufficient_data,json=sufficientData,proto3" json:"sufficient_data,omitempty"`
+	BetweenGraderVariance float64 `protobuf:"fixed64,2,opt,name=between_grader_variance,json=betweenGraderVariance,proto3" json:"between_grader_variance,omitempty"`
+	WithinGraderVariance  float64 `protobuf:"fixed64,3,opt,name=within_grader_variance,json=withinGraderVariance,proto3" json:"within_grader_variance,omitempty"`
+	// Share of score variance explained by who graded
+	GraderShare float64 `protobuf:"fixed64,4,opt,name=grader_share,json=graderShare,proto3" json:"grader_share,omitempty"`
+	AnovaPValue float64 `protobuf:"fixed64,5,opt,name=anova_p_value,json=anovaPValue,proto3" json:"anova_p_value,omitempty"`
+}
+
+func (x *GraderVariance) Reset() {
+	*x = GraderVariance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderVariance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderVariance) ProtoMessage() {}
+
+func (x *GraderVariance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderVariance.ProtoReflect.Descriptor instead.
+func (*GraderVariance) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GraderVariance) GetSufficientData() bool {
+	if x != nil {
+		return x.SufficientData
+	}
+	return false
+}
+
+func (x *GraderVariance) GetBetweenGraderVariance() float64 {
+	if x != nil {
+		return x.BetweenGraderVariance
+	}
+	return 0
+}
+
+func (x *GraderVariance) GetWithinGraderVariance() float64 {
+	if x != nil {
+		return x.WithinGraderVariance
+	}
+	return 0
+}
+
+func (x *GraderVariance) GetGraderShare() float64 {
+	if x != nil {
+		return x.GraderShare
+	}
+	return 0
+}
+
+func (x *GraderVariance) GetAnovaPValue() float64 {
+	if x != nil {
+		return x.AnovaPValue
+	}
+	return 0
+}
+
+type GraderScoreShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId   int64   `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName string  `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Count      int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	MeanBefore float64 `protobuf:"fixed64,4,opt,name=mean_before,json=meanBefore,proto3" json:"mean_before,omitempty"`
+	MeanAfter  float64 `protobuf:"fixed64,5,opt,name=mean_after,json=meanAfter,proto3" json:"mean_after,omitempty"`
+}
+
+func (x *GraderScoreShift) Reset() {
+	*x = GraderScoreShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderScoreShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderScoreShift) ProtoMessage() {}
+
+func (x *GraderScoreShift) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderScoreShift.ProtoReflect.Descriptor instead.
+func (*GraderScoreShift) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GraderScoreShift) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderScoreShift) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderScoreShift) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GraderScoreShift) GetMeanBefore() float64 {
+	if x != nil {
+		return x.MeanBefore
+	}
+	return 0
+}
+
+func (x *GraderScoreShift) GetMeanAfter() float64 {
+	if x != nil {
+		return x.MeanAfter
+	}
+	return 0
+}
+
+type ProposedCriterionImpact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index           int32              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Label           string             `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Weight          float64            `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sources         []*CriterionSource `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	AssumedFraction float64            `protobuf:"fixed64,5,opt,name=assumed_fraction,json=assumedFraction,proto3" json:"assumed_fraction,omitempty"`
+	MeanAfter       float64            `protobuf:"fixed64,6,opt,name=mean_after,json=meanAfter,proto3" json:"mean_after,omitempty"`
+}
+
+func (x *ProposedCriterionImpact) Reset() {
+	*x = ProposedCriterionImpact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposedCriterionImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposedCriterionImpact) ProtoMessage() {}
+
+func (x *ProposedCriterionImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposedCriterionImpact.ProtoReflect.Descriptor instead.
+func (*ProposedCriterionImpact) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ProposedCriterionImpact) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ProposedCriterionImpact) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ProposedCriterionImpact) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProposedCriterionImpact) GetSources() []*CriterionSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ProposedCriterionImpact) GetAssumedFraction() float64 {
+	if x != nil {
+		return x.AssumedFraction
+	}
+	return 0
+}
+
+func (x *ProposedCriterionImpact) GetMeanAfter() float64 {
+	if x != nil {
+		return x.MeanAfter
+	}
+	return 0
+}
+
+type RubricImpactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId       int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	AssignmentId   int64 `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	CurrentVersion int32 `protobuf:"varint,3,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	ReplayedGrades int32 `protobuf:"varint,4,opt,name=replayed_grades,json=replayedGrades,proto3" json:"replayed_grades,omitempty"`
+	// Reported grades scored on an earlier version, which cannot be replayed
+	OutdatedGrades int32                      `protobuf:"varint,5,opt,name=outdated_grades,json=outdatedGrades,proto3" json:"outdated_grades,omitempty"`
+	Criteria       []*ProposedCriterionImpact `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	// Keys of current criteria no proposed criterion draws on
+	DroppedCriteria []string           `protobuf:"bytes,7,rep,name=dropped_criteria,json=droppedCriteria,proto3" json:"dropped_criteria,omitempty"`
+	Before          *ScoreDistribution `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After           *ScoreDistribution `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	Histogram       []*DistributionBin `protobuf:"bytes,10,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	// Largest changes first
+	Students             []*StudentScoreDelta `protobuf:"bytes,11,rep,name=students,proto3" json:"students,omitempty"`
+	StudentsUp           int32                `protobuf:"varint,12,opt,name=students_up,json=studentsUp,proto3" json:"students_up,omitempty"`
+	StudentsDown         int32                `protobuf:"varint,13,opt,name=students_down,json=studentsDown,proto3" json:"students_down,omitempty"`
+	StudentsUnchanged    int32                `protobuf:"varint,14,opt,name=students_unchanged,json=studentsUnchanged,proto3" json:"students_unchanged,omitempty"`
+	GraderVarianceBefore *GraderVariance      `protobuf:"bytes,15,opt,name=grader_variance_before,json=graderVarianceBefore,proto3" json:"grader_variance_before,omitempty"`
+	GraderVarianceAfter  *GraderVariance      `protobuf:"bytes,16,opt,name=grader_variance_after,json=graderVarianceAfter,proto3" json:"grader_variance_after,omitempty"`
+	Graders              []*GraderScoreShift  `protobuf:"bytes,17,rep,name=graders,proto3" json:"graders,omitempty"`
+}
+
+func (x *RubricImpactResponse) Reset() {
+	*x = RubricImpactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RubricImpactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricImpactResponse) ProtoMessage() {}
+
+func (x *RubricImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricImpactResponse.ProtoReflect.Descriptor instead.
+func (*RubricImpactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RubricImpactResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetReplayedGrades() int32 {
+	if x != nil {
+		return x.ReplayedGrades
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetOutdatedGrades() int32 {
+	if x != nil {
+		return x.OutdatedGrades
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetCriteria() []*ProposedCriterionImpact {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetDroppedCriteria() []string {
+	if x != nil {
+		return x.DroppedCriteria
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetBefore() *ScoreDistribution {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetAfter() *ScoreDistribution {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetHistogram() []*DistributionBin {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetStudents() []*StudentScoreDelta {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetStudentsUp() int32 {
+	if x != nil {
+		return x.StudentsUp
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetStudentsDown() int32 {
+	if x != nil {
+		return x.StudentsDown
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetStudentsUnchanged() int32 {
+	if x != nil {
+		return x.StudentsUnchanged
+	}
+	return 0
+}
+
+func (x *RubricImpactResponse) GetGraderVarianceBefore() *GraderVariance {
+	if x != nil {
+		return x.GraderVarianceBefore
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetGraderVarianceAfter() *GraderVariance {
+	if x != nil {
+		return x.GraderVarianceAfter
+	}
+	return nil
+}
+
+func (x *RubricImpactResponse) GetGraders() []*GraderScoreShift {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+// Messages for Grade service
+type Grade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId     int64                `protobuf:"varint,3,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	StudentId    string               `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	GraderId     int64                `protobuf:"varint,5,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName   string               `protobuf:"bytes,6,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	QuestionId   string               `protobuf:"bytes,7,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Score        float64              `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore     float64              `protobuf:"fixed64,9,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Feedback     string               `protobuf:"bytes,10,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	GradedAt     *timestamp.Timestamp `protobuf:"bytes,11,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+}
+
+func (x *Grade) Reset() {
+	*x = Grade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grade) ProtoMessage() {}
+
+func (x *Grade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
+func (*Grade) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Grade) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Grade) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *Grade) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *Grade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Grade) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *Grade) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *Grade) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *Grade) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Grade) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Grade) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Grade) GetGradedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+// ta_id names the grader by user ID, email or name and defaults to the
+// caller; only instructors can import grades for other graders. student_id
+// may also be a submission's blind token.
+type GradeData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId  string  `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	QuestionId string  `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Score      float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore   float64 `protobuf:"fixed64,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Feedback   string  `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	TaId       string  `protobuf:"bytes,6,opt,name=ta_id,json=taId,proto3" json:"ta_id,omitempty"`
+}
+
+func (x *GradeData) Reset() {
+	*x = GradeData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeData) ProtoMessage() {}
+
+func (x *GradeData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeData.ProtoReflect.Descriptor instead.
+func (*GradeData) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GradeData) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GradeData) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GradeData) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GradeData) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *GradeData) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *GradeData) GetTaId() string {
+	if x != nil {
+		return x.TaId
+	}
+	return ""
+}
+
+// Imports are all or nothing: if any row is invalid nothing is written.
+// dry_run validates the rows and reports what would change.
+type UploadGradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64        `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId     int64        `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Grades       []*GradeData `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+	DryRun       bool         `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *UploadGradesRequest) Reset() {
+	*x = UploadGradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGradesRequest) ProtoMessage() {}
+
+func (x *UploadGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGradesRequest.ProtoReflect.Descriptor instead.
+func (*UploadGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UploadGradesRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *UploadGradesRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *UploadGradesRequest) GetGrades() []*GradeData {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *UploadGradesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// row is the 1-based line of the CSV file, or the index of the GradeData
+// entry counted from 1
+type GradeImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row     int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column  string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GradeImportRowError) Reset() {
+	*x = GradeImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeImportRowError) ProtoMessage() {}
+
+func (x *GradeImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeImportRowError.ProtoReflect.Descriptor instead.
+func (*GradeImportRowError) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GradeImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *GradeImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *GradeImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UploadGradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TotalUploaded int32                  `protobuf:"varint,2,opt,name=total_uploaded,json=totalUploaded,proto3" json:"total_uploaded,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Committed     bool                   `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"`
+	RowsRead      int32                  `protobuf:"varint,6,opt,name=rows_read,json=rowsRead,proto3" json:"rows_read,omitempty"`
+	RowErrors     []*GradeImportRowError `protobuf:"bytes,7,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
+	GradesCreated int32                  `protobuf:"varint,8,opt,name=grades_created,json=gradesCreated,proto3" json:"grades_created,omitempty"`
+	GradesUpdated int32                  `protobuf:"varint,9,opt,name=grades_updated,json=gradesUpdated,proto3" json:"grades_updated,omitempty"`
+}
+
+func (x *UploadGradesResponse) Reset() {
+	*x = UploadGradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadGradesResponse) ProtoMessage() {}
+
+func (x *UploadGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadGradesResponse.ProtoReflect.Descriptor instead.
+func (*UploadGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UploadGradesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadGradesResponse) GetTotalUploaded() int32 {
+	if x != nil {
+		return x.TotalUploaded
+	}
+	return 0
+}
+
+func (x *UploadGradesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *UploadGradesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UploadGradesResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *UploadGradesResponse) GetRowsRead() int32 {
+	if x != nil {
+		return x.RowsRead
+	}
+	return 0
+}
+
+func (x *UploadGradesResponse) GetRowErrors() []*GradeImportRowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+func (x *UploadGradesResponse) GetGradesCreated() int32 {
+	if x != nil {
+		return x.GradesCreated
+	}
+	return 0
+}
+
+func (x *UploadGradesResponse) GetGradesUpdated() int32 {
+	if x != nil {
+		return x.GradesUpdated
+	}
+	return 0
+}
+
+// A CSV grade import sent in pieces. The first chunk names the assignment
+// and whether it is a dry run; every chunk carries the next bytes of the file,
+// which has a header row naming student_id, question_id and score columns
+// plus optional ta_id, max_score and feedback columns.
+type GradeImportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	DryRun       bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Data         []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GradeImportChunk) Reset() {
+	*x = GradeImportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeImportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeImportChunk) ProtoMessage() {}
+
+func (x *GradeImportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeImportChunk.ProtoReflect.Descriptor instead.
+func (*GradeImportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GradeImportChunk) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GradeImportChunk) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GradeImportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Like the analyses, grade stats and distributions only cover grades scored
+// against one version of the rubric: the current one unless
+// rubric_version_id picks an earlier one. Grades on other versions are left
+// out and counted in excluded_grades.
+type GetGradeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId        int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	RubricVersionId int64 `protobuf:"varint,2,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *GetGradeStatsRequest) Reset() {
+	*x = GetGradeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeStatsRequest) ProtoMessage() {}
+
+func (x *GetGradeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetGradeStatsRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *GetGradeStatsRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+type GradeStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaId          string  `protobuf:"bytes,1,opt,name=ta_id,json=taId,proto3" json:"ta_id,omitempty"`
+	QuestionId    string  `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Count         int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	AvgScore      float64 `protobuf:"fixed64,4,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	MinScore      float64 `protobuf:"fixed64,5,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore      float64 `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	AvgPercentage float64 `protobuf:"fixed64,7,opt,name=avg_percentage,json=avgPercentage,proto3" json:"avg_percentage,omitempty"`
+}
+
+func (x *GradeStat) Reset() {
+	*x = GradeStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeStat) ProtoMessage() {}
+
+func (x *GradeStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeStat.ProtoReflect.Descriptor instead.
+func (*GradeStat) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GradeStat) GetTaId() string {
+	if x != nil {
+		return x.TaId
+	}
+	return ""
+}
+
+func (x *GradeStat) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GradeStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GradeStat) GetAvgScore() float64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *GradeStat) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *GradeStat) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *GradeStat) GetAvgPercentage() float64 {
+	if x != nil {
+		return x.AvgPercentage
+	}
+	return 0
+}
+
+type GetGradeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats           []*GradeStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	RubricVersionId int64        `protobuf:"varint,2,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades  int32        `protobuf:"varint,3,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *GetGradeStatsResponse) Reset() {
+	*x = GetGradeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeStatsResponse) ProtoMessage() {}
+
+func (x *GetGradeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetGradeStatsResponse) GetStats() []*GradeStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetGradeStatsResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *GetGradeStatsResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+type GetGradeDistributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId        int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	RubricVersionId int64 `protobuf:"varint,2,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *GetGradeDistributionRequest) Reset() {
+	*x = GetGradeDistributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradeDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDistributionRequest) ProtoMessage() {}
+
+func (x *GetGradeDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetGradeDistributionRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *GetGradeDistributionRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+type QuestionDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId      string                     `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	TaDistributions map[string]*TADistribution `protobuf:"bytes,2,rep,name=ta_distributions,json=taDistributions,proto3" json:"ta_distributions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *QuestionDistribution) Reset() {
+	*x = QuestionDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionDistribution) ProtoMessage() {}
+
+func (x *QuestionDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionDistribution.ProtoReflect.Descriptor instead.
+func (*QuestionDistribution) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *QuestionDistribution) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionDistribution) GetTaDistributions() map[string]*TADistribution {
+	if x != nil {
+		return x.TaDistributions
+	}
+	return nil
+}
+
+type TADistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scores []float64 `protobuf:"fixed64,1,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *TADistribution) Reset() {
+	*x = TADistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TADistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TADistribution) ProtoMessage() {}
+
+func (x *TADistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TADistribution.ProtoReflect.Descriptor instead.
+func (*TADistribution) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TADistribution) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type GetGradeDistributionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Distributions   []*QuestionDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	RubricVersionId int64                   `protobuf:"varint,2,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades  int32                   `protobuf:"varint,3,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *GetGradeDistributionResponse) Reset() {
+	*x = GetGradeDistributionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradeDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeDistributionResponse) ProtoMessage() {}
+
+func (x *GetGradeDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeDistributionResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetGradeDistributionResponse) GetDistributions() []*QuestionDistribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+func (x *GetGradeDistributionResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *GetGradeDistributionResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// Rubric-based grade for a single submission. rubric_scores is keyed by
+// criterion index ("0", "1", ...) into the assignment's rubric.
+type RubricGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId   int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId   int64                `protobuf:"varint,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId      string               `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName    string               `protobuf:"bytes,5,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	FileName       string               `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	GraderId       int64                `protobuf:"varint,7,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName     string               `protobuf:"bytes,8,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	RubricScores   map[string]float64   `protobuf:"bytes,9,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalScore     float64              `protobuf:"fixed64,10,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	NeedsRegrading bool                 `protobuf:"varint,11,opt,name=needs_regrading,json=needsRegrading,proto3" json:"needs_regrading,omitempty"`
+	AssignmentName string               `protobuf:"bytes,12,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
+	GradedAt       *timestamp.Timestamp `protobuf:"bytes,13,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	UpdatedAt      *timestamp.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Incremented on every change; see SubmitGradeRequest.expected_version
+	Version int32 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	// Feedback for the student keyed like rubric_scores, plus an overall comment
+	CriterionFeedback map[string]string `protobuf:"bytes,16,rep,name=criterion_feedback,json=criterionFeedback,proto3" json:"criterion_feedback,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Feedback          string            `protobuf:"bytes,17,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// Comment bank entries applied to the grade; only set on single-grade lookups
+	AppliedComments []*AppliedBankComment `protobuf:"bytes,18,rep,name=applied_comments,json=appliedComments,proto3" json:"applied_comments,omitempty"`
+	// Set when student_id is the submission's blind token; see Submission
+	Anonymized bool `protobuf:"varint,19,opt,name=anonymized,proto3" json:"anonymized,omitempty"`
+	// The rubric version the scores were given against; rubric_outdated is set
+	// once the rubric has changed since
+	RubricVersionId int64 `protobuf:"varint,20,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	RubricVersion   int32 `protobuf:"varint,21,opt,name=rubric_version,json=rubricVersion,proto3" json:"rubric_version,omitempty"`
+	RubricOutdated  bool  `protobuf:"varint,22,opt,name=rubric_outdated,json=rubricOutdated,proto3" json:"rubric_outdated,omitempty"`
+}
+
+func (x *RubricGrade) Reset() {
+	*x = RubricGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RubricGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricGrade) ProtoMessage() {}
+
+func (x *RubricGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricGrade.ProtoReflect.Descriptor instead.
+func (*RubricGrade) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RubricGrade) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *RubricGrade) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *RubricGrade) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *RubricGrade) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *RubricGrade) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *RubricGrade) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetNeedsRegrading() bool {
+	if x != nil {
+		return x.NeedsRegrading
+	}
+	return false
+}
+
+func (x *RubricGrade) GetAssignmentName() string {
+	if x != nil {
+		return x.AssignmentName
+	}
+	return ""
+}
+
+func (x *RubricGrade) GetGradedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *RubricGrade) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RubricGrade) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetCriterionFeedback() map[string]string {
+	if x != nil {
+		return x.CriterionFeedback
+	}
+	return nil
+}
+
+func (x *RubricGrade) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *RubricGrade) GetAppliedComments() []*AppliedBankComment {
+	if x != nil {
+		return x.AppliedComments
+	}
+	return nil
+}
+
+func (x *RubricGrade) GetAnonymized() bool {
+	if x != nil {
+		return x.Anonymized
+	}
+	return false
+}
+
+func (x *RubricGrade) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetRubricVersion() int32 {
+	if x != nil {
+		return x.RubricVersion
+	}
+	return 0
+}
+
+func (x *RubricGrade) GetRubricOutdated() bool {
+	if x != nil {
+		return x.RubricOutdated
+	}
+	return false
+}
+
+// rubric_scores must use criterion indexes of the assignment's rubric with
+// each score between 0 and the criterion's weight. total_score is ignored;
+// the server sums rubric_scores instead.
+//
+// Updating an existing grade requires expected_version to match the grade's
+// current version. Otherwise the call fails with ABORTED and the current
+// grade attached as a RubricGrade status detail.
+type SubmitGradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64              `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId int64              `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId    string             `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	RubricScores map[string]float64 `protobuf:"bytes,4,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalScore   float64            `protobuf:"fixed64,5,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	// Optional explanation recorded in the grade's revision history
+	Reason          string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedVersion int32  `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// Replaces the grade's feedback; keys must be criteria of the rubric
+	CriterionFeedback map[string]string `protobuf:"bytes,8,rep,name=criterion_feedback,json=criterionFeedback,proto3" json:"criterion_feedback,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Feedback          string            `protobuf:"bytes,9,opt,name=feedback,proto3" json:"feedback,omitempty"`
+}
+
+func (x *SubmitGradeRequest) Reset() {
+	*x = SubmitGradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGradeRequest) ProtoMessage() {}
+
+func (x *SubmitGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGradeRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SubmitGradeRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SubmitGradeRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *SubmitGradeRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SubmitGradeRequest) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *SubmitGradeRequest) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *SubmitGradeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubmitGradeRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SubmitGradeRequest) GetCriterionFeedback() map[string]string {
+	if x != nil {
+		return x.CriterionFeedback
+	}
+	return nil
+}
+
+func (x *SubmitGradeRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+type SubmitGradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grade   *RubricGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Created bool         `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SubmitGradeResponse) Reset() {
+	*x = SubmitGradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGradeResponse) ProtoMessage() {}
+
+func (x *SubmitGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGradeResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubmitGradeResponse) GetGrade() *RubricGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *SubmitGradeResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *SubmitGradeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetSubmissionGradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId int64 `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+}
+
+func (x *GetSubmissionGradeRequest) Reset() {
+	*x = GetSubmissionGradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionGradeRequest) ProtoMessage() {}
+
+func (x *GetSubmissionGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionGradeRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetSubmissionGradeRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+// grade is the caller's own grade when they have one. Instructors also get
+// every independent grade of an overlap submission in grades.
+type SubmissionGradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grade  *RubricGrade   `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Grades []*RubricGrade `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+}
+
+func (x *SubmissionGradeResponse) Reset() {
+	*x = SubmissionGradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionGradeResponse) ProtoMessage() {}
+
+func (x *SubmissionGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionGradeResponse.ProtoReflect.Descriptor instead.
+func (*SubmissionGradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SubmissionGradeResponse) GetGrade() *RubricGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *SubmissionGradeResponse) GetGrades() []*RubricGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+type ListAssignmentGradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *ListAssignmentGradesRequest) Reset() {
+	*x = ListAssignmentGradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAssignmentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentGradesRequest) ProtoMessage() {}
+
+func (x *ListAssignmentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentGradesRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListAssignmentGradesRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type ListRegradeQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRegradeQueueRequest) Reset() {
+	*x = ListRegradeQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegradeQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegradeQueueRequest) ProtoMessage() {}
+
+func (x *ListRegradeQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegradeQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListRegradeQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{91}
+}
+
+type ListGradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grades []*RubricGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+}
+
+func (x *ListGradesResponse) Reset() {
+	*x = ListGradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradesResponse) ProtoMessage() {}
+
+func (x *ListGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradesResponse.ProtoReflect.Descriptor instead.
+func (*ListGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListGradesResponse) GetGrades() []*RubricGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// One change to a grade. The first revision of a grade created after
+// history was introduced has has_previous unset and no old scores.
+type GradeRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GradeId         int64                `protobuf:"varint,2,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	Revision        int32                `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	ChangedBy       int64                `protobuf:"varint,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedByName   string               `protobuf:"bytes,5,opt,name=changed_by_name,json=changedByName,proto3" json:"changed_by_name,omitempty"`
+	Source          string               `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	HasPrevious     bool                 `protobuf:"varint,7,opt,name=has_previous,json=hasPrevious,proto3" json:"has_previous,omitempty"`
+	OldRubricScores map[string]float64   `protobuf:"bytes,8,rep,name=old_rubric_scores,json=oldRubricScores,proto3" json:"old_rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	NewRubricScores map[string]float64   `protobuf:"bytes,9,rep,name=new_rubric_scores,json=newRubricScores,proto3" json:"new_rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	OldTotalScore   float64              `protobuf:"fixed64,10,opt,name=old_total_score,json=oldTotalScore,proto3" json:"old_total_score,omitempty"`
+	NewTotalScore   float64              `protobuf:"fixed64,11,opt,name=new_total_score,json=newTotalScore,proto3" json:"new_total_score,omitempty"`
+	Reason          string               `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt       *timestamp.Timestamp `protobuf:"bytes,13,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+}
+
+func (x *GradeRevision) Reset() {
+	*x = GradeRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeRevision) ProtoMessage() {}
+
+func (x *GradeRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeRevision.ProtoReflect.Descriptor instead.
+func (*GradeRevision) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GradeRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GradeRevision) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *GradeRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GradeRevision) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *GradeRevision) GetChangedByName() string {
+	if x != nil {
+		return x.ChangedByName
+	}
+	return ""
+}
+
+func (x *GradeRevision) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GradeRevision) GetHasPrevious() bool {
+	if x != nil {
+		return x.HasPrevious
+	}
+	return false
+}
+
+func (x *GradeRevision) GetOldRubricScores() map[string]float64 {
+	if x != nil {
+		return x.OldRubricScores
+	}
+	return nil
+}
+
+func (x *GradeRevision) GetNewRubricScores() map[string]float64 {
+	if x != nil {
+		return x.NewRubricScores
+	}
+	return nil
+}
+
+func (x *GradeRevision) GetOldTotalScore() float64 {
+	if x != nil {
+		return x.OldTotalScore
+	}
+	return 0
+}
+
+func (x *GradeRevision) GetNewTotalScore() float64 {
+	if x != nil {
+		return x.NewTotalScore
+	}
+	return 0
+}
+
+func (x *GradeRevision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GradeRevision) GetChangedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetGradeHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId int64 `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+}
+
+func (x *GetGradeHistoryRequest) Reset() {
+	*x = GetGradeHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeHistoryRequest) ProtoMessage() {}
+
+func (x *GetGradeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetGradeHistoryRequest) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+// Revisions are oldest first.
+type GradeHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId   int64            `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	Revisions []*GradeRevision `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GradeHistoryResponse) Reset() {
+	*x = GradeHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeHistoryResponse) ProtoMessage() {}
+
+func (x *GradeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GradeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GradeHistoryResponse) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *GradeHistoryResponse) GetRevisions() []*GradeRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Compares the grade as it stood after from_revision with the grade after
+// to_revision. Revision 0 is the grade before its first recorded change and
+// to_revision defaults to the latest; with neither set, the latest revision
+// is compared with the one before it.
+type DiffGradeRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId      int64 `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	FromRevision int32 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision   int32 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+}
+
+func (x *DiffGradeRevisionsRequest) Reset() {
+	*x = DiffGradeRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffGradeRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffGradeRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffGradeRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffGradeRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffGradeRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DiffGradeRevisionsRequest) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *DiffGradeRevisionsRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffGradeRevisionsRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type CriterionChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label    string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	HasOld   bool    `protobuf:"varint,3,opt,name=has_old,json=hasOld,proto3" json:"has_old,omitempty"`
+	HasNew   bool    `protobuf:"varint,4,opt,name=has_new,json=hasNew,proto3" json:"has_new,omitempty"`
+	OldScore float64 `protobuf:"fixed64,5,opt,name=old_score,json=oldScore,proto3" json:"old_score,omitempty"`
+	NewScore float64 `protobuf:"fixed64,6,opt,name=new_score,json=newScore,proto3" json:"new_score,omitempty"`
+	Delta    float64 `protobuf:"fixed64,7,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *CriterionChange) Reset() {
+	*x = CriterionChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriterionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionChange) ProtoMessage() {}
+
+func (x *CriterionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionChange.ProtoReflect.Descriptor instead.
+func (*CriterionChange) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CriterionChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CriterionChange) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CriterionChange) GetHasOld() bool {
+	if x != nil {
+		return x.HasOld
+	}
+	return false
+}
+
+func (x *CriterionChange) GetHasNew() bool {
+	if x != nil {
+		return x.HasNew
+	}
+	return false
+}
+
+func (x *CriterionChange) GetOldScore() float64 {
+	if x != nil {
+		return x.OldScore
+	}
+	return 0
+}
+
+func (x *CriterionChange) GetNewScore() float64 {
+	if x != nil {
+		return x.NewScore
+	}
+	return 0
+}
+
+func (x *CriterionChange) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type GradeRevisionDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId       int64              `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	FromRevision  int32              `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    int32              `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Changes       []*CriterionChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	OldTotalScore float64            `protobuf:"fixed64,5,opt,name=old_total_score,json=oldTotalScore,proto3" json:"old_total_score,omitempty"`
+	NewTotalScore float64            `protobuf:"fixed64,6,opt,name=new_total_score,json=newTotalScore,proto3" json:"new_total_score,omitempty"`
+	TotalDelta    float64            `protobuf:"fixed64,7,opt,name=total_delta,json=totalDelta,proto3" json:"total_delta,omitempty"`
+}
+
+func (x *GradeRevisionDiffResponse) Reset() {
+	*x = GradeRevisionDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeRevisionDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeRevisionDiffResponse) ProtoMessage() {}
+
+func (x *GradeRevisionDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeRevisionDiffResponse.ProtoReflect.Descriptor instead.
+func (*GradeRevisionDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GradeRevisionDiffResponse) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *GradeRevisionDiffResponse) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *GradeRevisionDiffResponse) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *GradeRevisionDiffResponse) GetChanges() []*CriterionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GradeRevisionDiffResponse) GetOldTotalScore() float64 {
+	if x != nil {
+		return x.OldTotalScore
+	}
+	return 0
+}
+
+func (x *GradeRevisionDiffResponse) GetNewTotalScore() float64 {
+	if x != nil {
+		return x.NewTotalScore
+	}
+	return 0
+}
+
+func (x *GradeRevisionDiffResponse) GetTotalDelta() float64 {
+	if x != nil {
+		return x.TotalDelta
+	}
+	return 0
+}
+
+// A message in a grade's discussion thread. Regrade requests post their
+// justification and decisions here too.
+type GradeComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GradeId  int64  `protobuf:"varint,2,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	UserId   int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserRole string `protobuf:"bytes,5,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	Message  string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// The comment this one replies to; zero for a new thread
+	ParentId  int64                `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GradeComment) Reset() {
+	*x = GradeComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeComment) ProtoMessage() {}
+
+func (x *GradeComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeComment.ProtoReflect.Descriptor instead.
+func (*GradeComment) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GradeComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GradeComment) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *GradeComment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GradeComment) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GradeComment) GetUserRole() string {
+	if x != nil {
+		return x.UserRole
+	}
+	return ""
+}
+
+func (x *GradeComment) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GradeComment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *GradeComment) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListGradeCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId int64 `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+}
+
+func (x *ListGradeCommentsRequest) Reset() {
+	*x = ListGradeCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradeCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeCommentsRequest) ProtoMessage() {}
+
+func (x *ListGradeCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListGradeCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListGradeCommentsRequest) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+// Comments are oldest first.
+type ListGradeCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId  int64           `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	Comments []*GradeComment `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *ListGradeCommentsResponse) Reset() {
+	*x = ListGradeCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradeCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradeCommentsResponse) ProtoMessage() {}
+
+func (x *ListGradeCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradeCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListGradeCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListGradeCommentsResponse) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *ListGradeCommentsResponse) GetComments() []*GradeComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type PostGradeCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId  int64  `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ParentId int64  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *PostGradeCommentRequest) Reset() {
+	*x = PostGradeCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostGradeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostGradeCommentRequest) ProtoMessage() {}
+
+func (x *PostGradeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostGradeCommentRequest.ProtoReflect.Descriptor instead.
+func (*PostGradeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PostGradeCommentRequest) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *PostGradeCommentRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PostGradeCommentRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type GradeCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *GradeComment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Message string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GradeCommentResponse) Reset() {
+	*x = GradeCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeCommentResponse) ProtoMessage() {}
+
+func (x *GradeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeCommentResponse.ProtoReflect.Descriptor instead.
+func (*GradeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GradeCommentResponse) GetComment() *GradeComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *GradeCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Messages for Grading Assignment service
+// strategy is one of round_robin, balanced, student_id_range or random.
+// grader_ids defaults to every TA in the course. Without reassign only
+// submissions nobody is assigned to yet are distributed; reassign also
+// redistributes the ungraded ones.
+type DistributeSubmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Strategy     string  `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	GraderIds    []int64 `protobuf:"varint,3,rep,packed,name=grader_ids,json=graderIds,proto3" json:"grader_ids,omitempty"`
+	Seed         int64   `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	Reassign     bool    `protobuf:"varint,5,opt,name=reassign,proto3" json:"reassign,omitempty"`
+}
+
+func (x *DistributeSubmissionsRequest) Reset() {
+	*x = DistributeSubmissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributeSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributeSubmissionsRequest) ProtoMessage() {}
+
+func (x *DistributeSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributeSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*DistributeSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DistributeSubmissionsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *DistributeSubmissionsRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *DistributeSubmissionsRequest) GetGraderIds() []int64 {
+	if x != nil {
+		return x.GraderIds
+	}
+	return nil
+}
+
+func (x *DistributeSubmissionsRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *DistributeSubmissionsRequest) GetReassign() bool {
+	if x != nil {
+		return x.Reassign
+	}
+	return false
+}
+
+type GradingAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId int64                `protobuf:"varint,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId    string               `protobuf:"bytes,4,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	GraderId     int64                `protobuf:"varint,5,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName   string               `protobuf:"bytes,6,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Strategy     string               `protobuf:"bytes,7,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	AssignedAt   *timestamp.Timestamp `protobuf:"bytes,8,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+}
+
+func (x *GradingAssignment) Reset() {
+	*x = GradingAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingAssignment) ProtoMessage() {}
+
+func (x *GradingAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingAssignment.ProtoReflect.Descriptor instead.
+func (*GradingAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GradingAssignment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GradingAssignment) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GradingAssignment) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *GradingAssignment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GradingAssignment) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GradingAssignment) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GradingAssignment) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *GradingAssignment) GetAssignedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+type DistributeSubmissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*GradingAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Seed        int64                `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Message     string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DistributeSubmissionsResponse) Reset() {
+	*x = DistributeSubmissionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributeSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributeSubmissionsResponse) ProtoMessage() {}
+
+func (x *DistributeSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributeSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*DistributeSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DistributeSubmissionsResponse) GetAssignments() []*GradingAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *DistributeSubmissionsResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *DistributeSubmissionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// grader_id defaults to the caller; only instructors can view other queues.
+type ListGradingQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	GraderId     int64 `protobuf:"varint,2,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+}
+
+func (x *ListGradingQueueRequest) Reset() {
+	*x = ListGradingQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradingQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradingQueueRequest) ProtoMessage() {}
+
+func (x *ListGradingQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradingQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListGradingQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListGradingQueueRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ListGradingQueueRequest) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+type GradingQueueItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId int64                `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId    string               `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName  string               `protobuf:"bytes,3,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	FileName     string               `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Graded       bool                 `protobuf:"varint,5,opt,name=graded,proto3" json:"graded,omitempty"`
+	GradeId      int64                `protobuf:"varint,6,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	AssignedAt   *timestamp.Timestamp `protobuf:"bytes,7,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+}
+
+func (x *GradingQueueItem) Reset() {
+	*x = GradingQueueItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingQueueItem) ProtoMessage() {}
+
+func (x *GradingQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingQueueItem.ProtoReflect.Descriptor instead.
+func (*GradingQueueItem) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GradingQueueItem) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *GradingQueueItem) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GradingQueueItem) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *GradingQueueItem) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GradingQueueItem) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *GradingQueueItem) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *GradingQueueItem) GetAssignedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+type GradingQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64               `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	GraderId     int64               `protobuf:"varint,2,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	Items        []*GradingQueueItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GradingQueueResponse) Reset() {
+	*x = GradingQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingQueueResponse) ProtoMessage() {}
+
+func (x *GradingQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingQueueResponse.ProtoReflect.Descriptor instead.
+func (*GradingQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GradingQueueResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GradingQueueResponse) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GradingQueueResponse) GetItems() []*GradingQueueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetGradingProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetGradingProgressRequest) Reset() {
+	*x = GetGradingProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGradingProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingProgressRequest) ProtoMessage() {}
+
+func (x *GetGradingProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetGradingProgressRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type GraderProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId        int64   `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName      string  `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Assigned        int32   `protobuf:"varint,3,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	Graded          int32   `protobuf:"varint,4,opt,name=graded,proto3" json:"graded,omitempty"`
+	Remaining       int32   `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	PercentComplete float64 `protobuf:"fixed64,6,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+}
+
+func (x *GraderProgress) Reset() {
+	*x = GraderProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderProgress) ProtoMessage() {}
+
+func (x *GraderProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderProgress.ProtoReflect.Descriptor instead.
+func (*GraderProgress) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GraderProgress) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderProgress) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderProgress) GetAssigned() int32 {
+	if x != nil {
+		return x.Assigned
+	}
+	return 0
+}
+
+func (x *GraderProgress) GetGraded() int32 {
+	if x != nil {
+		return x.Graded
+	}
+	return 0
+}
+
+func (x *GraderProgress) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *GraderProgress) GetPercentComplete() float64 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+type GradingProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId          int64             `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	TotalSubmissions      int32             `protobuf:"varint,2,opt,name=total_submissions,json=totalSubmissions,proto3" json:"total_submissions,omitempty"`
+	UnassignedSubmissions int32             `protobuf:"varint,3,opt,name=unassigned_submissions,json=unassignedSubmissions,proto3" json:"unassigned_submissions,omitempty"`
+	Graders               []*GraderProgress `protobuf:"bytes,4,rep,name=graders,proto3" json:"graders,omitempty"`
+	OverlapPercent        float64           `protobuf:"fixed64,5,opt,name=overlap_percent,json=overlapPercent,proto3" json:"overlap_percent,omitempty"`
+	OverlapGraders        int32             `protobuf:"varint,6,opt,name=overlap_graders,json=overlapGraders,proto3" json:"overlap_graders,omitempty"`
+	OverlapSubmissions    int32             `protobuf:"varint,7,opt,name=overlap_submissions,json=overlapSubmissions,proto3" json:"overlap_submissions,omitempty"`
+}
+
+func (x *GradingProgressResponse) Reset() {
+	*x = GradingProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingProgressResponse) ProtoMessage() {}
+
+func (x *GradingProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingProgressResponse.ProtoReflect.Descriptor instead.
+func (*GradingProgressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GradingProgressResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GradingProgressResponse) GetTotalSubmissions() int32 {
+	if x != nil {
+		return x.TotalSubmissions
+	}
+	return 0
+}
+
+func (x *GradingProgressResponse) GetUnassignedSubmissions() int32 {
+	if x != nil {
+		return x.UnassignedSubmissions
+	}
+	return 0
+}
+
+func (x *GradingProgressResponse) GetGraders() []*GraderProgress {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+func (x *GradingProgressResponse) GetOverlapPercent() float64 {
+	if x != nil {
+		return x.OverlapPercent
+	}
+	return 0
+}
+
+func (x *GradingProgressResponse) GetOverlapGraders() int32 {
+	if x != nil {
+		return x.OverlapGraders
+	}
+	return 0
+}
+
+func (x *GradingProgressResponse) GetOverlapSubmissions() int32 {
+	if x != nil {
+		return x.OverlapSubmissions
+	}
+	return 0
+}
+
+// overlap_percent of submissions distributed afterwards are routed to
+// overlap_graders different graders for independent double grading.
+type ConfigureOverlapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId   int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	OverlapPercent float64 `protobuf:"fixed64,2,opt,name=overlap_percent,json=overlapPercent,proto3" json:"overlap_percent,omitempty"`
+	OverlapGraders int32   `protobuf:"varint,3,opt,name=overlap_graders,json=overlapGraders,proto3" json:"overlap_graders,omitempty"`
+}
+
+func (x *ConfigureOverlapRequest) Reset() {
+	*x = ConfigureOverlapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureOverlapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureOverlapRequest) ProtoMessage() {}
+
+func (x *ConfigureOverlapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureOverlapRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureOverlapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ConfigureOverlapRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ConfigureOverlapRequest) GetOverlapPercent() float64 {
+	if x != nil {
+		return x.OverlapPercent
+	}
+	return 0
+}
+
+func (x *ConfigureOverlapRequest) GetOverlapGraders() int32 {
+	if x != nil {
+		return x.OverlapGraders
+	}
+	return 0
+}
+
+type OverlapSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId   int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	OverlapPercent float64 `protobuf:"fixed64,2,opt,name=overlap_percent,json=overlapPercent,proto3" json:"overlap_percent,omitempty"`
+	OverlapGraders int32   `protobuf:"varint,3,opt,name=overlap_graders,json=overlapGraders,proto3" json:"overlap_graders,omitempty"`
+	Message        string  `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *OverlapSettingsResponse) Reset() {
+	*x = OverlapSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OverlapSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverlapSettingsResponse) ProtoMessage() {}
+
+func (x *OverlapSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverlapSettingsResponse.ProtoReflect.Descriptor instead.
+func (*OverlapSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *OverlapSettingsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *OverlapSettingsResponse) GetOverlapPercent() float64 {
+	if x != nil {
+		return x.OverlapPercent
+	}
+	return 0
+}
+
+func (x *OverlapSettingsResponse) GetOverlapGraders() int32 {
+	if x != nil {
+		return x.OverlapGraders
+	}
+	return 0
+}
+
+func (x *OverlapSettingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Messages for Calibration service
+type CalibrationGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId int64                `protobuf:"varint,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	GraderId     int64                `protobuf:"varint,4,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName   string               `protobuf:"bytes,5,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Gold         bool                 `protobuf:"varint,6,opt,name=gold,proto3" json:"gold,omitempty"`
+	RubricScores map[string]float64   `protobuf:"bytes,7,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalScore   float64              `protobuf:"fixed64,8,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	GradedAt     *timestamp.Timestamp `protobuf:"bytes,9,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	// Version of the rubric the scores were given against. TA attempts are only
+	// compared with a gold grade on the same version.
+	RubricVersionId int64 `protobuf:"varint,10,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *CalibrationGrade) Reset() {
+	*x = CalibrationGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationGrade) ProtoMessage() {}
+
+func (x *CalibrationGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationGrade.ProtoReflect.Descriptor instead.
+func (*CalibrationGrade) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CalibrationGrade) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CalibrationGrade) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CalibrationGrade) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *CalibrationGrade) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *CalibrationGrade) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *CalibrationGrade) GetGold() bool {
+	if x != nil {
+		return x.Gold
+	}
+	return false
+}
+
+func (x *CalibrationGrade) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *CalibrationGrade) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *CalibrationGrade) GetGradedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *CalibrationGrade) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+// The instructor's reference grade for a calibration submission. Setting a
+// gold grade on a submission adds it to the assignment's calibration set.
+// Calibration scores are validated like SubmitGradeRequest and total_score
+// is likewise computed by the server.
+type SetGoldGradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64              `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId int64              `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	RubricScores map[string]float64 `protobuf:"bytes,3,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalScore   float64            `protobuf:"fixed64,4,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+}
+
+func (x *SetGoldGradeRequest) Reset() {
+	*x = SetGoldGradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGoldGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGoldGradeRequest) ProtoMessage() {}
+
+func (x *SetGoldGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGoldGradeRequest.ProtoReflect.Descriptor instead.
+func (*SetGoldGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SetGoldGradeRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SetGoldGradeRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *SetGoldGradeRequest) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *SetGoldGradeRequest) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+type SubmitCalibrationGradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64              `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId int64              `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	RubricScores map[string]float64 `protobuf:"bytes,3,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalScore   float64            `protobuf:"fixed64,4,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+}
+
+func (x *SubmitCalibrationGradeRequest) Reset() {
+	*x = SubmitCalibrationGradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitCalibrationGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCalibrationGradeRequest) ProtoMessage() {}
+
+func (x *SubmitCalibrationGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCalibrationGradeRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCalibrationGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SubmitCalibrationGradeRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SubmitCalibrationGradeRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *SubmitCalibrationGradeRequest) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *SubmitCalibrationGradeRequest) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+type CalibrationGradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grade   *CalibrationGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Message string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CalibrationGradeResponse) Reset() {
+	*x = CalibrationGradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationGradeResponse) ProtoMessage() {}
+
+func (x *CalibrationGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationGradeResponse.ProtoReflect.Descriptor instead.
+func (*CalibrationGradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CalibrationGradeResponse) GetGrade() *CalibrationGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *CalibrationGradeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCalibrationSubmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *ListCalibrationSubmissionsRequest) Reset() {
+	*x = ListCalibrationSubmissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCalibrationSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalibrationSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListCalibrationSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalibrationSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalibrationSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListCalibrationSubmissionsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type CalibrationSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId int64  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId    string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName  string `protobuf:"bytes,3,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	FileName     string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Graded       bool   `protobuf:"varint,5,opt,name=graded,proto3" json:"graded,omitempty"`
+	GradeId      int64  `protobuf:"varint,6,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+}
+
+func (x *CalibrationSubmission) Reset() {
+	*x = CalibrationSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationSubmission) ProtoMessage() {}
+
+func (x *CalibrationSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationSubmission.ProtoReflect.Descriptor instead.
+func (*CalibrationSubmission) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CalibrationSubmission) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *CalibrationSubmission) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CalibrationSubmission) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *CalibrationSubmission) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CalibrationSubmission) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *CalibrationSubmission) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+// status is the caller's calibration status and is empty for instructors.
+type CalibrationSubmissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64                    `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Threshold    float64                  `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Status       string                   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Submissions  []*CalibrationSubmission `protobuf:"bytes,4,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *CalibrationSubmissionsResponse) Reset() {
+	*x = CalibrationSubmissionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationSubmissionsResponse) ProtoMessage() {}
+
+func (x *CalibrationSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*CalibrationSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CalibrationSubmissionsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CalibrationSubmissionsResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CalibrationSubmissionsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CalibrationSubmissionsResponse) GetSubmissions() []*CalibrationSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// grader_id defaults to every TA for instructors; TAs only see their own report.
+type GetCalibrationReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	GraderId     int64 `protobuf:"varint,2,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+}
+
+func (x *GetCalibrationReportRequest) Reset() {
+	*x = GetCalibrationReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalibrationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalibrationReportRequest) ProtoMessage() {}
+
+func (x *GetCalibrationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalibrationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCalibrationReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetCalibrationReportRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetCalibrationReportRequest) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+// Deviations are the grader's score minus the gold score, averaged over the
+// calibration submissions the grader has graded.
+type CriterionDeviation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key                   string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label                 string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	GoldMean              float64 `protobuf:"fixed64,3,opt,name=gold_mean,json=goldMean,proto3" json:"gold_mean,omitempty"`
+	GraderMean            float64 `protobuf:"fixed64,4,opt,name=grader_mean,json=graderMean,proto3" json:"grader_mean,omitempty"`
+	MeanDeviation         float64 `protobuf:"fixed64,5,opt,name=mean_deviation,json=meanDeviation,proto3" json:"mean_deviation,omitempty"`
+	MeanAbsoluteDeviation float64 `protobuf:"fixed64,6,opt,name=mean_absolute_deviation,json=meanAbsoluteDeviation,proto3" json:"mean_absolute_deviation,omitempty"`
+	ExceedsThreshold      bool    `protobuf:"varint,7,opt,name=exceeds_threshold,json=exceedsThreshold,proto3" json:"exceeds_threshold,omitempty"`
+}
+
+func (x *CriterionDeviation) Reset() {
+	*x = CriterionDeviation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriterionDeviation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionDeviation) ProtoMessage() {}
+
+func (x *CriterionDeviation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionDeviation.ProtoReflect.Descriptor instead.
+func (*CriterionDeviation) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CriterionDeviation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CriterionDeviation) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CriterionDeviation) GetGoldMean() float64 {
+	if x != nil {
+		return x.GoldMean
+	}
+	return 0
+}
+
+func (x *CriterionDeviation) GetGraderMean() float64 {
+	if x != nil {
+		return x.GraderMean
+	}
+	return 0
+}
+
+func (x *CriterionDeviation) GetMeanDeviation() float64 {
+	if x != nil {
+		return x.MeanDeviation
+	}
+	return 0
+}
+
+func (x *CriterionDeviation) GetMeanAbsoluteDeviation() float64 {
+	if x != nil {
+		return x.MeanAbsoluteDeviation
+	}
+	return 0
+}
+
+func (x *CriterionDeviation) GetExceedsThreshold() bool {
+	if x != nil {
+		return x.ExceedsThreshold
+	}
+	return false
+}
+
+// status is one of not_started, in_progress, passed or failed.
+type GraderCalibration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId                   int64                 `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName                 string                `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Graded                     int32                 `protobuf:"varint,3,opt,name=graded,proto3" json:"graded,omitempty"`
+	Status                     string                `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Blocked                    bool                  `protobuf:"varint,5,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Criteria                   []*CriterionDeviation `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	TotalMeanDeviation         float64               `protobuf:"fixed64,7,opt,name=total_mean_deviation,json=totalMeanDeviation,proto3" json:"total_mean_deviation,omitempty"`
+	TotalMeanAbsoluteDeviation float64               `protobuf:"fixed64,8,opt,name=total_mean_absolute_deviation,json=totalMeanAbsoluteDeviation,proto3" json:"total_mean_absolute_deviation,omitempty"`
+	MaxCriterionDeviation      float64               `protobuf:"fixed64,9,opt,name=max_criterion_deviation,json=maxCriterionDeviation,proto3" json:"max_criterion_deviation,omitempty"`
+}
+
+func (x *GraderCalibration) Reset() {
+	*x = GraderCalibration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderCalibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderCalibration) ProtoMessage() {}
+
+func (x *GraderCalibration) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderCalibration.ProtoReflect.Descriptor instead.
+func (*GraderCalibration) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GraderCalibration) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderCalibration) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderCalibration) GetGraded() int32 {
+	if x != nil {
+		return x.Graded
+	}
+	return 0
+}
+
+func (x *GraderCalibration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GraderCalibration) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *GraderCalibration) GetCriteria() []*CriterionDeviation {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *GraderCalibration) GetTotalMeanDeviation() float64 {
+	if x != nil {
+		return x.TotalMeanDeviation
+	}
+	return 0
+}
+
+func (x *GraderCalibration) GetTotalMeanAbsoluteDeviation() float64 {
+	if x != nil {
+		return x.TotalMeanAbsoluteDeviation
+	}
+	return 0
+}
+
+func (x *GraderCalibration) GetMaxCriterionDeviation() float64 {
+	if x != nil {
+		return x.MaxCriterionDeviation
+	}
+	return 0
+}
+
+type CalibrationReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64                `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Threshold       float64              `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	GoldSubmissions int32                `protobuf:"varint,3,opt,name=gold_submissions,json=goldSubmissions,proto3" json:"gold_submissions,omitempty"`
+	Graders         []*GraderCalibration `protobuf:"bytes,4,rep,name=graders,proto3" json:"graders,omitempty"`
+}
+
+func (x *CalibrationReportResponse) Reset() {
+	*x = CalibrationReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationReportResponse) ProtoMessage() {}
+
+func (x *CalibrationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationReportResponse.ProtoReflect.Descriptor instead.
+func (*CalibrationReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CalibrationReportResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CalibrationReportResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CalibrationReportResponse) GetGoldSubmissions() int32 {
+	if x != nil {
+		return x.GoldSubmissions
+	}
+	return 0
+}
+
+func (x *CalibrationReportResponse) GetGraders() []*GraderCalibration {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+// TAs whose mean absolute deviation on any criterion exceeds threshold are
+// blocked from their grading queue. A threshold of 0 turns blocking off.
+type ConfigureCalibrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Threshold    float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *ConfigureCalibrationRequest) Reset() {
+	*x = ConfigureCalibrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigureCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureCalibrationRequest) ProtoMessage() {}
+
+func (x *ConfigureCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ConfigureCalibrationRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ConfigureCalibrationRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type CalibrationSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Threshold       float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	GoldSubmissions int32   `protobuf:"varint,3,opt,name=gold_submissions,json=goldSubmissions,proto3" json:"gold_submissions,omitempty"`
+	Message         string  `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CalibrationSettingsResponse) Reset() {
+	*x = CalibrationSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationSettingsResponse) ProtoMessage() {}
+
+func (x *CalibrationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*CalibrationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CalibrationSettingsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CalibrationSettingsResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CalibrationSettingsResponse) GetGoldSubmissions() int32 {
+	if x != nil {
+		return x.GoldSubmissions
+	}
+	return 0
+}
+
+func (x *CalibrationSettingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Clears a TA's calibration grades so they can calibrate again.
+type ResetCalibrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	GraderId     int64 `protobuf:"varint,2,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+}
+
+func (x *ResetCalibrationRequest) Reset() {
+	*x = ResetCalibrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalibrationRequest) ProtoMessage() {}
+
+func (x *ResetCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*ResetCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ResetCalibrationRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ResetCalibrationRequest) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+type ResetCalibrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cleared int32  `protobuf:"varint,3,opt,name=cleared,proto3" json:"cleared,omitempty"`
+}
+
+func (x *ResetCalibrationResponse) Reset() {
+	*x = ResetCalibrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetCalibrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCalibrationResponse) ProtoMessage() {}
+
+func (x *ResetCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*ResetCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ResetCalibrationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResetCalibrationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResetCalibrationResponse) GetCleared() int32 {
+	if x != nil {
+		return x.Cleared
+	}
+	return 0
+}
+
+// Messages for Regrade service
+// status moves from open to assigned when staff take the request on, then to
+// accepted or rejected, and finally to resolved once the outcome is settled.
+// criteria are the rubric criterion indexes being disputed.
+type RegradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GradeId         int64    `protobuf:"varint,2,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	AssignmentId    int64    `protobuf:"varint,3,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionId    int64    `protobuf:"varint,4,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId       string   `protobuf:"bytes,5,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	GraderId        int64    `protobuf:"varint,6,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName      string   `protobuf:"bytes,7,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Criteria        []string `protobuf:"bytes,8,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	CriterionLabels []string `protobuf:"bytes,9,rep,name=criterion_labels,json=criterionLabels,proto3" json:"criterion_labels,omitempty"`
+	Justification   string   `protobuf:"bytes,10,opt,name=justification,proto3" json:"justification,omitempty"`
+	Status          string   `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy     int64    `protobuf:"varint,12,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedByName string   `protobuf:"bytes,13,opt,name=requested_by_name,json=requestedByName,proto3" json:"requested_by_name,omitempty"`
+	AssignedTo      int64    `protobuf:"varint,14,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	AssignedToName  string   `protobuf:"bytes,15,opt,name=assigned_to_name,json=assignedToName,proto3" json:"assigned_to_name,omitempty"`
+	Response        string   `protobuf:"bytes,16,opt,name=response,proto3" json:"response,omitempty"`
+	// Comment thread on the grade that the request and its replies are posted to
+	CommentId int64 `protobuf:"varint,17,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Grade revision made when the request was accepted, if the scores changed
+	RevisionId int64                `protobuf:"varint,18,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	CreatedAt  *timestamp.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamp.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResolvedAt *timestamp.Timestamp `protobuf:"bytes,21,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+}
+
+func (x *RegradeRequest) Reset() {
+	*x = RegradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeRequest) ProtoMessage() {}
+
+func (x *RegradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeRequest.ProtoReflect.Descriptor instead.
+func (*RegradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *RegradeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetCriteria() []string {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RegradeRequest) GetCriterionLabels() []string {
+	if x != nil {
+		return x.CriterionLabels
+	}
+	return nil
+}
+
+func (x *RegradeRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetRequestedBy() int64 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetRequestedByName() string {
+	if x != nil {
+		return x.RequestedByName
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetAssignedTo() int64 {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetAssignedToName() string {
+	if x != nil {
+		return x.AssignedToName
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RegradeRequest) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RegradeRequest) GetResolvedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type CreateRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId       int64    `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	Criteria      []string `protobuf:"bytes,2,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Justification string   `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (x *CreateRegradeRequestRequest) Reset() {
+	*x = CreateRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRegradeRequestRequest) ProtoMessage() {}
+
+func (x *CreateRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CreateRegradeRequestRequest) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *CreateRegradeRequestRequest) GetCriteria() []string {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *CreateRegradeRequestRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+type RegradeRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *RegradeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RegradeRequestResponse) Reset() {
+	*x = RegradeRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeRequestResponse) ProtoMessage() {}
+
+func (x *RegradeRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeRequestResponse.ProtoReflect.Descriptor instead.
+func (*RegradeRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RegradeRequestResponse) GetRequest() *RegradeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *RegradeRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Instructors see every request; TAs see requests on their own grades and
+// requests they filed or were assigned. assigned_to_me limits either to the
+// caller's assignments.
+type ListRegradeRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedToMe bool   `protobuf:"varint,3,opt,name=assigned_to_me,json=assignedToMe,proto3" json:"assigned_to_me,omitempty"`
+}
+
+func (x *ListRegradeRequestsRequest) Reset() {
+	*x = ListRegradeRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegradeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegradeRequestsRequest) ProtoMessage() {}
+
+func (x *ListRegradeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegradeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegradeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListRegradeRequestsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ListRegradeRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRegradeRequestsRequest) GetAssignedToMe() bool {
+	if x != nil {
+		return x.AssignedToMe
+	}
+	return false
+}
+
+type ListRegradeRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*RegradeRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListRegradeRequestsResponse) Reset() {
+	*x = ListRegradeRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegradeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegradeRequestsResponse) ProtoMessage() {}
+
+func (x *ListRegradeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegradeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegradeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListRegradeRequestsResponse) GetRequests() []*RegradeRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type GetRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRegradeRequestRequest) Reset() {
+	*x = GetRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegradeRequestRequest) ProtoMessage() {}
+
+func (x *GetRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetRegradeRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Only instructors assign requests, to any instructor or TA of the course
+type AssignRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssigneeId int64 `protobuf:"varint,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+}
+
+func (x *AssignRegradeRequestRequest) Reset() {
+	*x = AssignRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRegradeRequestRequest) ProtoMessage() {}
+
+func (x *AssignRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*AssignRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AssignRegradeRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignRegradeRequestRequest) GetAssigneeId() int64 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+// rubric_scores may only change the criteria named in the request and is
+// merged into the grade's current scores. expected_version is optional; when
+// set it must match the grade's version as in SubmitGradeRequest.
+type AcceptRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RubricScores    map[string]float64 `protobuf:"bytes,2,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Response        string             `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	ExpectedVersion int32              `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *AcceptRegradeRequestRequest) Reset() {
+	*x = AcceptRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptRegradeRequestRequest) ProtoMessage() {}
+
+func (x *AcceptRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AcceptRegradeRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcceptRegradeRequestRequest) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *AcceptRegradeRequestRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *AcceptRegradeRequestRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RejectRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Response string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *RejectRegradeRequestRequest) Reset() {
+	*x = RejectRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRegradeRequestRequest) ProtoMessage() {}
+
+func (x *RejectRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *RejectRegradeRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectRegradeRequestRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type ResolveRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Response string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *ResolveRegradeRequestRequest) Reset() {
+	*x = ResolveRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRegradeRequestRequest) ProtoMessage() {}
+
+func (x *ResolveRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ResolveRegradeRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveRegradeRequestRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type GetRegradeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetRegradeStatsRequest) Reset() {
+	*x = GetRegradeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegradeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegradeStatsRequest) ProtoMessage() {}
+
+func (x *GetRegradeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegradeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRegradeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetRegradeStatsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+// Regrade requests against one grader's grades
+type GraderRegradeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId   int64  `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName string `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Total      int32  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Open       int32  `protobuf:"varint,4,opt,name=open,proto3" json:"open,omitempty"`
+	Assigned   int32  `protobuf:"varint,5,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	Accepted   int32  `protobuf:"varint,6,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected   int32  `protobuf:"varint,7,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Resolved   int32  `protobuf:"varint,8,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// Total score change from accepted requests
+	PointsChanged float64 `protobuf:"fixed64,9,opt,name=points_changed,json=pointsChanged,proto3" json:"points_changed,omitempty"`
+}
+
+func (x *GraderRegradeStats) Reset() {
+	*x = GraderRegradeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderRegradeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderRegradeStats) ProtoMessage() {}
+
+func (x *GraderRegradeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderRegradeStats.ProtoReflect.Descriptor instead.
+func (*GraderRegradeStats) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GraderRegradeStats) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderRegradeStats) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetAssigned() int32 {
+	if x != nil {
+		return x.Assigned
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetResolved() int32 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
+}
+
+func (x *GraderRegradeStats) GetPointsChanged() float64 {
+	if x != nil {
+		return x.PointsChanged
+	}
+	return 0
+}
+
+type RegradeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64                 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Total        int32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Graders      []*GraderRegradeStats `protobuf:"bytes,3,rep,name=graders,proto3" json:"graders,omitempty"`
+}
+
+func (x *RegradeStatsResponse) Reset() {
+	*x = RegradeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeStatsResponse) ProtoMessage() {}
+
+func (x *RegradeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeStatsResponse.ProtoReflect.Descriptor instead.
+func (*RegradeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RegradeStatsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *RegradeStatsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RegradeStatsResponse) GetGraders() []*GraderRegradeStats {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+// Messages for Student service
+type ListStudentCoursesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListStudentCoursesRequest) Reset() {
+	*x = ListStudentCoursesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStudentCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentCoursesRequest) ProtoMessage() {}
+
+func (x *ListStudentCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentCoursesRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{143}
+}
+
+type StudentCourse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code           string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Description    string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Semester       string `protobuf:"bytes,5,opt,name=semester,proto3" json:"semester,omitempty"`
+	Year           int32  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	InstructorName string `protobuf:"bytes,7,opt,name=instructor_name,json=instructorName,proto3" json:"instructor_name,omitempty"`
+}
+
+func (x *StudentCourse) Reset() {
+	*x = StudentCourse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentCourse) ProtoMessage() {}
+
+func (x *StudentCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentCourse.ProtoReflect.Descriptor instead.
+func (*StudentCourse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *StudentCourse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StudentCourse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudentCourse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StudentCourse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StudentCourse) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *StudentCourse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *StudentCourse) GetInstructorName() string {
+	if x != nil {
+		return x.InstructorName
+	}
+	return ""
+}
+
+type StudentCoursesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courses []*StudentCourse `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+}
+
+func (x *StudentCoursesResponse) Reset() {
+	*x = StudentCoursesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentCoursesResponse) ProtoMessage() {}
+
+func (x *StudentCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentCoursesResponse.ProtoReflect.Descriptor instead.
+func (*StudentCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *StudentCoursesResponse) GetCourses() []*StudentCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type ListStudentAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId int64 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+}
+
+func (x *ListStudentAssignmentsRequest) Reset() {
+	*x = ListStudentAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStudentAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListStudentAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListStudentAssignmentsRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+// total_score is only set once the assignment's grades are released
+type StudentAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId        int64                `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Name            string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string               `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	MaxScore        float64              `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Released        bool                 `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"`
+	SubmissionCount int32                `protobuf:"varint,8,opt,name=submission_count,json=submissionCount,proto3" json:"submission_count,omitempty"`
+	Graded          bool                 `protobuf:"varint,9,opt,name=graded,proto3" json:"graded,omitempty"`
+	TotalScore      float64              `protobuf:"fixed64,10,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+}
+
+func (x *StudentAssignment) Reset() {
+	*x = StudentAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAssignment) ProtoMessage() {}
+
+func (x *StudentAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAssignment.ProtoReflect.Descriptor instead.
+func (*StudentAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *StudentAssignment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StudentAssignment) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *StudentAssignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetDueDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *StudentAssignment) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *StudentAssignment) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *StudentAssignment) GetSubmissionCount() int32 {
+	if x != nil {
+		return x.SubmissionCount
+	}
+	return 0
+}
+
+func (x *StudentAssignment) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *StudentAssignment) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+type StudentAssignmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*StudentAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *StudentAssignmentsResponse) Reset() {
+	*x = StudentAssignmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAssignmentsResponse) ProtoMessage() {}
+
+func (x *StudentAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*StudentAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *StudentAssignmentsResponse) GetAssignments() []*StudentAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// Both filters are optional
+type ListStudentSubmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId     int64 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	AssignmentId int64 `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *ListStudentSubmissionsRequest) Reset() {
+	*x = ListStudentSubmissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStudentSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListStudentSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListStudentSubmissionsRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListStudentSubmissionsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type StudentSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId   int64                `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	AssignmentName string               `protobuf:"bytes,3,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
+	FileName       string               `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	UploadedAt     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+}
+
+func (x *StudentSubmission) Reset() {
+	*x = StudentSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSubmission) ProtoMessage() {}
+
+func (x *StudentSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSubmission.ProtoReflect.Descriptor instead.
+func (*StudentSubmission) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *StudentSubmission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StudentSubmission) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *StudentSubmission) GetAssignmentName() string {
+	if x != nil {
+		return x.AssignmentName
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetUploadedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+type StudentSubmissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*StudentSubmission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *StudentSubmissionsResponse) Reset() {
+	*x = StudentSubmissionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSubmissionsResponse) ProtoMessage() {}
+
+func (x *StudentSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*StudentSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *StudentSubmissionsResponse) GetSubmissions() []*StudentSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type GetStudentGradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetStudentGradeRequest) Reset() {
+	*x = GetStudentGradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStudentGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentGradeRequest) ProtoMessage() {}
+
+func (x *GetStudentGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentGradeRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentGradeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetStudentGradeRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type StudentCriterionScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label    string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Score    float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore float64 `protobuf:"fixed64,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Graded   bool    `protobuf:"varint,5,opt,name=graded,proto3" json:"graded,omitempty"`
+	Feedback string  `protobuf:"bytes,6,opt,name=feedback,proto3" json:"feedback,omitempty"`
+}
+
+func (x *StudentCriterionScore) Reset() {
+	*x = StudentCriterionScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentCriterionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentCriterionScore) ProtoMessage() {}
+
+func (x *StudentCriterionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentCriterionScore.ProtoReflect.Descriptor instead.
+func (*StudentCriterionScore) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *StudentCriterionScore) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StudentCriterionScore) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *StudentCriterionScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *StudentCriterionScore) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *StudentCriterionScore) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *StudentCriterionScore) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+// grader_name is only set when the course shows graders to students
+type StudentGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId   int64                    `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	AssignmentName string                   `protobuf:"bytes,2,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
+	SubmissionId   int64                    `protobuf:"varint,3,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	TotalScore     float64                  `protobuf:"fixed64,4,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	MaxScore       float64                  `protobuf:"fixed64,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Criteria       []*StudentCriterionScore `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	GraderName     string                   `protobuf:"bytes,7,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	GradedAt       *timestamp.Timestamp     `protobuf:"bytes,8,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	UpdatedAt      *timestamp.Timestamp     `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Overall comment from the grader
+	Feedback string `protobuf:"bytes,10,opt,name=feedback,proto3" json:"feedback,omitempty"`
+}
+
+func (x *StudentGrade) Reset() {
+	*x = StudentGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGrade) ProtoMessage() {}
+
+func (x *StudentGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGrade.ProtoReflect.Descriptor instead.
+func (*StudentGrade) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *StudentGrade) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *StudentGrade) GetAssignmentName() string {
+	if x != nil {
+		return x.AssignmentName
+	}
+	return ""
+}
+
+func (x *StudentGrade) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *StudentGrade) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *StudentGrade) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *StudentGrade) GetCriteria() []*StudentCriterionScore {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *StudentGrade) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *StudentGrade) GetGradedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *StudentGrade) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StudentGrade) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+type StudentGradeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grade *StudentGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+}
+
+func (x *StudentGradeResponse) Reset() {
+	*x = StudentGradeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGradeResponse) ProtoMessage() {}
+
+func (x *StudentGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGradeResponse.ProtoReflect.Descriptor instead.
+func (*StudentGradeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *StudentGradeResponse) GetGrade() *StudentGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// A student files a regrade request against the released grade they see for
+// an assignment, so they never need to know its grade ID
+type CreateStudentRegradeRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId  int64    `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Criteria      []string `protobuf:"bytes,2,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Justification string   `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (x *CreateStudentRegradeRequestRequest) Reset() {
+	*x = CreateStudentRegradeRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStudentRegradeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStudentRegradeRequestRequest) ProtoMessage() {}
+
+func (x *CreateStudentRegradeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStudentRegradeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateStudentRegradeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *CreateStudentRegradeRequestRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CreateStudentRegradeRequestRequest) GetCriteria() []string {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *CreateStudentRegradeRequestRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+type GetStudentRubricRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetStudentRubricRequest) Reset() {
+	*x = GetStudentRubricRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStudentRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentRubricRequest) ProtoMessage() {}
+
+func (x *GetStudentRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentRubricRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentRubricRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetStudentRubricRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type StudentRubricCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label    string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	MaxScore float64 `protobuf:"fixed64,3,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+}
+
+func (x *StudentRubricCriterion) Reset() {
+	*x = StudentRubricCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentRubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentRubricCriterion) ProtoMessage() {}
+
+func (x *StudentRubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentRubricCriterion.ProtoReflect.Descriptor instead.
+func (*StudentRubricCriterion) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *StudentRubricCriterion) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StudentRubricCriterion) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *StudentRubricCriterion) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+type StudentRubricResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64                     `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricName   string                    `protobuf:"bytes,2,opt,name=rubric_name,json=rubricName,proto3" json:"rubric_name,omitempty"`
+	Criteria     []*StudentRubricCriterion `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	// Sub-criteria and performance level descriptors
+	CriterionTree []*RubricCriterion `protobuf:"bytes,4,rep,name=criterion_tree,json=criterionTree,proto3" json:"criterion_tree,omitempty"`
+}
+
+func (x *StudentRubricResponse) Reset() {
+	*x = StudentRubricResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentRubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentRubricResponse) ProtoMessage() {}
+
+func (x *StudentRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentRubricResponse.ProtoReflect.Descriptor instead.
+func (*StudentRubricResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *StudentRubricResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *StudentRubricResponse) GetRubricName() string {
+	if x != nil {
+		return x.RubricName
+	}
+	return ""
+}
+
+func (x *StudentRubricResponse) GetCriteria() []*StudentRubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *StudentRubricResponse) GetCriterionTree() []*RubricCriterion {
+	if x != nil {
+		return x.CriterionTree
+	}
+	return nil
+}
+
+// Messages for CommentBank service
+type BankComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RubricId int64 `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	// Rubric criterion index, as used in rubric_scores
+	CriterionKey   string `protobuf:"bytes,3,opt,name=criterion_key,json=criterionKey,proto3" json:"criterion_key,omitempty"`
+	CriterionLabel string `protobuf:"bytes,4,opt,name=criterion_label,json=criterionLabel,proto3" json:"criterion_label,omitempty"`
+	Text           string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// Points taken off the criterion when the comment is applied
+	Deduction     float64              `protobuf:"fixed64,6,opt,name=deduction,proto3" json:"deduction,omitempty"`
+	CreatedBy     int64                `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedByName string               `protobuf:"bytes,8,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name,omitempty"`
+	UsageCount    int32                `protobuf:"varint,9,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Rubric version whose criteria criterion_key refers to
+	RubricVersionId int64 `protobuf:"varint,12,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *BankComment) Reset() {
+	*x = BankComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankComment) ProtoMessage() {}
+
+func (x *BankComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankComment.ProtoReflect.Descriptor instead.
+func (*BankComment) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *BankComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BankComment) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *BankComment) GetCriterionKey() string {
+	if x != nil {
+		return x.CriterionKey
+	}
+	return ""
+}
+
+func (x *BankComment) GetCriterionLabel() string {
+	if x != nil {
+		return x.CriterionLabel
+	}
+	return ""
+}
+
+func (x *BankComment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *BankComment) GetDeduction() float64 {
+	if x != nil {
+		return x.Deduction
+	}
+	return 0
+}
+
+func (x *BankComment) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *BankComment) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *BankComment) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *BankComment) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BankComment) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BankComment) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+type CreateBankCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId     int64   `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	CriterionKey string  `protobuf:"bytes,2,opt,name=criterion_key,json=criterionKey,proto3" json:"criterion_key,omitempty"`
+	Text         string  `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Deduction    float64 `protobuf:"fixed64,4,opt,name=deduction,proto3" json:"deduction,omitempty"`
+}
+
+func (x *CreateBankCommentRequest) Reset() {
+	*x = CreateBankCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBankCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBankCommentRequest) ProtoMessage() {}
+
+func (x *CreateBankCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBankCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateBankCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *CreateBankCommentRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *CreateBankCommentRequest) GetCriterionKey() string {
+	if x != nil {
+		return x.CriterionKey
+	}
+	return ""
+}
+
+func (x *CreateBankCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateBankCommentRequest) GetDeduction() float64 {
+	if x != nil {
+		return x.Deduction
+	}
+	return 0
+}
+
+// Lists the bank of rubric_id, or of the rubric assignment_id is graded with
+type ListBankCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId     int64  `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	AssignmentId int64  `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	CriterionKey string `protobuf:"bytes,3,opt,name=criterion_key,json=criterionKey,proto3" json:"criterion_key,omitempty"`
+}
+
+func (x *ListBankCommentsRequest) Reset() {
+	*x = ListBankCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBankCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankCommentsRequest) ProtoMessage() {}
+
+func (x *ListBankCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListBankCommentsRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *ListBankCommentsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ListBankCommentsRequest) GetCriterionKey() string {
+	if x != nil {
+		return x.CriterionKey
+	}
+	return ""
+}
+
+type ListBankCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*BankComment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *ListBankCommentsResponse) Reset() {
+	*x = ListBankCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBankCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankCommentsResponse) ProtoMessage() {}
+
+func (x *ListBankCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListBankCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListBankCommentsResponse) GetComments() []*BankComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type UpdateBankCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Deduction float64 `protobuf:"fixed64,3,opt,name=deduction,proto3" json:"deduction,omitempty"`
+}
+
+func (x *UpdateBankCommentRequest) Reset() {
+	*x = UpdateBankCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBankCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankCommentRequest) ProtoMessage() {}
+
+func (x *UpdateBankCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBankCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *UpdateBankCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBankCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UpdateBankCommentRequest) GetDeduction() float64 {
+	if x != nil {
+		return x.Deduction
+	}
+	return 0
+}
+
+type BankCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *BankComment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Message string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BankCommentResponse) Reset() {
+	*x = BankCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankCommentResponse) ProtoMessage() {}
+
+func (x *BankCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankCommentResponse.ProtoReflect.Descriptor instead.
+func (*BankCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *BankCommentResponse) GetComment() *BankComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *BankCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Deleted comments are hidden from the bank; grades they were applied to keep
+// their scores and feedback
+type DeleteBankCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteBankCommentRequest) Reset() {
+	*x = DeleteBankCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBankCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBankCommentRequest) ProtoMessage() {}
+
+func (x *DeleteBankCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBankCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBankCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *DeleteBankCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBankCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteBankCommentResponse) Reset() {
+	*x = DeleteBankCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBankCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBankCommentResponse) ProtoMessage() {}
+
+func (x *DeleteBankCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBankCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBankCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *DeleteBankCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Applies a comment to, or removes it from, the caller's grade of the
+// submission. Applying to an ungraded submission starts a grade with the
+// criterion at full marks less the deduction.
+type ApplyBankCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId    int64 `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	CommentId       int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	ExpectedVersion int32 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *ApplyBankCommentRequest) Reset() {
+	*x = ApplyBankCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBankCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBankCommentRequest) ProtoMessage() {}
+
+func (x *ApplyBankCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBankCommentRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBankCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ApplyBankCommentRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *ApplyBankCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ApplyBankCommentRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type AppliedBankComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId    int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	CriterionKey string `protobuf:"bytes,2,opt,name=criterion_key,json=criterionKey,proto3" json:"criterion_key,omitempty"`
+	Text         string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// Points actually taken off, which is less than the preset deduction when
+	// the criterion had fewer points left
+	Deduction float64              `protobuf:"fixed64,4,opt,name=deduction,proto3" json:"deduction,omitempty"`
+	AppliedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+}
+
+func (x *AppliedBankComment) Reset() {
+	*x = AppliedBankComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppliedBankComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedBankComment) ProtoMessage() {}
+
+func (x *AppliedBankComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedBankComment.ProtoReflect.Descriptor instead.
+func (*AppliedBankComment) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *AppliedBankComment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *AppliedBankComment) GetCriterionKey() string {
+	if x != nil {
+		return x.CriterionKey
+	}
+	return ""
+}
+
+func (x *AppliedBankComment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AppliedBankComment) GetDeduction() float64 {
+	if x != nil {
+		return x.Deduction
+	}
+	return 0
+}
+
+func (x *AppliedBankComment) GetAppliedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+type GetCommentBankStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *GetCommentBankStatsRequest) Reset() {
+	*x = GetCommentBankStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentBankStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentBankStatsRequest) ProtoMessage() {}
+
+func (x *GetCommentBankStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentBankStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentBankStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetCommentBankStatsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+// How one grader used a bank comment. mean_points_lost is the average points
+// the criterion lost on the grades it was applied to.
+type GraderBankCommentUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId       int64   `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName     string  `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	UsageCount     int32   `protobuf:"varint,3,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	MeanPointsLost float64 `protobuf:"fixed64,4,opt,name=mean_points_lost,json=meanPointsLost,proto3" json:"mean_points_lost,omitempty"`
+}
+
+func (x *GraderBankCommentUsage) Reset() {
+	*x = GraderBankCommentUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderBankCommentUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderBankCommentUsage) ProtoMessage() {}
+
+func (x *GraderBankCommentUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderBankCommentUsage.ProtoReflect.Descriptor instead.
+func (*GraderBankCommentUsage) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GraderBankCommentUsage) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderBankCommentUsage) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderBankCommentUsage) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *GraderBankCommentUsage) GetMeanPointsLost() float64 {
+	if x != nil {
+		return x.MeanPointsLost
+	}
+	return 0
+}
+
+// A comment is inconsistent when graders applying it take off noticeably
+// different points for the same mistake
+type BankCommentStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId        int64                     `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	CriterionKey     string                    `protobuf:"bytes,2,opt,name=criterion_key,json=criterionKey,proto3" json:"criterion_key,omitempty"`
+	CriterionLabel   string                    `protobuf:"bytes,3,opt,name=criterion_label,json=criterionLabel,proto3" json:"criterion_label,omitempty"`
+	Text             string                    `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Deduction        float64                   `protobuf:"fixed64,5,opt,name=deduction,proto3" json:"deduction,omitempty"`
+	UsageCount       int32                     `protobuf:"varint,6,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	Graders          []*GraderBankCommentUsage `protobuf:"bytes,7,rep,name=graders,proto3" json:"graders,omitempty"`
+	PointsLostSpread float64                   `protobuf:"fixed64,8,opt,name=points_lost_spread,json=pointsLostSpread,proto3" json:"points_lost_spread,omitempty"`
+	Inconsistent     bool                      `protobuf:"varint,9,opt,name=inconsistent,proto3" json:"inconsistent,omitempty"`
+}
+
+func (x *BankCommentStats) Reset() {
+	*x = BankCommentStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankCommentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankCommentStats) ProtoMessage() {}
+
+func (x *BankCommentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankCommentStats.ProtoReflect.Descriptor instead.
+func (*BankCommentStats) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *BankCommentStats) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *BankCommentStats) GetCriterionKey() string {
+	if x != nil {
+		return x.CriterionKey
+	}
+	return ""
+}
+
+func (x *BankCommentStats) GetCriterionLabel() string {
+	if x != nil {
+		return x.CriterionLabel
+	}
+	return ""
+}
+
+func (x *BankCommentStats) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *BankCommentStats) GetDeduction() float64 {
+	if x != nil {
+		return x.Deduction
+	}
+	return 0
+}
+
+func (x *BankCommentStats) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *BankCommentStats) GetGraders() []*GraderBankCommentUsage {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+func (x *BankCommentStats) GetPointsLostSpread() float64 {
+	if x != nil {
+		return x.PointsLostSpread
+	}
+	return 0
+}
+
+func (x *BankCommentStats) GetInconsistent() bool {
+	if x != nil {
+		return x.Inconsistent
+	}
+	return false
+}
+
+type GraderBankUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId         int64  `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName       string `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Applied          int32  `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	DistinctComments int32  `protobuf:"varint,4,opt,name=distinct_comments,json=distinctComments,proto3" json:"distinct_comments,omitempty"`
+}
+
+func (x *GraderBankUsage) Reset() {
+	*x = GraderBankUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderBankUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderBankUsage) ProtoMessage() {}
+
+func (x *GraderBankUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderBankUsage.ProtoReflect.Descriptor instead.
+func (*GraderBankUsage) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *GraderBankUsage) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderBankUsage) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderBankUsage) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *GraderBankUsage) GetDistinctComments() int32 {
+	if x != nil {
+		return x.DistinctComments
+	}
+	return 0
+}
+
+type CommentBankStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64               `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Comments     []*BankCommentStats `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
+	Graders      []*GraderBankUsage  `protobuf:"bytes,3,rep,name=graders,proto3" json:"graders,omitempty"`
+}
+
+func (x *CommentBankStatsResponse) Reset() {
+	*x = CommentBankStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentBankStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentBankStatsResponse) ProtoMessage() {}
+
+func (x *CommentBankStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentBankStatsResponse.ProtoReflect.Descriptor instead.
+func (*CommentBankStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *CommentBankStatsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CommentBankStatsResponse) GetComments() []*BankCommentStats {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *CommentBankStatsResponse) GetGraders() []*GraderBankUsage {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+// Messages for Analysis service
+// Runs are scoped to an assignment when assignment_id is set, otherwise to
+// every assignment that uses rubric_id. Only grades scored against one
+// version of the rubric are analyzed together: rubric_version_id, or the
+// current version when unset. The rest are counted in excluded_grades.
+type RunAnomalyAnalysisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId        int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	AssignmentId    int64 `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricVersionId int64 `protobuf:"varint,3,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *RunAnomalyAnalysisRequest) Reset() {
+	*x = RunAnomalyAnalysisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunAnomalyAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunAnomalyAnalysisRequest) ProtoMessage() {}
+
+func (x *RunAnomalyAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunAnomalyAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*RunAnomalyAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *RunAnomalyAnalysisRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *RunAnomalyAnalysisRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *RunAnomalyAnalysisRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+// grade_id and submission_id are set for anomalies about a single grade.
+type Anomaly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	QuestionId   string            `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Severity     string            `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Details      map[string]string `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GradeId      int64             `protobuf:"varint,5,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	SubmissionId int64             `protobuf:"varint,6,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *Anomaly) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Anomaly) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *Anomaly) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Anomaly) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Anomaly) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *Anomaly) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+type Statistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mean        float64 `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	Variance    float64 `protobuf:"fixed64,2,opt,name=variance,proto3" json:"variance,omitempty"`
+	StdDev      float64 `protobuf:"fixed64,3,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Count       int32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Reliability float64 `protobuf:"fixed64,5,opt,name=reliability,proto3" json:"reliability,omitempty"`
+}
+
+func (x *Statistics) Reset() {
+	*x = Statistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Statistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statistics) ProtoMessage() {}
+
+func (x *Statistics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statistics.ProtoReflect.Descriptor instead.
+func (*Statistics) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *Statistics) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *Statistics) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *Statistics) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *Statistics) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Statistics) GetReliability() float64 {
+	if x != nil {
+		return x.Reliability
+	}
+	return 0
+}
+
+type AnomalyAnalysisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Anomalies         []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	Statistics        map[string]*Statistics `protobuf:"bytes,2,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TotalGrades       int32                  `protobuf:"varint,3,opt,name=total_grades,json=totalGrades,proto3" json:"total_grades,omitempty"`
+	AnalysisTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=analysis_timestamp,json=analysisTimestamp,proto3" json:"analysis_timestamp,omitempty"`
+	AnalysisId        int64                  `protobuf:"varint,5,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id,omitempty"`
+	CourseId          int64                  `protobuf:"varint,6,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	AssignmentId      int64                  `protobuf:"varint,7,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId          int64                  `protobuf:"varint,8,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	RubricVersionId   int64                  `protobuf:"varint,9,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades    int32                  `protobuf:"varint,10,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *AnomalyAnalysisResponse) Reset() {
+	*x = AnomalyAnalysisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyAnalysisResponse) ProtoMessage() {}
+
+func (x *AnomalyAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*AnomalyAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *AnomalyAnalysisResponse) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *AnomalyAnalysisResponse) GetStatistics() map[string]*Statistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *AnomalyAnalysisResponse) GetTotalGrades() int32 {
+	if x != nil {
+		return x.TotalGrades
+	}
+	return 0
+}
+
+func (x *AnomalyAnalysisResponse) GetAnalysisTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.AnalysisTimestamp
+	}
+	return nil
+}
+
+func (x *AnomalyAnalysisResponse) GetAnalysisId() int64 {
+	if x != nil {
+		return x.AnalysisId
+	}
+	return 0
+}
+
+func (x *AnomalyAnalysisResponse) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *AnomalyAnalysisResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *AnomalyAnalysisResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *AnomalyAnalysisResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *AnomalyAnalysisResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// History is filtered by assignment_id or rubric_id and returned newest
+// first. Pass next_before_id from a previous response as before_id to page
+// back through older runs.
+type GetAnalysisHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RubricId     int64 `protobuf:"varint,1,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	AssignmentId int64 `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	PageSize     int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	BeforeId     int64 `protobuf:"varint,4,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+}
+
+func (x *GetAnalysisHistoryRequest) Reset() {
+	*x = GetAnalysisHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnalysisHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalysisHistoryRequest) ProtoMessage() {}
+
+func (x *GetAnalysisHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalysisHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalysisHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *GetAnalysisHistoryRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *GetAnalysisHistoryRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetAnalysisHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAnalysisHistoryRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+type AnalysisResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RubricId     int64                `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	AnalysisType string               `protobuf:"bytes,3,opt,name=analysis_type,json=analysisType,proto3" json:"analysis_type,omitempty"`
+	ResultsJson  string               `protobuf:"bytes,4,opt,name=results_json,json=resultsJson,proto3" json:"results_json,omitempty"`
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CourseId     int64                `protobuf:"varint,6,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	AssignmentId int64                `protobuf:"varint,7,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	TotalGrades  int32                `protobuf:"varint,8,opt,name=total_grades,json=totalGrades,proto3" json:"total_grades,omitempty"`
+	AnomalyCount int32                `protobuf:"varint,9,opt,name=anomaly_count,json=anomalyCount,proto3" json:"anomaly_count,omitempty"`
+}
+
+func (x *AnalysisResult) Reset() {
+	*x = AnalysisResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalysisResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisResult) ProtoMessage() {}
+
+func (x *AnalysisResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisResult.ProtoReflect.Descriptor instead.
+func (*AnalysisResult) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *AnalysisResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AnalysisResult) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *AnalysisResult) GetAnalysisType() string {
+	if x != nil {
+		return x.AnalysisType
+	}
+	return ""
+}
+
+func (x *AnalysisResult) GetResultsJson() string {
+	if x != nil {
+		return x.ResultsJson
+	}
+	return ""
+}
+
+func (x *AnalysisResult) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AnalysisResult) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *AnalysisResult) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *AnalysisResult) GetTotalGrades() int32 {
+	if x != nil {
+		return x.TotalGrades
+	}
+	return 0
+}
+
+func (x *AnalysisResult) GetAnomalyCount() int32 {
+	if x != nil {
+		return x.AnomalyCount
+	}
+	return 0
+}
+
+type GetAnalysisHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*AnalysisResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextBeforeId int64             `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"`
+}
+
+func (x *GetAnalysisHistoryResponse) Reset() {
+	*x = GetAnalysisHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnalysisHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalysisHistoryResponse) ProtoMessage() {}
+
+func (x *GetAnalysisHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalysisHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalysisHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *GetAnalysisHistoryResponse) GetResults() []*AnalysisResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetAnalysisHistoryResponse) GetNextBeforeId() int64 {
+	if x != nil {
+		return x.NextBeforeId
+	}
+	return 0
+}
+
+// Inter-grader reliability is computed from submissions graded by more than
+// one TA. Scoped like RunAnomalyAnalysisRequest.
+type GetReliabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId        int64 `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	RubricVersionId int64 `protobuf:"varint,3,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *GetReliabilityRequest) Reset() {
+	*x = GetReliabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReliabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReliabilityRequest) ProtoMessage() {}
+
+func (x *GetReliabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReliabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetReliabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetReliabilityRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetReliabilityRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *GetReliabilityRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+// Krippendorff's alpha for one criterion, or for the total score when key is
+// "total". Alphas are only meaningful when sufficient_data is set.
+type ReliabilityEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key            string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label          string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	IntervalAlpha  float64 `protobuf:"fixed64,3,opt,name=interval_alpha,json=intervalAlpha,proto3" json:"interval_alpha,omitempty"`
+	OrdinalAlpha   float64 `protobuf:"fixed64,4,opt,name=ordinal_alpha,json=ordinalAlpha,proto3" json:"ordinal_alpha,omitempty"`
+	Units          int32   `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	PairableValues int32   `protobuf:"varint,6,opt,name=pairable_values,json=pairableValues,proto3" json:"pairable_values,omitempty"`
+	SufficientData bool    `protobuf:"varint,7,opt,name=sufficient_data,json=sufficientData,proto3" json:"sufficient_data,omitempty"`
+}
+
+func (x *ReliabilityEstimate) Reset() {
+	*x = ReliabilityEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReliabilityEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReliabilityEstimate) ProtoMessage() {}
+
+func (x *ReliabilityEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReliabilityEstimate.ProtoReflect.Descriptor instead.
+func (*ReliabilityEstimate) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *ReliabilityEstimate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ReliabilityEstimate) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ReliabilityEstimate) GetIntervalAlpha() float64 {
+	if x != nil {
+		return x.IntervalAlpha
+	}
+	return 0
+}
+
+func (x *ReliabilityEstimate) GetOrdinalAlpha() float64 {
+	if x != nil {
+		return x.OrdinalAlpha
+	}
+	return 0
+}
+
+func (x *ReliabilityEstimate) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *ReliabilityEstimate) GetPairableValues() int32 {
+	if x != nil {
+		return x.PairableValues
+	}
+	return 0
+}
+
+func (x *ReliabilityEstimate) GetSufficientData() bool {
+	if x != nil {
+		return x.SufficientData
+	}
+	return false
+}
+
+type ReliabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId            int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId                int64                  `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	DoubleGradedSubmissions int32                  `protobuf:"varint,3,opt,name=double_graded_submissions,json=doubleGradedSubmissions,proto3" json:"double_graded_submissions,omitempty"`
+	Overall                 *ReliabilityEstimate   `protobuf:"bytes,4,opt,name=overall,proto3" json:"overall,omitempty"`
+	Criteria                []*ReliabilityEstimate `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	RubricVersionId         int64                  `protobuf:"varint,6,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades          int32                  `protobuf:"varint,7,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *ReliabilityResponse) Reset() {
+	*x = ReliabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReliabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReliabilityResponse) ProtoMessage() {}
+
+func (x *ReliabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReliabilityResponse.ProtoReflect.Descriptor instead.
+func (*ReliabilityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ReliabilityResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ReliabilityResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *ReliabilityResponse) GetDoubleGradedSubmissions() int32 {
+	if x != nil {
+		return x.DoubleGradedSubmissions
+	}
+	return 0
+}
+
+func (x *ReliabilityResponse) GetOverall() *ReliabilityEstimate {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *ReliabilityResponse) GetCriteria() []*ReliabilityEstimate {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *ReliabilityResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *ReliabilityResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// Tests whether TAs grade differently, per criterion and for the total
+// score. Scoped like RunAnomalyAnalysisRequest.
+type CompareGradersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId        int64 `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	RubricVersionId int64 `protobuf:"varint,3,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *CompareGradersRequest) Reset() {
+	*x = CompareGradersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareGradersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareGradersRequest) ProtoMessage() {}
+
+func (x *CompareGradersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareGradersRequest.ProtoReflect.Descriptor instead.
+func (*CompareGradersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *CompareGradersRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *CompareGradersRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *CompareGradersRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+type GraderGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId   int64   `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName string  `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Count      int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Mean       float64 `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	MeanRank   float64 `protobuf:"fixed64,5,opt,name=mean_rank,json=meanRank,proto3" json:"mean_rank,omitempty"`
+}
+
+func (x *GraderGroup) Reset() {
+	*x = GraderGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderGroup) ProtoMessage() {}
+
+func (x *GraderGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderGroup.ProtoReflect.Descriptor instead.
+func (*GraderGroup) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *GraderGroup) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderGroup) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderGroup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GraderGroup) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *GraderGroup) GetMeanRank() float64 {
+	if x != nil {
+		return x.MeanRank
+	}
+	return 0
+}
+
+// difference is grader_a minus grader_b (means for Tukey, mean ranks for
+// Dunn). p_value is adjusted for multiple comparisons.
+type PairwiseComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderAId   int64   `protobuf:"varint,1,opt,name=grader_a_id,json=graderAId,proto3" json:"grader_a_id,omitempty"`
+	GraderBId   int64   `protobuf:"varint,2,opt,name=grader_b_id,json=graderBId,proto3" json:"grader_b_id,omitempty"`
+	Difference  float64 `protobuf:"fixed64,3,opt,name=difference,proto3" json:"difference,omitempty"`
+	Statistic   float64 `protobuf:"fixed64,4,opt,name=statistic,proto3" json:"statistic,omitempty"`
+	PValue      float64 `protobuf:"fixed64,5,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	Significant bool    `protobuf:"varint,6,opt,name=significant,proto3" json:"significant,omitempty"`
+}
+
+func (x *PairwiseComparison) Reset() {
+	*x = PairwiseComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairwiseComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairwiseComparison) ProtoMessage() {}
+
+func (x *PairwiseComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairwiseComparison.ProtoReflect.Descriptor instead.
+func (*PairwiseComparison) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *PairwiseComparison) GetGraderAId() int64 {
+	if x != nil {
+		return x.GraderAId
+	}
+	return 0
+}
+
+func (x *PairwiseComparison) GetGraderBId() int64 {
+	if x != nil {
+		return x.GraderBId
+	}
+	return 0
+}
+
+func (x *PairwiseComparison) GetDifference() float64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *PairwiseComparison) GetStatistic() float64 {
+	if x != nil {
+		return x.Statistic
+	}
+	return 0
+}
+
+func (x *PairwiseComparison) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *PairwiseComparison) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+type AnovaTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	F          float64               `protobuf:"fixed64,1,opt,name=f,proto3" json:"f,omitempty"`
+	DfBetween  int32                 `protobuf:"varint,2,opt,name=df_between,json=dfBetween,proto3" json:"df_between,omitempty"`
+	DfWithin   int32                 `protobuf:"varint,3,opt,name=df_within,json=dfWithin,proto3" json:"df_within,omitempty"`
+	PValue     float64               `protobuf:"fixed64,4,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	EtaSquared float64               `protobuf:"fixed64,5,opt,name=eta_squared,json=etaSquared,proto3" json:"eta_squared,omitempty"`
+	TukeyHsd   []*PairwiseComparison `protobuf:"bytes,6,rep,name=tukey_hsd,json=tukeyHsd,proto3" json:"tukey_hsd,omitempty"`
+}
+
+func (x *AnovaTest) Reset() {
+	*x = AnovaTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnovaTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnovaTest) ProtoMessage() {}
+
+func (x *AnovaTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnovaTest.ProtoReflect.Descriptor instead.
+func (*AnovaTest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *AnovaTest) GetF() float64 {
+	if x != nil {
+		return x.F
+	}
+	return 0
+}
+
+func (x *AnovaTest) GetDfBetween() int32 {
+	if x != nil {
+		return x.DfBetween
+	}
+	return 0
+}
+
+func (x *AnovaTest) GetDfWithin() int32 {
+	if x != nil {
+		return x.DfWithin
+	}
+	return 0
+}
+
+func (x *AnovaTest) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *AnovaTest) GetEtaSquared() float64 {
+	if x != nil {
+		return x.EtaSquared
+	}
+	return 0
+}
+
+func (x *AnovaTest) GetTukeyHsd() []*PairwiseComparison {
+	if x != nil {
+		return x.TukeyHsd
+	}
+	return nil
+}
+
+type KruskalWallisTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	H          float64               `protobuf:"fixed64,1,opt,name=h,proto3" json:"h,omitempty"`
+	Df         int32                 `protobuf:"varint,2,opt,name=df,proto3" json:"df,omitempty"`
+	PValue     float64               `protobuf:"fixed64,3,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	EtaSquared float64               `protobuf:"fixed64,4,opt,name=eta_squared,json=etaSquared,proto3" json:"eta_squared,omitempty"`
+	Dunn       []*PairwiseComparison `protobuf:"bytes,5,rep,name=dunn,proto3" json:"dunn,omitempty"`
+}
+
+func (x *KruskalWallisTest) Reset() {
+	*x = KruskalWallisTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KruskalWallisTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KruskalWallisTest) ProtoMessage() {}
+
+func (x *KruskalWallisTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KruskalWallisTest.ProtoReflect.Descriptor instead.
+func (*KruskalWallisTest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *KruskalWallisTest) GetH() float64 {
+	if x != nil {
+		return x.H
+	}
+	return 0
+}
+
+func (x *KruskalWallisTest) GetDf() int32 {
+	if x != nil {
+		return x.Df
+	}
+	return 0
+}
+
+func (x *KruskalWallisTest) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *KruskalWallisTest) GetEtaSquared() float64 {
+	if x != nil {
+		return x.EtaSquared
+	}
+	return 0
+}
+
+func (x *KruskalWallisTest) GetDunn() []*PairwiseComparison {
+	if x != nil {
+		return x.Dunn
+	}
+	return nil
+}
+
+// anova and kruskal_wallis are unset when fewer than two TAs have enough
+// grades to compare.
+type GraderDifferenceTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           string             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string             `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Graders       []*GraderGroup     `protobuf:"bytes,3,rep,name=graders,proto3" json:"graders,omitempty"`
+	Anova         *AnovaTest         `protobuf:"bytes,4,opt,name=anova,proto3" json:"anova,omitempty"`
+	KruskalWallis *KruskalWallisTest `protobuf:"bytes,5,opt,name=kruskal_wallis,json=kruskalWallis,proto3" json:"kruskal_wallis,omitempty"`
+}
+
+func (x *GraderDifferenceTest) Reset() {
+	*x = GraderDifferenceTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderDifferenceTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderDifferenceTest) ProtoMessage() {}
+
+func (x *GraderDifferenceTest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderDifferenceTest.ProtoReflect.Descriptor instead.
+func (*GraderDifferenceTest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GraderDifferenceTest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GraderDifferenceTest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GraderDifferenceTest) GetGraders() []*GraderGroup {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+func (x *GraderDifferenceTest) GetAnova() *AnovaTest {
+	if x != nil {
+		return x.Anova
+	}
+	return nil
+}
+
+func (x *GraderDifferenceTest) GetKruskalWallis() *KruskalWallisTest {
+	if x != nil {
+		return x.KruskalWallis
+	}
+	return nil
+}
+
+type GraderComparisonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId      int64                   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId          int64                   `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	SignificanceLevel float64                 `protobuf:"fixed64,3,opt,name=significance_level,json=significanceLevel,proto3" json:"significance_level,omitempty"`
+	Overall           *GraderDifferenceTest   `protobuf:"bytes,4,opt,name=overall,proto3" json:"overall,omitempty"`
+	Criteria          []*GraderDifferenceTest `protobuf:"bytes,5,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	RubricVersionId   int64                   `protobuf:"varint,6,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades    int32                   `protobuf:"varint,7,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *GraderComparisonResponse) Reset() {
+	*x = GraderComparisonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderComparisonResponse) ProtoMessage() {}
+
+func (x *GraderComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderComparisonResponse.ProtoReflect.Descriptor instead.
+func (*GraderComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *GraderComparisonResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GraderComparisonResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *GraderComparisonResponse) GetSignificanceLevel() float64 {
+	if x != nil {
+		return x.SignificanceLevel
+	}
+	return 0
+}
+
+func (x *GraderComparisonResponse) GetOverall() *GraderDifferenceTest {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *GraderComparisonResponse) GetCriteria() []*GraderDifferenceTest {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *GraderComparisonResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *GraderComparisonResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// Control charts of scores in grading order. question_id selects a
+// criterion index and defaults to the total score. Scoped like
+// RunAnomalyAnalysisRequest.
+type DetectDriftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId        int64  `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	QuestionId      string `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	RubricVersionId int64  `protobuf:"varint,4,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *DetectDriftRequest) Reset() {
+	*x = DetectDriftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectDriftRequest) ProtoMessage() {}
+
+func (x *DetectDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectDriftRequest.ProtoReflect.Descriptor instead.
+func (*DetectDriftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *DetectDriftRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *DetectDriftRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *DetectDriftRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DetectDriftRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+// cusum_upper and cusum_lower are in units of the baseline standard deviation.
+type DriftPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence     int32                `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	GradeId      int64                `protobuf:"varint,2,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	GradedAt     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	Score        float64              `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	Ewma         float64              `protobuf:"fixed64,5,opt,name=ewma,proto3" json:"ewma,omitempty"`
+	EwmaLower    float64              `protobuf:"fixed64,6,opt,name=ewma_lower,json=ewmaLower,proto3" json:"ewma_lower,omitempty"`
+	EwmaUpper    float64              `protobuf:"fixed64,7,opt,name=ewma_upper,json=ewmaUpper,proto3" json:"ewma_upper,omitempty"`
+	CusumUpper   float64              `protobuf:"fixed64,8,opt,name=cusum_upper,json=cusumUpper,proto3" json:"cusum_upper,omitempty"`
+	CusumLower   float64              `protobuf:"fixed64,9,opt,name=cusum_lower,json=cusumLower,proto3" json:"cusum_lower,omitempty"`
+	OutOfControl bool                 `protobuf:"varint,10,opt,name=out_of_control,json=outOfControl,proto3" json:"out_of_control,omitempty"`
+}
+
+func (x *DriftPoint) Reset() {
+	*x = DriftPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriftPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftPoint) ProtoMessage() {}
+
+func (x *DriftPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftPoint.ProtoReflect.Descriptor instead.
+func (*DriftPoint) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *DriftPoint) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetGradedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *DriftPoint) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetEwma() float64 {
+	if x != nil {
+		return x.Ewma
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetEwmaLower() float64 {
+	if x != nil {
+		return x.EwmaLower
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetEwmaUpper() float64 {
+	if x != nil {
+		return x.EwmaUpper
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetCusumUpper() float64 {
+	if x != nil {
+		return x.CusumUpper
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetCusumLower() float64 {
+	if x != nil {
+		return x.CusumLower
+	}
+	return 0
+}
+
+func (x *DriftPoint) GetOutOfControl() bool {
+	if x != nil {
+		return x.OutOfControl
+	}
+	return false
+}
+
+// A series covers the whole course (grader_id 0) or a single TA. The
+// baseline is estimated from the start of the series.
+type DriftSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope          string        `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	GraderId       int64         `protobuf:"varint,2,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName     string        `protobuf:"bytes,3,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	BaselineMean   float64       `protobuf:"fixed64,4,opt,name=baseline_mean,json=baselineMean,proto3" json:"baseline_mean,omitempty"`
+	BaselineStdDev float64       `protobuf:"fixed64,5,opt,name=baseline_std_dev,json=baselineStdDev,proto3" json:"baseline_std_dev,omitempty"`
+	BaselineSize   int32         `protobuf:"varint,6,opt,name=baseline_size,json=baselineSize,proto3" json:"baseline_size,omitempty"`
+	Points         []*DriftPoint `protobuf:"bytes,7,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *DriftSeries) Reset() {
+	*x = DriftSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriftSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftSeries) ProtoMessage() {}
+
+func (x *DriftSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftSeries.ProtoReflect.Descriptor instead.
+func (*DriftSeries) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *DriftSeries) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *DriftSeries) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *DriftSeries) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *DriftSeries) GetBaselineMean() float64 {
+	if x != nil {
+		return x.BaselineMean
+	}
+	return 0
+}
+
+func (x *DriftSeries) GetBaselineStdDev() float64 {
+	if x != nil {
+		return x.BaselineStdDev
+	}
+	return 0
+}
+
+func (x *DriftSeries) GetBaselineSize() int32 {
+	if x != nil {
+		return x.BaselineSize
+	}
+	return 0
+}
+
+func (x *DriftSeries) GetPoints() []*DriftPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type DriftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId      int64          `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId          int64          `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	QuestionId        string         `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	EwmaLambda        float64        `protobuf:"fixed64,4,opt,name=ewma_lambda,json=ewmaLambda,proto3" json:"ewma_lambda,omitempty"`
+	ControlLimitWidth float64        `protobuf:"fixed64,5,opt,name=control_limit_width,json=controlLimitWidth,proto3" json:"control_limit_width,omitempty"`
+	CusumK            float64        `protobuf:"fixed64,6,opt,name=cusum_k,json=cusumK,proto3" json:"cusum_k,omitempty"`
+	CusumH            float64        `protobuf:"fixed64,7,opt,name=cusum_h,json=cusumH,proto3" json:"cusum_h,omitempty"`
+	Course            *DriftSeries   `protobuf:"bytes,8,opt,name=course,proto3" json:"course,omitempty"`
+	Graders           []*DriftSeries `protobuf:"bytes,9,rep,name=graders,proto3" json:"graders,omitempty"`
+	Anomalies         []*Anomaly     `protobuf:"bytes,10,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	RubricVersionId   int64          `protobuf:"varint,11,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades    int32          `protobuf:"varint,12,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *DriftResponse) Reset() {
+	*x = DriftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftResponse) ProtoMessage() {}
+
+func (x *DriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftResponse.ProtoReflect.Descriptor instead.
+func (*DriftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *DriftResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DriftResponse) GetEwmaLambda() float64 {
+	if x != nil {
+		return x.EwmaLambda
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetControlLimitWidth() float64 {
+	if x != nil {
+		return x.ControlLimitWidth
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetCusumK() float64 {
+	if x != nil {
+		return x.CusumK
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetCusumH() float64 {
+	if x != nil {
+		return x.CusumH
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetCourse() *DriftSeries {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *DriftResponse) GetGraders() []*DriftSeries {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+func (x *DriftResponse) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *DriftResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *DriftResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// Estimates each TA's leniency per criterion with a random effects model
+// and previews every grade as if it had been given by the average TA.
+// Scoped like RunAnomalyAnalysisRequest.
+type EstimateLeniencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId        int64 `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	RubricVersionId int64 `protobuf:"varint,3,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *EstimateLeniencyRequest) Reset() {
+	*x = EstimateLeniencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateLeniencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateLeniencyRequest) ProtoMessage() {}
+
+func (x *EstimateLeniencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateLeniencyRequest.ProtoReflect.Descriptor instead.
+func (*EstimateLeniencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *EstimateLeniencyRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *EstimateLeniencyRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *EstimateLeniencyRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+// bias is the shrunken estimate of how many points above the average TA
+// this grader scores; shrinkage is the weight given to their own data.
+type GraderLeniency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId      int64   `protobuf:"varint,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName    string  `protobuf:"bytes,2,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	Count         int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Mean          float64 `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	RawDifference float64 `protobuf:"fixed64,5,opt,name=raw_difference,json=rawDifference,proto3" json:"raw_difference,omitempty"`
+	Bias          float64 `protobuf:"fixed64,6,opt,name=bias,proto3" json:"bias,omitempty"`
+	BiasLower     float64 `protobuf:"fixed64,7,opt,name=bias_lower,json=biasLower,proto3" json:"bias_lower,omitempty"`
+	BiasUpper     float64 `protobuf:"fixed64,8,opt,name=bias_upper,json=biasUpper,proto3" json:"bias_upper,omitempty"`
+	Shrinkage     float64 `protobuf:"fixed64,9,opt,name=shrinkage,proto3" json:"shrinkage,omitempty"`
+}
+
+func (x *GraderLeniency) Reset() {
+	*x = GraderLeniency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderLeniency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderLeniency) ProtoMessage() {}
+
+func (x *GraderLeniency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderLeniency.ProtoReflect.Descriptor instead.
+func (*GraderLeniency) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *GraderLeniency) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderLeniency) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetRawDifference() float64 {
+	if x != nil {
+		return x.RawDifference
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetBias() float64 {
+	if x != nil {
+		return x.Bias
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetBiasLower() float64 {
+	if x != nil {
+		return x.BiasLower
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetBiasUpper() float64 {
+	if x != nil {
+		return x.BiasUpper
+	}
+	return 0
+}
+
+func (x *GraderLeniency) GetShrinkage() float64 {
+	if x != nil {
+		return x.Shrinkage
+	}
+	return 0
+}
+
+type CriterionLeniency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key                   string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Label                 string            `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	GrandMean             float64           `protobuf:"fixed64,3,opt,name=grand_mean,json=grandMean,proto3" json:"grand_mean,omitempty"`
+	BetweenGraderVariance float64           `protobuf:"fixed64,4,opt,name=between_grader_variance,json=betweenGraderVariance,proto3" json:"between_grader_variance,omitempty"`
+	WithinGraderVariance  float64           `protobuf:"fixed64,5,opt,name=within_grader_variance,json=withinGraderVariance,proto3" json:"within_grader_variance,omitempty"`
+	Graders               []*GraderLeniency `protobuf:"bytes,6,rep,name=graders,proto3" json:"graders,omitempty"`
+	SufficientData        bool              `protobuf:"varint,7,opt,name=sufficient_data,json=sufficientData,proto3" json:"sufficient_data,omitempty"`
+}
+
+func (x *CriterionLeniency) Reset() {
+	*x = CriterionLeniency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriterionLeniency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionLeniency) ProtoMessage() {}
+
+func (x *CriterionLeniency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionLeniency.ProtoReflect.Descriptor instead.
+func (*CriterionLeniency) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *CriterionLeniency) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CriterionLeniency) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CriterionLeniency) GetGrandMean() float64 {
+	if x != nil {
+		return x.GrandMean
+	}
+	return 0
+}
+
+func (x *CriterionLeniency) GetBetweenGraderVariance() float64 {
+	if x != nil {
+		return x.BetweenGraderVariance
+	}
+	return 0
+}
+
+func (x *CriterionLeniency) GetWithinGraderVariance() float64 {
+	if x != nil {
+		return x.WithinGraderVariance
+	}
+	return 0
+}
+
+func (x *CriterionLeniency) GetGraders() []*GraderLeniency {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+func (x *CriterionLeniency) GetSufficientData() bool {
+	if x != nil {
+		return x.SufficientData
+	}
+	return false
+}
+
+// Adjusted scores subtract the grader's estimated bias; bounds reflect the
+// uncertainty in that estimate.
+type AdjustedGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId            int64              `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	SubmissionId       int64              `protobuf:"varint,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId          string             `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	GraderId           int64              `protobuf:"varint,4,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	RubricScores       map[string]float64 `protobuf:"bytes,5,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	AdjustedScores     map[string]float64 `protobuf:"bytes,6,rep,name=adjusted_scores,json=adjustedScores,proto3" json:"adjusted_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	AdjustedLower      map[string]float64 `protobuf:"bytes,7,rep,name=adjusted_lower,json=adjustedLower,proto3" json:"adjusted_lower,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	AdjustedUpper      map[string]float64 `protobuf:"bytes,8,rep,name=adjusted_upper,json=adjustedUpper,proto3" json:"adjusted_upper,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalScore         float64            `protobuf:"fixed64,9,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	AdjustedTotal      float64            `protobuf:"fixed64,10,opt,name=adjusted_total,json=adjustedTotal,proto3" json:"adjusted_total,omitempty"`
+	AdjustedTotalLower float64            `protobuf:"fixed64,11,opt,name=adjusted_total_lower,json=adjustedTotalLower,proto3" json:"adjusted_total_lower,omitempty"`
+	AdjustedTotalUpper float64            `protobuf:"fixed64,12,opt,name=adjusted_total_upper,json=adjustedTotalUpper,proto3" json:"adjusted_total_upper,omitempty"`
+}
+
+func (x *AdjustedGrade) Reset() {
+	*x = AdjustedGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustedGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustedGrade) ProtoMessage() {}
+
+func (x *AdjustedGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustedGrade.ProtoReflect.Descriptor instead.
+func (*AdjustedGrade) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *AdjustedGrade) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *AdjustedGrade) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *AdjustedGrade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AdjustedGrade) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *AdjustedGrade) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *AdjustedGrade) GetAdjustedScores() map[string]float64 {
+	if x != nil {
+		return x.AdjustedScores
+	}
+	return nil
+}
+
+func (x *AdjustedGrade) GetAdjustedLower() map[string]float64 {
+	if x != nil {
+		return x.AdjustedLower
+	}
+	return nil
+}
+
+func (x *AdjustedGrade) GetAdjustedUpper() map[string]float64 {
+	if x != nil {
+		return x.AdjustedUpper
+	}
+	return nil
+}
+
+func (x *AdjustedGrade) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *AdjustedGrade) GetAdjustedTotal() float64 {
+	if x != nil {
+		return x.AdjustedTotal
+	}
+	return 0
+}
+
+func (x *AdjustedGrade) GetAdjustedTotalLower() float64 {
+	if x != nil {
+		return x.AdjustedTotalLower
+	}
+	return 0
+}
+
+func (x *AdjustedGrade) GetAdjustedTotalUpper() float64 {
+	if x != nil {
+		return x.AdjustedTotalUpper
+	}
+	return 0
+}
+
+type LeniencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64                `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId        int64                `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	ConfidenceLevel float64              `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Criteria        []*CriterionLeniency `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Grades          []*AdjustedGrade     `protobuf:"bytes,5,rep,name=grades,proto3" json:"grades,omitempty"`
+	RubricVersionId int64                `protobuf:"varint,6,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades  int32                `protobuf:"varint,7,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *LeniencyResponse) Reset() {
+	*x = LeniencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeniencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeniencyResponse) ProtoMessage() {}
+
+func (x *LeniencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeniencyResponse.ProtoReflect.Descriptor instead.
+func (*LeniencyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *LeniencyResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *LeniencyResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *LeniencyResponse) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *LeniencyResponse) GetCriteria() []*CriterionLeniency {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *LeniencyResponse) GetGrades() []*AdjustedGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *LeniencyResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *LeniencyResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// Compares independent grades of the same submission. threshold is the
+// total score spread that counts as a disagreement and defaults to 10% of
+// the rubric's total points. Scoped like RunAnomalyAnalysisRequest.
+type GetDisagreementReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId    int64   `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId        int64   `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Threshold       float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	RubricVersionId int64   `protobuf:"varint,4,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+}
+
+func (x *GetDisagreementReportRequest) Reset() {
+	*x = GetDisagreementReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDisagreementReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisagreementReportRequest) ProtoMessage() {}
+
+func (x *GetDisagreementReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisagreementReportRequest.ProtoReflect.Descriptor instead.
+func (*GetDisagreementReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *GetDisagreementReportRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetDisagreementReportRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *GetDisagreementReportRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetDisagreementReportRequest) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+type GraderScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GradeId      int64              `protobuf:"varint,1,opt,name=grade_id,json=gradeId,proto3" json:"grade_id,omitempty"`
+	GraderId     int64              `protobuf:"varint,2,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	GraderName   string             `protobuf:"bytes,3,opt,name=grader_name,json=graderName,proto3" json:"grader_name,omitempty"`
+	TotalScore   float64            `protobuf:"fixed64,4,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	RubricScores map[string]float64 `protobuf:"bytes,5,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *GraderScore) Reset() {
+	*x = GraderScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderScore) ProtoMessage() {}
+
+func (x *GraderScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderScore.ProtoReflect.Descriptor instead.
+func (*GraderScore) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *GraderScore) GetGradeId() int64 {
+	if x != nil {
+		return x.GradeId
+	}
+	return 0
+}
+
+func (x *GraderScore) GetGraderId() int64 {
+	if x != nil {
+		return x.GraderId
+	}
+	return 0
+}
+
+func (x *GraderScore) GetGraderName() string {
+	if x != nil {
+		return x.GraderName
+	}
+	return ""
+}
+
+func (x *GraderScore) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *GraderScore) GetRubricScores() map[string]float64 {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+type SubmissionDisagreement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId     int64              `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	StudentId        string             `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Grades           []*GraderScore     `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+	TotalSpread      float64            `protobuf:"fixed64,4,opt,name=total_spread,json=totalSpread,proto3" json:"total_spread,omitempty"`
+	CriterionSpreads map[string]float64 `protobuf:"bytes,5,rep,name=criterion_spreads,json=criterionSpreads,proto3" json:"criterion_spreads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Flagged          bool               `protobuf:"varint,6,opt,name=flagged,proto3" json:"flagged,omitempty"`
+}
+
+func (x *SubmissionDisagreement) Reset() {
+	*x = SubmissionDisagreement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionDisagreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionDisagreement) ProtoMessage() {}
+
+func (x *SubmissionDisagreement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionDisagreement.ProtoReflect.Descriptor instead.
+func (*SubmissionDisagreement) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *SubmissionDisagreement) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *SubmissionDisagreement) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SubmissionDisagreement) GetGrades() []*GraderScore {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *SubmissionDisagreement) GetTotalSpread() float64 {
+	if x != nil {
+		return x.TotalSpread
+	}
+	return 0
+}
+
+func (x *SubmissionDisagreement) GetCriterionSpreads() map[string]float64 {
+	if x != nil {
+		return x.CriterionSpreads
+	}
+	return nil
+}
+
+func (x *SubmissionDisagreement) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+// mean_difference is grader_a minus grader_b on the total score.
+type GraderPairAgreement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderAId              int64   `protobuf:"varint,1,opt,name=grader_a_id,json=graderAId,proto3" json:"grader_a_id,omitempty"`
+	GraderAName            string  `protobuf:"bytes,2,opt,name=grader_a_name,json=graderAName,proto3" json:"grader_a_name,omitempty"`
+	GraderBId              int64   `protobuf:"varint,3,opt,name=grader_b_id,json=graderBId,proto3" json:"grader_b_id,omitempty"`
+	GraderBName            string  `protobuf:"bytes,4,opt,name=grader_b_name,json=graderBName,proto3" json:"grader_b_name,omitempty"`
+	SharedSubmissions      int32   `protobuf:"varint,5,opt,name=shared_submissions,json=sharedSubmissions,proto3" json:"shared_submissions,omitempty"`
+	MeanDifference         float64 `protobuf:"fixed64,6,opt,name=mean_difference,json=meanDifference,proto3" json:"mean_difference,omitempty"`
+	MeanAbsoluteDifference float64 `protobuf:"fixed64,7,opt,name=mean_absolute_difference,json=meanAbsoluteDifference,proto3" json:"mean_absolute_difference,omitempty"`
+}
+
+func (x *GraderPairAgreement) Reset() {
+	*x = GraderPairAgreement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraderPairAgreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraderPairAgreement) ProtoMessage() {}
+
+func (x *GraderPairAgreement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraderPairAgreement.ProtoReflect.Descriptor instead.
+func (*GraderPairAgreement) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *GraderPairAgreement) GetGraderAId() int64 {
+	if x != nil {
+		return x.GraderAId
+	}
+	return 0
+}
+
+func (x *GraderPairAgreement) GetGraderAName() string {
+	if x != nil {
+		return x.GraderAName
+	}
+	return ""
+}
+
+func (x *GraderPairAgreement) GetGraderBId() int64 {
+	if x != nil {
+		return x.GraderBId
+	}
+	return 0
+}
+
+func (x *GraderPairAgreement) GetGraderBName() string {
+	if x != nil {
+		return x.GraderBName
+	}
+	return ""
+}
+
+func (x *GraderPairAgreement) GetSharedSubmissions() int32 {
+	if x != nil {
+		return x.SharedSubmissions
+	}
+	return 0
+}
+
+func (x *GraderPairAgreement) GetMeanDifference() float64 {
+	if x != nil {
+		return x.MeanDifference
+	}
+	return 0
+}
+
+func (x *GraderPairAgreement) GetMeanAbsoluteDifference() float64 {
+	if x != nil {
+		return x.MeanAbsoluteDifference
+	}
+	return 0
+}
+
+type DisagreementReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId       int64                     `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RubricId           int64                     `protobuf:"varint,2,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Threshold          float64                   `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	OverlapSubmissions int32                     `protobuf:"varint,4,opt,name=overlap_submissions,json=overlapSubmissions,proto3" json:"overlap_submissions,omitempty"`
+	FlaggedSubmissions int32                     `protobuf:"varint,5,opt,name=flagged_submissions,json=flaggedSubmissions,proto3" json:"flagged_submissions,omitempty"`
+	Submissions        []*SubmissionDisagreement `protobuf:"bytes,6,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	GraderPairs        []*GraderPairAgreement    `protobuf:"bytes,7,rep,name=grader_pairs,json=graderPairs,proto3" json:"grader_pairs,omitempty"`
+	RubricVersionId    int64                     `protobuf:"varint,8,opt,name=rubric_version_id,json=rubricVersionId,proto3" json:"rubric_version_id,omitempty"`
+	ExcludedGrades     int32                     `protobuf:"varint,9,opt,name=excluded_grades,json=excludedGrades,proto3" json:"excluded_grades,omitempty"`
+}
+
+func (x *DisagreementReportResponse) Reset() {
+	*x = DisagreementReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisagreementReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisagreementReportResponse) ProtoMessage() {}
+
+func (x *DisagreementReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisagreementReportResponse.ProtoReflect.Descriptor instead.
+func (*DisagreementReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *DisagreementReportResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *DisagreementReportResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *DisagreementReportResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *DisagreementReportResponse) GetOverlapSubmissions() int32 {
+	if x != nil {
+		return x.OverlapSubmissions
+	}
+	return 0
+}
+
+func (x *DisagreementReportResponse) GetFlaggedSubmissions() int32 {
+	if x != nil {
+		return x.FlaggedSubmissions
+	}
+	return 0
+}
+
+func (x *DisagreementReportResponse) GetSubmissions() []*SubmissionDisagreement {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *DisagreementReportResponse) GetGraderPairs() []*GraderPairAgreement {
+	if x != nil {
+		return x.GraderPairs
+	}
+	return nil
+}
+
+func (x *DisagreementReportResponse) GetRubricVersionId() int64 {
+	if x != nil {
+		return x.RubricVersionId
+	}
+	return 0
+}
+
+func (x *DisagreementReportResponse) GetExcludedGrades() int32 {
+	if x != nil {
+		return x.ExcludedGrades
+	}
+	return 0
+}
+
+// Health check messages
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{206}
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *HealthCheckResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Messages for Gradebook service
+// format is csv (the default), canvas or gradescope. Without assignment_id the
+// whole course is exported. Assignments whose grades are not released are left
+// out unless include_unreleased is set, which only instructors may set, so
+// TAs export released grades only. For TAs, a blind-graded assignment
+// that hides identities can only be exported on its own in the csv format,
+// with submission tokens in place of students; course exports leave it out.
+type ExportGradebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId     int64  `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	AssignmentId int64  `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Format       string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Adds a column per rubric criterion, named like the "q1" question IDs the
+	// grade import accepts
+	IncludeCriteria bool `protobuf:"varint,4,opt,name=include_criteria,json=includeCriteria,proto3" json:"include_criteria,omitempty"`
+	// Adds each assignment's overall feedback, and with include_criteria each
+	// criterion's feedback; csv format only
+	IncludeFeedback   bool `protobuf:"varint,5,opt,name=include_feedback,json=includeFeedback,proto3" json:"include_feedback,omitempty"`
+	IncludeUnreleased bool `protobuf:"varint,6,opt,name=include_unreleased,json=includeUnreleased,proto3" json:"include_unreleased,omitempty"`
+}
+
+func (x *ExportGradebookRequest) Reset() {
+	*x = ExportGradebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGradebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGradebookRequest) ProtoMessage() {}
+
+func (x *ExportGradebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGradebookRequest.ProtoReflect.Descriptor instead.
+func (*ExportGradebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *ExportGradebookRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ExportGradebookRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ExportGradebookRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportGradebookRequest) GetIncludeCriteria() bool {
+	if x != nil {
+		return x.IncludeCriteria
+	}
+	return false
+}
+
+func (x *ExportGradebookRequest) GetIncludeFeedback() bool {
+	if x != nil {
+		return x.IncludeFeedback
+	}
+	return false
+}
+
+func (x *ExportGradebookRequest) GetIncludeUnreleased() bool {
+	if x != nil {
+		return x.IncludeUnreleased
+	}
+	return false
+}
+
+// The first chunk also carries the suggested file name
+type GradebookChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *GradebookChunk) Reset() {
+	*x = GradebookChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradebookChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradebookChunk) ProtoMessage() {}
+
+func (x *GradebookChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradebookChunk.ProtoReflect.Descriptor instead.
+func (*GradebookChunk) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GradebookChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GradebookChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// Messages for LTI service
+type LtiPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer   string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Launches from other deployments are refused when set
+	DeploymentId string               `protobuf:"bytes,5,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	AuthLoginUrl string               `protobuf:"bytes,6,opt,name=auth_login_url,json=authLoginUrl,proto3" json:"auth_login_url,omitempty"`
+	AuthTokenUrl string               `protobuf:"bytes,7,opt,name=auth_token_url,json=authTokenUrl,proto3" json:"auth_token_url,omitempty"`
+	JwksUrl      string               `protobuf:"bytes,8,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	CreatedAt    *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// First launches with a verified email sign in to the account with that email
+	TrustEmail bool `protobuf:"varint,10,opt,name=trust_email,json=trustEmail,proto3" json:"trust_email,omitempty"`
+}
+
+func (x *LtiPlatform) Reset() {
+	*x = LtiPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtiPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiPlatform) ProtoMessage() {}
+
+func (x *LtiPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiPlatform.ProtoReflect.Descriptor instead.
+func (*LtiPlatform) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *LtiPlatform) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LtiPlatform) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetAuthLoginUrl() string {
+	if x != nil {
+		return x.AuthLoginUrl
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetAuthTokenUrl() string {
+	if x != nil {
+		return x.AuthTokenUrl
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LtiPlatform) GetTrustEmail() bool {
+	if x != nil {
+		return x.TrustEmail
+	}
+	return false
+}
+
+type RegisterLtiPlatformRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer       string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId     string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	DeploymentId string `protobuf:"bytes,4,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	AuthLoginUrl string `protobuf:"bytes,5,opt,name=auth_login_url,json=authLoginUrl,proto3" json:"auth_login_url,omitempty"`
+	AuthTokenUrl string `protobuf:"bytes,6,opt,name=auth_token_url,json=authTokenUrl,proto3" json:"auth_token_url,omitempty"`
+	JwksUrl      string `protobuf:"bytes,7,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	// Set only for platforms that verify their users' emails; otherwise each
+	// platform user gets their own account
+	TrustEmail bool `protobuf:"varint,8,opt,name=trust_email,json=trustEmail,proto3" json:"trust_email,omitempty"`
+}
+
+func (x *RegisterLtiPlatformRequest) Reset() {
+	*x = RegisterLtiPlatformRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterLtiPlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterLtiPlatformRequest) ProtoMessage() {}
+
+func (x *RegisterLtiPlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterLtiPlatformRequest.ProtoReflect.Descriptor instead.
+func (*RegisterLtiPlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *RegisterLtiPlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetAuthLoginUrl() string {
+	if x != nil {
+		return x.AuthLoginUrl
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetAuthTokenUrl() string {
+	if x != nil {
+		return x.AuthTokenUrl
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetTrustEmail() bool {
+	if x != nil {
+		return x.TrustEmail
+	}
+	return false
+}
+
+type LtiPlatformResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platform *LtiPlatform `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Message  string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LtiPlatformResponse) Reset() {
+	*x = LtiPlatformResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtiPlatformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiPlatformResponse) ProtoMessage() {}
+
+func (x *LtiPlatformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiPlatformResponse.ProtoReflect.Descriptor instead.
+func (*LtiPlatformResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *LtiPlatformResponse) GetPlatform() *LtiPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+func (x *LtiPlatformResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListLtiPlatformsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLtiPlatformsRequest) Reset() {
+	*x = ListLtiPlatformsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLtiPlatformsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLtiPlatformsRequest) ProtoMessage() {}
+
+func (x *ListLtiPlatformsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLtiPlatformsRequest.ProtoReflect.Descriptor instead.
+func (*ListLtiPlatformsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{213}
+}
+
+type ListLtiPlatformsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platforms []*LtiPlatform `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+}
+
+func (x *ListLtiPlatformsResponse) Reset() {
+	*x = ListLtiPlatformsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLtiPlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLtiPlatformsResponse) ProtoMessage() {}
+
+func (x *ListLtiPlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLtiPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*ListLtiPlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *ListLtiPlatformsResponse) GetPlatforms() []*LtiPlatform {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+type LtiResourceLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlatformId     int64  `protobuf:"varint,2,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	ResourceLinkId string `protobuf:"bytes,3,opt,name=resource_link_id,json=resourceLinkId,proto3" json:"resource_link_id,omitempty"`
+	CourseId       int64  `protobuf:"varint,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	AssignmentId   int64  `protobuf:"varint,5,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Title          string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	LineitemUrl    string `protobuf:"bytes,7,opt,name=lineitem_url,json=lineitemUrl,proto3" json:"lineitem_url,omitempty"`
+	// Whether the platform granted the score scope, so grades can be passed back
+	CanPassBack bool `protobuf:"varint,8,opt,name=can_pass_back,json=canPassBack,proto3" json:"can_pass_back,omitempty"`
+}
+
+func (x *LtiResourceLink) Reset() {
+	*x = LtiResourceLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[215]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtiResourceLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiResourceLink) ProtoMessage() {}
+
+func (x *LtiResourceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[215]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiResourceLink.ProtoReflect.Descriptor instead.
+func (*LtiResourceLink) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *LtiResourceLink) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LtiResourceLink) GetPlatformId() int64 {
+	if x != nil {
+		return x.PlatformId
+	}
+	return 0
+}
+
+func (x *LtiResourceLink) GetResourceLinkId() string {
+	if x != nil {
+		return x.ResourceLinkId
+	}
+	return ""
+}
+
+func (x *LtiResourceLink) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LtiResourceLink) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *LtiResourceLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LtiResourceLink) GetLineitemUrl() string {
+	if x != nil {
+		return x.LineitemUrl
+	}
+	return ""
+}
+
+func (x *LtiResourceLink) GetCanPassBack() bool {
+	if x != nil {
+		return x.CanPassBack
+	}
+	return false
+}
+
+type ListLtiResourceLinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId int64 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+}
+
+func (x *ListLtiResourceLinksRequest) Reset() {
+	*x = ListLtiResourceLinksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[216]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLtiResourceLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLtiResourceLinksRequest) ProtoMessage() {}
+
+func (x *ListLtiResourceLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[216]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLtiResourceLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListLtiResourceLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *ListLtiResourceLinksRequest) GetCourseId() int64 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+type ListLtiResourceLinksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links []*LtiResourceLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *ListLtiResourceLinksResponse) Reset() {
+	*x = ListLtiResourceLinksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLtiResourceLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLtiResourceLinksResponse) ProtoMessage() {}
+
+func (x *ListLtiResourceLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLtiResourceLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListLtiResourceLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *ListLtiResourceLinksResponse) GetLinks() []*LtiResourceLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// An assignment_id of 0 unlinks the resource link
+type LinkLtiResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkId       int64 `protobuf:"varint,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	AssignmentId int64 `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *LinkLtiResourceRequest) Reset() {
+	*x = LinkLtiResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[218]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkLtiResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkLtiResourceRequest) ProtoMessage() {}
+
+func (x *LinkLtiResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[218]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkLtiResourceRequest.ProtoReflect.Descriptor instead.
+func (*LinkLtiResourceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *LinkLtiResourceRequest) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+func (x *LinkLtiResourceRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type LtiResourceLinkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link    *LtiResourceLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Message string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LtiResourceLinkResponse) Reset() {
+	*x = LtiResourceLinkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[219]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtiResourceLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiResourceLinkResponse) ProtoMessage() {}
+
+func (x *LtiResourceLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[219]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiResourceLinkResponse.ProtoReflect.Descriptor instead.
+func (*LtiResourceLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *LtiResourceLinkResponse) GetLink() *LtiResourceLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *LtiResourceLinkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PassbackGradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *PassbackGradesRequest) Reset() {
+	*x = PassbackGradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[220]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassbackGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassbackGradesRequest) ProtoMessage() {}
+
+func (x *PassbackGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[220]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassbackGradesRequest.ProtoReflect.Descriptor instead.
+func (*PassbackGradesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *PassbackGradesRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+// status is sent, skipped or failed
+type LtiScoreResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkId      int64   `protobuf:"varint,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	UserId      int64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StudentId   string  `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName string  `protobuf:"bytes,4,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	Score       float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore    float64 `protobuf:"fixed64,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Status      string  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Message     string  `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LtiScoreResult) Reset() {
+	*x = LtiScoreResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[221]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtiScoreResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiScoreResult) ProtoMessage() {}
+
+func (x *LtiScoreResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[221]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiScoreResult.ProtoReflect.Descriptor instead.
+func (*LtiScoreResult) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *LtiScoreResult) GetLinkId() int64 {
+	if x != nil {
+		return x.LinkId
+	}
+	return 0
+}
+
+func (x *LtiScoreResult) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LtiScoreResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *LtiScoreResult) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *LtiScoreResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LtiScoreResult) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *LtiScoreResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LtiScoreResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PassbackGradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId int64             `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Sent         int32             `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	Skipped      int32             `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed       int32             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results      []*LtiScoreResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	Message      string            `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PassbackGradesResponse) Reset() {
+	*x = PassbackGradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_talytics_proto_msgTypes[222]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassbackGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassbackGradesResponse) ProtoMessage() {}
+
+func (x *PassbackGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_talytics_proto_msgTypes[222]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassbackGradesResponse.ProtoReflect.Descriptor instead.
+func (*PassbackGradesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_talytics_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *PassbackGradesResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *PassbackGradesResponse) GetSent() int32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *PassbackGradesResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *PassbackGradesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *PassbackGradesResponse) GetResults() []*LtiScoreResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PassbackGradesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_proto_talytics_proto protoreflect.FileDescriptor
+
+var file_proto_talytics_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x22, 0x7d, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x62, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x0e,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x4c, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdf, 0x03,
+	0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x68, 0x6f, 0x77,
+	0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x73, 0x68, 0x6f, 0x77, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0xbd, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x8f, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x13,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x35, 0x0a,
+	0x17, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x73, 0x68, 0x6f, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69,
+	0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x6a, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x0e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc7, 0x04, 0x0a, 0x0a, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52,
+	0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x82, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x67, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x35, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x8e, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74,
+	0x22, 0x57, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x18, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xbb, 0x02, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x9d, 0x01,
+	0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x64, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xb2, 0x03, 0x0a, 0x06, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x0f, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a,
+	0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x31, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a,
+	0x0e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x52, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x07, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x86, 0x03, 0x0a, 0x0d, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x40, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65,
+	0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65,
+	0x65, 0x22, 0x38, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d, 0x0a, 0x1c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x02, 0x0a, 0x15, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x6f, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x4d, 0x65, 0x61, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x74, 0x6f, 0x4d, 0x65, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x61,
+	0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5,
+	0x02, 0x0a, 0x17, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3b, 0x0a,
+	0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x3e, 0x0a, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x74, 0x6f,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x08, 0x74, 0x6f,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x1b, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x40,
+	0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65,
+	0x12, 0x36, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x75,
+	0x6d, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x0f, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xd6,
+	0x01, 0x0a, 0x11, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x73, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x46,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x02,
+	0x0a, 0x11, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x65,
+	0x6c, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x0e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x65, 0x74, 0x77, 0x65,
+	0x65, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x14, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6e, 0x6f, 0x76,
+	0x61, 0x5f, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x61, 0x6e, 0x6f, 0x76, 0x61, 0x50, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa6, 0x01, 0x0a,
+	0x10, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6e,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6e,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xdc, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0xe0, 0x06, 0x0a, 0x14, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52,
+	0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x6e, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x75, 0x70, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x70, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x44, 0x6f, 0x77, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x16, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xdf, 0x02, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x37, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x09, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x13,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x59, 0x0a, 0x13, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x3c, 0x0a, 0x0a,
+	0x72, 0x6f, 0x77, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x09, 0x72, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5f,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0xd5, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76,
+	0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x22, 0x66, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x5e, 0x0a, 0x10, 0x74, 0x61, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0f, 0x74, 0x61, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x5c, 0x0a, 0x14, 0x54, 0x61, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x41, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x28, 0x0a, 0x0e, 0x54, 0x41, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xb8, 0x08, 0x0a, 0x0b, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c,
+	0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a,
+	0x12, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x47, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x6f, 0x75, 0x74,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x3f, 0x0a, 0x11, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xbd, 0x04, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x53, 0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x12, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x1a, 0x3f, 0x0a, 0x11, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x76, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x17,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x43, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xb7, 0x05, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x6c, 0x64, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6f, 0x6c, 0x64,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x11,
+	0x6e, 0x65, 0x77, 0x5f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x6f, 0x6c, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x42, 0x0a, 0x14, 0x4f, 0x6c, 0x64,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a,
+	0x14, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x33, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x47, 0x72, 0x61, 0x64, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x7c, 0x0a, 0x19, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbb,
+	0x01, 0x0a, 0x0f, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x61,
+	0x73, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73,
+	0x4f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6e, 0x65,
+	0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xa2, 0x02, 0x0a,
+	0x19, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x72,
+	0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x22, 0xfe, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x35, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x17, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x62, 0x0a, 0x14, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x22, 0xa3, 0x02, 0x0a, 0x11, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01,
+	0x0a, 0x1d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x47, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x40, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xcb, 0x01, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22,
+	0xd9, 0x02, 0x0a, 0x17, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x16, 0x75, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x75,
+	0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x17,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xaa,
+	0x01, 0x0a, 0x17, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd8, 0x03, 0x0a, 0x10,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x6c, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x6f, 0x6c, 0x64, 0x12, 0x51, 0x0a, 0x0d,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x2e,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x47, 0x6f,
+	0x6c, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x6f,
+	0x6c, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x1a,
+	0x3f, 0x0a, 0x11, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xab, 0x02, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x5e, 0x0a, 0x0d,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x3f, 0x0a,
+	0x11, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66,
+	0x0a, 0x18, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xce, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x64, 0x22, 0xbe, 0x01, 0x0a, 0x1e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x41, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x61, 0x6e, 0x44, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x65, 0x61, 0x6e, 0x5f,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x6d, 0x65, 0x61, 0x6e, 0x41, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x65,
+	0x65, 0x64, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x82, 0x03, 0x0a,
+	0x11, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x61, 0x6e, 0x44, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x61, 0x6e, 0x41, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x19, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x67, 0x6f,
+	0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x60, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x6f, 0x6c,
+	0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x67, 0x6f, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b,
+	0x0a, 0x17, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x18, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x65, 0x64, 0x22, 0x90, 0x06, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x12,
+	0x28, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x54, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x24,
+	0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x7f, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x4d, 0x65, 0x22, 0x53, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e,
+	0x0a, 0x1b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x49, 0x64, 0x22, 0x93,
+	0x02, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5c,
+	0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4a, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x93, 0x02, 0x0a, 0x12, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x22, 0x89, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x1b, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0d, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4b,
+	0x0a, 0x16, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0xca, 0x02, 0x0a, 0x11, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x5b, 0x0a, 0x1a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x61, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x1a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x3d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xa6, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0xad, 0x03, 0x0a, 0x0c, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x08,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x8b, 0x01, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5d, 0x0a,
+	0x16, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xdd, 0x01, 0x0a,
+	0x15, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x08,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x22, 0xc4, 0x03, 0x0a,
+	0x0b, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e,
+	0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x4d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x13, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x35, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0x41, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xa1, 0x01, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x61,
+	0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4c,
+	0x6f, 0x73, 0x74, 0x22, 0xe0, 0x02, 0x0a, 0x10, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x6b, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x6c,
+	0x6f, 0x73, 0x74, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4c, 0x6f, 0x73, 0x74, 0x53, 0x70, 0x72, 0x65,
+	0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x42, 0x61, 0x6e, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x64,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0xac, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x42,
+	0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x6b,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x89,
+	0x01, 0x0a, 0x19, 0x52, 0x75, 0x6e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x07, 0x41,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x01,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73,
+	0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xb5, 0x04,
+	0x0a, 0x17, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52,
+	0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x49, 0x0a, 0x12, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x1a, 0x53, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x22,
+	0xca, 0x02, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6e, 0x6f, 0x6d, 0x61,
+	0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x69,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x6c, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0xdc, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12,
+	0x39, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x6c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22,
+	0x85, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0xcd, 0x01, 0x0a,
+	0x12, 0x50, 0x61, 0x69, 0x72, 0x77, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x41, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x42, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a,
+	0x09, 0x41, 0x6e, 0x6f, 0x76, 0x61, 0x54, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x66, 0x5f, 0x62,
+	0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x66,
+	0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x66, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x66, 0x57, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x65, 0x74, 0x61, 0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x12, 0x39,
+	0x0a, 0x09, 0x74, 0x75, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x73, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x61, 0x69,
+	0x72, 0x77, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x08, 0x74, 0x75, 0x6b, 0x65, 0x79, 0x48, 0x73, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x4b, 0x72,
+	0x75, 0x73, 0x6b, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x12,
+	0x0c, 0x0a, 0x01, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x68, 0x12, 0x0e, 0x0a,
+	0x02, 0x64, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x64, 0x66, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x71,
+	0x75, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x74, 0x61,
+	0x53, 0x71, 0x75, 0x61, 0x72, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x75, 0x6e, 0x6e, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x77, 0x69, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x75, 0x6e, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x14, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x07, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x61,
+	0x6e, 0x6f, 0x76, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x76, 0x61, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x05, 0x61, 0x6e, 0x6f, 0x76, 0x61, 0x12, 0x42, 0x0a, 0x0e, 0x6b, 0x72, 0x75, 0x73, 0x6b, 0x61,
+	0x6c, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4b, 0x72, 0x75, 0x73, 0x6b, 0x61,
+	0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x6b, 0x72, 0x75,
+	0x73, 0x6b, 0x61, 0x6c, 0x57, 0x61, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0xd6, 0x02, 0x0a, 0x18, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x2a,
+	0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xcc, 0x02, 0x0a, 0x0a, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x77, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x65, 0x77,
+	0x6d, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x77, 0x6d, 0x61, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x77, 0x6d, 0x61, 0x4c, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x77, 0x6d, 0x61, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x77, 0x6d, 0x61, 0x55, 0x70, 0x70, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x55, 0x70, 0x70, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x4c, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x4f,
+	0x66, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x22, 0x83, 0x02, 0x0a, 0x0b, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x61,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74,
+	0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xdb,
+	0x03, 0x0a, 0x0d, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x77, 0x6d, 0x61, 0x5f, 0x6c, 0x61, 0x6d, 0x62,
+	0x64, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x77, 0x6d, 0x61, 0x4c, 0x61,
+	0x6d, 0x62, 0x64, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x5f, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x4b, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x75, 0x73, 0x75, 0x6d, 0x5f, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x63, 0x75, 0x73, 0x75, 0x6d, 0x48, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c,
+	0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x09, 0x61, 0x6e,
+	0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a,
+	0x17, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x61, 0x77, 0x44, 0x69,
+	0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x69, 0x61, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x62, 0x69, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x69, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x62, 0x69, 0x61, 0x73, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x69, 0x61, 0x73, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x62, 0x69, 0x61, 0x73, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68,
+	0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73,
+	0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x5f,
+	0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e,
+	0x64, 0x4d, 0x65, 0x61, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x73, 0x75, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x8b, 0x07, 0x0a, 0x0d, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4e,
+	0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x54,
+	0x0a, 0x0f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0e, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x6f,
+	0x77, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x0e, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x55, 0x70, 0x70, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x61, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x12, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c,
+	0x6f, 0x77, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x70, 0x70, 0x65, 0x72, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x55, 0x70, 0x70, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe,
+	0x02, 0x0a, 0x10, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x37, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x2f, 0x0a, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22,
+	0xaa, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x96, 0x02, 0x0a,
+	0x0b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x63, 0x0a, 0x11, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x1a, 0x43, 0x0a, 0x15, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x02, 0x0a, 0x13, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x41,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x41, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x42, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x42, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61,
+	0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x62, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x41, 0x62, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xb9, 0x03, 0x0a,
+	0x1a, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x6f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x6c, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x40, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x67,
+	0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xf7, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x75, 0x6e,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x6e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xce, 0x02, 0x0a, 0x0b, 0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x92, 0x02, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x62, 0x0a, 0x13, 0x4c, 0x74,
+	0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c,
+	0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x19,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x0f, 0x4c,
+	0x74, 0x69, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x69, 0x74, 0x65,
+	0x6d, 0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x61, 0x6e,
+	0x50, 0x61, 0x73, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x22, 0x3a, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x74, 0x69, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x74, 0x69, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c,
+	0x74, 0x69, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x56, 0x0a, 0x16, 0x4c, 0x69, 0x6e, 0x6b, 0x4c, 0x74, 0x69,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x62, 0x0a,
+	0x17, 0x4c, 0x74, 0x69, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x4c, 0x74, 0x69, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3c, 0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xe9, 0x01, 0x0a, 0x0e, 0x4c, 0x74, 0x69, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x16,
+	0x50, 0x61, 0x73, 0x73, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x74,
+	0x69, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
+	0xca, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3d, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x12, 0x17, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdf, 0x04, 0x0a,
+	0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1d,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1b, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe5,
+	0x04, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc1, 0x03, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa3, 0x05, 0x0a, 0x0d, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x15, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x12, 0x5d, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xaa, 0x08, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x1a, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x1e, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x50,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x63, 0x0a,
+	0x10, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x30, 0x01, 0x32, 0xf9, 0x04, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x23, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x6e, 0x6f, 0x6d, 0x61,
+	0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x69,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x1c, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x26, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93,
+	0x03, 0x0a, 0x18, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x15, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x12, 0x21,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe8, 0x04, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x53,
+	0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x6f, 0x6c, 0x64, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x27, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
+	0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x8b, 0x06, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb9, 0x05,
+	0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8b, 0x05, 0x0a, 0x12, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x56, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x6b,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e,
+	0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x10, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61,
+	0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcb, 0x03, 0x0a, 0x0a, 0x4c, 0x74, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x24, 0x2e, 0x74, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x74,
+	0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x74, 0x69, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x12, 0x21, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x74, 0x69, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x25, 0x2e, 0x74,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x74, 0x69, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x74, 0x69, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x74, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4c, 0x74, 0x69, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x74, 0x69, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x61,
+	0x73, 0x73, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50,
+	0x61, 0x73, 0x73, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x55, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x1c, 0x2e, 0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x74, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x22, 0x5a, 0x20,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_talytics_proto_rawDescOnce sync.Once
+	file_proto_talytics_proto_rawDescData = file_proto_talytics_proto_rawDesc
+)
+
+func file_proto_talytics_proto_rawDescGZIP() []byte {
+	file_proto_talytics_proto_rawDescOnce.Do(func() {
+		file_proto_talytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_talytics_proto_rawDescData)
+	})
+	return file_proto_talytics_proto_rawDescData
+}
+
+var file_proto_talytics_proto_msgTypes = make([]protoimpl.MessageInfo, 242)
+var file_proto_talytics_proto_goTypes = []interface{}{
+	(*User)(nil),                               // 0: talytics.User
+	(*RegisterRequest)(nil),                    // 1: talytics.RegisterRequest
+	(*LoginRequest)(nil),                       // 2: talytics.LoginRequest
+	(*AuthResponse)(nil),                       // 3: talytics.AuthResponse
+	(*LogoutRequest)(nil),                      // 4: talytics.LogoutRequest
+	(*LogoutResponse)(nil),                     // 5: talytics.LogoutResponse
+	(*GetProfileRequest)(nil),                  // 6: talytics.GetProfileRequest
+	(*VerifyTokenRequest)(nil),                 // 7: talytics.VerifyTokenRequest
+	(*UserResponse)(nil),                       // 8: talytics.UserResponse
+	(*Course)(nil),                             // 9: talytics.Course
+	(*CourseMember)(nil),                       // 10: talytics.CourseMember
+	(*CreateCourseRequest)(nil),                // 11: talytics.CreateCourseRequest
+	(*GetCourseRequest)(nil),                   // 12: talytics.GetCourseRequest
+	(*ListCoursesRequest)(nil),                 // 13: talytics.ListCoursesRequest
+	(*UpdateCourseRequest)(nil),                // 14: talytics.UpdateCourseRequest
+	(*JoinCourseRequest)(nil),                  // 15: talytics.JoinCourseRequest
+	(*LeaveCourseRequest)(nil),                 // 16: talytics.LeaveCourseRequest
+	(*DeleteCourseRequest)(nil),                // 17: talytics.DeleteCourseRequest
+	(*SetStudentIdRequest)(nil),                // 18: talytics.SetStudentIdRequest
+	(*CourseResponse)(nil),                     // 19: talytics.CourseResponse
+	(*ListCoursesResponse)(nil),                // 20: talytics.ListCoursesResponse
+	(*LeaveCourseResponse)(nil),                // 21: talytics.LeaveCourseResponse
+	(*DeleteCourseResponse)(nil),               // 22: talytics.DeleteCourseResponse
+	(*SetStudentIdResponse)(nil),               // 23: talytics.SetStudentIdResponse
+	(*Assignment)(nil),                         // 24: talytics.Assignment
+	(*CreateAssignmentRequest)(nil),            // 25: talytics.CreateAssignmentRequest
+	(*GetAssignmentRequest)(nil),               // 26: talytics.GetAssignmentRequest
+	(*ListAssignmentsRequest)(nil),             // 27: talytics.ListAssignmentsRequest
+	(*UpdateAssignmentRequest)(nil),            // 28: talytics.UpdateAssignmentRequest
+	(*DeleteAssignmentRequest)(nil),            // 29: talytics.DeleteAssignmentRequest
+	(*AssignmentResponse)(nil),                 // 30: talytics.AssignmentResponse
+	(*ListAssignmentsResponse)(nil),            // 31: talytics.ListAssignmentsResponse
+	(*SetReleaseStateRequest)(nil),             // 32: talytics.SetReleaseStateRequest
+	(*SetBlindGradingRequest)(nil),             // 33: talytics.SetBlindGradingRequest
+	(*DeleteAssignmentResponse)(nil),           // 34: talytics.DeleteAssignmentResponse
+	(*Submission)(nil),                         // 35: talytics.Submission
+	(*UploadSubmissionRequest)(nil),            // 36: talytics.UploadSubmissionRequest
+	(*GetSubmissionRequest)(nil),               // 37: talytics.GetSubmissionRequest
+	(*ListSubmissionsRequest)(nil),             // 38: talytics.ListSubmissionsRequest
+	(*DeleteSubmissionRequest)(nil),            // 39: talytics.DeleteSubmissionRequest
+	(*SubmissionResponse)(nil),                 // 40: talytics.SubmissionResponse
+	(*ListSubmissionsResponse)(nil),            // 41: talytics.ListSubmissionsResponse
+	(*SubmissionFileResponse)(nil),             // 42: talytics.SubmissionFileResponse
+	(*DeleteSubmissionResponse)(nil),           // 43: talytics.DeleteSubmissionResponse
+	(*Rubric)(nil),                             // 44: talytics.Rubric
+	(*RubricCriterion)(nil),                    // 45: talytics.RubricCriterion
+	(*RubricLevel)(nil),                        // 46: talytics.RubricLevel
+	(*CreateRubricRequest)(nil),                // 47: talytics.CreateRubricRequest
+	(*GetRubricRequest)(nil),                   // 48: talytics.GetRubricRequest
+	(*ListRubricsRequest)(nil),                 // 49: talytics.ListRubricsRequest
+	(*UpdateRubricRequest)(nil),                // 50: talytics.UpdateRubricRequest
+	(*DeleteRubricRequest)(nil),                // 51: talytics.DeleteRubricRequest
+	(*RubricResponse)(nil),                     // 52: talytics.RubricResponse
+	(*ListRubricsResponse)(nil),                // 53: talytics.ListRubricsResponse
+	(*DeleteRubricResponse)(nil),               // 54: talytics.DeleteRubricResponse
+	(*RubricVersion)(nil),                      // 55: talytics.RubricVersion
+	(*ListRubricVersionsRequest)(nil),          // 56: talytics.ListRubricVersionsRequest
+	(*ListRubricVersionsResponse)(nil),         // 57: talytics.ListRubricVersionsResponse
+	(*CompareRubricVersionsRequest)(nil),       // 58: talytics.CompareRubricVersionsRequest
+	(*RubricCriterionChange)(nil),              // 59: talytics.RubricCriterionChange
+	(*RubricVersionScores)(nil),                // 60: talytics.RubricVersionScores
+	(*RubricVersionComparison)(nil),            // 61: talytics.RubricVersionComparison
+	(*SimulateRubricChangeRequest)(nil),        // 62: talytics.SimulateRubricChangeRequest
+	(*CriterionMapping)(nil),                   // 63: talytics.CriterionMapping
+	(*CriterionSource)(nil),                    // 64: talytics.CriterionSource
+	(*ScoreDistribution)(nil),                  // 65: talytics.ScoreDistribution
+	(*DistributionBin)(nil),                    // 66: talytics.DistributionBin
+	(*StudentScoreDelta)(nil),                  // 67: talytics.StudentScoreDelta
+	(*GraderVariance)(nil),                     // 68: talytics.GraderVariance
+	(*GraderScoreShift)(nil),                   // 69: talytics.GraderScoreShift
+	(*ProposedCriterionImpact)(nil),            // 70: talytics.ProposedCriterionImpact
+	(*RubricImpactResponse)(nil),               // 71: talytics.RubricImpactResponse
+	(*Grade)(nil),                              // 72: talytics.Grade
+	(*GradeData)(nil),                          // 73: talytics.GradeData
+	(*UploadGradesRequest)(nil),                // 74: talytics.UploadGradesRequest
+	(*GradeImportRowError)(nil),                // 75: talytics.GradeImportRowError
+	(*UploadGradesResponse)(nil),               // 76: talytics.UploadGradesResponse
+	(*GradeImportChunk)(nil),                   // 77: talytics.GradeImportChunk
+	(*GetGradeStatsRequest)(nil),               // 78: talytics.GetGradeStatsRequest
+	(*GradeStat)(nil),                          // 79: talytics.GradeStat
+	(*GetGradeStatsResponse)(nil),              // 80: talytics.GetGradeStatsResponse
+	(*GetGradeDistributionRequest)(nil),        // 81: talytics.GetGradeDistributionRequest
+	(*QuestionDistribution)(nil),               // 82: talytics.QuestionDistribution
+	(*TADistribution)(nil),                     // 83: talytics.TADistribution
+	(*GetGradeDistributionResponse)(nil),       // 84: talytics.GetGradeDistributionResponse
+	(*RubricGrade)(nil),                        // 85: talytics.RubricGrade
+	(*SubmitGradeRequest)(nil),                 // 86: talytics.SubmitGradeRequest
+	(*SubmitGradeResponse)(nil),                // 87: talytics.SubmitGradeResponse
+	(*GetSubmissionGradeRequest)(nil),          // 88: talytics.GetSubmissionGradeRequest
+	(*SubmissionGradeResponse)(nil),            // 89: talytics.SubmissionGradeResponse
+	(*ListAssignmentGradesRequest)(nil),        // 90: talytics.ListAssignmentGradesRequest
+	(*ListRegradeQueueRequest)(nil),            // 91: talytics.ListRegradeQueueRequest
+	(*ListGradesResponse)(nil),                 // 92: talytics.ListGradesResponse
+	(*GradeRevision)(nil),                      // 93: talytics.GradeRevision
+	(*GetGradeHistoryRequest)(nil),             // 94: talytics.GetGradeHistoryRequest
+	(*GradeHistoryResponse)(nil),               // 95: talytics.GradeHistoryResponse
+	(*DiffGradeRevisionsRequest)(nil),          // 96: talytics.DiffGradeRevisionsRequest
+	(*CriterionChange)(nil),                    // 97: talytics.CriterionChange
+	(*GradeRevisionDiffResponse)(nil),          // 98: talytics.GradeRevisionDiffResponse
+	(*GradeComment)(nil),                       // 99: talytics.GradeComment
+	(*ListGradeCommentsRequest)(nil),           // 100: talytics.ListGradeCommentsRequest
+	(*ListGradeCommentsResponse)(nil),          // 101: talytics.ListGradeCommentsResponse
+	(*PostGradeCommentRequest)(nil),            // 102: talytics.PostGradeCommentRequest
+	(*GradeCommentResponse)(nil),               // 103: talytics.GradeCommentResponse
+	(*DistributeSubmissionsRequest)(nil),       // 104: talytics.DistributeSubmissionsRequest
+	(*GradingAssignment)(nil),                  // 105: talytics.GradingAssignment
+	(*DistributeSubmissionsResponse)(nil),      // 106: talytics.DistributeSubmissionsResponse
+	(*ListGradingQueueRequest)(nil),            // 107: talytics.ListGradingQueueRequest
+	(*GradingQueueItem)(nil),                   // 108: talytics.GradingQueueItem
+	(*GradingQueueResponse)(nil),               // 109: talytics.GradingQueueResponse
+	(*GetGradingProgressRequest)(nil),          // 110: talytics.GetGradingProgressRequest
+	(*GraderProgress)(nil),                     // 111: talytics.GraderProgress
+	(*GradingProgressResponse)(nil),            // 112: talytics.GradingProgressResponse
+	(*ConfigureOverlapRequest)(nil),            // 113: talytics.ConfigureOverlapRequest
+	(*OverlapSettingsResponse)(nil),            // 114: talytics.OverlapSettingsResponse
+	(*CalibrationGrade)(nil),                   // 115: talytics.CalibrationGrade
+	(*SetGoldGradeRequest)(nil),                // 116: talytics.SetGoldGradeRequest
+	(*SubmitCalibrationGradeRequest)(nil),      // 117: talytics.SubmitCalibrationGradeRequest
+	(*CalibrationGradeResponse)(nil),           // 118: talytics.CalibrationGradeResponse
+	(*ListCalibrationSubmissionsRequest)(nil),  // 119: talytics.ListCalibrationSubmissionsRequest
+	(*CalibrationSubmission)(nil),              // 120: talytics.CalibrationSubmission
+	(*CalibrationSubmissionsResponse)(nil),     // 121: talytics.CalibrationSubmissionsResponse
+	(*GetCalibrationReportRequest)(nil),        // 122: talytics.GetCalibrationReportRequest
+	(*CriterionDeviation)(nil),                 // 123: talytics.CriterionDeviation
+	(*GraderCalibration)(nil),                  // 124: talytics.GraderCalibration
+	(*CalibrationReportResponse)(nil),          // 125: talytics.CalibrationReportResponse
+	(*ConfigureCalibrationRequest)(nil),        // 126: talytics.ConfigureCalibrationRequest
+	(*CalibrationSettingsResponse)(nil),        // 127: talytics.CalibrationSettingsResponse
+	(*ResetCalibrationRequest)(nil),            // 128: talytics.ResetCalibrationRequest
+	(*ResetCalibrationResponse)(nil),           // 129: talytics.ResetCalibrationResponse
+	(*RegradeRequest)(nil),                     // 130: talytics.RegradeRequest
+	(*CreateRegradeRequestRequest)(nil),        // 131: talytics.CreateRegradeRequestRequest
+	(*RegradeRequestResponse)(nil),             // 132: talytics.RegradeRequestResponse
+	(*ListRegradeRequestsRequest)(nil),         // 133: talytics.ListRegradeRequestsRequest
+	(*ListRegradeRequestsResponse)(nil),        // 134: talytics.ListRegradeRequestsResponse
+	(*GetRegradeRequestRequest)(nil),           // 135: talytics.GetRegradeRequestRequest
+	(*AssignRegradeRequestRequest)(nil),        // 136: talytics.AssignRegradeRequestRequest
+	(*AcceptRegradeRequestRequest)(nil),        // 137: talytics.AcceptRegradeRequestRequest
+	(*RejectRegradeRequestRequest)(nil),        // 138: talytics.RejectRegradeRequestRequest
+	(*ResolveRegradeRequestRequest)(nil),       // 139: talytics.ResolveRegradeRequestRequest
+	(*GetRegradeStatsRequest)(nil),             // 140: talytics.GetRegradeStatsRequest
+	(*GraderRegradeStats)(nil),                 // 141: talytics.GraderRegradeStats
+	(*RegradeStatsResponse)(nil),               // 142: talytics.RegradeStatsResponse
+	(*ListStudentCoursesRequest)(nil),          // 143: talytics.ListStudentCoursesRequest
+	(*StudentCourse)(nil),                      // 144: talytics.StudentCourse
+	(*StudentCoursesResponse)(nil),             // 145: talytics.StudentCoursesResponse
+	(*ListStudentAssignmentsRequest)(nil),      // 146: talytics.ListStudentAssignmentsRequest
+	(*StudentAssignment)(nil),                  // 147: talytics.StudentAssignment
+	(*StudentAssignmentsResponse)(nil),         // 148: talytics.StudentAssignmentsResponse
+	(*ListStudentSubmissionsRequest)(nil),      // 149: talytics.ListStudentSubmissionsRequest
+	(*StudentSubmission)(nil),                  // 150: talytics.StudentSubmission
+	(*StudentSubmissionsResponse)(nil),         // 151: talytics.StudentSubmissionsResponse
+	(*GetStudentGradeRequest)(nil),             // 152: talytics.GetStudentGradeRequest
+	(*StudentCriterionScore)(nil),              // 153: talytics.StudentCriterionScore
+	(*StudentGrade)(nil),                       // 154: talytics.StudentGrade
+	(*StudentGradeResponse)(nil),               // 155: talytics.StudentGradeResponse
+	(*CreateStudentRegradeRequestRequest)(nil), // 156: talytics.CreateStudentRegradeRequestRequest
+	(*GetStudentRubricRequest)(nil),            // 157: talytics.GetStudentRubricRequest
+	(*StudentRubricCriterion)(nil),             // 158: talytics.StudentRubricCriterion
+	(*StudentRubricResponse)(nil),              // 159: talytics.StudentRubricResponse
+	(*BankComment)(nil),                        // 160: talytics.BankComment
+	(*CreateBankCommentRequest)(nil),           // 161: talytics.CreateBankCommentRequest
+	(*ListBankCommentsRequest)(nil),            // 162: talytics.ListBankCommentsRequest
+	(*ListBankCommentsResponse)(nil),           // 163: talytics.ListBankCommentsResponse
+	(*UpdateBankCommentRequest)(nil),           // 164: talytics.UpdateBankCommentRequest
+	(*BankCommentResponse)(nil),                // 165: talytics.BankCommentResponse
+	(*DeleteBankCommentRequest)(nil),           // 166: talytics.DeleteBankCommentRequest
+	(*DeleteBankCommentResponse)(nil),          // 167: talytics.DeleteBankCommentResponse
+	(*ApplyBankCommentRequest)(nil),            // 168: talytics.ApplyBankCommentRequest
+	(*AppliedBankComment)(nil),                 // 169: talytics.AppliedBankComment
+	(*GetCommentBankStatsRequest)(nil),         // 170: talytics.GetCommentBankStatsRequest
+	(*GraderBankCommentUsage)(nil),             // 171: talytics.GraderBankCommentUsage
+	(*BankCommentStats)(nil),                   // 172: talytics.BankCommentStats
+	(*GraderBankUsage)(nil),                    // 173: talytics.GraderBankUsage
+	(*CommentBankStatsResponse)(nil),           // 174: talytics.CommentBankStatsResponse
+	(*RunAnomalyAnalysisRequest)(nil),          // 175: talytics.RunAnomalyAnalysisRequest
+	(*Anomaly)(nil),                            // 176: talytics.Anomaly
+	(*Statistics)(nil),                         // 177: talytics.Statistics
+	(*AnomalyAnalysisResponse)(nil),            // 178: talytics.AnomalyAnalysisResponse
+	(*GetAnalysisHistoryRequest)(nil),          // 179: talytics.GetAnalysisHistoryRequest
+	(*AnalysisResult)(nil),                     // 180: talytics.AnalysisResult
+	(*GetAnalysisHistoryResponse)(nil),         // 181: talytics.GetAnalysisHistoryResponse
+	(*GetReliabilityRequest)(nil),              // 182: talytics.GetReliabilityRequest
+	(*ReliabilityEstimate)(nil),                // 183: talytics.ReliabilityEstimate
+	(*ReliabilityResponse)(nil),                // 184: talytics.ReliabilityResponse
+	(*CompareGradersRequest)(nil),              // 185: talytics.CompareGradersRequest
+	(*GraderGroup)(nil),                        // 186: talytics.GraderGroup
+	(*PairwiseComparison)(nil),                 // 187: talytics.PairwiseComparison
+	(*AnovaTest)(nil),                          // 188: talytics.AnovaTest
+	(*KruskalWallisTest)(nil),                  // 189: talytics.KruskalWallisTest
+	(*GraderDifferenceTest)(nil),               // 190: talytics.GraderDifferenceTest
+	(*GraderComparisonResponse)(nil),           // 191: talytics.GraderComparisonResponse
+	(*DetectDriftRequest)(nil),                 // 192: talytics.DetectDriftRequest
+	(*DriftPoint)(nil),                         // 193: talytics.DriftPoint
+	(*DriftSeries)(nil),                        // 194: talytics.DriftSeries
+	(*DriftResponse)(nil),                      // 195: talytics.DriftResponse
+	(*EstimateLeniencyRequest)(nil),            // 196: talytics.EstimateLeniencyRequest
+	(*GraderLeniency)(nil),                     // 197: talytics.GraderLeniency
+	(*CriterionLeniency)(nil),                  // 198: talytics.CriterionLeniency
+	(*AdjustedGrade)(nil),                      // 199: talytics.AdjustedGrade
+	(*LeniencyResponse)(nil),                   // 200: talytics.LeniencyResponse
+	(*GetDisagreementReportRequest)(nil),       // 201: talytics.GetDisagreementReportRequest
+	(*GraderScore)(nil),                        // 202: talytics.GraderScore
+	(*SubmissionDisagreement)(nil),             // 203: talytics.SubmissionDisagreement
+	(*GraderPairAgreement)(nil),                // 204: talytics.GraderPairAgreement
+	(*DisagreementReportResponse)(nil),         // 205: talytics.DisagreementReportResponse
+	(*HealthCheckRequest)(nil),                 // 206: talytics.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                // 207: talytics.HealthCheckResponse
+	(*ExportGradebookRequest)(nil),             // 208: talytics.ExportGradebookRequest
+	(*GradebookChunk)(nil),                     // 209: talytics.GradebookChunk
+	(*LtiPlatform)(nil),                        // 210: talytics.LtiPlatform
+	(*RegisterLtiPlatformRequest)(nil),         // 211: talytics.RegisterLtiPlatformRequest
+	(*LtiPlatformResponse)(nil),                // 212: talytics.LtiPlatformResponse
+	(*ListLtiPlatformsRequest)(nil),            // 213: talytics.ListLtiPlatformsRequest
+	(*ListLtiPlatformsResponse)(nil),           // 214: talytics.ListLtiPlatformsResponse
+	(*LtiResourceLink)(nil),                    // 215: talytics.LtiResourceLink
+	(*ListLtiResourceLinksRequest)(nil),        // 216: talytics.ListLtiResourceLinksRequest
+	(*ListLtiResourceLinksResponse)(nil),       // 217: talytics.ListLtiResourceLinksResponse
+	(*LinkLtiResourceRequest)(nil),             // 218: talytics.LinkLtiResourceRequest
+	(*LtiResourceLinkResponse)(nil),            // 219: talytics.LtiResourceLinkResponse
+	(*PassbackGradesRequest)(nil),              // 220: talytics.PassbackGradesRequest
+	(*LtiScoreResult)(nil),                     // 221: talytics.LtiScoreResult
+	(*PassbackGradesResponse)(nil),             // 222: talytics.PassbackGradesResponse
+	nil,                                        // 223: talytics.QuestionDistribution.TaDistributionsEntry
+	nil,                                        // 224: talytics.RubricGrade.RubricScoresEntry
+	nil,                                        // 225: talytics.RubricGrade.CriterionFeedbackEntry
+	nil,                                        // 226: talytics.SubmitGradeRequest.RubricScoresEntry
+	nil,                                        // 227: talytics.SubmitGradeRequest.CriterionFeedbackEntry
+	nil,                                        // 228: talytics.GradeRevision.OldRubricScoresEntry
+	nil,                                        // 229: talytics.GradeRevision.NewRubricScoresEntry
+	nil,                                        // 230: talytics.CalibrationGrade.RubricScoresEntry
+	nil,                                        // 231: talytics.SetGoldGradeRequest.RubricScoresEntry
+	nil,                                        // 232: talytics.SubmitCalibrationGradeRequest.RubricScoresEntry
+	nil,                                        // 233: talytics.AcceptRegradeRequestRequest.RubricScoresEntry
+	nil,                                        // 234: talytics.Anomaly.DetailsEntry
+	nil,                                        // 235: talytics.AnomalyAnalysisResponse.StatisticsEntry
+	nil,                                        // 236: talytics.AdjustedGrade.RubricScoresEntry
+	nil,                                        // 237: talytics.AdjustedGrade.AdjustedScoresEntry
+	nil,                                        // 238: talytics.AdjustedGrade.AdjustedLowerEntry
+	nil,                                        // 239: talytics.AdjustedGrade.AdjustedUpperEntry
+	nil,                                        // 240: talytics.GraderScore.RubricScoresEntry
+	nil,                                        // 241: talytics.SubmissionDisagreement.CriterionSpreadsEntry
+	(*timestamp.Timestamp)(nil),                // 242: google.protobuf.Timestamp
+}
+var file_proto_talytics_proto_depIdxs = []int32{
+	242, // 0: talytics.User.created_at:type_name -> google.protobuf.Timestamp
+	242, // 1: talytics.User.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: talytics.AuthResponse.user:type_name -> talytics.User
+	0,   // 3: talytics.UserResponse.user:type_name -> talytics.User
+	10,  // 4: talytics.Course.members:type_name -> talytics.CourseMember
+	242, // 5: talytics.Course.created_at:type_name -> google.protobuf.Timestamp
+	242, // 6: talytics.Course.updated_at:type_name -> google.protobuf.Timestamp
+	242, // 7: talytics.CourseMember.joined_at:type_name -> google.protobuf.Timestamp
+	9,   // 8: talytics.CourseResponse.course:type_name -> talytics.Course
+	9,   // 9: talytics.ListCoursesResponse.courses:type_name -> talytics.Course
+	10,  // 10: talytics.SetStudentIdResponse.member:type_name -> talytics.CourseMember
+	242, // 11: talytics.Assignment.due_date:type_name -> google.protobuf.Timestamp
+	44,  // 12: talytics.Assignment.rubric:type_name -> talytics.Rubric
+	242, // 13: talytics.Assignment.created_at:type_name -> google.protobuf.Timestamp
+	242, // 14: talytics.Assignment.updated_at:type_name -> google.protobuf.Timestamp
+	242, // 15: talytics.Assignment.release_at:type_name -> google.protobuf.Timestamp
+	242, // 16: talytics.CreateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	242, // 17: talytics.UpdateAssignmentRequest.due_date:type_name -> google.protobuf.Timestamp
+	24,  // 18: talytics.AssignmentResponse.assignment:type_name -> talytics.Assignment
+	24,  // 19: talytics.ListAssignmentsResponse.assignments:type_name -> talytics.Assignment
+	242, // 20: talytics.SetReleaseStateRequest.release_at:type_name -> google.protobuf.Timestamp
+	242, // 21: talytics.Submission.uploaded_at:type_name -> google.protobuf.Timestamp
+	35,  // 22: talytics.SubmissionResponse.submission:type_name -> talytics.Submission
+	35,  // 23: talytics.ListSubmissionsResponse.submissions:type_name -> talytics.Submission
+	242, // 24: talytics.Rubric.created_at:type_name -> google.protobuf.Timestamp
+	242, // 25: talytics.Rubric.updated_at:type_name -> google.protobuf.Timestamp
+	45,  // 26: talytics.Rubric.criterion_tree:type_name -> talytics.RubricCriterion
+	46,  // 27: talytics.RubricCriterion.levels:type_name -> talytics.RubricLevel
+	45,  // 28: talytics.RubricCriterion.sub_criteria:type_name -> talytics.RubricCriterion
+	45,  // 29: talytics.CreateRubricRequest.criterion_tree:type_name -> talytics.RubricCriterion
+	45,  // 30: talytics.UpdateRubricRequest.criterion_tree:type_name -> talytics.RubricCriterion
+	44,  // 31: talytics.RubricResponse.rubric:type_name -> talytics.Rubric
+	44,  // 32: talytics.ListRubricsResponse.rubrics:type_name -> talytics.Rubric
+	242, // 33: talytics.RubricVersion.created_at:type_name -> google.protobuf.Timestamp
+	45,  // 34: talytics.RubricVersion.criterion_tree:type_name -> talytics.RubricCriterion
+	55,  // 35: talytics.ListRubricVersionsResponse.versions:type_name -> talytics.RubricVersion
+	55,  // 36: talytics.RubricVersionComparison.from:type_name -> talytics.RubricVersion
+	55,  // 37: talytics.RubricVersionComparison.to:type_name -> talytics.RubricVersion
+	59,  // 38: talytics.RubricVersionComparison.criteria:type_name -> talytics.RubricCriterionChange
+	60,  // 39: talytics.RubricVersionComparison.from_scores:type_name -> talytics.RubricVersionScores
+	60,  // 40: talytics.RubricVersionComparison.to_scores:type_name -> talytics.RubricVersionScores
+	45,  // 41: talytics.SimulateRubricChangeRequest.criterion_tree:type_name -> talytics.RubricCriterion
+	63,  // 42: talytics.SimulateRubricChangeRequest.mappings:type_name -> talytics.CriterionMapping
+	64,  // 43: talytics.CriterionMapping.sources:type_name -> talytics.CriterionSource
+	64,  // 44: talytics.ProposedCriterionImpact.sources:type_name -> talytics.CriterionSource
+	70,  // 45: talytics.RubricImpactResponse.criteria:type_name -> talytics.ProposedCriterionImpact
+	65,  // 46: talytics.RubricImpactResponse.before:type_name -> talytics.ScoreDistribution
+	65,  // 47: talytics.RubricImpactResponse.after:type_name -> talytics.ScoreDistribution
+	66,  // 48: talytics.RubricImpactResponse.histogram:type_name -> talytics.DistributionBin
+	67,  // 49: talytics.RubricImpactResponse.students:type_name -> talytics.StudentScoreDelta
+	68,  // 50: talytics.RubricImpactResponse.grader_variance_before:type_name -> talytics.GraderVariance
+	68,  // 51: talytics.RubricImpactResponse.grader_variance_after:type_name -> talytics.GraderVariance
+	69,  // 52: talytics.RubricImpactResponse.graders:type_name -> talytics.GraderScoreShift
+	242, // 53: talytics.Grade.graded_at:type_name -> google.protobuf.Timestamp
+	73,  // 54: talytics.UploadGradesRequest.grades:type_name -> talytics.GradeData
+	75,  // 55: talytics.UploadGradesResponse.row_errors:type_name -> talytics.GradeImportRowError
+	79,  // 56: talytics.GetGradeStatsResponse.stats:type_name -> talytics.GradeStat
+	223, // 57: talytics.QuestionDistribution.ta_distributions:type_name -> talytics.QuestionDistribution.TaDistributionsEntry
+	82,  // 58: talytics.GetGradeDistributionResponse.distributions:type_name -> talytics.QuestionDistribution
+	224, // 59: talytics.RubricGrade.rubric_scores:type_name -> talytics.RubricGrade.RubricScoresEntry
+	242, // 60: talytics.RubricGrade.graded_at:type_name -> google.protobuf.Timestamp
+	242, // 61: talytics.RubricGrade.updated_at:type_name -> google.protobuf.Timestamp
+	225, // 62: talytics.RubricGrade.criterion_feedback:type_name -> talytics.RubricGrade.CriterionFeedbackEntry
+	169, // 63: talytics.RubricGrade.applied_comments:type_name -> talytics.AppliedBankComment
+	226, // 64: talytics.SubmitGradeRequest.rubric_scores:type_name -> talytics.SubmitGradeRequest.RubricScoresEntry
+	227, // 65: talytics.SubmitGradeRequest.criterion_feedback:type_name -> talytics.SubmitGradeRequest.CriterionFeedbackEntry
+	85,  // 66: talytics.SubmitGradeResponse.grade:type_name -> talytics.RubricGrade
+	85,  // 67: talytics.SubmissionGradeResponse.grade:type_name -> talytics.RubricGrade
+	85,  // 68: talytics.SubmissionGradeResponse.grades:type_name -> talytics.RubricGrade
+	85,  // 69: talytics.ListGradesResponse.grades:type_name -> talytics.RubricGrade
+	228, // 70: talytics.GradeRevision.old_rubric_scores:type_name -> talytics.GradeRevision.OldRubricScoresEntry
+	229, // 71: talytics.GradeRevision.new_rubric_scores:type_name -> talytics.GradeRevision.NewRubricScoresEntry
+	242, // 72: talytics.GradeRevision.changed_at:type_name -> google.protobuf.Timestamp
+	93,  // 73: talytics.GradeHistoryResponse.revisions:type_name -> talytics.GradeRevision
+	97,  // 74: talytics.GradeRevisionDiffResponse.changes:type_name -> talytics.CriterionChange
+	242, // 75: talytics.GradeComment.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 76: talytics.ListGradeCommentsResponse.comments:type_name -> talytics.GradeComment
+	99,  // 77: talytics.GradeCommentResponse.comment:type_name -> talytics.GradeComment
+	242, // 78: talytics.GradingAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	105, // 79: talytics.DistributeSubmissionsResponse.assignments:type_name -> talytics.GradingAssignment
+	242, // 80: talytics.GradingQueueItem.assigned_at:type_name -> google.protobuf.Timestamp
+	108, // 81: talytics.GradingQueueResponse.items:type_name -> talytics.GradingQueueItem
+	111, // 82: talytics.GradingProgressResponse.graders:type_name -> talytics.GraderProgress
+	230, // 83: talytics.CalibrationGrade.rubric_scores:type_name -> talytics.CalibrationGrade.RubricScoresEntry
+	242, // 84: talytics.CalibrationGrade.graded_at:type_name -> google.protobuf.Timestamp
+	231, // 85: talytics.SetGoldGradeRequest.rubric_scores:type_name -> talytics.SetGoldGradeRequest.RubricScoresEntry
+	232, // 86: talytics.SubmitCalibrationGradeRequest.rubric_scores:type_name -> talytics.SubmitCalibrationGradeRequest.RubricScoresEntry
+	115, // 87: talytics.CalibrationGradeResponse.grade:type_name -> talytics.CalibrationGrade
+	120, // 88: talytics.CalibrationSubmissionsResponse.submissions:type_name -> talytics.CalibrationSubmission
+	123, // 89: talytics.GraderCalibration.criteria:type_name -> talytics.CriterionDeviation
+	124, // 90: talytics.CalibrationReportResponse.graders:type_name -> talytics.GraderCalibration
+	242, // 91: talytics.RegradeRequest.created_at:type_name -> google.protobuf.Timestamp
+	242, // 92: talytics.RegradeRequest.updated_at:type_name -> google.protobuf.Timestamp
+	242, // 93: talytics.RegradeRequest.resolved_at:type_name -> google.protobuf.Timestamp
+	130, // 94: talytics.RegradeRequestResponse.request:type_name -> talytics.RegradeRequest
+	130, // 95: talytics.ListRegradeRequestsResponse.requests:type_name -> talytics.RegradeRequest
+	233, // 96: talytics.AcceptRegradeRequestRequest.rubric_scores:type_name -> talytics.AcceptRegradeRequestRequest.RubricScoresEntry
+	141, // 97: talytics.RegradeStatsResponse.graders:type_name -> talytics.GraderRegradeStats
+	144, // 98: talytics.StudentCoursesResponse.courses:type_name -> talytics.StudentCourse
+	242, // 99: talytics.StudentAssignment.due_date:type_name -> google.protobuf.Timestamp
+	147, // 100: talytics.StudentAssignmentsResponse.assignments:type_name -> talytics.StudentAssignment
+	242, // 101: talytics.StudentSubmission.uploaded_at:type_name -> google.protobuf.Timestamp
+	150, // 102: talytics.StudentSubmissionsResponse.submissions:type_name -> talytics.StudentSubmission
+	153, // 103: talytics.StudentGrade.criteria:type_name -> talytics.StudentCriterionScore
+	242, // 104: talytics.StudentGrade.graded_at:type_name -> google.protobuf.Timestamp
+	242, // 105: talytics.StudentGrade.updated_at:type_name -> google.protobuf.Timestamp
+	154, // 106: talytics.StudentGradeResponse.grade:type_name -> talytics.StudentGrade
+	158, // 107: talytics.StudentRubricResponse.criteria:type_name -> talytics.StudentRubricCriterion
+	45,  // 108: talytics.StudentRubricResponse.criterion_tree:type_name -> talytics.RubricCriterion
+	242, // 109: talytics.BankComment.created_at:type_name -> google.protobuf.Timestamp
+	242, // 110: talytics.BankComment.updated_at:type_name -> google.protobuf.Timestamp
+	160, // 111: talytics.ListBankCommentsResponse.comments:type_name -> talytics.BankComment
+	160, // 112: talytics.BankCommentResponse.comment:type_name -> talytics.BankComment
+	242, // 113: talytics.AppliedBankComment.applied_at:type_name -> google.protobuf.Timestamp
+	171, // 114: talytics.BankCommentStats.graders:type_name -> talytics.GraderBankCommentUsage
+	172, // 115: talytics.CommentBankStatsResponse.comments:type_name -> talytics.BankCommentStats
+	173, // 116: talytics.CommentBankStatsResponse.graders:type_name -> talytics.GraderBankUsage
+	234, // 117: talytics.Anomaly.details:type_name -> talytics.Anomaly.DetailsEntry
+	176, // 118: talytics.AnomalyAnalysisResponse.anomalies:type_name -> talytics.Anomaly
+	235, // 119: talytics.AnomalyAnalysisResponse.statistics:type_name -> talytics.AnomalyAnalysisResponse.StatisticsEntry
+	242, // 120: talytics.AnomalyAnalysisResponse.analysis_timestamp:type_name -> google.protobuf.Timestamp
+	242, // 121: talytics.AnalysisResult.created_at:type_name -> google.protobuf.Timestamp
+	180, // 122: talytics.GetAnalysisHistoryResponse.results:type_name -> talytics.AnalysisResult
+	183, // 123: talytics.ReliabilityResponse.overall:type_name -> talytics.ReliabilityEstimate
+	183, // 124: talytics.ReliabilityResponse.criteria:type_name -> talytics.ReliabilityEstimate
+	187, // 125: talytics.AnovaTest.tukey_hsd:type_name -> talytics.PairwiseComparison
+	187, // 126: talytics.KruskalWallisTest.dunn:type_name -> talytics.PairwiseComparison
+	186, // 127: talytics.GraderDifferenceTest.graders:type_name -> talytics.GraderGroup
+	188, // 128: talytics.GraderDifferenceTest.anova:type_name -> talytics.AnovaTest
+	189, // 129: talytics.GraderDifferenceTest.kruskal_wallis:type_name -> talytics.KruskalWallisTest
+	190, // 130: talytics.GraderComparisonResponse.overall:type_name -> talytics.GraderDifferenceTest
+	190, // 131: talytics.GraderComparisonResponse.criteria:type_name -> talytics.GraderDifferenceTest
+	242, // 132: talytics.DriftPoint.graded_at:type_name -> google.protobuf.Timestamp
+	193, // 133: talytics.DriftSeries.points:type_name -> talytics.DriftPoint
+	194, // 134: talytics.DriftResponse.course:type_name -> talytics.DriftSeries
+	194, // 135: talytics.DriftResponse.graders:type_name -> talytics.DriftSeries
+	176, // 136: talytics.DriftResponse.anomalies:type_name -> talytics.Anomaly
+	197, // 137: talytics.CriterionLeniency.graders:type_name -> talytics.GraderLeniency
+	236, // 138: talytics.AdjustedGrade.rubric_scores:type_name -> talytics.AdjustedGrade.RubricScoresEntry
+	237, // 139: talytics.AdjustedGrade.adjusted_scores:type_name -> talytics.AdjustedGrade.AdjustedScoresEntry
+	238, // 140: talytics.AdjustedGrade.adjusted_lower:type_name -> talytics.AdjustedGrade.AdjustedLowerEntry
+	239, // 141: talytics.AdjustedGrade.adjusted_upper:type_name -> talytics.AdjustedGrade.AdjustedUpperEntry
+	198, // 142: talytics.LeniencyResponse.criteria:type_name -> talytics.CriterionLeniency
+	199, // 143: talytics.LeniencyResponse.grades:type_name -> talytics.AdjustedGrade
+	240, // 144: talytics.GraderScore.rubric_scores:type_name -> talytics.GraderScore.RubricScoresEntry
+	202, // 145: talytics.SubmissionDisagreement.grades:type_name -> talytics.GraderScore
+	241, // 146: talytics.SubmissionDisagreement.criterion_spreads:type_name -> talytics.SubmissionDisagreement.CriterionSpreadsEntry
+	203, // 147: talytics.DisagreementReportResponse.submissions:type_name -> talytics.SubmissionDisagreement
+	204, // 148: talytics.DisagreementReportResponse.grader_pairs:type_name -> talytics.GraderPairAgreement
+	242, // 149: talytics.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	242, // 150: talytics.LtiPlatform.created_at:type_name -> google.protobuf.Timestamp
+	210, // 151: talytics.LtiPlatformResponse.platform:type_name -> talytics.LtiPlatform
+	210, // 152: talytics.ListLtiPlatformsResponse.platforms:type_name -> talytics.LtiPlatform
+	215, // 153: talytics.ListLtiResourceLinksResponse.links:type_name -> talytics.LtiResourceLink
+	215, // 154: talytics.LtiResourceLinkResponse.link:type_name -> talytics.LtiResourceLink
+	221, // 155: talytics.PassbackGradesResponse.results:type_name -> talytics.LtiScoreResult
+	83,  // 156: talytics.QuestionDistribution.TaDistributionsEntry.value:type_name -> talytics.TADistribution
+	177, // 157: talytics.AnomalyAnalysisResponse.StatisticsEntry.value:type_name -> talytics.Statistics
+	1,   // 158: talytics.UserService.Register:input_type -> talytics.RegisterRequest
+	2,   // 159: talytics.UserService.Login:input_type -> talytics.LoginRequest
+	4,   // 160: talytics.UserService.Logout:input_type -> talytics.LogoutRequest
+	6,   // 161: talytics.UserService.GetProfile:input_type -> talytics.GetProfileRequest
+	7,   // 162: talytics.UserService.VerifyToken:input_type -> talytics.VerifyTokenRequest
+	11,  // 163: talytics.CourseService.CreateCourse:input_type -> talytics.CreateCourseRequest
+	12,  // 164: talytics.CourseService.GetCourse:input_type -> talytics.GetCourseRequest
+	13,  // 165: talytics.CourseService.ListCourses:input_type -> talytics.ListCoursesRequest
+	14,  // 166: talytics.CourseService.UpdateCourse:input_type -> talytics.UpdateCourseRequest
+	15,  // 167: talytics.CourseService.JoinCourse:input_type -> talytics.JoinCourseRequest
+	16,  // 168: talytics.CourseService.LeaveCourse:input_type -> talytics.LeaveCourseRequest
+	17,  // 169: talytics.CourseService.DeleteCourse:input_type -> talytics.DeleteCourseRequest
+	18,  // 170: talytics.CourseService.SetStudentId:input_type -> talytics.SetStudentIdRequest
+	25,  // 171: talytics.AssignmentService.CreateAssignment:input_type -> talytics.CreateAssignmentRequest
+	26,  // 172: talytics.AssignmentService.GetAssignment:input_type -> talytics.GetAssignmentRequest
+	27,  // 173: talytics.AssignmentService.ListAssignments:input_type -> talytics.ListAssignmentsRequest
+	28,  // 174: talytics.AssignmentService.UpdateAssignment:input_type -> talytics.UpdateAssignmentRequest
+	29,  // 175: talytics.AssignmentService.DeleteAssignment:input_type -> talytics.DeleteAssignmentRequest
+	32,  // 176: talytics.AssignmentService.SetReleaseState:input_type -> talytics.SetReleaseStateRequest
+	33,  // 177: talytics.AssignmentService.SetBlindGrading:input_type -> talytics.SetBlindGradingRequest
+	36,  // 178: talytics.SubmissionService.UploadSubmission:input_type -> talytics.UploadSubmissionRequest
+	37,  // 179: talytics.SubmissionService.GetSubmission:input_type -> talytics.GetSubmissionRequest
+	38,  // 180: talytics.SubmissionService.ListSubmissions:input_type -> talytics.ListSubmissionsRequest
+	37,  // 181: talytics.SubmissionService.GetSubmissionFile:input_type -> talytics.GetSubmissionRequest
+	39,  // 182: talytics.SubmissionService.DeleteSubmission:input_type -> talytics.DeleteSubmissionRequest
+	47,  // 183: talytics.RubricService.CreateRubric:input_type -> talytics.CreateRubricRequest
+	48,  // 184: talytics.RubricService.GetRubric:input_type -> talytics.GetRubricRequest
+	49,  // 185: talytics.RubricService.ListRubrics:input_type -> talytics.ListRubricsRequest
+	50,  // 186: talytics.RubricService.UpdateRubric:input_type -> talytics.UpdateRubricRequest
+	51,  // 187: talytics.RubricService.DeleteRubric:input_type -> talytics.DeleteRubricRequest
+	56,  // 188: talytics.RubricService.ListRubricVersions:input_type -> talytics.ListRubricVersionsRequest
+	58,  // 189: talytics.RubricService.CompareRubricVersions:input_type -> talytics.CompareRubricVersionsRequest
+	62,  // 190: talytics.RubricService.SimulateRubricChange:input_type -> talytics.SimulateRubricChangeRequest
+	74,  // 191: talytics.GradeService.UploadGrades:input_type -> talytics.UploadGradesRequest
+	77,  // 192: talytics.GradeService.ImportGrades:input_type -> talytics.GradeImportChunk
+	78,  // 193: talytics.GradeService.GetGradeStats:input_type -> talytics.GetGradeStatsRequest
+	81,  // 194: talytics.GradeService.GetGradeDistribution:input_type -> talytics.GetGradeDistributionRequest
+	86,  // 195: talytics.GradeService.SubmitGrade:input_type -> talytics.SubmitGradeRequest
+	88,  // 196: talytics.GradeService.GetSubmissionGrade:input_type -> talytics.GetSubmissionGradeRequest
+	90,  // 197: talytics.GradeService.ListAssignmentGrades:input_type -> talytics.ListAssignmentGradesRequest
+	91,  // 198: talytics.GradeService.ListRegradeQueue:input_type -> talytics.ListRegradeQueueRequest
+	94,  // 199: talytics.GradeService.GetGradeHistory:input_type -> talytics.GetGradeHistoryRequest
+	96,  // 200: talytics.GradeService.DiffGradeRevisions:input_type -> talytics.DiffGradeRevisionsRequest
+	100, // 201: talytics.GradeService.ListGradeComments:input_type -> talytics.ListGradeCommentsRequest
+	102, // 202: talytics.GradeService.PostGradeComment:input_type -> talytics.PostGradeCommentRequest
+	208, // 203: talytics.GradebookService.ExportGradebook:input_type -> talytics.ExportGradebookRequest
+	175, // 204: talytics.AnalysisService.RunAnomalyAnalysis:input_type -> talytics.RunAnomalyAnalysisRequest
+	179, // 205: talytics.AnalysisService.GetAnalysisHistory:input_type -> talytics.GetAnalysisHistoryRequest
+	182, // 206: talytics.AnalysisService.GetReliability:input_type -> talytics.GetReliabilityRequest
+	185, // 207: talytics.AnalysisService.CompareGraders:input_type -> talytics.CompareGradersRequest
+	192, // 208: talytics.AnalysisService.DetectDrift:input_type -> talytics.DetectDriftRequest
+	196, // 209: talytics.AnalysisService.EstimateLeniency:input_type -> talytics.EstimateLeniencyRequest
+	201, // 210: talytics.AnalysisService.GetDisagreementReport:input_type -> talytics.GetDisagreementReportRequest
+	104, // 211: talytics.GradingAssignmentService.DistributeSubmissions:input_type -> talytics.DistributeSubmissionsRequest
+	107, // 212: talytics.GradingAssignmentService.ListGradingQueue:input_type -> talytics.ListGradingQueueRequest
+	110, // 213: talytics.GradingAssignmentService.GetGradingProgress:input_type -> talytics.GetGradingProgressRequest
+	113, // 214: talytics.GradingAssignmentService.ConfigureOverlap:input_type -> talytics.ConfigureOverlapRequest
+	116, // 215: talytics.CalibrationService.SetGoldGrade:input_type -> talytics.SetGoldGradeRequest
+	119, // 216: talytics.CalibrationService.ListCalibrationSubmissions:input_type -> talytics.ListCalibrationSubmissionsRequest
+	117, // 217: talytics.CalibrationService.SubmitCalibrationGrade:input_type -> talytics.SubmitCalibrationGradeRequest
+	122, // 218: talytics.CalibrationService.GetCalibrationReport:input_type -> talytics.GetCalibrationReportRequest
+	126, // 219: talytics.CalibrationService.ConfigureCalibration:input_type -> talytics.ConfigureCalibrationRequest
+	128, // 220: talytics.CalibrationService.ResetCalibration:input_type -> talytics.ResetCalibrationRequest
+	131, // 221: talytics.RegradeService.CreateRegradeRequest:input_type -> talytics.CreateRegradeRequestRequest
+	133, // 222: talytics.RegradeService.ListRegradeRequests:input_type -> talytics.ListRegradeRequestsRequest
+	135, // 223: talytics.RegradeService.GetRegradeRequest:input_type -> talytics.GetRegradeRequestRequest
+	136, // 224: talytics.RegradeService.AssignRegradeRequest:input_type -> talytics.AssignRegradeRequestRequest
+	137, // 225: talytics.RegradeService.AcceptRegradeRequest:input_type -> talytics.AcceptRegradeRequestRequest
+	138, // 226: talytics.RegradeService.RejectRegradeRequest:input_type -> talytics.RejectRegradeRequestRequest
+	139, // 227: talytics.RegradeService.ResolveRegradeRequest:input_type -> talytics.ResolveRegradeRequestRequest
+	140, // 228: talytics.RegradeService.GetRegradeStats:input_type -> talytics.GetRegradeStatsRequest
+	143, // 229: talytics.StudentService.ListStudentCourses:input_type -> talytics.ListStudentCoursesRequest
+	146, // 230: talytics.StudentService.ListStudentAssignments:input_type -> talytics.ListStudentAssignmentsRequest
+	149, // 231: talytics.StudentService.ListStudentSubmissions:input_type -> talytics.ListStudentSubmissionsRequest
+	37,  // 232: talytics.StudentService.GetStudentSubmissionFile:input_type -> talytics.GetSubmissionRequest
+	152, // 233: talytics.StudentService.GetStudentGrade:input_type -> talytics.GetStudentGradeRequest
+	157, // 234: talytics.StudentService.GetStudentRubric:input_type -> talytics.GetStudentRubricRequest
+	156, // 235: talytics.StudentService.CreateStudentRegradeRequest:input_type -> talytics.CreateStudentRegradeRequestRequest
+	161, // 236: talytics.CommentBankService.CreateBankComment:input_type -> talytics.CreateBankCommentRequest
+	162, // 237: talytics.CommentBankService.ListBankComments:input_type -> talytics.ListBankCommentsRequest
+	164, // 238: talytics.CommentBankService.UpdateBankComment:input_type -> talytics.UpdateBankCommentRequest
+	166, // 239: talytics.CommentBankService.DeleteBankComment:input_type -> talytics.DeleteBankCommentRequest
+	168, // 240: talytics.CommentBankService.ApplyBankComment:input_type -> talytics.ApplyBankCommentRequest
+	168, // 241: talytics.CommentBankService.RemoveBankComment:input_type -> talytics.ApplyBankCommentRequest
+	170, // 242: talytics.CommentBankService.GetCommentBankStats:input_type -> talytics.GetCommentBankStatsRequest
+	211, // 243: talytics.LtiService.RegisterPlatform:input_type -> talytics.RegisterLtiPlatformRequest
+	213, // 244: talytics.LtiService.ListPlatforms:input_type -> talytics.ListLtiPlatformsRequest
+	216, // 245: talytics.LtiService.ListResourceLinks:input_type -> talytics.ListLtiResourceLinksRequest
+	218, // 246: talytics.LtiService.LinkResource:input_type -> talytics.LinkLtiResourceRequest
+	220, // 247: talytics.LtiService.PassbackGrades:input_type -> talytics.PassbackGradesRequest
+	206, // 248: talytics.HealthService.Check:input_type -> talytics.HealthCheckRequest
+	3,   // 249: talytics.UserService.Register:output_type -> talytics.AuthResponse
+	3,   // 250: talytics.UserService.Login:output_type -> talytics.AuthResponse
+	5,   // 251: talytics.UserService.Logout:output_type -> talytics.LogoutResponse
+	8,   // 252: talytics.UserService.GetProfile:output_type -> talytics.UserResponse
+	8,   // 253: talytics.UserService.VerifyToken:output_type -> talytics.UserResponse
+	19,  // 254: talytics.CourseService.CreateCourse:output_type -> talytics.CourseResponse
+	19,  // 255: talytics.CourseService.GetCourse:output_type -> talytics.CourseResponse
+	20,  // 256: talytics.CourseService.ListCourses:output_type -> talytics.ListCoursesResponse
+	19,  // 257: talytics.CourseService.UpdateCourse:output_type -> talytics.CourseResponse
+	19,  // 258: talytics.CourseService.JoinCourse:output_type -> talytics.CourseResponse
+	21,  // 259: talytics.CourseService.LeaveCourse:output_type -> talytics.LeaveCourseResponse
+	22,  // 260: talytics.CourseService.DeleteCourse:output_type -> talytics.DeleteCourseResponse
+	23,  // 261: talytics.CourseService.SetStudentId:output_type -> talytics.SetStudentIdResponse
+	30,  // 262: talytics.AssignmentService.CreateAssignment:output_type -> talytics.AssignmentResponse
+	30,  // 263: talytics.AssignmentService.GetAssignment:output_type -> talytics.AssignmentResponse
+	31,  // 264: talytics.AssignmentService.ListAssignments:output_type -> talytics.ListAssignmentsResponse
+	30,  // 265: talytics.AssignmentService.UpdateAssignment:output_type -> talytics.AssignmentResponse
+	34,  // 266: talytics.AssignmentService.DeleteAssignment:output_type -> talytics.DeleteAssignmentResponse
+	30,  // 267: talytics.AssignmentService.SetReleaseState:output_type -> talytics.AssignmentResponse
+	30,  // 268: talytics.AssignmentService.SetBlindGrading:output_type -> talytics.AssignmentResponse
+	40,  // 269: talytics.SubmissionService.UploadSubmission:output_type -> talytics.SubmissionResponse
+	40,  // 270: talytics.SubmissionService.GetSubmission:output_type -> talytics.SubmissionResponse
+	41,  // 271: talytics.SubmissionService.ListSubmissions:output_type -> talytics.ListSubmissionsResponse
+	42,  // 272: talytics.SubmissionService.GetSubmissionFile:output_type -> talytics.SubmissionFileResponse
+	43,  // 273: talytics.SubmissionService.DeleteSubmission:output_type -> talytics.DeleteSubmissionResponse
+	52,  // 274: talytics.RubricService.CreateRubric:output_type -> talytics.RubricResponse
+	52,  // 275: talytics.RubricService.GetRubric:output_type -> talytics.RubricResponse
+	53,  // 276: talytics.RubricService.ListRubrics:output_type -> talytics.ListRubricsResponse
+	52,  // 277: talytics.RubricService.UpdateRubric:output_type -> talytics.RubricResponse
+	54,  // 278: talytics.RubricService.DeleteRubric:output_type -> talytics.DeleteRubricResponse
+	57,  // 279: talytics.RubricService.ListRubricVersions:output_type -> talytics.ListRubricVersionsResponse
+	61,  // 280: talytics.RubricService.CompareRubricVersions:output_type -> talytics.RubricVersionComparison
+	71,  // 281: talytics.RubricService.SimulateRubricChange:output_type -> talytics.RubricImpactResponse
+	76,  // 282: talytics.GradeService.UploadGrades:output_type -> talytics.UploadGradesResponse
+	76,  // 283: talytics.GradeService.ImportGrades:output_type -> talytics.UploadGradesResponse
+	80,  // 284: talytics.GradeService.GetGradeStats:output_type -> talytics.GetGradeStatsResponse
+	84,  // 285: talytics.GradeService.GetGradeDistribution:output_type -> talytics.GetGradeDistributionResponse
+	87,  // 286: talytics.GradeService.SubmitGrade:output_type -> talytics.SubmitGradeResponse
+	89,  // 287: talytics.GradeService.GetSubmissionGrade:output_type -> talytics.SubmissionGradeResponse
+	92,  // 288: talytics.GradeService.ListAssignmentGrades:output_type -> talytics.ListGradesResponse
+	92,  // 289: talytics.GradeService.ListRegradeQueue:output_type -> talytics.ListGradesResponse
+	95,  // 290: talytics.GradeService.GetGradeHistory:output_type -> talytics.GradeHistoryResponse
+	98,  // 291: talytics.GradeService.DiffGradeRevisions:output_type -> talytics.GradeRevisionDiffResponse
+	101, // 292: talytics.GradeService.ListGradeComments:output_type -> talytics.ListGradeCommentsResponse
+	103, // 293: talytics.GradeService.PostGradeComment:output_type -> talytics.GradeCommentResponse
+	209, // 294: talytics.GradebookService.ExportGradebook:output_type -> talytics.GradebookChunk
+	178, // 295: talytics.AnalysisService.RunAnomalyAnalysis:output_type -> talytics.AnomalyAnalysisResponse
+	181, // 296: talytics.AnalysisService.GetAnalysisHistory:output_type -> talytics.GetAnalysisHistoryResponse
+	184, // 297: talytics.AnalysisService.GetReliability:output_type -> talytics.ReliabilityResponse
+	191, // 298: talytics.AnalysisService.CompareGraders:output_type -> talytics.GraderComparisonResponse
+	195, // 299: talytics.AnalysisService.DetectDrift:output_type -> talytics.DriftResponse
+	200, // 300: talytics.AnalysisService.EstimateLeniency:output_type -> talytics.LeniencyResponse
+	205, // 301: talytics.AnalysisService.GetDisagreementReport:output_type -> talytics.DisagreementReportResponse
+	106, // 302: talytics.GradingAssignmentService.DistributeSubmissions:output_type -> talytics.DistributeSubmissionsResponse
+	109, // 303: talytics.GradingAssignmentService.ListGradingQueue:output_type -> talytics.GradingQueueResponse
+	112, // 304: talytics.GradingAssignmentService.GetGradingProgress:output_type -> talytics.GradingProgressResponse
+	114, // 305: talytics.GradingAssignmentService.ConfigureOverlap:output_type -> talytics.OverlapSettingsResponse
+	118, // 306: talytics.CalibrationService.SetGoldGrade:output_type -> talytics.CalibrationGradeResponse
+	121, // 307: talytics.CalibrationService.ListCalibrationSubmissions:output_type -> talytics.CalibrationSubmissionsResponse
+	118, // 308: talytics.CalibrationService.SubmitCalibrationGrade:output_type -> talytics.CalibrationGradeResponse
+	125, // 309: talytics.CalibrationService.GetCalibrationReport:output_type -> talytics.CalibrationReportResponse
+	127, // 310: talytics.CalibrationService.ConfigureCalibration:output_type -> talytics.CalibrationSettingsResponse
+	129, // 311: talytics.CalibrationService.ResetCalibration:output_type -> talytics.ResetCalibrationResponse
+	132, // 312: talytics.RegradeService.CreateRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	134, // 313: talytics.RegradeService.ListRegradeRequests:output_type -> talytics.ListRegradeRequestsResponse
+	132, // 314: talytics.RegradeService.GetRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	132, // 315: talytics.RegradeService.AssignRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	132, // 316: talytics.RegradeService.AcceptRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	132, // 317: talytics.RegradeService.RejectRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	132, // 318: talytics.RegradeService.ResolveRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	142, // 319: talytics.RegradeService.GetRegradeStats:output_type -> talytics.RegradeStatsResponse
+	145, // 320: talytics.StudentService.ListStudentCourses:output_type -> talytics.StudentCoursesResponse
+	148, // 321: talytics.StudentService.ListStudentAssignments:output_type -> talytics.StudentAssignmentsResponse
+	151, // 322: talytics.StudentService.ListStudentSubmissions:output_type -> talytics.StudentSubmissionsResponse
+	42,  // 323: talytics.StudentService.GetStudentSubmissionFile:output_type -> talytics.SubmissionFileResponse
+	155, // 324: talytics.StudentService.GetStudentGrade:output_type -> talytics.StudentGradeResponse
+	159, // 325: talytics.StudentService.GetStudentRubric:output_type -> talytics.StudentRubricResponse
+	132, // 326: talytics.StudentService.CreateStudentRegradeRequest:output_type -> talytics.RegradeRequestResponse
+	165, // 327: talytics.CommentBankService.CreateBankComment:output_type -> talytics.BankCommentResponse
+	163, // 328: talytics.CommentBankService.ListBankComments:output_type -> talytics.ListBankCommentsResponse
+	165, // 329: talytics.CommentBankService.UpdateBankComment:output_type -> talytics.BankCommentResponse
+	167, // 330: talytics.CommentBankService.DeleteBankComment:output_type -> talytics.DeleteBankCommentResponse
+	87,  // 331: talytics.CommentBankService.ApplyBankComment:output_type -> talytics.SubmitGradeResponse
+	87,  // 332: talytics.CommentBankService.RemoveBankComment:output_type -> talytics.SubmitGradeResponse
+	174, // 333: talytics.CommentBankService.GetCommentBankStats:output_type -> talytics.CommentBankStatsResponse
+	212, // 334: talytics.LtiService.RegisterPlatform:output_type -> talytics.LtiPlatformResponse
+	214, // 335: talytics.LtiService.ListPlatforms:output_type -> talytics.ListLtiPlatformsResponse
+	217, // 336: talytics.LtiService.ListResourceLinks:output_type -> talytics.ListLtiResourceLinksResponse
+	219, // 337: talytics.LtiService.LinkResource:output_type -> talytics.LtiResourceLinkResponse
+	222, // 338: talytics.LtiService.PassbackGrades:output_type -> talytics.PassbackGradesResponse
+	207, // 339: talytics.HealthService.Check:output_type -> talytics.HealthCheckResponse
+	249, // [249:340] is the sub-list for method output_type
+	158, // [158:249] is the sub-list for method input_type
+	158, // [158:158] is the sub-list for extension type_name
+	158, // [158:158] is the sub-list for extension extendee
+	0,   // [0:158] is the sub-list for field type_name
+}
+
+func init() { file_proto_talytics_proto_init() }
+func file_proto_talytics_proto_init() {
+	if File_proto_talytics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_talytics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCoursesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStudentIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCoursesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveCourseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCourseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStudentIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssignmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssignmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReleaseStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBlindGradingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAssignmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubmissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubmissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubmissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rubric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricCriterion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRubricRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRubricRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRubricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRubricRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRubricRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRubricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRubricResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRubricVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRubricVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRubricVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricCriterionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricVersionScores); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricVersionComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateRubricChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionBin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentScoreDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderVariance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderScoreShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposedCriterionImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricImpactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadGradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeImportRowError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadGradesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeImportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradeDistributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TADistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradeDistributionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RubricGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitGradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionGradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionGradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAssignmentGradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegradeQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGradesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradeHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffGradeRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeRevisionDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeComment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGradeCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGradeCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostGradeCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributeSubmissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributeSubmissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGradingQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingQueueItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGradingProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureOverlapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OverlapSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGoldGradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitCalibrationGradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationGradeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCalibrationSubmissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationSubmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationSubmissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalibrationReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderCalibration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureCalibrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCalibrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCalibrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRegradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegradeRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegradeRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRegradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptRegradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectRegradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveRegradeRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegradeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderRegradeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStudentCoursesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentCourse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentCoursesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStudentAssignmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentAssignmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStudentSubmissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentSubmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentSubmissionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4520,8 +22047,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+		file_proto_talytics_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudentGradeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4532,8 +22059,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_proto_talytics_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentCriterionScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4544,8 +22071,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResponse); i {
+		file_proto_talytics_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentGrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4556,8 +22083,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogoutRequest); i {
+		file_proto_talytics_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentGradeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4568,8 +22095,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogoutResponse); i {
+		file_proto_talytics_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStudentRegradeRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4580,8 +22107,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProfileRequest); i {
+		file_proto_talytics_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudentRubricRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4592,8 +22119,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyTokenRequest); i {
+		file_proto_talytics_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentRubricCriterion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4604,8 +22131,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResponse); i {
+		file_proto_talytics_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentRubricResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4616,8 +22143,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_proto_talytics_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankComment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4628,8 +22155,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseMember); i {
+		file_proto_talytics_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBankCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4640,8 +22167,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCourseRequest); i {
+		file_proto_talytics_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBankCommentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4652,8 +22179,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCourseRequest); i {
+		file_proto_talytics_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBankCommentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4664,8 +22191,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCoursesRequest); i {
+		file_proto_talytics_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBankCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4676,8 +22203,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCourseRequest); i {
+		file_proto_talytics_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4688,8 +22215,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinCourseRequest); i {
+		file_proto_talytics_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBankCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4700,8 +22227,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveCourseRequest); i {
+		file_proto_talytics_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBankCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4712,8 +22239,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCourseRequest); i {
+		file_proto_talytics_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBankCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4724,8 +22251,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CourseResponse); i {
+		file_proto_talytics_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppliedBankComment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4736,8 +22263,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCoursesResponse); i {
+		file_proto_talytics_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentBankStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4748,8 +22275,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveCourseResponse); i {
+		file_proto_talytics_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderBankCommentUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4760,8 +22287,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCourseResponse); i {
+		file_proto_talytics_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankCommentStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4772,8 +22299,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_proto_talytics_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderBankUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4784,8 +22311,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAssignmentRequest); i {
+		file_proto_talytics_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentBankStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4796,8 +22323,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAssignmentRequest); i {
+		file_proto_talytics_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunAnomalyAnalysisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4808,8 +22335,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssignmentsRequest); i {
+		file_proto_talytics_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Anomaly); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4820,8 +22347,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAssignmentRequest); i {
+		file_proto_talytics_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4832,8 +22359,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAssignmentRequest); i {
+		file_proto_talytics_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnomalyAnalysisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4844,8 +22371,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignmentResponse); i {
+		file_proto_talytics_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnalysisHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4856,8 +22383,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAssignmentsResponse); i {
+		file_proto_talytics_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalysisResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4868,8 +22395,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAssignmentResponse); i {
+		file_proto_talytics_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnalysisHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4880,8 +22407,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rubric); i {
+		file_proto_talytics_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReliabilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4892,8 +22419,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRubricRequest); i {
+		file_proto_talytics_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReliabilityEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4904,8 +22431,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRubricRequest); i {
+		file_proto_talytics_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReliabilityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4916,8 +22443,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRubricsRequest); i {
+		file_proto_talytics_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareGradersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4928,8 +22455,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRubricRequest); i {
+		file_proto_talytics_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4940,8 +22467,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRubricRequest); i {
+		file_proto_talytics_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairwiseComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4952,8 +22479,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RubricResponse); i {
+		file_proto_talytics_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnovaTest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4964,8 +22491,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRubricsResponse); i {
+		file_proto_talytics_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KruskalWallisTest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4976,8 +22503,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRubricResponse); i {
+		file_proto_talytics_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderDifferenceTest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4988,8 +22515,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Grade); i {
+		file_proto_talytics_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderComparisonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5000,8 +22527,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradeData); i {
+		file_proto_talytics_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectDriftRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5012,8 +22539,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadGradesRequest); i {
+		file_proto_talytics_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriftPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5024,8 +22551,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadGradesResponse); i {
+		file_proto_talytics_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriftSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5036,8 +22563,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGradeStatsRequest); i {
+		file_proto_talytics_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DriftResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5048,8 +22575,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GradeStat); i {
+		file_proto_talytics_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateLeniencyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5060,8 +22587,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGradeStatsResponse); i {
+		file_proto_talytics_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderLeniency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5072,8 +22599,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGradeDistributionRequest); i {
+		file_proto_talytics_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionLeniency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5084,8 +22611,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuestionDistribution); i {
+		file_proto_talytics_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustedGrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5096,8 +22623,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TADistribution); i {
+		file_proto_talytics_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeniencyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5108,8 +22635,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGradeDistributionResponse); i {
+		file_proto_talytics_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDisagreementReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5120,8 +22647,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunAnomalyAnalysisRequest); i {
+		file_proto_talytics_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5132,8 +22659,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Anomaly); i {
+		file_proto_talytics_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionDisagreement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5144,8 +22671,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Statistics); i {
+		file_proto_talytics_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraderPairAgreement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5156,8 +22683,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnomalyAnalysisResponse); i {
+		file_proto_talytics_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisagreementReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5168,8 +22695,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAnalysisHistoryRequest); i {
+		file_proto_talytics_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5180,8 +22707,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnalysisResult); i {
+		file_proto_talytics_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5192,8 +22719,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAnalysisHistoryResponse); i {
+		file_proto_talytics_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGradebookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5204,8 +22731,8 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckRequest); i {
+		file_proto_talytics_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradebookChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5216,8 +22743,152 @@ func file_proto_talytics_proto_init() {
 				return nil
 			}
 		}
-		file_proto_talytics_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HealthCheckResponse); i {
+		file_proto_talytics_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtiPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterLtiPlatformRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtiPlatformResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLtiPlatformsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLtiPlatformsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtiResourceLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLtiResourceLinksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[217].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLtiResourceLinksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[218].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkLtiResourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtiResourceLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassbackGradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtiScoreResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_talytics_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassbackGradesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5235,9 +22906,9 @@ func file_proto_talytics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_talytics_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   63,
+			NumMessages:   242,
 			NumExtensions: 0,
-			NumServices:   7,
+			NumServices:   15,
 		},
 		GoTypes:           file_proto_talytics_proto_goTypes,
 		DependencyIndexes: file_proto_talytics_proto_depIdxs,
@@ -259,6 +259,7 @@ type CourseServiceClient interface {
 	JoinCourse(ctx context.Context, in *JoinCourseRequest, opts ...grpc.CallOption) (*CourseResponse, error)
 	LeaveCourse(ctx context.Context, in *LeaveCourseRequest, opts ...grpc.CallOption) (*LeaveCourseResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
+	SetStudentId(ctx context.Context, in *SetStudentIdRequest, opts ...grpc.CallOption) (*SetStudentIdResponse, error)
 }
 
 type courseServiceClient struct {
@@ -332,6 +333,15 @@ func (c *courseServiceClient) DeleteCourse(ctx context.Context, in *DeleteCourse
 	return out, nil
 }
 
+func (c *courseServiceClient) SetStudentId(ctx context.Context, in *SetStudentIdRequest, opts ...grpc.CallOption) (*SetStudentIdResponse, error) {
+	out := new(SetStudentIdResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CourseService/SetStudentId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CourseServiceServer is the server API for CourseService service.
 // All implementations must embed UnimplementedCourseServiceServer
 // for forward compatibility
@@ -343,6 +353,7 @@ type CourseServiceServer interface {
 	JoinCourse(context.Context, *JoinCourseRequest) (*CourseResponse, error)
 	LeaveCourse(context.Context, *LeaveCourseRequest) (*LeaveCourseResponse, error)
 	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	SetStudentId(context.Context, *SetStudentIdRequest) (*SetStudentIdResponse, error)
 	mustEmbedUnimplementedCourseServiceServer()
 }
 
@@ -371,6 +382,9 @@ func (UnimplementedCourseServiceServer) LeaveCourse(context.Context, *LeaveCours
 func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
 }
+func (UnimplementedCourseServiceServer) SetStudentId(context.Context, *SetStudentIdRequest) (*SetStudentIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStudentId not implemented")
+}
 func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
 
 // UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -510,6 +524,24 @@ func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_SetStudentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStudentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetStudentId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CourseService/SetStudentId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetStudentId(ctx, req.(*SetStudentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -545,6 +577,10 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCourse",
 			Handler:    _CourseService_DeleteCourse_Handler,
 		},
+		{
+			MethodName: "SetStudentId",
+			Handler:    _CourseService_SetStudentId_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/talytics.proto",
@@ -559,6 +595,8 @@ type AssignmentServiceClient interface {
 	ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
 	UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
 	DeleteAssignment(ctx context.Context, in *DeleteAssignmentRequest, opts ...grpc.CallOption) (*DeleteAssignmentResponse, error)
+	SetReleaseState(ctx context.Context, in *SetReleaseStateRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	SetBlindGrading(ctx context.Context, in *SetBlindGradingRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
 }
 
 type assignmentServiceClient struct {
@@ -614,6 +652,24 @@ func (c *assignmentServiceClient) DeleteAssignment(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *assignmentServiceClient) SetReleaseState(ctx context.Context, in *SetReleaseStateRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AssignmentService/SetReleaseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) SetBlindGrading(ctx context.Context, in *SetBlindGradingRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AssignmentService/SetBlindGrading", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssignmentServiceServer is the server API for AssignmentService service.
 // All implementations must embed UnimplementedAssignmentServiceServer
 // for forward compatibility
@@ -623,6 +679,8 @@ type AssignmentServiceServer interface {
 	ListAssignments(context.Context, *ListAssignmentsRequest) (*ListAssignmentsResponse, error)
 	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*AssignmentResponse, error)
 	DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*DeleteAssignmentResponse, error)
+	SetReleaseState(context.Context, *SetReleaseStateRequest) (*AssignmentResponse, error)
+	SetBlindGrading(context.Context, *SetBlindGradingRequest) (*AssignmentResponse, error)
 	mustEmbedUnimplementedAssignmentServiceServer()
 }
 
@@ -645,6 +703,12 @@ func (UnimplementedAssignmentServiceServer) UpdateAssignment(context.Context, *U
 func (UnimplementedAssignmentServiceServer) DeleteAssignment(context.Context, *DeleteAssignmentRequest) (*DeleteAssignmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
 }
+func (UnimplementedAssignmentServiceServer) SetReleaseState(context.Context, *SetReleaseStateRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReleaseState not implemented")
+}
+func (UnimplementedAssignmentServiceServer) SetBlindGrading(context.Context, *SetBlindGradingRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlindGrading not implemented")
+}
 func (UnimplementedAssignmentServiceServer) mustEmbedUnimplementedAssignmentServiceServer() {}
 
 // UnsafeAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -748,6 +812,42 @@ func _AssignmentService_DeleteAssignment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssignmentService_SetReleaseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReleaseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).SetReleaseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AssignmentService/SetReleaseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).SetReleaseState(ctx, req.(*SetReleaseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_SetBlindGrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlindGradingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).SetBlindGrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AssignmentService/SetBlindGrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).SetBlindGrading(ctx, req.(*SetBlindGradingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssignmentService_ServiceDesc is the grpc.ServiceDesc for AssignmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -775,6 +875,244 @@ var AssignmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAssignment",
 			Handler:    _AssignmentService_DeleteAssignment_Handler,
 		},
+		{
+			MethodName: "SetReleaseState",
+			Handler:    _AssignmentService_SetReleaseState_Handler,
+		},
+		{
+			MethodName: "SetBlindGrading",
+			Handler:    _AssignmentService_SetBlindGrading_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/talytics.proto",
+}
+
+// SubmissionServiceClient is the client API for SubmissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubmissionServiceClient interface {
+	UploadSubmission(ctx context.Context, in *UploadSubmissionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error)
+	GetSubmission(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error)
+	ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
+	GetSubmissionFile(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*SubmissionFileResponse, error)
+	DeleteSubmission(ctx context.Context, in *DeleteSubmissionRequest, opts ...grpc.CallOption) (*DeleteSubmissionResponse, error)
+}
+
+type submissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubmissionServiceClient(cc grpc.ClientConnInterface) SubmissionServiceClient {
+	return &submissionServiceClient{cc}
+}
+
+func (c *submissionServiceClient) UploadSubmission(ctx context.Context, in *UploadSubmissionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error) {
+	out := new(SubmissionResponse)
+	err := c.cc.Invoke(ctx, "/talytics.SubmissionService/UploadSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) GetSubmission(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*SubmissionResponse, error) {
+	out := new(SubmissionResponse)
+	err := c.cc.Invoke(ctx, "/talytics.SubmissionService/GetSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error) {
+	out := new(ListSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.SubmissionService/ListSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) GetSubmissionFile(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*SubmissionFileResponse, error) {
+	out := new(SubmissionFileResponse)
+	err := c.cc.Invoke(ctx, "/talytics.SubmissionService/GetSubmissionFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) DeleteSubmission(ctx context.Context, in *DeleteSubmissionRequest, opts ...grpc.CallOption) (*DeleteSubmissionResponse, error) {
+	out := new(DeleteSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/talytics.SubmissionService/DeleteSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubmissionServiceServer is the server API for SubmissionService service.
+// All implementations must embed UnimplementedSubmissionServiceServer
+// for forward compatibility
+type SubmissionServiceServer interface {
+	UploadSubmission(context.Context, *UploadSubmissionRequest) (*SubmissionResponse, error)
+	GetSubmission(context.Context, *GetSubmissionRequest) (*SubmissionResponse, error)
+	ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error)
+	GetSubmissionFile(context.Context, *GetSubmissionRequest) (*SubmissionFileResponse, error)
+	DeleteSubmission(context.Context, *DeleteSubmissionRequest) (*DeleteSubmissionResponse, error)
+	mustEmbedUnimplementedSubmissionServiceServer()
+}
+
+// UnimplementedSubmissionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSubmissionServiceServer struct {
+}
+
+func (UnimplementedSubmissionServiceServer) UploadSubmission(context.Context, *UploadSubmissionRequest) (*SubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadSubmission not implemented")
+}
+func (UnimplementedSubmissionServiceServer) GetSubmission(context.Context, *GetSubmissionRequest) (*SubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmission not implemented")
+}
+func (UnimplementedSubmissionServiceServer) ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissions not implemented")
+}
+func (UnimplementedSubmissionServiceServer) GetSubmissionFile(context.Context, *GetSubmissionRequest) (*SubmissionFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionFile not implemented")
+}
+func (UnimplementedSubmissionServiceServer) DeleteSubmission(context.Context, *DeleteSubmissionRequest) (*DeleteSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubmission not implemented")
+}
+func (UnimplementedSubmissionServiceServer) mustEmbedUnimplementedSubmissionServiceServer() {}
+
+// UnsafeSubmissionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubmissionServiceServer will
+// result in compilation errors.
+type UnsafeSubmissionServiceServer interface {
+	mustEmbedUnimplementedSubmissionServiceServer()
+}
+
+func RegisterSubmissionServiceServer(s grpc.ServiceRegistrar, srv SubmissionServiceServer) {
+	s.RegisterService(&SubmissionService_ServiceDesc, srv)
+}
+
+func _SubmissionService_UploadSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).UploadSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.SubmissionService/UploadSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).UploadSubmission(ctx, req.(*UploadSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).GetSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.SubmissionService/GetSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).GetSubmission(ctx, req.(*GetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_ListSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).ListSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.SubmissionService/ListSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).ListSubmissions(ctx, req.(*ListSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_GetSubmissionFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).GetSubmissionFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.SubmissionService/GetSubmissionFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).GetSubmissionFile(ctx, req.(*GetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_DeleteSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).DeleteSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.SubmissionService/DeleteSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).DeleteSubmission(ctx, req.(*DeleteSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubmissionService_ServiceDesc is the grpc.ServiceDesc for SubmissionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubmissionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.SubmissionService",
+	HandlerType: (*SubmissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadSubmission",
+			Handler:    _SubmissionService_UploadSubmission_Handler,
+		},
+		{
+			MethodName: "GetSubmission",
+			Handler:    _SubmissionService_GetSubmission_Handler,
+		},
+		{
+			MethodName: "ListSubmissions",
+			Handler:    _SubmissionService_ListSubmissions_Handler,
+		},
+		{
+			MethodName: "GetSubmissionFile",
+			Handler:    _SubmissionService_GetSubmissionFile_Handler,
+		},
+		{
+			MethodName: "DeleteSubmission",
+			Handler:    _SubmissionService_DeleteSubmission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/talytics.proto",
@@ -789,6 +1127,9 @@ type RubricServiceClient interface {
 	ListRubrics(ctx context.Context, in *ListRubricsRequest, opts ...grpc.CallOption) (*ListRubricsResponse, error)
 	UpdateRubric(ctx context.Context, in *UpdateRubricRequest, opts ...grpc.CallOption) (*RubricResponse, error)
 	DeleteRubric(ctx context.Context, in *DeleteRubricRequest, opts ...grpc.CallOption) (*DeleteRubricResponse, error)
+	ListRubricVersions(ctx context.Context, in *ListRubricVersionsRequest, opts ...grpc.CallOption) (*ListRubricVersionsResponse, error)
+	CompareRubricVersions(ctx context.Context, in *CompareRubricVersionsRequest, opts ...grpc.CallOption) (*RubricVersionComparison, error)
+	SimulateRubricChange(ctx context.Context, in *SimulateRubricChangeRequest, opts ...grpc.CallOption) (*RubricImpactResponse, error)
 }
 
 type rubricServiceClient struct {
@@ -844,6 +1185,33 @@ func (c *rubricServiceClient) DeleteRubric(ctx context.Context, in *DeleteRubric
 	return out, nil
 }
 
+func (c *rubricServiceClient) ListRubricVersions(ctx context.Context, in *ListRubricVersionsRequest, opts ...grpc.CallOption) (*ListRubricVersionsResponse, error) {
+	out := new(ListRubricVersionsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RubricService/ListRubricVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubricServiceClient) CompareRubricVersions(ctx context.Context, in *CompareRubricVersionsRequest, opts ...grpc.CallOption) (*RubricVersionComparison, error) {
+	out := new(RubricVersionComparison)
+	err := c.cc.Invoke(ctx, "/talytics.RubricService/CompareRubricVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubricServiceClient) SimulateRubricChange(ctx context.Context, in *SimulateRubricChangeRequest, opts ...grpc.CallOption) (*RubricImpactResponse, error) {
+	out := new(RubricImpactResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RubricService/SimulateRubricChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RubricServiceServer is the server API for RubricService service.
 // All implementations must embed UnimplementedRubricServiceServer
 // for forward compatibility
@@ -853,6 +1221,9 @@ type RubricServiceServer interface {
 	ListRubrics(context.Context, *ListRubricsRequest) (*ListRubricsResponse, error)
 	UpdateRubric(context.Context, *UpdateRubricRequest) (*RubricResponse, error)
 	DeleteRubric(context.Context, *DeleteRubricRequest) (*DeleteRubricResponse, error)
+	ListRubricVersions(context.Context, *ListRubricVersionsRequest) (*ListRubricVersionsResponse, error)
+	CompareRubricVersions(context.Context, *CompareRubricVersionsRequest) (*RubricVersionComparison, error)
+	SimulateRubricChange(context.Context, *SimulateRubricChangeRequest) (*RubricImpactResponse, error)
 	mustEmbedUnimplementedRubricServiceServer()
 }
 
@@ -875,6 +1246,15 @@ func (UnimplementedRubricServiceServer) UpdateRubric(context.Context, *UpdateRub
 func (UnimplementedRubricServiceServer) DeleteRubric(context.Context, *DeleteRubricRequest) (*DeleteRubricResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRubric not implemented")
 }
+func (UnimplementedRubricServiceServer) ListRubricVersions(context.Context, *ListRubricVersionsRequest) (*ListRubricVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRubricVersions not implemented")
+}
+func (UnimplementedRubricServiceServer) CompareRubricVersions(context.Context, *CompareRubricVersionsRequest) (*RubricVersionComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareRubricVersions not implemented")
+}
+func (UnimplementedRubricServiceServer) SimulateRubricChange(context.Context, *SimulateRubricChangeRequest) (*RubricImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRubricChange not implemented")
+}
 func (UnimplementedRubricServiceServer) mustEmbedUnimplementedRubricServiceServer() {}
 
 // UnsafeRubricServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -978,35 +1358,101 @@ func _RubricService_DeleteRubric_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// RubricService_ServiceDesc is the grpc.ServiceDesc for RubricService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RubricService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "talytics.RubricService",
-	HandlerType: (*RubricServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRubric",
-			Handler:    _RubricService_CreateRubric_Handler,
-		},
-		{
-			MethodName: "GetRubric",
-			Handler:    _RubricService_GetRubric_Handler,
-		},
-		{
-			MethodName: "ListRubrics",
-			Handler:    _RubricService_ListRubrics_Handler,
-		},
-		{
-			MethodName: "UpdateRubric",
-			Handler:    _RubricService_UpdateRubric_Handler,
-		},
-		{
-			MethodName: "DeleteRubric",
-			Handler:    _RubricService_DeleteRubric_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
+func _RubricService_ListRubricVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRubricVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubricServiceServer).ListRubricVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RubricService/ListRubricVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubricServiceServer).ListRubricVersions(ctx, req.(*ListRubricVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubricService_CompareRubricVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRubricVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubricServiceServer).CompareRubricVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RubricService/CompareRubricVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubricServiceServer).CompareRubricVersions(ctx, req.(*CompareRubricVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubricService_SimulateRubricChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRubricChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubricServiceServer).SimulateRubricChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RubricService/SimulateRubricChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubricServiceServer).SimulateRubricChange(ctx, req.(*SimulateRubricChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RubricService_ServiceDesc is the grpc.ServiceDesc for RubricService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RubricService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.RubricService",
+	HandlerType: (*RubricServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRubric",
+			Handler:    _RubricService_CreateRubric_Handler,
+		},
+		{
+			MethodName: "GetRubric",
+			Handler:    _RubricService_GetRubric_Handler,
+		},
+		{
+			MethodName: "ListRubrics",
+			Handler:    _RubricService_ListRubrics_Handler,
+		},
+		{
+			MethodName: "UpdateRubric",
+			Handler:    _RubricService_UpdateRubric_Handler,
+		},
+		{
+			MethodName: "DeleteRubric",
+			Handler:    _RubricService_DeleteRubric_Handler,
+		},
+		{
+			MethodName: "ListRubricVersions",
+			Handler:    _RubricService_ListRubricVersions_Handler,
+		},
+		{
+			MethodName: "CompareRubricVersions",
+			Handler:    _RubricService_CompareRubricVersions_Handler,
+		},
+		{
+			MethodName: "SimulateRubricChange",
+			Handler:    _RubricService_SimulateRubricChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/talytics.proto",
 }
 
@@ -1015,275 +1461,2560 @@ var RubricService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GradeServiceClient interface {
 	UploadGrades(ctx context.Context, in *UploadGradesRequest, opts ...grpc.CallOption) (*UploadGradesResponse, error)
+	ImportGrades(ctx context.Context, opts ...grpc.CallOption) (GradeService_ImportGradesClient, error)
 	GetGradeStats(ctx context.Context, in *GetGradeStatsRequest, opts ...grpc.CallOption) (*GetGradeStatsResponse, error)
 	GetGradeDistribution(ctx context.Context, in *GetGradeDistributionRequest, opts ...grpc.CallOption) (*GetGradeDistributionResponse, error)
+	SubmitGrade(ctx context.Context, in *SubmitGradeRequest, opts ...grpc.CallOption) (*SubmitGradeResponse, error)
+	GetSubmissionGrade(ctx context.Context, in *GetSubmissionGradeRequest, opts ...grpc.CallOption) (*SubmissionGradeResponse, error)
+	ListAssignmentGrades(ctx context.Context, in *ListAssignmentGradesRequest, opts ...grpc.CallOption) (*ListGradesResponse, error)
+	ListRegradeQueue(ctx context.Context, in *ListRegradeQueueRequest, opts ...grpc.CallOption) (*ListGradesResponse, error)
+	GetGradeHistory(ctx context.Context, in *GetGradeHistoryRequest, opts ...grpc.CallOption) (*GradeHistoryResponse, error)
+	DiffGradeRevisions(ctx context.Context, in *DiffGradeRevisionsRequest, opts ...grpc.CallOption) (*GradeRevisionDiffResponse, error)
+	ListGradeComments(ctx context.Context, in *ListGradeCommentsRequest, opts ...grpc.CallOption) (*ListGradeCommentsResponse, error)
+	PostGradeComment(ctx context.Context, in *PostGradeCommentRequest, opts ...grpc.CallOption) (*GradeCommentResponse, error)
+}
+
+type gradeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradeServiceClient(cc grpc.ClientConnInterface) GradeServiceClient {
+	return &gradeServiceClient{cc}
+}
+
+func (c *gradeServiceClient) UploadGrades(ctx context.Context, in *UploadGradesRequest, opts ...grpc.CallOption) (*UploadGradesResponse, error) {
+	out := new(UploadGradesResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/UploadGrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ImportGrades(ctx context.Context, opts ...grpc.CallOption) (GradeService_ImportGradesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GradeService_ServiceDesc.Streams[0], "/talytics.GradeService/ImportGrades", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gradeServiceImportGradesClient{stream}
+	return x, nil
+}
+
+type GradeService_ImportGradesClient interface {
+	Send(*GradeImportChunk) error
+	CloseAndRecv() (*UploadGradesResponse, error)
+	grpc.ClientStream
+}
+
+type gradeServiceImportGradesClient struct {
+	grpc.ClientStream
+}
+
+func (x *gradeServiceImportGradesClient) Send(m *GradeImportChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gradeServiceImportGradesClient) CloseAndRecv() (*UploadGradesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadGradesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gradeServiceClient) GetGradeStats(ctx context.Context, in *GetGradeStatsRequest, opts ...grpc.CallOption) (*GetGradeStatsResponse, error) {
+	out := new(GetGradeStatsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/GetGradeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) GetGradeDistribution(ctx context.Context, in *GetGradeDistributionRequest, opts ...grpc.CallOption) (*GetGradeDistributionResponse, error) {
+	out := new(GetGradeDistributionResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/GetGradeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) SubmitGrade(ctx context.Context, in *SubmitGradeRequest, opts ...grpc.CallOption) (*SubmitGradeResponse, error) {
+	out := new(SubmitGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/SubmitGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) GetSubmissionGrade(ctx context.Context, in *GetSubmissionGradeRequest, opts ...grpc.CallOption) (*SubmissionGradeResponse, error) {
+	out := new(SubmissionGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/GetSubmissionGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ListAssignmentGrades(ctx context.Context, in *ListAssignmentGradesRequest, opts ...grpc.CallOption) (*ListGradesResponse, error) {
+	out := new(ListGradesResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/ListAssignmentGrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ListRegradeQueue(ctx context.Context, in *ListRegradeQueueRequest, opts ...grpc.CallOption) (*ListGradesResponse, error) {
+	out := new(ListGradesResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/ListRegradeQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) GetGradeHistory(ctx context.Context, in *GetGradeHistoryRequest, opts ...grpc.CallOption) (*GradeHistoryResponse, error) {
+	out := new(GradeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/GetGradeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) DiffGradeRevisions(ctx context.Context, in *DiffGradeRevisionsRequest, opts ...grpc.CallOption) (*GradeRevisionDiffResponse, error) {
+	out := new(GradeRevisionDiffResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/DiffGradeRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) ListGradeComments(ctx context.Context, in *ListGradeCommentsRequest, opts ...grpc.CallOption) (*ListGradeCommentsResponse, error) {
+	out := new(ListGradeCommentsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/ListGradeComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradeServiceClient) PostGradeComment(ctx context.Context, in *PostGradeCommentRequest, opts ...grpc.CallOption) (*GradeCommentResponse, error) {
+	out := new(GradeCommentResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradeService/PostGradeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradeServiceServer is the server API for GradeService service.
+// All implementations must embed UnimplementedGradeServiceServer
+// for forward compatibility
+type GradeServiceServer interface {
+	UploadGrades(context.Context, *UploadGradesRequest) (*UploadGradesResponse, error)
+	ImportGrades(GradeService_ImportGradesServer) error
+	GetGradeStats(context.Context, *GetGradeStatsRequest) (*GetGradeStatsResponse, error)
+	GetGradeDistribution(context.Context, *GetGradeDistributionRequest) (*GetGradeDistributionResponse, error)
+	SubmitGrade(context.Context, *SubmitGradeRequest) (*SubmitGradeResponse, error)
+	GetSubmissionGrade(context.Context, *GetSubmissionGradeRequest) (*SubmissionGradeResponse, error)
+	ListAssignmentGrades(context.Context, *ListAssignmentGradesRequest) (*ListGradesResponse, error)
+	ListRegradeQueue(context.Context, *ListRegradeQueueRequest) (*ListGradesResponse, error)
+	GetGradeHistory(context.Context, *GetGradeHistoryRequest) (*GradeHistoryResponse, error)
+	DiffGradeRevisions(context.Context, *DiffGradeRevisionsRequest) (*GradeRevisionDiffResponse, error)
+	ListGradeComments(context.Context, *ListGradeCommentsRequest) (*ListGradeCommentsResponse, error)
+	PostGradeComment(context.Context, *PostGradeCommentRequest) (*GradeCommentResponse, error)
+	mustEmbedUnimplementedGradeServiceServer()
+}
+
+// UnimplementedGradeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGradeServiceServer struct {
+}
+
+func (UnimplementedGradeServiceServer) UploadGrades(context.Context, *UploadGradesRequest) (*UploadGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadGrades not implemented")
+}
+func (UnimplementedGradeServiceServer) ImportGrades(GradeService_ImportGradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportGrades not implemented")
+}
+func (UnimplementedGradeServiceServer) GetGradeStats(context.Context, *GetGradeStatsRequest) (*GetGradeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeStats not implemented")
+}
+func (UnimplementedGradeServiceServer) GetGradeDistribution(context.Context, *GetGradeDistributionRequest) (*GetGradeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeDistribution not implemented")
+}
+func (UnimplementedGradeServiceServer) SubmitGrade(context.Context, *SubmitGradeRequest) (*SubmitGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitGrade not implemented")
+}
+func (UnimplementedGradeServiceServer) GetSubmissionGrade(context.Context, *GetSubmissionGradeRequest) (*SubmissionGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionGrade not implemented")
+}
+func (UnimplementedGradeServiceServer) ListAssignmentGrades(context.Context, *ListAssignmentGradesRequest) (*ListGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignmentGrades not implemented")
+}
+func (UnimplementedGradeServiceServer) ListRegradeQueue(context.Context, *ListRegradeQueueRequest) (*ListGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegradeQueue not implemented")
+}
+func (UnimplementedGradeServiceServer) GetGradeHistory(context.Context, *GetGradeHistoryRequest) (*GradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeHistory not implemented")
+}
+func (UnimplementedGradeServiceServer) DiffGradeRevisions(context.Context, *DiffGradeRevisionsRequest) (*GradeRevisionDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffGradeRevisions not implemented")
+}
+func (UnimplementedGradeServiceServer) ListGradeComments(context.Context, *ListGradeCommentsRequest) (*ListGradeCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradeComments not implemented")
+}
+func (UnimplementedGradeServiceServer) PostGradeComment(context.Context, *PostGradeCommentRequest) (*GradeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostGradeComment not implemented")
+}
+func (UnimplementedGradeServiceServer) mustEmbedUnimplementedGradeServiceServer() {}
+
+// UnsafeGradeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradeServiceServer will
+// result in compilation errors.
+type UnsafeGradeServiceServer interface {
+	mustEmbedUnimplementedGradeServiceServer()
+}
+
+func RegisterGradeServiceServer(s grpc.ServiceRegistrar, srv GradeServiceServer) {
+	s.RegisterService(&GradeService_ServiceDesc, srv)
+}
+
+func _GradeService_UploadGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).UploadGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/UploadGrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).UploadGrades(ctx, req.(*UploadGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ImportGrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GradeServiceServer).ImportGrades(&gradeServiceImportGradesServer{stream})
+}
+
+type GradeService_ImportGradesServer interface {
+	SendAndClose(*UploadGradesResponse) error
+	Recv() (*GradeImportChunk, error)
+	grpc.ServerStream
+}
+
+type gradeServiceImportGradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *gradeServiceImportGradesServer) SendAndClose(m *UploadGradesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gradeServiceImportGradesServer) Recv() (*GradeImportChunk, error) {
+	m := new(GradeImportChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GradeService_GetGradeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetGradeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/GetGradeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetGradeStats(ctx, req.(*GetGradeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_GetGradeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetGradeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/GetGradeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetGradeDistribution(ctx, req.(*GetGradeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_SubmitGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).SubmitGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/SubmitGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).SubmitGrade(ctx, req.(*SubmitGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_GetSubmissionGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetSubmissionGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/GetSubmissionGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetSubmissionGrade(ctx, req.(*GetSubmissionGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ListAssignmentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).ListAssignmentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/ListAssignmentGrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).ListAssignmentGrades(ctx, req.(*ListAssignmentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ListRegradeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegradeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).ListRegradeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/ListRegradeQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).ListRegradeQueue(ctx, req.(*ListRegradeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_GetGradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).GetGradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/GetGradeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).GetGradeHistory(ctx, req.(*GetGradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_DiffGradeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffGradeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).DiffGradeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/DiffGradeRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).DiffGradeRevisions(ctx, req.(*DiffGradeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_ListGradeComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradeCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).ListGradeComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/ListGradeComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).ListGradeComments(ctx, req.(*ListGradeCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradeService_PostGradeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostGradeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradeServiceServer).PostGradeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradeService/PostGradeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradeServiceServer).PostGradeComment(ctx, req.(*PostGradeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradeService_ServiceDesc is the grpc.ServiceDesc for GradeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.GradeService",
+	HandlerType: (*GradeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadGrades",
+			Handler:    _GradeService_UploadGrades_Handler,
+		},
+		{
+			MethodName: "GetGradeStats",
+			Handler:    _GradeService_GetGradeStats_Handler,
+		},
+		{
+			MethodName: "GetGradeDistribution",
+			Handler:    _GradeService_GetGradeDistribution_Handler,
+		},
+		{
+			MethodName: "SubmitGrade",
+			Handler:    _GradeService_SubmitGrade_Handler,
+		},
+		{
+			MethodName: "GetSubmissionGrade",
+			Handler:    _GradeService_GetSubmissionGrade_Handler,
+		},
+		{
+			MethodName: "ListAssignmentGrades",
+			Handler:    _GradeService_ListAssignmentGrades_Handler,
+		},
+		{
+			MethodName: "ListRegradeQueue",
+			Handler:    _GradeService_ListRegradeQueue_Handler,
+		},
+		{
+			MethodName: "GetGradeHistory",
+			Handler:    _GradeService_GetGradeHistory_Handler,
+		},
+		{
+			MethodName: "DiffGradeRevisions",
+			Handler:    _GradeService_DiffGradeRevisions_Handler,
+		},
+		{
+			MethodName: "ListGradeComments",
+			Handler:    _GradeService_ListGradeComments_Handler,
+		},
+		{
+			MethodName: "PostGradeComment",
+			Handler:    _GradeService_PostGradeComment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportGrades",
+			Handler:       _GradeService_ImportGrades_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/talytics.proto",
+}
+
+// GradebookServiceClient is the client API for GradebookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GradebookServiceClient interface {
+	ExportGradebook(ctx context.Context, in *ExportGradebookRequest, opts ...grpc.CallOption) (GradebookService_ExportGradebookClient, error)
+}
+
+type gradebookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradebookServiceClient(cc grpc.ClientConnInterface) GradebookServiceClient {
+	return &gradebookServiceClient{cc}
+}
+
+func (c *gradebookServiceClient) ExportGradebook(ctx context.Context, in *ExportGradebookRequest, opts ...grpc.CallOption) (GradebookService_ExportGradebookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GradebookService_ServiceDesc.Streams[0], "/talytics.GradebookService/ExportGradebook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gradebookServiceExportGradebookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GradebookService_ExportGradebookClient interface {
+	Recv() (*GradebookChunk, error)
+	grpc.ClientStream
+}
+
+type gradebookServiceExportGradebookClient struct {
+	grpc.ClientStream
+}
+
+func (x *gradebookServiceExportGradebookClient) Recv() (*GradebookChunk, error) {
+	m := new(GradebookChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GradebookServiceServer is the server API for GradebookService service.
+// All implementations must embed UnimplementedGradebookServiceServer
+// for forward compatibility
+type GradebookServiceServer interface {
+	ExportGradebook(*ExportGradebookRequest, GradebookService_ExportGradebookServer) error
+	mustEmbedUnimplementedGradebookServiceServer()
+}
+
+// UnimplementedGradebookServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGradebookServiceServer struct {
+}
+
+func (UnimplementedGradebookServiceServer) ExportGradebook(*ExportGradebookRequest, GradebookService_ExportGradebookServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportGradebook not implemented")
+}
+func (UnimplementedGradebookServiceServer) mustEmbedUnimplementedGradebookServiceServer() {}
+
+// UnsafeGradebookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradebookServiceServer will
+// result in compilation errors.
+type UnsafeGradebookServiceServer interface {
+	mustEmbedUnimplementedGradebookServiceServer()
+}
+
+func RegisterGradebookServiceServer(s grpc.ServiceRegistrar, srv GradebookServiceServer) {
+	s.RegisterService(&GradebookService_ServiceDesc, srv)
+}
+
+func _GradebookService_ExportGradebook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGradebookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GradebookServiceServer).ExportGradebook(m, &gradebookServiceExportGradebookServer{stream})
+}
+
+type GradebookService_ExportGradebookServer interface {
+	Send(*GradebookChunk) error
+	grpc.ServerStream
+}
+
+type gradebookServiceExportGradebookServer struct {
+	grpc.ServerStream
+}
+
+func (x *gradebookServiceExportGradebookServer) Send(m *GradebookChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GradebookService_ServiceDesc is the grpc.ServiceDesc for GradebookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradebookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.GradebookService",
+	HandlerType: (*GradebookServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportGradebook",
+			Handler:       _GradebookService_ExportGradebook_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/talytics.proto",
+}
+
+// AnalysisServiceClient is the client API for AnalysisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnalysisServiceClient interface {
+	RunAnomalyAnalysis(ctx context.Context, in *RunAnomalyAnalysisRequest, opts ...grpc.CallOption) (*AnomalyAnalysisResponse, error)
+	GetAnalysisHistory(ctx context.Context, in *GetAnalysisHistoryRequest, opts ...grpc.CallOption) (*GetAnalysisHistoryResponse, error)
+	GetReliability(ctx context.Context, in *GetReliabilityRequest, opts ...grpc.CallOption) (*ReliabilityResponse, error)
+	CompareGraders(ctx context.Context, in *CompareGradersRequest, opts ...grpc.CallOption) (*GraderComparisonResponse, error)
+	DetectDrift(ctx context.Context, in *DetectDriftRequest, opts ...grpc.CallOption) (*DriftResponse, error)
+	EstimateLeniency(ctx context.Context, in *EstimateLeniencyRequest, opts ...grpc.CallOption) (*LeniencyResponse, error)
+	GetDisagreementReport(ctx context.Context, in *GetDisagreementReportRequest, opts ...grpc.CallOption) (*DisagreementReportResponse, error)
+}
+
+type analysisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalysisServiceClient(cc grpc.ClientConnInterface) AnalysisServiceClient {
+	return &analysisServiceClient{cc}
+}
+
+func (c *analysisServiceClient) RunAnomalyAnalysis(ctx context.Context, in *RunAnomalyAnalysisRequest, opts ...grpc.CallOption) (*AnomalyAnalysisResponse, error) {
+	out := new(AnomalyAnalysisResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/RunAnomalyAnalysis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) GetAnalysisHistory(ctx context.Context, in *GetAnalysisHistoryRequest, opts ...grpc.CallOption) (*GetAnalysisHistoryResponse, error) {
+	out := new(GetAnalysisHistoryResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/GetAnalysisHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) GetReliability(ctx context.Context, in *GetReliabilityRequest, opts ...grpc.CallOption) (*ReliabilityResponse, error) {
+	out := new(ReliabilityResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/GetReliability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) CompareGraders(ctx context.Context, in *CompareGradersRequest, opts ...grpc.CallOption) (*GraderComparisonResponse, error) {
+	out := new(GraderComparisonResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/CompareGraders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) DetectDrift(ctx context.Context, in *DetectDriftRequest, opts ...grpc.CallOption) (*DriftResponse, error) {
+	out := new(DriftResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/DetectDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) EstimateLeniency(ctx context.Context, in *EstimateLeniencyRequest, opts ...grpc.CallOption) (*LeniencyResponse, error) {
+	out := new(LeniencyResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/EstimateLeniency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) GetDisagreementReport(ctx context.Context, in *GetDisagreementReportRequest, opts ...grpc.CallOption) (*DisagreementReportResponse, error) {
+	out := new(DisagreementReportResponse)
+	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/GetDisagreementReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalysisServiceServer is the server API for AnalysisService service.
+// All implementations must embed UnimplementedAnalysisServiceServer
+// for forward compatibility
+type AnalysisServiceServer interface {
+	RunAnomalyAnalysis(context.Context, *RunAnomalyAnalysisRequest) (*AnomalyAnalysisResponse, error)
+	GetAnalysisHistory(context.Context, *GetAnalysisHistoryRequest) (*GetAnalysisHistoryResponse, error)
+	GetReliability(context.Context, *GetReliabilityRequest) (*ReliabilityResponse, error)
+	CompareGraders(context.Context, *CompareGradersRequest) (*GraderComparisonResponse, error)
+	DetectDrift(context.Context, *DetectDriftRequest) (*DriftResponse, error)
+	EstimateLeniency(context.Context, *EstimateLeniencyRequest) (*LeniencyResponse, error)
+	GetDisagreementReport(context.Context, *GetDisagreementReportRequest) (*DisagreementReportResponse, error)
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+// UnimplementedAnalysisServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAnalysisServiceServer struct {
+}
+
+func (UnimplementedAnalysisServiceServer) RunAnomalyAnalysis(context.Context, *RunAnomalyAnalysisRequest) (*AnomalyAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunAnomalyAnalysis not implemented")
+}
+func (UnimplementedAnalysisServiceServer) GetAnalysisHistory(context.Context, *GetAnalysisHistoryRequest) (*GetAnalysisHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisHistory not implemented")
+}
+func (UnimplementedAnalysisServiceServer) GetReliability(context.Context, *GetReliabilityRequest) (*ReliabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReliability not implemented")
+}
+func (UnimplementedAnalysisServiceServer) CompareGraders(context.Context, *CompareGradersRequest) (*GraderComparisonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareGraders not implemented")
+}
+func (UnimplementedAnalysisServiceServer) DetectDrift(context.Context, *DetectDriftRequest) (*DriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectDrift not implemented")
+}
+func (UnimplementedAnalysisServiceServer) EstimateLeniency(context.Context, *EstimateLeniencyRequest) (*LeniencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateLeniency not implemented")
+}
+func (UnimplementedAnalysisServiceServer) GetDisagreementReport(context.Context, *GetDisagreementReportRequest) (*DisagreementReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDisagreementReport not implemented")
+}
+func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
+
+// UnsafeAnalysisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalysisServiceServer will
+// result in compilation errors.
+type UnsafeAnalysisServiceServer interface {
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+func RegisterAnalysisServiceServer(s grpc.ServiceRegistrar, srv AnalysisServiceServer) {
+	s.RegisterService(&AnalysisService_ServiceDesc, srv)
+}
+
+func _AnalysisService_RunAnomalyAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunAnomalyAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).RunAnomalyAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/RunAnomalyAnalysis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).RunAnomalyAnalysis(ctx, req.(*RunAnomalyAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_GetAnalysisHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalysisHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).GetAnalysisHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/GetAnalysisHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).GetAnalysisHistory(ctx, req.(*GetAnalysisHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_GetReliability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReliabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).GetReliability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/GetReliability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).GetReliability(ctx, req.(*GetReliabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_CompareGraders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareGradersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).CompareGraders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/CompareGraders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).CompareGraders(ctx, req.(*CompareGradersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_DetectDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).DetectDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/DetectDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).DetectDrift(ctx, req.(*DetectDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_EstimateLeniency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateLeniencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).EstimateLeniency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/EstimateLeniency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).EstimateLeniency(ctx, req.(*EstimateLeniencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_GetDisagreementReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisagreementReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).GetDisagreementReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.AnalysisService/GetDisagreementReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).GetDisagreementReport(ctx, req.(*GetDisagreementReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalysisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.AnalysisService",
+	HandlerType: (*AnalysisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunAnomalyAnalysis",
+			Handler:    _AnalysisService_RunAnomalyAnalysis_Handler,
+		},
+		{
+			MethodName: "GetAnalysisHistory",
+			Handler:    _AnalysisService_GetAnalysisHistory_Handler,
+		},
+		{
+			MethodName: "GetReliability",
+			Handler:    _AnalysisService_GetReliability_Handler,
+		},
+		{
+			MethodName: "CompareGraders",
+			Handler:    _AnalysisService_CompareGraders_Handler,
+		},
+		{
+			MethodName: "DetectDrift",
+			Handler:    _AnalysisService_DetectDrift_Handler,
+		},
+		{
+			MethodName: "EstimateLeniency",
+			Handler:    _AnalysisService_EstimateLeniency_Handler,
+		},
+		{
+			MethodName: "GetDisagreementReport",
+			Handler:    _AnalysisService_GetDisagreementReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/talytics.proto",
+}
+
+// GradingAssignmentServiceClient is the client API for GradingAssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GradingAssignmentServiceClient interface {
+	DistributeSubmissions(ctx context.Context, in *DistributeSubmissionsRequest, opts ...grpc.CallOption) (*DistributeSubmissionsResponse, error)
+	ListGradingQueue(ctx context.Context, in *ListGradingQueueRequest, opts ...grpc.CallOption) (*GradingQueueResponse, error)
+	GetGradingProgress(ctx context.Context, in *GetGradingProgressRequest, opts ...grpc.CallOption) (*GradingProgressResponse, error)
+	ConfigureOverlap(ctx context.Context, in *ConfigureOverlapRequest, opts ...grpc.CallOption) (*OverlapSettingsResponse, error)
+}
+
+type gradingAssignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradingAssignmentServiceClient(cc grpc.ClientConnInterface) GradingAssignmentServiceClient {
+	return &gradingAssignmentServiceClient{cc}
+}
+
+func (c *gradingAssignmentServiceClient) DistributeSubmissions(ctx context.Context, in *DistributeSubmissionsRequest, opts ...grpc.CallOption) (*DistributeSubmissionsResponse, error) {
+	out := new(DistributeSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradingAssignmentService/DistributeSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingAssignmentServiceClient) ListGradingQueue(ctx context.Context, in *ListGradingQueueRequest, opts ...grpc.CallOption) (*GradingQueueResponse, error) {
+	out := new(GradingQueueResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradingAssignmentService/ListGradingQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingAssignmentServiceClient) GetGradingProgress(ctx context.Context, in *GetGradingProgressRequest, opts ...grpc.CallOption) (*GradingProgressResponse, error) {
+	out := new(GradingProgressResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradingAssignmentService/GetGradingProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingAssignmentServiceClient) ConfigureOverlap(ctx context.Context, in *ConfigureOverlapRequest, opts ...grpc.CallOption) (*OverlapSettingsResponse, error) {
+	out := new(OverlapSettingsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.GradingAssignmentService/ConfigureOverlap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradingAssignmentServiceServer is the server API for GradingAssignmentService service.
+// All implementations must embed UnimplementedGradingAssignmentServiceServer
+// for forward compatibility
+type GradingAssignmentServiceServer interface {
+	DistributeSubmissions(context.Context, *DistributeSubmissionsRequest) (*DistributeSubmissionsResponse, error)
+	ListGradingQueue(context.Context, *ListGradingQueueRequest) (*GradingQueueResponse, error)
+	GetGradingProgress(context.Context, *GetGradingProgressRequest) (*GradingProgressResponse, error)
+	ConfigureOverlap(context.Context, *ConfigureOverlapRequest) (*OverlapSettingsResponse, error)
+	mustEmbedUnimplementedGradingAssignmentServiceServer()
+}
+
+// UnimplementedGradingAssignmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGradingAssignmentServiceServer struct {
+}
+
+func (UnimplementedGradingAssignmentServiceServer) DistributeSubmissions(context.Context, *DistributeSubmissionsRequest) (*DistributeSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeSubmissions not implemented")
+}
+func (UnimplementedGradingAssignmentServiceServer) ListGradingQueue(context.Context, *ListGradingQueueRequest) (*GradingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGradingQueue not implemented")
+}
+func (UnimplementedGradingAssignmentServiceServer) GetGradingProgress(context.Context, *GetGradingProgressRequest) (*GradingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingProgress not implemented")
+}
+func (UnimplementedGradingAssignmentServiceServer) ConfigureOverlap(context.Context, *ConfigureOverlapRequest) (*OverlapSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureOverlap not implemented")
+}
+func (UnimplementedGradingAssignmentServiceServer) mustEmbedUnimplementedGradingAssignmentServiceServer() {
+}
+
+// UnsafeGradingAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradingAssignmentServiceServer will
+// result in compilation errors.
+type UnsafeGradingAssignmentServiceServer interface {
+	mustEmbedUnimplementedGradingAssignmentServiceServer()
+}
+
+func RegisterGradingAssignmentServiceServer(s grpc.ServiceRegistrar, srv GradingAssignmentServiceServer) {
+	s.RegisterService(&GradingAssignmentService_ServiceDesc, srv)
+}
+
+func _GradingAssignmentService_DistributeSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistributeSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingAssignmentServiceServer).DistributeSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradingAssignmentService/DistributeSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingAssignmentServiceServer).DistributeSubmissions(ctx, req.(*DistributeSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingAssignmentService_ListGradingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingAssignmentServiceServer).ListGradingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradingAssignmentService/ListGradingQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingAssignmentServiceServer).ListGradingQueue(ctx, req.(*ListGradingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingAssignmentService_GetGradingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingAssignmentServiceServer).GetGradingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradingAssignmentService/GetGradingProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingAssignmentServiceServer).GetGradingProgress(ctx, req.(*GetGradingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingAssignmentService_ConfigureOverlap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureOverlapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingAssignmentServiceServer).ConfigureOverlap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.GradingAssignmentService/ConfigureOverlap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingAssignmentServiceServer).ConfigureOverlap(ctx, req.(*ConfigureOverlapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradingAssignmentService_ServiceDesc is the grpc.ServiceDesc for GradingAssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradingAssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.GradingAssignmentService",
+	HandlerType: (*GradingAssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DistributeSubmissions",
+			Handler:    _GradingAssignmentService_DistributeSubmissions_Handler,
+		},
+		{
+			MethodName: "ListGradingQueue",
+			Handler:    _GradingAssignmentService_ListGradingQueue_Handler,
+		},
+		{
+			MethodName: "GetGradingProgress",
+			Handler:    _GradingAssignmentService_GetGradingProgress_Handler,
+		},
+		{
+			MethodName: "ConfigureOverlap",
+			Handler:    _GradingAssignmentService_ConfigureOverlap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/talytics.proto",
+}
+
+// CalibrationServiceClient is the client API for CalibrationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalibrationServiceClient interface {
+	SetGoldGrade(ctx context.Context, in *SetGoldGradeRequest, opts ...grpc.CallOption) (*CalibrationGradeResponse, error)
+	ListCalibrationSubmissions(ctx context.Context, in *ListCalibrationSubmissionsRequest, opts ...grpc.CallOption) (*CalibrationSubmissionsResponse, error)
+	SubmitCalibrationGrade(ctx context.Context, in *SubmitCalibrationGradeRequest, opts ...grpc.CallOption) (*CalibrationGradeResponse, error)
+	GetCalibrationReport(ctx context.Context, in *GetCalibrationReportRequest, opts ...grpc.CallOption) (*CalibrationReportResponse, error)
+	ConfigureCalibration(ctx context.Context, in *ConfigureCalibrationRequest, opts ...grpc.CallOption) (*CalibrationSettingsResponse, error)
+	ResetCalibration(ctx context.Context, in *ResetCalibrationRequest, opts ...grpc.CallOption) (*ResetCalibrationResponse, error)
+}
+
+type calibrationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalibrationServiceClient(cc grpc.ClientConnInterface) CalibrationServiceClient {
+	return &calibrationServiceClient{cc}
+}
+
+func (c *calibrationServiceClient) SetGoldGrade(ctx context.Context, in *SetGoldGradeRequest, opts ...grpc.CallOption) (*CalibrationGradeResponse, error) {
+	out := new(CalibrationGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CalibrationService/SetGoldGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibrationServiceClient) ListCalibrationSubmissions(ctx context.Context, in *ListCalibrationSubmissionsRequest, opts ...grpc.CallOption) (*CalibrationSubmissionsResponse, error) {
+	out := new(CalibrationSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CalibrationService/ListCalibrationSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibrationServiceClient) SubmitCalibrationGrade(ctx context.Context, in *SubmitCalibrationGradeRequest, opts ...grpc.CallOption) (*CalibrationGradeResponse, error) {
+	out := new(CalibrationGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CalibrationService/SubmitCalibrationGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibrationServiceClient) GetCalibrationReport(ctx context.Context, in *GetCalibrationReportRequest, opts ...grpc.CallOption) (*CalibrationReportResponse, error) {
+	out := new(CalibrationReportResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CalibrationService/GetCalibrationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibrationServiceClient) ConfigureCalibration(ctx context.Context, in *ConfigureCalibrationRequest, opts ...grpc.CallOption) (*CalibrationSettingsResponse, error) {
+	out := new(CalibrationSettingsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CalibrationService/ConfigureCalibration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibrationServiceClient) ResetCalibration(ctx context.Context, in *ResetCalibrationRequest, opts ...grpc.CallOption) (*ResetCalibrationResponse, error) {
+	out := new(ResetCalibrationResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CalibrationService/ResetCalibration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalibrationServiceServer is the server API for CalibrationService service.
+// All implementations must embed UnimplementedCalibrationServiceServer
+// for forward compatibility
+type CalibrationServiceServer interface {
+	SetGoldGrade(context.Context, *SetGoldGradeRequest) (*CalibrationGradeResponse, error)
+	ListCalibrationSubmissions(context.Context, *ListCalibrationSubmissionsRequest) (*CalibrationSubmissionsResponse, error)
+	SubmitCalibrationGrade(context.Context, *SubmitCalibrationGradeRequest) (*CalibrationGradeResponse, error)
+	GetCalibrationReport(context.Context, *GetCalibrationReportRequest) (*CalibrationReportResponse, error)
+	ConfigureCalibration(context.Context, *ConfigureCalibrationRequest) (*CalibrationSettingsResponse, error)
+	ResetCalibration(context.Context, *ResetCalibrationRequest) (*ResetCalibrationResponse, error)
+	mustEmbedUnimplementedCalibrationServiceServer()
+}
+
+// UnimplementedCalibrationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCalibrationServiceServer struct {
+}
+
+func (UnimplementedCalibrationServiceServer) SetGoldGrade(context.Context, *SetGoldGradeRequest) (*CalibrationGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGoldGrade not implemented")
+}
+func (UnimplementedCalibrationServiceServer) ListCalibrationSubmissions(context.Context, *ListCalibrationSubmissionsRequest) (*CalibrationSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCalibrationSubmissions not implemented")
+}
+func (UnimplementedCalibrationServiceServer) SubmitCalibrationGrade(context.Context, *SubmitCalibrationGradeRequest) (*CalibrationGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCalibrationGrade not implemented")
+}
+func (UnimplementedCalibrationServiceServer) GetCalibrationReport(context.Context, *GetCalibrationReportRequest) (*CalibrationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalibrationReport not implemented")
+}
+func (UnimplementedCalibrationServiceServer) ConfigureCalibration(context.Context, *ConfigureCalibrationRequest) (*CalibrationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureCalibration not implemented")
+}
+func (UnimplementedCalibrationServiceServer) ResetCalibration(context.Context, *ResetCalibrationRequest) (*ResetCalibrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCalibration not implemented")
+}
+func (UnimplementedCalibrationServiceServer) mustEmbedUnimplementedCalibrationServiceServer() {}
+
+// UnsafeCalibrationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalibrationServiceServer will
+// result in compilation errors.
+type UnsafeCalibrationServiceServer interface {
+	mustEmbedUnimplementedCalibrationServiceServer()
+}
+
+func RegisterCalibrationServiceServer(s grpc.ServiceRegistrar, srv CalibrationServiceServer) {
+	s.RegisterService(&CalibrationService_ServiceDesc, srv)
+}
+
+func _CalibrationService_SetGoldGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGoldGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibrationServiceServer).SetGoldGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CalibrationService/SetGoldGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibrationServiceServer).SetGoldGrade(ctx, req.(*SetGoldGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalibrationService_ListCalibrationSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalibrationSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibrationServiceServer).ListCalibrationSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CalibrationService/ListCalibrationSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibrationServiceServer).ListCalibrationSubmissions(ctx, req.(*ListCalibrationSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalibrationService_SubmitCalibrationGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCalibrationGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibrationServiceServer).SubmitCalibrationGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CalibrationService/SubmitCalibrationGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibrationServiceServer).SubmitCalibrationGrade(ctx, req.(*SubmitCalibrationGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalibrationService_GetCalibrationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalibrationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibrationServiceServer).GetCalibrationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CalibrationService/GetCalibrationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibrationServiceServer).GetCalibrationReport(ctx, req.(*GetCalibrationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalibrationService_ConfigureCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibrationServiceServer).ConfigureCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CalibrationService/ConfigureCalibration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibrationServiceServer).ConfigureCalibration(ctx, req.(*ConfigureCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalibrationService_ResetCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibrationServiceServer).ResetCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CalibrationService/ResetCalibration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibrationServiceServer).ResetCalibration(ctx, req.(*ResetCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalibrationService_ServiceDesc is the grpc.ServiceDesc for CalibrationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalibrationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.CalibrationService",
+	HandlerType: (*CalibrationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetGoldGrade",
+			Handler:    _CalibrationService_SetGoldGrade_Handler,
+		},
+		{
+			MethodName: "ListCalibrationSubmissions",
+			Handler:    _CalibrationService_ListCalibrationSubmissions_Handler,
+		},
+		{
+			MethodName: "SubmitCalibrationGrade",
+			Handler:    _CalibrationService_SubmitCalibrationGrade_Handler,
+		},
+		{
+			MethodName: "GetCalibrationReport",
+			Handler:    _CalibrationService_GetCalibrationReport_Handler,
+		},
+		{
+			MethodName: "ConfigureCalibration",
+			Handler:    _CalibrationService_ConfigureCalibration_Handler,
+		},
+		{
+			MethodName: "ResetCalibration",
+			Handler:    _CalibrationService_ResetCalibration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/talytics.proto",
+}
+
+// RegradeServiceClient is the client API for RegradeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RegradeServiceClient interface {
+	CreateRegradeRequest(ctx context.Context, in *CreateRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+	ListRegradeRequests(ctx context.Context, in *ListRegradeRequestsRequest, opts ...grpc.CallOption) (*ListRegradeRequestsResponse, error)
+	GetRegradeRequest(ctx context.Context, in *GetRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+	AssignRegradeRequest(ctx context.Context, in *AssignRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+	AcceptRegradeRequest(ctx context.Context, in *AcceptRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+	RejectRegradeRequest(ctx context.Context, in *RejectRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+	ResolveRegradeRequest(ctx context.Context, in *ResolveRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+	GetRegradeStats(ctx context.Context, in *GetRegradeStatsRequest, opts ...grpc.CallOption) (*RegradeStatsResponse, error)
+}
+
+type regradeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRegradeServiceClient(cc grpc.ClientConnInterface) RegradeServiceClient {
+	return &regradeServiceClient{cc}
+}
+
+func (c *regradeServiceClient) CreateRegradeRequest(ctx context.Context, in *CreateRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/CreateRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) ListRegradeRequests(ctx context.Context, in *ListRegradeRequestsRequest, opts ...grpc.CallOption) (*ListRegradeRequestsResponse, error) {
+	out := new(ListRegradeRequestsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/ListRegradeRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) GetRegradeRequest(ctx context.Context, in *GetRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/GetRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) AssignRegradeRequest(ctx context.Context, in *AssignRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/AssignRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) AcceptRegradeRequest(ctx context.Context, in *AcceptRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/AcceptRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) RejectRegradeRequest(ctx context.Context, in *RejectRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/RejectRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) ResolveRegradeRequest(ctx context.Context, in *ResolveRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/ResolveRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *regradeServiceClient) GetRegradeStats(ctx context.Context, in *GetRegradeStatsRequest, opts ...grpc.CallOption) (*RegradeStatsResponse, error) {
+	out := new(RegradeStatsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.RegradeService/GetRegradeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegradeServiceServer is the server API for RegradeService service.
+// All implementations must embed UnimplementedRegradeServiceServer
+// for forward compatibility
+type RegradeServiceServer interface {
+	CreateRegradeRequest(context.Context, *CreateRegradeRequestRequest) (*RegradeRequestResponse, error)
+	ListRegradeRequests(context.Context, *ListRegradeRequestsRequest) (*ListRegradeRequestsResponse, error)
+	GetRegradeRequest(context.Context, *GetRegradeRequestRequest) (*RegradeRequestResponse, error)
+	AssignRegradeRequest(context.Context, *AssignRegradeRequestRequest) (*RegradeRequestResponse, error)
+	AcceptRegradeRequest(context.Context, *AcceptRegradeRequestRequest) (*RegradeRequestResponse, error)
+	RejectRegradeRequest(context.Context, *RejectRegradeRequestRequest) (*RegradeRequestResponse, error)
+	ResolveRegradeRequest(context.Context, *ResolveRegradeRequestRequest) (*RegradeRequestResponse, error)
+	GetRegradeStats(context.Context, *GetRegradeStatsRequest) (*RegradeStatsResponse, error)
+	mustEmbedUnimplementedRegradeServiceServer()
+}
+
+// UnimplementedRegradeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRegradeServiceServer struct {
+}
+
+func (UnimplementedRegradeServiceServer) CreateRegradeRequest(context.Context, *CreateRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRegradeRequest not implemented")
+}
+func (UnimplementedRegradeServiceServer) ListRegradeRequests(context.Context, *ListRegradeRequestsRequest) (*ListRegradeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegradeRequests not implemented")
+}
+func (UnimplementedRegradeServiceServer) GetRegradeRequest(context.Context, *GetRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegradeRequest not implemented")
+}
+func (UnimplementedRegradeServiceServer) AssignRegradeRequest(context.Context, *AssignRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRegradeRequest not implemented")
+}
+func (UnimplementedRegradeServiceServer) AcceptRegradeRequest(context.Context, *AcceptRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRegradeRequest not implemented")
+}
+func (UnimplementedRegradeServiceServer) RejectRegradeRequest(context.Context, *RejectRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRegradeRequest not implemented")
+}
+func (UnimplementedRegradeServiceServer) ResolveRegradeRequest(context.Context, *ResolveRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveRegradeRequest not implemented")
+}
+func (UnimplementedRegradeServiceServer) GetRegradeStats(context.Context, *GetRegradeStatsRequest) (*RegradeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegradeStats not implemented")
+}
+func (UnimplementedRegradeServiceServer) mustEmbedUnimplementedRegradeServiceServer() {}
+
+// UnsafeRegradeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RegradeServiceServer will
+// result in compilation errors.
+type UnsafeRegradeServiceServer interface {
+	mustEmbedUnimplementedRegradeServiceServer()
+}
+
+func RegisterRegradeServiceServer(s grpc.ServiceRegistrar, srv RegradeServiceServer) {
+	s.RegisterService(&RegradeService_ServiceDesc, srv)
+}
+
+func _RegradeService_CreateRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).CreateRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/CreateRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).CreateRegradeRequest(ctx, req.(*CreateRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_ListRegradeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegradeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).ListRegradeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/ListRegradeRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).ListRegradeRequests(ctx, req.(*ListRegradeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_GetRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).GetRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/GetRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).GetRegradeRequest(ctx, req.(*GetRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_AssignRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).AssignRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/AssignRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).AssignRegradeRequest(ctx, req.(*AssignRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_AcceptRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).AcceptRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/AcceptRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).AcceptRegradeRequest(ctx, req.(*AcceptRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_RejectRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).RejectRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/RejectRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).RejectRegradeRequest(ctx, req.(*RejectRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_ResolveRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).ResolveRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/ResolveRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).ResolveRegradeRequest(ctx, req.(*ResolveRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegradeService_GetRegradeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegradeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegradeServiceServer).GetRegradeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.RegradeService/GetRegradeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegradeServiceServer).GetRegradeStats(ctx, req.(*GetRegradeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RegradeService_ServiceDesc is the grpc.ServiceDesc for RegradeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RegradeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.RegradeService",
+	HandlerType: (*RegradeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRegradeRequest",
+			Handler:    _RegradeService_CreateRegradeRequest_Handler,
+		},
+		{
+			MethodName: "ListRegradeRequests",
+			Handler:    _RegradeService_ListRegradeRequests_Handler,
+		},
+		{
+			MethodName: "GetRegradeRequest",
+			Handler:    _RegradeService_GetRegradeRequest_Handler,
+		},
+		{
+			MethodName: "AssignRegradeRequest",
+			Handler:    _RegradeService_AssignRegradeRequest_Handler,
+		},
+		{
+			MethodName: "AcceptRegradeRequest",
+			Handler:    _RegradeService_AcceptRegradeRequest_Handler,
+		},
+		{
+			MethodName: "RejectRegradeRequest",
+			Handler:    _RegradeService_RejectRegradeRequest_Handler,
+		},
+		{
+			MethodName: "ResolveRegradeRequest",
+			Handler:    _RegradeService_ResolveRegradeRequest_Handler,
+		},
+		{
+			MethodName: "GetRegradeStats",
+			Handler:    _RegradeService_GetRegradeStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/talytics.proto",
+}
+
+// StudentServiceClient is the client API for StudentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StudentServiceClient interface {
+	ListStudentCourses(ctx context.Context, in *ListStudentCoursesRequest, opts ...grpc.CallOption) (*StudentCoursesResponse, error)
+	ListStudentAssignments(ctx context.Context, in *ListStudentAssignmentsRequest, opts ...grpc.CallOption) (*StudentAssignmentsResponse, error)
+	ListStudentSubmissions(ctx context.Context, in *ListStudentSubmissionsRequest, opts ...grpc.CallOption) (*StudentSubmissionsResponse, error)
+	GetStudentSubmissionFile(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*SubmissionFileResponse, error)
+	GetStudentGrade(ctx context.Context, in *GetStudentGradeRequest, opts ...grpc.CallOption) (*StudentGradeResponse, error)
+	GetStudentRubric(ctx context.Context, in *GetStudentRubricRequest, opts ...grpc.CallOption) (*StudentRubricResponse, error)
+	CreateStudentRegradeRequest(ctx context.Context, in *CreateStudentRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error)
+}
+
+type studentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStudentServiceClient(cc grpc.ClientConnInterface) StudentServiceClient {
+	return &studentServiceClient{cc}
+}
+
+func (c *studentServiceClient) ListStudentCourses(ctx context.Context, in *ListStudentCoursesRequest, opts ...grpc.CallOption) (*StudentCoursesResponse, error) {
+	out := new(StudentCoursesResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/ListStudentCourses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) ListStudentAssignments(ctx context.Context, in *ListStudentAssignmentsRequest, opts ...grpc.CallOption) (*StudentAssignmentsResponse, error) {
+	out := new(StudentAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/ListStudentAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) ListStudentSubmissions(ctx context.Context, in *ListStudentSubmissionsRequest, opts ...grpc.CallOption) (*StudentSubmissionsResponse, error) {
+	out := new(StudentSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/ListStudentSubmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentSubmissionFile(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*SubmissionFileResponse, error) {
+	out := new(SubmissionFileResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/GetStudentSubmissionFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentGrade(ctx context.Context, in *GetStudentGradeRequest, opts ...grpc.CallOption) (*StudentGradeResponse, error) {
+	out := new(StudentGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/GetStudentGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentRubric(ctx context.Context, in *GetStudentRubricRequest, opts ...grpc.CallOption) (*StudentRubricResponse, error) {
+	out := new(StudentRubricResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/GetStudentRubric", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) CreateStudentRegradeRequest(ctx context.Context, in *CreateStudentRegradeRequestRequest, opts ...grpc.CallOption) (*RegradeRequestResponse, error) {
+	out := new(RegradeRequestResponse)
+	err := c.cc.Invoke(ctx, "/talytics.StudentService/CreateStudentRegradeRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StudentServiceServer is the server API for StudentService service.
+// All implementations must embed UnimplementedStudentServiceServer
+// for forward compatibility
+type StudentServiceServer interface {
+	ListStudentCourses(context.Context, *ListStudentCoursesRequest) (*StudentCoursesResponse, error)
+	ListStudentAssignments(context.Context, *ListStudentAssignmentsRequest) (*StudentAssignmentsResponse, error)
+	ListStudentSubmissions(context.Context, *ListStudentSubmissionsRequest) (*StudentSubmissionsResponse, error)
+	GetStudentSubmissionFile(context.Context, *GetSubmissionRequest) (*SubmissionFileResponse, error)
+	GetStudentGrade(context.Context, *GetStudentGradeRequest) (*StudentGradeResponse, error)
+	GetStudentRubric(context.Context, *GetStudentRubricRequest) (*StudentRubricResponse, error)
+	CreateStudentRegradeRequest(context.Context, *CreateStudentRegradeRequestRequest) (*RegradeRequestResponse, error)
+	mustEmbedUnimplementedStudentServiceServer()
+}
+
+// UnimplementedStudentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStudentServiceServer struct {
+}
+
+func (UnimplementedStudentServiceServer) ListStudentCourses(context.Context, *ListStudentCoursesRequest) (*StudentCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentCourses not implemented")
+}
+func (UnimplementedStudentServiceServer) ListStudentAssignments(context.Context, *ListStudentAssignmentsRequest) (*StudentAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentAssignments not implemented")
+}
+func (UnimplementedStudentServiceServer) ListStudentSubmissions(context.Context, *ListStudentSubmissionsRequest) (*StudentSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentSubmissions not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentSubmissionFile(context.Context, *GetSubmissionRequest) (*SubmissionFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentSubmissionFile not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentGrade(context.Context, *GetStudentGradeRequest) (*StudentGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGrade not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentRubric(context.Context, *GetStudentRubricRequest) (*StudentRubricResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentRubric not implemented")
+}
+func (UnimplementedStudentServiceServer) CreateStudentRegradeRequest(context.Context, *CreateStudentRegradeRequestRequest) (*RegradeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStudentRegradeRequest not implemented")
+}
+func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
+
+// UnsafeStudentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StudentServiceServer will
+// result in compilation errors.
+type UnsafeStudentServiceServer interface {
+	mustEmbedUnimplementedStudentServiceServer()
+}
+
+func RegisterStudentServiceServer(s grpc.ServiceRegistrar, srv StudentServiceServer) {
+	s.RegisterService(&StudentService_ServiceDesc, srv)
+}
+
+func _StudentService_ListStudentCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).ListStudentCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/ListStudentCourses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).ListStudentCourses(ctx, req.(*ListStudentCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_ListStudentAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).ListStudentAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/ListStudentAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).ListStudentAssignments(ctx, req.(*ListStudentAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_ListStudentSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).ListStudentSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/ListStudentSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).ListStudentSubmissions(ctx, req.(*ListStudentSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetStudentSubmissionFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentSubmissionFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/GetStudentSubmissionFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentSubmissionFile(ctx, req.(*GetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetStudentGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/GetStudentGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentGrade(ctx, req.(*GetStudentGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetStudentRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/GetStudentRubric",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentRubric(ctx, req.(*GetStudentRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_CreateStudentRegradeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStudentRegradeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).CreateStudentRegradeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.StudentService/CreateStudentRegradeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).CreateStudentRegradeRequest(ctx, req.(*CreateStudentRegradeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StudentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.StudentService",
+	HandlerType: (*StudentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListStudentCourses",
+			Handler:    _StudentService_ListStudentCourses_Handler,
+		},
+		{
+			MethodName: "ListStudentAssignments",
+			Handler:    _StudentService_ListStudentAssignments_Handler,
+		},
+		{
+			MethodName: "ListStudentSubmissions",
+			Handler:    _StudentService_ListStudentSubmissions_Handler,
+		},
+		{
+			MethodName: "GetStudentSubmissionFile",
+			Handler:    _StudentService_GetStudentSubmissionFile_Handler,
+		},
+		{
+			MethodName: "GetStudentGrade",
+			Handler:    _StudentService_GetStudentGrade_Handler,
+		},
+		{
+			MethodName: "GetStudentRubric",
+			Handler:    _StudentService_GetStudentRubric_Handler,
+		},
+		{
+			MethodName: "CreateStudentRegradeRequest",
+			Handler:    _StudentService_CreateStudentRegradeRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/talytics.proto",
+}
+
+// CommentBankServiceClient is the client API for CommentBankService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentBankServiceClient interface {
+	CreateBankComment(ctx context.Context, in *CreateBankCommentRequest, opts ...grpc.CallOption) (*BankCommentResponse, error)
+	ListBankComments(ctx context.Context, in *ListBankCommentsRequest, opts ...grpc.CallOption) (*ListBankCommentsResponse, error)
+	UpdateBankComment(ctx context.Context, in *UpdateBankCommentRequest, opts ...grpc.CallOption) (*BankCommentResponse, error)
+	DeleteBankComment(ctx context.Context, in *DeleteBankCommentRequest, opts ...grpc.CallOption) (*DeleteBankCommentResponse, error)
+	ApplyBankComment(ctx context.Context, in *ApplyBankCommentRequest, opts ...grpc.CallOption) (*SubmitGradeResponse, error)
+	RemoveBankComment(ctx context.Context, in *ApplyBankCommentRequest, opts ...grpc.CallOption) (*SubmitGradeResponse, error)
+	GetCommentBankStats(ctx context.Context, in *GetCommentBankStatsRequest, opts ...grpc.CallOption) (*CommentBankStatsResponse, error)
+}
+
+type commentBankServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentBankServiceClient(cc grpc.ClientConnInterface) CommentBankServiceClient {
+	return &commentBankServiceClient{cc}
+}
+
+func (c *commentBankServiceClient) CreateBankComment(ctx context.Context, in *CreateBankCommentRequest, opts ...grpc.CallOption) (*BankCommentResponse, error) {
+	out := new(BankCommentResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/CreateBankComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type gradeServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *commentBankServiceClient) ListBankComments(ctx context.Context, in *ListBankCommentsRequest, opts ...grpc.CallOption) (*ListBankCommentsResponse, error) {
+	out := new(ListBankCommentsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/ListBankComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewGradeServiceClient(cc grpc.ClientConnInterface) GradeServiceClient {
-	return &gradeServiceClient{cc}
+func (c *commentBankServiceClient) UpdateBankComment(ctx context.Context, in *UpdateBankCommentRequest, opts ...grpc.CallOption) (*BankCommentResponse, error) {
+	out := new(BankCommentResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/UpdateBankComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *gradeServiceClient) UploadGrades(ctx context.Context, in *UploadGradesRequest, opts ...grpc.CallOption) (*UploadGradesResponse, error) {
-	out := new(UploadGradesResponse)
-	err := c.cc.Invoke(ctx, "/talytics.GradeService/UploadGrades", in, out, opts...)
+func (c *commentBankServiceClient) DeleteBankComment(ctx context.Context, in *DeleteBankCommentRequest, opts ...grpc.CallOption) (*DeleteBankCommentResponse, error) {
+	out := new(DeleteBankCommentResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/DeleteBankComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gradeServiceClient) GetGradeStats(ctx context.Context, in *GetGradeStatsRequest, opts ...grpc.CallOption) (*GetGradeStatsResponse, error) {
-	out := new(GetGradeStatsResponse)
-	err := c.cc.Invoke(ctx, "/talytics.GradeService/GetGradeStats", in, out, opts...)
+func (c *commentBankServiceClient) ApplyBankComment(ctx context.Context, in *ApplyBankCommentRequest, opts ...grpc.CallOption) (*SubmitGradeResponse, error) {
+	out := new(SubmitGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/ApplyBankComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gradeServiceClient) GetGradeDistribution(ctx context.Context, in *GetGradeDistributionRequest, opts ...grpc.CallOption) (*GetGradeDistributionResponse, error) {
-	out := new(GetGradeDistributionResponse)
-	err := c.cc.Invoke(ctx, "/talytics.GradeService/GetGradeDistribution", in, out, opts...)
+func (c *commentBankServiceClient) RemoveBankComment(ctx context.Context, in *ApplyBankCommentRequest, opts ...grpc.CallOption) (*SubmitGradeResponse, error) {
+	out := new(SubmitGradeResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/RemoveBankComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GradeServiceServer is the server API for GradeService service.
-// All implementations must embed UnimplementedGradeServiceServer
+func (c *commentBankServiceClient) GetCommentBankStats(ctx context.Context, in *GetCommentBankStatsRequest, opts ...grpc.CallOption) (*CommentBankStatsResponse, error) {
+	out := new(CommentBankStatsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.CommentBankService/GetCommentBankStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentBankServiceServer is the server API for CommentBankService service.
+// All implementations must embed UnimplementedCommentBankServiceServer
 // for forward compatibility
-type GradeServiceServer interface {
-	UploadGrades(context.Context, *UploadGradesRequest) (*UploadGradesResponse, error)
-	GetGradeStats(context.Context, *GetGradeStatsRequest) (*GetGradeStatsResponse, error)
-	GetGradeDistribution(context.Context, *GetGradeDistributionRequest) (*GetGradeDistributionResponse, error)
-	mustEmbedUnimplementedGradeServiceServer()
+type CommentBankServiceServer interface {
+	CreateBankComment(context.Context, *CreateBankCommentRequest) (*BankCommentResponse, error)
+	ListBankComments(context.Context, *ListBankCommentsRequest) (*ListBankCommentsResponse, error)
+	UpdateBankComment(context.Context, *UpdateBankCommentRequest) (*BankCommentResponse, error)
+	DeleteBankComment(context.Context, *DeleteBankCommentRequest) (*DeleteBankCommentResponse, error)
+	ApplyBankComment(context.Context, *ApplyBankCommentRequest) (*SubmitGradeResponse, error)
+	RemoveBankComment(context.Context, *ApplyBankCommentRequest) (*SubmitGradeResponse, error)
+	GetCommentBankStats(context.Context, *GetCommentBankStatsRequest) (*CommentBankStatsResponse, error)
+	mustEmbedUnimplementedCommentBankServiceServer()
 }
 
-// UnimplementedGradeServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGradeServiceServer struct {
+// UnimplementedCommentBankServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCommentBankServiceServer struct {
 }
 
-func (UnimplementedGradeServiceServer) UploadGrades(context.Context, *UploadGradesRequest) (*UploadGradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadGrades not implemented")
+func (UnimplementedCommentBankServiceServer) CreateBankComment(context.Context, *CreateBankCommentRequest) (*BankCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankComment not implemented")
 }
-func (UnimplementedGradeServiceServer) GetGradeStats(context.Context, *GetGradeStatsRequest) (*GetGradeStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGradeStats not implemented")
+func (UnimplementedCommentBankServiceServer) ListBankComments(context.Context, *ListBankCommentsRequest) (*ListBankCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankComments not implemented")
 }
-func (UnimplementedGradeServiceServer) GetGradeDistribution(context.Context, *GetGradeDistributionRequest) (*GetGradeDistributionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGradeDistribution not implemented")
+func (UnimplementedCommentBankServiceServer) UpdateBankComment(context.Context, *UpdateBankCommentRequest) (*BankCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankComment not implemented")
 }
-func (UnimplementedGradeServiceServer) mustEmbedUnimplementedGradeServiceServer() {}
+func (UnimplementedCommentBankServiceServer) DeleteBankComment(context.Context, *DeleteBankCommentRequest) (*DeleteBankCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankComment not implemented")
+}
+func (UnimplementedCommentBankServiceServer) ApplyBankComment(context.Context, *ApplyBankCommentRequest) (*SubmitGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBankComment not implemented")
+}
+func (UnimplementedCommentBankServiceServer) RemoveBankComment(context.Context, *ApplyBankCommentRequest) (*SubmitGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBankComment not implemented")
+}
+func (UnimplementedCommentBankServiceServer) GetCommentBankStats(context.Context, *GetCommentBankStatsRequest) (*CommentBankStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentBankStats not implemented")
+}
+func (UnimplementedCommentBankServiceServer) mustEmbedUnimplementedCommentBankServiceServer() {}
 
-// UnsafeGradeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GradeServiceServer will
+// UnsafeCommentBankServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentBankServiceServer will
 // result in compilation errors.
-type UnsafeGradeServiceServer interface {
-	mustEmbedUnimplementedGradeServiceServer()
+type UnsafeCommentBankServiceServer interface {
+	mustEmbedUnimplementedCommentBankServiceServer()
 }
 
-func RegisterGradeServiceServer(s grpc.ServiceRegistrar, srv GradeServiceServer) {
-	s.RegisterService(&GradeService_ServiceDesc, srv)
+func RegisterCommentBankServiceServer(s grpc.ServiceRegistrar, srv CommentBankServiceServer) {
+	s.RegisterService(&CommentBankService_ServiceDesc, srv)
 }
 
-func _GradeService_UploadGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadGradesRequest)
+func _CommentBankService_CreateBankComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GradeServiceServer).UploadGrades(ctx, in)
+		return srv.(CommentBankServiceServer).CreateBankComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/talytics.GradeService/UploadGrades",
+		FullMethod: "/talytics.CommentBankService/CreateBankComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradeServiceServer).UploadGrades(ctx, req.(*UploadGradesRequest))
+		return srv.(CommentBankServiceServer).CreateBankComment(ctx, req.(*CreateBankCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GradeService_GetGradeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGradeStatsRequest)
+func _CommentBankService_ListBankComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GradeServiceServer).GetGradeStats(ctx, in)
+		return srv.(CommentBankServiceServer).ListBankComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/talytics.GradeService/GetGradeStats",
+		FullMethod: "/talytics.CommentBankService/ListBankComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradeServiceServer).GetGradeStats(ctx, req.(*GetGradeStatsRequest))
+		return srv.(CommentBankServiceServer).ListBankComments(ctx, req.(*ListBankCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GradeService_GetGradeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGradeDistributionRequest)
+func _CommentBankService_UpdateBankComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GradeServiceServer).GetGradeDistribution(ctx, in)
+		return srv.(CommentBankServiceServer).UpdateBankComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/talytics.GradeService/GetGradeDistribution",
+		FullMethod: "/talytics.CommentBankService/UpdateBankComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradeServiceServer).GetGradeDistribution(ctx, req.(*GetGradeDistributionRequest))
+		return srv.(CommentBankServiceServer).UpdateBankComment(ctx, req.(*UpdateBankCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// GradeService_ServiceDesc is the grpc.ServiceDesc for GradeService service.
+func _CommentBankService_DeleteBankComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentBankServiceServer).DeleteBankComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CommentBankService/DeleteBankComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentBankServiceServer).DeleteBankComment(ctx, req.(*DeleteBankCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentBankService_ApplyBankComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBankCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentBankServiceServer).ApplyBankComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CommentBankService/ApplyBankComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentBankServiceServer).ApplyBankComment(ctx, req.(*ApplyBankCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentBankService_RemoveBankComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBankCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentBankServiceServer).RemoveBankComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CommentBankService/RemoveBankComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentBankServiceServer).RemoveBankComment(ctx, req.(*ApplyBankCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentBankService_GetCommentBankStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentBankStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentBankServiceServer).GetCommentBankStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.CommentBankService/GetCommentBankStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentBankServiceServer).GetCommentBankStats(ctx, req.(*GetCommentBankStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentBankService_ServiceDesc is the grpc.ServiceDesc for CommentBankService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var GradeService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "talytics.GradeService",
-	HandlerType: (*GradeServiceServer)(nil),
+var CommentBankService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.CommentBankService",
+	HandlerType: (*CommentBankServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "UploadGrades",
-			Handler:    _GradeService_UploadGrades_Handler,
+			MethodName: "CreateBankComment",
+			Handler:    _CommentBankService_CreateBankComment_Handler,
 		},
 		{
-			MethodName: "GetGradeStats",
-			Handler:    _GradeService_GetGradeStats_Handler,
+			MethodName: "ListBankComments",
+			Handler:    _CommentBankService_ListBankComments_Handler,
 		},
 		{
-			MethodName: "GetGradeDistribution",
-			Handler:    _GradeService_GetGradeDistribution_Handler,
+			MethodName: "UpdateBankComment",
+			Handler:    _CommentBankService_UpdateBankComment_Handler,
+		},
+		{
+			MethodName: "DeleteBankComment",
+			Handler:    _CommentBankService_DeleteBankComment_Handler,
+		},
+		{
+			MethodName: "ApplyBankComment",
+			Handler:    _CommentBankService_ApplyBankComment_Handler,
+		},
+		{
+			MethodName: "RemoveBankComment",
+			Handler:    _CommentBankService_RemoveBankComment_Handler,
+		},
+		{
+			MethodName: "GetCommentBankStats",
+			Handler:    _CommentBankService_GetCommentBankStats_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/talytics.proto",
 }
 
-// AnalysisServiceClient is the client API for AnalysisService service.
+// LtiServiceClient is the client API for LtiService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type AnalysisServiceClient interface {
-	RunAnomalyAnalysis(ctx context.Context, in *RunAnomalyAnalysisRequest, opts ...grpc.CallOption) (*AnomalyAnalysisResponse, error)
-	GetAnalysisHistory(ctx context.Context, in *GetAnalysisHistoryRequest, opts ...grpc.CallOption) (*GetAnalysisHistoryResponse, error)
+type LtiServiceClient interface {
+	RegisterPlatform(ctx context.Context, in *RegisterLtiPlatformRequest, opts ...grpc.CallOption) (*LtiPlatformResponse, error)
+	ListPlatforms(ctx context.Context, in *ListLtiPlatformsRequest, opts ...grpc.CallOption) (*ListLtiPlatformsResponse, error)
+	ListResourceLinks(ctx context.Context, in *ListLtiResourceLinksRequest, opts ...grpc.CallOption) (*ListLtiResourceLinksResponse, error)
+	LinkResource(ctx context.Context, in *LinkLtiResourceRequest, opts ...grpc.CallOption) (*LtiResourceLinkResponse, error)
+	PassbackGrades(ctx context.Context, in *PassbackGradesRequest, opts ...grpc.CallOption) (*PassbackGradesResponse, error)
 }
 
-type analysisServiceClient struct {
+type ltiServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewAnalysisServiceClient(cc grpc.ClientConnInterface) AnalysisServiceClient {
-	return &analysisServiceClient{cc}
+func NewLtiServiceClient(cc grpc.ClientConnInterface) LtiServiceClient {
+	return &ltiServiceClient{cc}
 }
 
-func (c *analysisServiceClient) RunAnomalyAnalysis(ctx context.Context, in *RunAnomalyAnalysisRequest, opts ...grpc.CallOption) (*AnomalyAnalysisResponse, error) {
-	out := new(AnomalyAnalysisResponse)
-	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/RunAnomalyAnalysis", in, out, opts...)
+func (c *ltiServiceClient) RegisterPlatform(ctx context.Context, in *RegisterLtiPlatformRequest, opts ...grpc.CallOption) (*LtiPlatformResponse, error) {
+	out := new(LtiPlatformResponse)
+	err := c.cc.Invoke(ctx, "/talytics.LtiService/RegisterPlatform", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *analysisServiceClient) GetAnalysisHistory(ctx context.Context, in *GetAnalysisHistoryRequest, opts ...grpc.CallOption) (*GetAnalysisHistoryResponse, error) {
-	out := new(GetAnalysisHistoryResponse)
-	err := c.cc.Invoke(ctx, "/talytics.AnalysisService/GetAnalysisHistory", in, out, opts...)
+func (c *ltiServiceClient) ListPlatforms(ctx context.Context, in *ListLtiPlatformsRequest, opts ...grpc.CallOption) (*ListLtiPlatformsResponse, error) {
+	out := new(ListLtiPlatformsResponse)
+	err := c.cc.Invoke(ctx, "/talytics.LtiService/ListPlatforms", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AnalysisServiceServer is the server API for AnalysisService service.
-// All implementations must embed UnimplementedAnalysisServiceServer
+func (c *ltiServiceClient) ListResourceLinks(ctx context.Context, in *ListLtiResourceLinksRequest, opts ...grpc.CallOption) (*ListLtiResourceLinksResponse, error) {
+	out := new(ListLtiResourceLinksResponse)
+	err := c.cc.Invoke(ctx, "/talytics.LtiService/ListResourceLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ltiServiceClient) LinkResource(ctx context.Context, in *LinkLtiResourceRequest, opts ...grpc.CallOption) (*LtiResourceLinkResponse, error) {
+	out := new(LtiResourceLinkResponse)
+	err := c.cc.Invoke(ctx, "/talytics.LtiService/LinkResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ltiServiceClient) PassbackGrades(ctx context.Context, in *PassbackGradesRequest, opts ...grpc.CallOption) (*PassbackGradesResponse, error) {
+	out := new(PassbackGradesResponse)
+	err := c.cc.Invoke(ctx, "/talytics.LtiService/PassbackGrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LtiServiceServer is the server API for LtiService service.
+// All implementations must embed UnimplementedLtiServiceServer
 // for forward compatibility
-type AnalysisServiceServer interface {
-	RunAnomalyAnalysis(context.Context, *RunAnomalyAnalysisRequest) (*AnomalyAnalysisResponse, error)
-	GetAnalysisHistory(context.Context, *GetAnalysisHistoryRequest) (*GetAnalysisHistoryResponse, error)
-	mustEmbedUnimplementedAnalysisServiceServer()
+type LtiServiceServer interface {
+	RegisterPlatform(context.Context, *RegisterLtiPlatformRequest) (*LtiPlatformResponse, error)
+	ListPlatforms(context.Context, *ListLtiPlatformsRequest) (*ListLtiPlatformsResponse, error)
+	ListResourceLinks(context.Context, *ListLtiResourceLinksRequest) (*ListLtiResourceLinksResponse, error)
+	LinkResource(context.Context, *LinkLtiResourceRequest) (*LtiResourceLinkResponse, error)
+	PassbackGrades(context.Context, *PassbackGradesRequest) (*PassbackGradesResponse, error)
+	mustEmbedUnimplementedLtiServiceServer()
 }
 
-// UnimplementedAnalysisServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedAnalysisServiceServer struct {
+// UnimplementedLtiServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLtiServiceServer struct {
 }
 
-func (UnimplementedAnalysisServiceServer) RunAnomalyAnalysis(context.Context, *RunAnomalyAnalysisRequest) (*AnomalyAnalysisResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunAnomalyAnalysis not implemented")
+func (UnimplementedLtiServiceServer) RegisterPlatform(context.Context, *RegisterLtiPlatformRequest) (*LtiPlatformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPlatform not implemented")
 }
-func (UnimplementedAnalysisServiceServer) GetAnalysisHistory(context.Context, *GetAnalysisHistoryRequest) (*GetAnalysisHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAnalysisHistory not implemented")
+func (UnimplementedLtiServiceServer) ListPlatforms(context.Context, *ListLtiPlatformsRequest) (*ListLtiPlatformsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlatforms not implemented")
 }
-func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
+func (UnimplementedLtiServiceServer) ListResourceLinks(context.Context, *ListLtiResourceLinksRequest) (*ListLtiResourceLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceLinks not implemented")
+}
+func (UnimplementedLtiServiceServer) LinkResource(context.Context, *LinkLtiResourceRequest) (*LtiResourceLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkResource not implemented")
+}
+func (UnimplementedLtiServiceServer) PassbackGrades(context.Context, *PassbackGradesRequest) (*PassbackGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PassbackGrades not implemented")
+}
+func (UnimplementedLtiServiceServer) mustEmbedUnimplementedLtiServiceServer() {}
 
-// UnsafeAnalysisServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AnalysisServiceServer will
+// UnsafeLtiServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LtiServiceServer will
 // result in compilation errors.
-type UnsafeAnalysisServiceServer interface {
-	mustEmbedUnimplementedAnalysisServiceServer()
+type UnsafeLtiServiceServer interface {
+	mustEmbedUnimplementedLtiServiceServer()
 }
 
-func RegisterAnalysisServiceServer(s grpc.ServiceRegistrar, srv AnalysisServiceServer) {
-	s.RegisterService(&AnalysisService_ServiceDesc, srv)
+func RegisterLtiServiceServer(s grpc.ServiceRegistrar, srv LtiServiceServer) {
+	s.RegisterService(&LtiService_ServiceDesc, srv)
 }
 
-func _AnalysisService_RunAnomalyAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RunAnomalyAnalysisRequest)
+func _LtiService_RegisterPlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterLtiPlatformRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalysisServiceServer).RunAnomalyAnalysis(ctx, in)
+		return srv.(LtiServiceServer).RegisterPlatform(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/talytics.AnalysisService/RunAnomalyAnalysis",
+		FullMethod: "/talytics.LtiService/RegisterPlatform",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnalysisServiceServer).RunAnomalyAnalysis(ctx, req.(*RunAnomalyAnalysisRequest))
+		return srv.(LtiServiceServer).RegisterPlatform(ctx, req.(*RegisterLtiPlatformRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnalysisService_GetAnalysisHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAnalysisHistoryRequest)
+func _LtiService_ListPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLtiPlatformsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnalysisServiceServer).GetAnalysisHistory(ctx, in)
+		return srv.(LtiServiceServer).ListPlatforms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/talytics.AnalysisService/GetAnalysisHistory",
+		FullMethod: "/talytics.LtiService/ListPlatforms",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnalysisServiceServer).GetAnalysisHistory(ctx, req.(*GetAnalysisHistoryRequest))
+		return srv.(LtiServiceServer).ListPlatforms(ctx, req.(*ListLtiPlatformsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+func _LtiService_ListResourceLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLtiResourceLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LtiServiceServer).ListResourceLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.LtiService/ListResourceLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LtiServiceServer).ListResourceLinks(ctx, req.(*ListLtiResourceLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LtiService_LinkResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkLtiResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LtiServiceServer).LinkResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.LtiService/LinkResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LtiServiceServer).LinkResource(ctx, req.(*LinkLtiResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LtiService_PassbackGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PassbackGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LtiServiceServer).PassbackGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/talytics.LtiService/PassbackGrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LtiServiceServer).PassbackGrades(ctx, req.(*PassbackGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LtiService_ServiceDesc is the grpc.ServiceDesc for LtiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var AnalysisService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "talytics.AnalysisService",
-	HandlerType: (*AnalysisServiceServer)(nil),
+var LtiService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "talytics.LtiService",
+	HandlerType: (*LtiServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "RunAnomalyAnalysis",
-			Handler:    _AnalysisService_RunAnomalyAnalysis_Handler,
+			MethodName: "RegisterPlatform",
+			Handler:    _LtiService_RegisterPlatform_Handler,
 		},
 		{
-			MethodName: "GetAnalysisHistory",
-			Handler:    _AnalysisService_GetAnalysisHistory_Handler,
+			MethodName: "ListPlatforms",
+			Handler:    _LtiService_ListPlatforms_Handler,
+		},
+		{
+			MethodName: "ListResourceLinks",
+			Handler:    _LtiService_ListResourceLinks_Handler,
+		},
+		{
+			MethodName: "LinkResource",
+			Handler:    _LtiService_LinkResource_Handler,
+		},
+		{
+			MethodName: "PassbackGrades",
+			Handler:    _LtiService_PassbackGrades_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},